@@ -66,7 +66,6 @@ type protobufWriter struct {
 	encodingTimeSamplingRate float64
 	encoder                  protobuf.AggregatedEncoder
 	p                        producer.Producer
-	numShards                uint32
 
 	closed  bool
 	m       aggregated.MetricWithStoragePolicy
@@ -90,7 +89,6 @@ func NewProtobufWriter(
 		encodingTimeSamplingRate: opts.EncodingTimeSamplingRate(),
 		encoder:                  protobuf.NewAggregatedEncoder(opts.BytesPool()),
 		p:                        producer,
-		numShards:                producer.NumShards(),
 		closed:                   false,
 		rand:                     rand.New(rand.NewSource(nowFn().UnixNano())),
 		metrics:                  newProtobufWriterMetrics(instrumentOpts.MetricsScope()),
@@ -110,14 +108,14 @@ func (w *protobufWriter) Write(mp aggregated.ChunkedMetricWithStoragePolicy) err
 	if w.encodingTimeSamplingRate > 0 && w.randFn() < w.encodingTimeSamplingRate {
 		encodeNanos = w.nowFn().UnixNano()
 	}
-	m, shard := w.prepare(mp)
+	m, shards := w.prepare(mp)
 	if err := w.encoder.Encode(m, encodeNanos); err != nil {
 		w.metrics.encodeErrors.Inc(1)
 		return err
 	}
 
 	w.metrics.encodeSuccess.Inc(1)
-	if err := w.p.Produce(newMessage(shard, mp.StoragePolicy, w.encoder.Buffer())); err != nil {
+	if err := w.p.Produce(newMessage(shards, mp.StoragePolicy, w.encoder.Buffer())); err != nil {
 		w.metrics.routeErrors.Inc(1)
 		return err
 	}
@@ -125,7 +123,7 @@ func (w *protobufWriter) Write(mp aggregated.ChunkedMetricWithStoragePolicy) err
 	return nil
 }
 
-func (w *protobufWriter) prepare(mp aggregated.ChunkedMetricWithStoragePolicy) (aggregated.MetricWithStoragePolicy, uint32) {
+func (w *protobufWriter) prepare(mp aggregated.ChunkedMetricWithStoragePolicy) (aggregated.MetricWithStoragePolicy, messageShards) {
 	// TODO(cw) Chunked metric has no 'type' field, consider adding one.
 	w.m.ID = w.m.ID[:0]
 	w.m.ID = append(w.m.ID, mp.Prefix...)
@@ -134,8 +132,16 @@ func (w *protobufWriter) prepare(mp aggregated.ChunkedMetricWithStoragePolicy) (
 	w.m.Metric.TimeNanos = mp.TimeNanos
 	w.m.Metric.Value = mp.Value
 	w.m.StoragePolicy = mp.StoragePolicy
-	shard := w.shardFn(w.m.ID, w.numShards)
-	return w.m, shard
+	// NB: The number of shards of the topic changes when it is resharded, the
+	// message is sharded for both the current and the pending number of shards
+	// so that the producer can write it to the shards of either.
+	shards := messageShards{numShards: w.p.NumShards()}
+	shards.shard = w.shardFn(w.m.ID, shards.numShards)
+	if pendingNumShards := w.p.PendingNumShards(); pendingNumShards > 0 {
+		shards.pendingNumShards = pendingNumShards
+		shards.pendingShard = w.shardFn(w.m.ID, pendingNumShards)
+	}
+	return w.m, shards
 }
 
 func (w *protobufWriter) Flush() error {
@@ -152,18 +158,38 @@ func (w *protobufWriter) Close() error {
 	return nil
 }
 
+// messageShards are the shards of a message for the number of shards and the
+// pending number of shards of the topic when the message was created.
+type messageShards struct {
+	numShards        uint32
+	shard            uint32
+	pendingNumShards uint32
+	pendingShard     uint32
+}
+
 type message struct {
-	shard uint32
-	sp    policy.StoragePolicy
-	data  protobuf.Buffer
+	shards messageShards
+	sp     policy.StoragePolicy
+	data   protobuf.Buffer
 }
 
-func newMessage(shard uint32, sp policy.StoragePolicy, data protobuf.Buffer) producer.Message {
-	return message{shard: shard, sp: sp, data: data}
+func newMessage(shards messageShards, sp policy.StoragePolicy, data protobuf.Buffer) producer.ReshardableMessage {
+	return message{shards: shards, sp: sp, data: data}
 }
 
 func (d message) Shard() uint32 {
-	return d.shard
+	return d.shards.shard
+}
+
+// ShardFor returns the shard of the message for the given number of shards,
+// the message is only sharded for the number of shards and the pending number
+// of shards of the topic when it was created, its shard is returned for any
+// other number of shards.
+func (d message) ShardFor(numShards uint32) uint32 {
+	if d.shards.pendingNumShards > 0 && numShards == d.shards.pendingNumShards {
+		return d.shards.pendingShard
+	}
+	return d.shards.shard
 }
 
 func (d message) Bytes() []byte {
@@ -94,8 +94,8 @@ func TestStoragePolicyFilter(t *testing.T) {
 	f := NewStoragePolicyFilter([]policy.StoragePolicy{sp2})
 
 	require.True(t, f(m2))
-	require.False(t, f(newMessage(0, sp1, protobuf.Buffer{})))
-	require.True(t, f(newMessage(0, sp2, protobuf.Buffer{})))
+	require.False(t, f(newMessage(messageShards{}, sp1, protobuf.Buffer{})))
+	require.True(t, f(newMessage(messageShards{}, sp2, protobuf.Buffer{})))
 }
 
 func TestProtobufWriterWriteClosed(t *testing.T) {
@@ -198,9 +198,48 @@ func TestProtobufWriterWrite(t *testing.T) {
 
 }
 
+func TestProtobufWriterWriteDuringReshard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		p       = producer.NewMockProducer(ctrl)
+		shardFn = sharding.Murmur32Hash.MustShardFn()
+		writer  = NewProtobufWriter(p, shardFn, NewOptions())
+		msgs    []producer.Message
+	)
+	p.EXPECT().Produce(gomock.Any()).DoAndReturn(func(m producer.Message) error {
+		msgs = append(msgs, m)
+		return nil
+	}).AnyTimes()
+
+	// The topic is being resharded from 2 to 16 shards.
+	p.EXPECT().NumShards().Return(uint32(2))
+	p.EXPECT().PendingNumShards().Return(uint32(16))
+	require.NoError(t, writer.Write(testChunkedMetricWithStoragePolicy))
+
+	// Resharding completed, the writer picks up the new number of shards.
+	p.EXPECT().NumShards().Return(uint32(16))
+	p.EXPECT().PendingNumShards().Return(uint32(0))
+	require.NoError(t, writer.Write(testChunkedMetricWithStoragePolicy))
+
+	require.Equal(t, 2, len(msgs))
+	m, ok := msgs[0].(producer.ReshardableMessage)
+	require.True(t, ok)
+	require.Equal(t, shardFn(testRawID, 2), m.Shard())
+	require.Equal(t, shardFn(testRawID, 2), m.ShardFor(2))
+	require.Equal(t, shardFn(testRawID, 16), m.ShardFor(16))
+
+	m, ok = msgs[1].(producer.ReshardableMessage)
+	require.True(t, ok)
+	require.Equal(t, shardFn(testRawID, 16), m.Shard())
+	require.Equal(t, shardFn(testRawID, 16), m.ShardFor(16))
+}
+
 func testProtobufWriter(t *testing.T, ctrl *gomock.Controller, opts Options) *protobufWriter {
 	p := producer.NewMockProducer(ctrl)
-	p.EXPECT().NumShards().Return(uint32(1024))
+	p.EXPECT().NumShards().Return(uint32(1024)).AnyTimes()
+	p.EXPECT().PendingNumShards().Return(uint32(0)).AnyTimes()
 	return NewProtobufWriter(p, sharding.Murmur32Hash.MustShardFn(), opts).(*protobufWriter)
 }
 
@@ -247,6 +247,56 @@ func (a mirroredAlgorithm) MarkAllShardsAvailable(
 	return a.shardedAlgo.MarkAllShardsAvailable(p)
 }
 
+func (a mirroredAlgorithm) UpdateInstanceWeights(
+	p placement.Placement,
+	weights map[string]uint32,
+) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	p, _, err := a.MarkAllShardsAvailable(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// All the instances in a shard set must be updated to the same weight,
+	// this is validated when the mirror placement is built.
+	if p, err = updateInstanceWeights(p, weights); err != nil {
+		return nil, err
+	}
+
+	return a.rebalance(p)
+}
+
+func (a mirroredAlgorithm) Rebalance(p placement.Placement) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	p, _, err := a.MarkAllShardsAvailable(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.rebalance(p)
+}
+
+// rebalance rebalances the shard sets rather than the individual instances so
+// that all the instances in a shard set keep owning the same shards.
+func (a mirroredAlgorithm) rebalance(p placement.Placement) (placement.Placement, error) {
+	mirrorPlacement, err := mirrorFromPlacement(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if mirrorPlacement, err = a.shardedAlgo.Rebalance(mirrorPlacement); err != nil {
+		return nil, err
+	}
+
+	return placementFromMirror(mirrorPlacement, p.Instances(), p.ReplicaFactor())
+}
+
 // allInitializing returns true when
 // 1: the given list of instances matches all the initializing instances in the placement.
 // 2: the shards are not cutover yet.
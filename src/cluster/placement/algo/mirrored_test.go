@@ -28,6 +28,7 @@ import (
 	"github.com/m3db/m3/src/cluster/shard"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMirrorWorkflow(t *testing.T) {
@@ -1573,3 +1574,62 @@ func TestMirrorAlgoWithSimpleShardStateType(t *testing.T) {
 	assert.NoError(t, placement.Validate(p))
 	verifyAllShardsInAvailableState(t, p)
 }
+
+func TestMirrorUpdateInstanceWeightsAndRebalance(t *testing.T) {
+	newInstance := func(id string, shardSetID uint32) placement.Instance {
+		return placement.NewInstance().
+			SetID(id).
+			SetIsolationGroup("r" + id).
+			SetEndpoint("endpoint-" + id).
+			SetShardSetID(shardSetID).
+			SetWeight(1)
+	}
+
+	numShards := 16
+	ids := make([]uint32, numShards)
+	for i := 0; i < len(ids); i++ {
+		ids[i] = uint32(i)
+	}
+
+	a := NewAlgorithm(placement.NewOptions().SetIsMirrored(true))
+	p, err := a.InitialPlacement([]placement.Instance{
+		newInstance("i1", 1),
+		newInstance("i2", 1),
+		newInstance("i3", 2),
+		newInstance("i4", 2),
+	}, ids, 2)
+	require.NoError(t, err)
+	p, _, err = a.MarkAllShardsAvailable(p)
+	require.NoError(t, err)
+
+	// Rebalancing a balanced placement does not move any shards.
+	p1, err := a.Rebalance(p)
+	require.NoError(t, err)
+	verifyAllShardsInAvailableState(t, p1)
+
+	// All the instances in a shard set must share the same weight.
+	_, err = a.UpdateInstanceWeights(p, map[string]uint32{"i3": 3})
+	require.Error(t, err)
+
+	p, err = a.UpdateInstanceWeights(p, map[string]uint32{"i3": 3, "i4": 3})
+	require.NoError(t, err)
+	assert.NoError(t, placement.Validate(p))
+
+	p, _, err = a.MarkAllShardsAvailable(p)
+	require.NoError(t, err)
+
+	i1, ok := p.Instance("i1")
+	require.True(t, ok)
+	i2, ok := p.Instance("i2")
+	require.True(t, ok)
+	i3, ok := p.Instance("i3")
+	require.True(t, ok)
+	i4, ok := p.Instance("i4")
+	require.True(t, ok)
+
+	assert.Equal(t, 4, loadOnInstance(i1))
+	assert.Equal(t, 12, loadOnInstance(i3))
+	assert.Equal(t, uint32(3), i3.Weight())
+	assert.True(t, i1.Shards().Equals(i2.Shards()))
+	assert.True(t, i3.Shards().Equals(i4.Shards()))
+}
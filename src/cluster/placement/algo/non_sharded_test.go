@@ -126,3 +126,27 @@ func TestIncompatibleWithNonShardedAlgo(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, errInCompatibleWithNonShardedAlgo, err)
 }
+
+func TestNonShardedAlgoUpdateInstanceWeights(t *testing.T) {
+	a := newNonShardedAlgorithm()
+
+	i1 := placement.NewInstance().SetID("i1").SetEndpoint("e1").SetWeight(1)
+	i2 := placement.NewInstance().SetID("i2").SetEndpoint("e2").SetWeight(1)
+	p, err := a.InitialPlacement([]placement.Instance{i1, i2}, []uint32{}, 1)
+	assert.NoError(t, err)
+
+	_, err = a.UpdateInstanceWeights(p, map[string]uint32{"i3": 2})
+	assert.Error(t, err)
+
+	p1, err := a.UpdateInstanceWeights(p, map[string]uint32{"i1": 2})
+	assert.NoError(t, err)
+	assert.NoError(t, placement.Validate(p1))
+
+	instance, ok := p1.Instance("i1")
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), instance.Weight())
+
+	p1, err = a.Rebalance(p1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, p1.NumInstances())
+}
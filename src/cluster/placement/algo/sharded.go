@@ -28,6 +28,7 @@ import (
 )
 
 var (
+	errShardsNotAvailable          = errors.New("could not rebalance placement, all shards must be available")
 	errNotEnoughIsolationGroups    = errors.New("not enough isolation groups to take shards, please make sure RF is less than number of isolation groups")
 	errIncompatibleWithShardedAlgo = errors.New("could not apply sharded algo on the placement")
 )
@@ -218,3 +219,48 @@ func (a shardedPlacementAlgorithm) MarkAllShardsAvailable(
 
 	return markAllShardsAvailable(p, a.opts)
 }
+
+func (a shardedPlacementAlgorithm) UpdateInstanceWeights(
+	p placement.Placement,
+	weights map[string]uint32,
+) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	if err := validateAllShardsAvailable(p); err != nil {
+		return nil, err
+	}
+
+	p, err := updateInstanceWeights(p.Clone(), weights)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.rebalance(p)
+}
+
+func (a shardedPlacementAlgorithm) Rebalance(p placement.Placement) (placement.Placement, error) {
+	if err := a.IsCompatibleWith(p); err != nil {
+		return nil, err
+	}
+
+	if err := validateAllShardsAvailable(p); err != nil {
+		return nil, err
+	}
+
+	return a.rebalance(p.Clone())
+}
+
+func (a shardedPlacementAlgorithm) rebalance(p placement.Placement) (placement.Placement, error) {
+	// Shards are only moved from instances above their target load to
+	// instances below it so the placement is balanced with the minimal
+	// amount of shard movement, the helper never places two replicas of a
+	// shard in the same isolation group.
+	ph := newHelper(p, p.ReplicaFactor(), a.opts)
+	if err := ph.optimize(minimal); err != nil {
+		return nil, err
+	}
+
+	return tryCleanupShardState(ph.generatePlacement(), a.opts)
+}
@@ -56,6 +56,9 @@ const (
 	// unsafe optimizes the load distribution with the potential of violating
 	// minimal shard movement in order to reach best shard distribution
 	unsafe
+	// minimal optimizes the load distribution by only moving shards from
+	// instances above their target load to instances below it.
+	minimal
 )
 
 type assignLoadFn func(instance placement.Instance) error
@@ -516,6 +519,8 @@ func (ph *helper) optimize(t optimizeType) error {
 		fn = ph.assignLoadToInstanceSafe
 	case unsafe:
 		fn = ph.assignLoadToInstanceUnsafe
+	case minimal:
+		fn = ph.assignLoadToInstanceMinimal
 	}
 	uniq := make(map[string]struct{}, len(ph.instances))
 	for {
@@ -546,6 +551,15 @@ func (ph *helper) assignLoadToInstanceUnsafe(addingInstance placement.Instance)
 	})
 }
 
+func (ph *helper) assignLoadToInstanceMinimal(addingInstance placement.Instance) error {
+	return ph.assignTargetLoad(addingInstance, func(from, to placement.Instance) bool {
+		if loadOnInstance(from) <= ph.targetLoadForInstance(from.ID()) {
+			return false
+		}
+		return ph.moveOneShard(from, to)
+	})
+}
+
 func (ph *helper) reclaimLeavingShards(instance placement.Instance) {
 	if instance.Shards().NumShardsForState(shard.Leaving) == 0 {
 		// Shortcut if there is nothing to be reclaimed.
@@ -716,6 +730,30 @@ func removeInstanceFromPlacement(p placement.Placement, id string) (placement.Pl
 	return p.SetInstances(removeInstanceFromList(p.Instances(), id)), leavingInstance, nil
 }
 
+func validateAllShardsAvailable(p placement.Placement) error {
+	for _, instance := range p.Instances() {
+		shards := instance.Shards()
+		if shards.NumShards() != shards.NumShardsForState(shard.Available) {
+			return errShardsNotAvailable
+		}
+	}
+	return nil
+}
+
+func updateInstanceWeights(p placement.Placement, weights map[string]uint32) (placement.Placement, error) {
+	for id, weight := range weights {
+		if weight == 0 {
+			return nil, fmt.Errorf("invalid weight for instance %s, weight must be positive", id)
+		}
+		instance, exist := p.Instance(id)
+		if !exist {
+			return nil, fmt.Errorf("instance %s does not exist in placement", id)
+		}
+		instance.SetWeight(weight)
+	}
+	return p, nil
+}
+
 func getShardMap(shards []shard.Shard) map[uint32]shard.Shard {
 	r := make(map[uint32]shard.Shard, len(shards))
 
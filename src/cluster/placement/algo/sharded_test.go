@@ -1226,6 +1226,122 @@ func TestGoodCaseWithSimpleShardStateType(t *testing.T) {
 	verifyAllShardsInAvailableState(t, p)
 }
 
+func TestUpdateInstanceWeights(t *testing.T) {
+	i1 := placement.NewEmptyInstance("i1", "r1", "z1", "endpoint1", 1)
+	i2 := placement.NewEmptyInstance("i2", "r2", "z1", "endpoint2", 1)
+	i3 := placement.NewEmptyInstance("i3", "r3", "z1", "endpoint3", 1)
+	i4 := placement.NewEmptyInstance("i4", "r4", "z1", "endpoint4", 1)
+
+	numShards := 64
+	ids := make([]uint32, numShards)
+	for i := 0; i < len(ids); i++ {
+		ids[i] = uint32(i)
+	}
+
+	a := newShardedAlgorithm(placement.NewOptions())
+	p, err := a.InitialPlacement([]placement.Instance{i1, i2, i3, i4}, ids, 2)
+	require.NoError(t, err)
+	p, _ = mustMarkAllShardsAsAvailable(t, p, nil)
+	validateDistribution(t, p, 1.01)
+
+	_, err = a.UpdateInstanceWeights(p, map[string]uint32{"non-existent": 3})
+	require.Error(t, err)
+
+	_, err = a.UpdateInstanceWeights(p, map[string]uint32{"i1": 0})
+	require.Error(t, err)
+
+	p1, err := a.UpdateInstanceWeights(p, map[string]uint32{"i1": 3})
+	require.NoError(t, err)
+
+	// The original placement must not be modified.
+	instance, ok := p.Instance("i1")
+	require.True(t, ok)
+	assert.Equal(t, uint32(1), instance.Weight())
+
+	instance, ok = p1.Instance("i1")
+	require.True(t, ok)
+	assert.Equal(t, uint32(3), instance.Weight())
+
+	// Only the shards needed to bring i1 up to its new target load are moved.
+	numInitializing := 0
+	for _, instance := range p1.Instances() {
+		initializing := instance.Shards().ShardsForState(shard.Initializing)
+		if instance.ID() != "i1" {
+			assert.Empty(t, initializing)
+		}
+		numInitializing += len(initializing)
+	}
+	assert.Equal(t, numShards/2, numInitializing)
+
+	p1, _ = mustMarkAllShardsAsAvailable(t, p1, nil)
+	validateDistribution(t, p1, 1.01)
+
+	instance, ok = p1.Instance("i1")
+	require.True(t, ok)
+	assert.Equal(t, numShards, loadOnInstance(instance))
+}
+
+func TestRebalance(t *testing.T) {
+	newInstance := func(id, group string, shards ...uint32) placement.Instance {
+		instance := placement.NewEmptyInstance(id, group, "z1", "endpoint-"+id, 1)
+		for _, s := range shards {
+			instance.Shards().Add(shard.NewShard(s).SetState(shard.Available))
+		}
+		return instance
+	}
+
+	p := placement.NewPlacement().
+		SetInstances([]placement.Instance{
+			newInstance("i1", "r1", 0, 1, 2, 3, 4, 5),
+			newInstance("i2", "r2", 6, 7, 8, 9),
+			newInstance("i3", "r3", 10, 11),
+		}).
+		SetShards([]uint32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}).
+		SetReplicaFactor(1).
+		SetIsSharded(true)
+
+	a := newShardedAlgorithm(placement.NewOptions())
+	p1, err := a.Rebalance(p)
+	require.NoError(t, err)
+
+	i1, ok := p1.Instance("i1")
+	require.True(t, ok)
+	assert.Equal(t, 2, i1.Shards().NumShardsForState(shard.Leaving))
+
+	i3, ok := p1.Instance("i3")
+	require.True(t, ok)
+	initializing := i3.Shards().ShardsForState(shard.Initializing)
+	require.Len(t, initializing, 2)
+	for _, s := range initializing {
+		assert.Equal(t, "i1", s.SourceID())
+	}
+
+	i2, ok := p1.Instance("i2")
+	require.True(t, ok)
+	assert.Equal(t, 4, i2.Shards().NumShardsForState(shard.Available))
+
+	// Shards which are still initializing must not be moved.
+	_, err = a.Rebalance(p1)
+	require.Equal(t, errShardsNotAvailable, err)
+	_, err = a.UpdateInstanceWeights(p1, map[string]uint32{"i1": 2})
+	require.Equal(t, errShardsNotAvailable, err)
+
+	p1, _ = mustMarkAllShardsAsAvailable(t, p1, nil)
+	for _, instance := range p1.Instances() {
+		assert.Equal(t, 4, loadOnInstance(instance))
+	}
+
+	// Rebalancing a balanced placement does not move any shards.
+	p2, err := a.Rebalance(p1)
+	require.NoError(t, err)
+	verifyAllShardsInAvailableState(t, p2)
+	for _, instance := range p2.Instances() {
+		expected, ok := p1.Instance(instance.ID())
+		require.True(t, ok)
+		assert.Equal(t, expected.Shards().AllIDs(), instance.Shards().AllIDs())
+	}
+}
+
 func verifyAllShardsInAvailableState(t *testing.T, p placement.Placement) {
 	for _, instance := range p.Instances() {
 		s := instance.Shards()
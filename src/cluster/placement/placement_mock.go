@@ -2335,6 +2335,36 @@ func (mr *MockServiceMockRecorder) MarkAllShardsAvailable() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllShardsAvailable", reflect.TypeOf((*MockService)(nil).MarkAllShardsAvailable))
 }
 
+// UpdateInstanceWeights mocks base method
+func (m *MockService) UpdateInstanceWeights(weights map[string]uint32) (Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceWeights", weights)
+	ret0, _ := ret[0].(Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstanceWeights indicates an expected call of UpdateInstanceWeights
+func (mr *MockServiceMockRecorder) UpdateInstanceWeights(weights interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceWeights", reflect.TypeOf((*MockService)(nil).UpdateInstanceWeights), weights)
+}
+
+// Rebalance mocks base method
+func (m *MockService) Rebalance() (Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance")
+	ret0, _ := ret[0].(Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rebalance indicates an expected call of Rebalance
+func (mr *MockServiceMockRecorder) Rebalance() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockService)(nil).Rebalance))
+}
+
 // MockAlgorithm is a mock of Algorithm interface
 type MockAlgorithm struct {
 	ctrl     *gomock.Controller
@@ -2483,6 +2513,36 @@ func (mr *MockAlgorithmMockRecorder) MarkAllShardsAvailable(p interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllShardsAvailable", reflect.TypeOf((*MockAlgorithm)(nil).MarkAllShardsAvailable), p)
 }
 
+// UpdateInstanceWeights mocks base method
+func (m *MockAlgorithm) UpdateInstanceWeights(p Placement, weights map[string]uint32) (Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceWeights", p, weights)
+	ret0, _ := ret[0].(Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstanceWeights indicates an expected call of UpdateInstanceWeights
+func (mr *MockAlgorithmMockRecorder) UpdateInstanceWeights(p, weights interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceWeights", reflect.TypeOf((*MockAlgorithm)(nil).UpdateInstanceWeights), p, weights)
+}
+
+// Rebalance mocks base method
+func (m *MockAlgorithm) Rebalance(p Placement) (Placement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", p)
+	ret0, _ := ret[0].(Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rebalance indicates an expected call of Rebalance
+func (mr *MockAlgorithmMockRecorder) Rebalance(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockAlgorithm)(nil).Rebalance), p)
+}
+
 // MockInstanceSelector is a mock of InstanceSelector interface
 type MockInstanceSelector struct {
 	ctrl     *gomock.Controller
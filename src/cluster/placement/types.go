@@ -526,6 +526,14 @@ type Service interface {
 
 	// MarkAllShardsAvailable marks shard states as available where applicable.
 	MarkAllShardsAvailable() (Placement, error)
+
+	// UpdateInstanceWeights updates the weights of the given instances and
+	// moves shards between instances to match the new weights.
+	UpdateInstanceWeights(weights map[string]uint32) (Placement, error)
+
+	// Rebalance moves shards between instances to even out the shard
+	// distribution according to the instance weights.
+	Rebalance() (Placement, error)
 }
 
 // Algorithm places shards on instances.
@@ -557,6 +565,14 @@ type Algorithm interface {
 
 	// MarkAllShardsAvailable marks shard states as available where applicable.
 	MarkAllShardsAvailable(p Placement) (Placement, bool, error)
+
+	// UpdateInstanceWeights updates the weights of the given instances and
+	// moves shards between instances to match the new weights.
+	UpdateInstanceWeights(p Placement, weights map[string]uint32) (Placement, error)
+
+	// Rebalance moves shards between instances to even out the shard
+	// distribution according to the instance weights.
+	Rebalance(p Placement) (Placement, error)
 }
 
 // InstanceSelector selects valid instances for the placement change.
@@ -684,6 +684,11 @@ func TestConfiguration(t *testing.T) {
     maxOutstandingWriteRequests: 0
     maxOutstandingReadRequests: 0
     maxOutstandingRepairedBytes: 0
+    maxConcurrentQueries: 0
+    docsMatched: null
+    seriesRead: null
+    bytesRead: null
+    seriesQuota: null
 coordinator: null
 `
 
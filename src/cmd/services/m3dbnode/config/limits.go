@@ -20,6 +20,12 @@
 
 package config
 
+import (
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/limits"
+)
+
 // Limits contains configuration for configurable limits that can be applied to M3DB.
 type Limits struct {
 	// MaxOutstandingWriteRequests controls the maximum number of outstanding write requests
@@ -39,4 +45,70 @@ type Limits struct {
 	// process would pause until some of the repaired bytes had been persisted to disk (and subsequently
 	// evicted from memory) at which point it would resume.
 	MaxOutstandingRepairedBytes int64 `yaml:"maxOutstandingRepairedBytes" validate:"min=0"`
+
+	// MaxConcurrentQueries controls the maximum number of index queries that can execute
+	// concurrently, further queries wait for a running query to complete until their
+	// timeout elapses.
+	MaxConcurrentQueries int `yaml:"maxConcurrentQueries" validate:"min=0"`
+
+	// DocsMatched limits the number of index documents matched by queries.
+	DocsMatched *LookbackLimitConfiguration `yaml:"docsMatched"`
+
+	// SeriesRead limits the number of series read by queries.
+	SeriesRead *LookbackLimitConfiguration `yaml:"seriesRead"`
+
+	// BytesRead limits the number of encoded bytes read by queries.
+	BytesRead *LookbackLimitConfiguration `yaml:"bytesRead"`
+
+	// SeriesQuota limits the number of active series per namespace, writes for
+	// new series beyond the quota are rejected. These defaults can be
+	// overridden at runtime via KV.
+	SeriesQuota *SeriesQuotaConfiguration `yaml:"seriesQuota"`
+}
+
+// QueryLimitsOptions returns the query limits options for the limits.
+func (c Limits) QueryLimitsOptions() limits.Options {
+	return limits.NewOptions().
+		SetMaxConcurrentQueries(c.MaxConcurrentQueries).
+		SetDocsLimitOptions(c.DocsMatched.options()).
+		SetSeriesReadLimitOptions(c.SeriesRead.options()).
+		SetBytesReadLimitOptions(c.BytesRead.options())
+}
+
+// LookbackLimitConfiguration configures a limit that applies both to individual
+// queries and across all queries within a rolling lookback window.
+type LookbackLimitConfiguration struct {
+	// Limit is the maximum value across all queries within the lookback window,
+	// zero disables the limit.
+	Limit int64 `yaml:"limit" validate:"min=0"`
+
+	// Lookback is the duration of the window the limit is applied over.
+	Lookback time.Duration `yaml:"lookback" validate:"min=0"`
+
+	// PerQueryLimit is the maximum value for a single query, zero disables the limit.
+	PerQueryLimit int64 `yaml:"perQueryLimit" validate:"min=0"`
+}
+
+func (c *LookbackLimitConfiguration) options() limits.LookbackLimitOptions {
+	if c == nil {
+		return limits.LookbackLimitOptions{}
+	}
+	return limits.LookbackLimitOptions{
+		Limit:         c.Limit,
+		Lookback:      c.Lookback,
+		PerQueryLimit: c.PerQueryLimit,
+	}
+}
+
+// SeriesQuotaConfiguration configures the max number of active series
+// per namespace, zero disables a quota.
+type SeriesQuotaConfiguration struct {
+	// ClusterMaxActiveSeriesPerNamespace is the max number of active series
+	// of each namespace across the cluster, enforced per shard by dividing
+	// the quota evenly across the shards of the cluster.
+	ClusterMaxActiveSeriesPerNamespace int `yaml:"clusterMaxActiveSeriesPerNamespace" validate:"min=0"`
+
+	// NodeMaxActiveSeriesPerNamespace is the max number of active series
+	// of each namespace on a single node.
+	NodeMaxActiveSeriesPerNamespace int `yaml:"nodeMaxActiveSeriesPerNamespace" validate:"min=0"`
 }
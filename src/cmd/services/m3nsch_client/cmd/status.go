@@ -70,5 +70,10 @@ func statusExec(_ *cobra.Command, _ []string) {
 		}
 		logger.Sugar().Infof("[%v] MaxQPS: %d, Status: %v, Token: %v, Workload: %+v",
 			endpoint, status.MaxQPS, status.Status, token, status.Workload)
+		for op, latencies := range status.Latencies {
+			logger.Sugar().Infof("[%v] %s: count: %d, errors: %d, p50: %v, p90: %v, p99: %v",
+				endpoint, op, latencies.Count(), latencies.Errors,
+				latencies.Quantile(0.5), latencies.Quantile(0.9), latencies.Quantile(0.99))
+		}
 	}
 }
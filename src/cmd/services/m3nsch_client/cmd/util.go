@@ -22,6 +22,8 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/m3db/m3/src/m3nsch"
@@ -33,6 +35,8 @@ import (
 type cliWorkload struct {
 	m3nsch.Workload
 	baseTimeOffset time.Duration
+	tags           []string
+	reads          []string
 }
 
 func (w *cliWorkload) validate() error {
@@ -52,14 +56,94 @@ func (w *cliWorkload) validate() error {
 	if w.UniqueAmplifier < 0.0 || w.UniqueAmplifier > 1.0 {
 		multiErr = multiErr.Add(fmt.Errorf("unique-amplifier must be between 0.0 and 1.0 (is %f)", w.UniqueAmplifier))
 	}
+	tags, err := parseTags(w.tags)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	reads, err := parseReads(w.reads)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	for _, r := range reads {
+		found := false
+		for _, tag := range tags {
+			found = found || tag.Name == r.TagName
+		}
+		if !found {
+			multiErr = multiErr.Add(fmt.Errorf("read tag %s must be one of the workload tags", r.TagName))
+		}
+	}
 	return multiErr.FinalError()
 }
 
 func (w *cliWorkload) toM3nschWorkload() m3nsch.Workload {
 	w.BaseTime = time.Now().Add(w.baseTimeOffset)
+	// NB: tags and reads are checked during validation.
+	w.Tags, _ = parseTags(w.tags)
+	w.Reads, _ = parseReads(w.reads)
 	return w.Workload
 }
 
+// parseTags parses tags specified as name:cardinality.
+func parseTags(specs []string) ([]m3nsch.Tag, error) {
+	tags := make([]m3nsch.Tag, 0, len(specs))
+	for _, spec := range specs {
+		parts := strings.Split(spec, ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid tag %q, expected name:cardinality", spec)
+		}
+		cardinality, err := strconv.Atoi(parts[1])
+		if err != nil || cardinality <= 0 {
+			return nil, fmt.Errorf("invalid tag %q, cardinality must be a positive integer", spec)
+		}
+		tags = append(tags, m3nsch.Tag{Name: parts[0], Cardinality: cardinality})
+	}
+	return tags, nil
+}
+
+// parseReads parses read workloads specified as comma separated key=value
+// pairs, e.g. type=regexp,tag=host,qps=100,concurrency=4,range=1h,limit=1000.
+func parseReads(specs []string) ([]m3nsch.ReadWorkload, error) {
+	reads := make([]m3nsch.ReadWorkload, 0, len(specs))
+	for _, spec := range specs {
+		r := m3nsch.ReadWorkload{
+			Range:       time.Hour,
+			Concurrency: 1,
+		}
+		for _, kv := range strings.Split(spec, ",") {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("invalid read %q, expected key=value pairs", spec)
+			}
+			var err error
+			switch key, value := parts[0], parts[1]; key {
+			case "type":
+				r.QueryType, err = m3nsch.ParseQueryType(value)
+			case "tag":
+				r.TagName = value
+			case "qps":
+				r.QPS, err = strconv.Atoi(value)
+			case "concurrency":
+				r.Concurrency, err = strconv.Atoi(value)
+			case "range":
+				r.Range, err = time.ParseDuration(value)
+			case "limit":
+				r.Limit, err = strconv.Atoi(value)
+			default:
+				err = fmt.Errorf("unknown key %s", key)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid read %q: %v", spec, err)
+			}
+		}
+		if r.TagName == "" || r.QPS <= 0 || r.Concurrency <= 0 || r.Range <= 0 || r.Limit < 0 {
+			return nil, fmt.Errorf("invalid read %q, tag, positive qps, concurrency and range are required", spec)
+		}
+		reads = append(reads, r)
+	}
+	return reads, nil
+}
+
 func registerWorkloadFlags(flags *pflag.FlagSet, workload *cliWorkload) {
 	flags.DurationVarP(&workload.baseTimeOffset, "basetime-offset", "b", -2*time.Minute,
 		`offset from current time to use for load, e.g. -2m, -30s`)
@@ -73,4 +157,8 @@ func registerWorkloadFlags(flags *pflag.FlagSet, workload *cliWorkload) {
 		`aggregate workload ingress qps`)
 	flags.Float64VarP(&workload.UniqueAmplifier, "unique-amplifier", "u", 0.0,
 		`% of generatic metrics as float [0.0,1.0] that will be unique`)
+	flags.StringSliceVar(&workload.tags, "tags", nil,
+		`tags added to each metric as name:cardinality, e.g. host:1000,dc:3; enables tagged writes`)
+	flags.StringArrayVar(&workload.reads, "read", nil,
+		`read workload as key=value pairs, e.g. type=term|regexp|negation,tag=host,qps=100,concurrency=4,range=1h,limit=1000; may be repeated`)
 }
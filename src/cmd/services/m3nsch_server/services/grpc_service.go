@@ -55,10 +55,11 @@ func (ms *menschServer) Status(ctx context.Context, req *proto.StatusRequest) (*
 	status := ms.agent.Status()
 	workload := convert.ToProtoWorkload(ms.agent.Workload())
 	response := &proto.StatusResponse{
-		Token:    status.Token,
-		Status:   convert.ToProtoStatus(status.Status),
-		MaxQPS:   ms.agent.MaxQPS(),
-		Workload: &workload,
+		Token:     status.Token,
+		Status:    convert.ToProtoStatus(status.Status),
+		MaxQPS:    ms.agent.MaxQPS(),
+		Workload:  &workload,
+		Latencies: convert.ToProtoLatencies(status.Latencies),
 	}
 	return response, nil
 }
@@ -108,6 +109,8 @@ func (ms *menschServer) Modify(_ context.Context, req *proto.ModifyRequest) (*pr
 	if err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "unable to parse workload: %v", err)
 	}
-	ms.agent.SetWorkload(workload)
+	if err := ms.agent.SetWorkload(workload); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid workload: %v", err)
+	}
 	return &proto.ModifyResponse{}, nil
 }
@@ -32,8 +32,11 @@ import (
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote"
+	"github.com/m3db/m3/src/query/graphite/events"
 	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/metadata"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/slowlog"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
 	xconfig "github.com/m3db/m3/src/x/config"
@@ -131,6 +134,23 @@ type Configuration struct {
 	// LookbackDuration determines the lookback duration for queries
 	LookbackDuration *time.Duration `yaml:"lookbackDuration"`
 
+	// AggregationPushdown enables evaluating supported aggregations, such as
+	// sum by (tags) (rate(series[range])), within M3DB rather than fetching
+	// every matching series.
+	AggregationPushdown bool `yaml:"aggregationPushdown"`
+
+	// SlowQueryLog configures logging of queries which exceed latency or
+	// fetched data thresholds, if not provided slow queries are not logged.
+	SlowQueryLog *slowlog.Configuration `yaml:"slowQueryLog"`
+
+	// MetricMetadata configures the store of metric metadata sent with
+	// Prometheus remote writes, if not provided it is kept in memory only.
+	MetricMetadata *metadata.Configuration `yaml:"metricMetadata"`
+
+	// GraphiteEvents configures the namespace Graphite events are stored in,
+	// if not provided the Graphite events API is disabled.
+	GraphiteEvents *events.Configuration `yaml:"graphiteEvents"`
+
 	// ResultOptions are the results options for query.
 	ResultOptions ResultOptions `yaml:"resultOptions"`
 
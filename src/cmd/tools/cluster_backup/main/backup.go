@@ -0,0 +1,257 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+
+	"github.com/golang/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+type backupRun struct {
+	logger          *zap.SugaredLogger
+	coordinator     coordinatorClient
+	root            string
+	namespaces      []string
+	nodeHTTPPort    int
+	snapshotTimeout time.Duration
+}
+
+type nodeBackup struct {
+	instance placement.Instance
+	result   backup.NodeBackupResult
+	err      error
+}
+
+func (b backupRun) run() error {
+	p, rawPlacement, err := b.coordinator.placement()
+	if err != nil {
+		return fmt.Errorf("unable to get placement: %v", err)
+	}
+	nsOpts, err := b.coordinator.namespaces()
+	if err != nil {
+		return fmt.Errorf("unable to get namespaces: %v", err)
+	}
+
+	manifest := backup.Manifest{
+		ID:        filepath.Base(b.root),
+		CreatedAt: time.Now(),
+		Placement: rawPlacement,
+	}
+	metadata := make(map[string]json.RawMessage, len(b.namespaces))
+	for _, ns := range b.namespaces {
+		opts, ok := nsOpts[ns]
+		if !ok {
+			return fmt.Errorf("unknown namespace: %s", ns)
+		}
+		raw, err := (&jsonpb.Marshaler{}).MarshalToString(opts)
+		if err != nil {
+			return err
+		}
+		metadata[ns] = json.RawMessage(raw)
+	}
+
+	// Every node is requested to take a snapshot and copies its filesets
+	// concurrently so that the backed up data is as close to a single point
+	// in time as possible.
+	var (
+		wg      sync.WaitGroup
+		backups = make([]nodeBackup, 0, p.NumInstances())
+	)
+	instances := p.Instances()
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].ID() < instances[j].ID()
+	})
+	for _, instance := range instances {
+		backups = append(backups, nodeBackup{instance: instance})
+	}
+	for i := range backups {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			backups[i].result, backups[i].err = b.backupNode(backups[i].instance)
+		}()
+	}
+	wg.Wait()
+
+	for _, nb := range backups {
+		if nb.err != nil {
+			b.logger.Warnf("unable to back up %s: %v", nb.instance.ID(), nb.err)
+			continue
+		}
+		b.logger.Infof("backed up %s, snapshot started at %v",
+			nb.instance.ID(), nb.result.SnapshotStartedAt)
+		// The backup holds every write acknowledged before the earliest
+		// snapshot of the nodes it was copied from.
+		if manifest.SnapshotStartedAt.IsZero() ||
+			nb.result.SnapshotStartedAt.Before(manifest.SnapshotStartedAt) {
+			manifest.SnapshotStartedAt = nb.result.SnapshotStartedAt
+		}
+	}
+
+	for _, ns := range b.namespaces {
+		nsManifest, err := namespaceManifest(ns, p, backups)
+		if err != nil {
+			return err
+		}
+		nsManifest.Metadata = metadata[ns]
+		manifest.Namespaces = append(manifest.Namespaces, nsManifest)
+	}
+
+	if err := writeManifest(b.root, manifest); err != nil {
+		return fmt.Errorf("unable to write manifest: %v", err)
+	}
+
+	b.logger.Infof("backup complete: root=%s, snapshotStartedAt=%v",
+		b.root, manifest.SnapshotStartedAt)
+	return nil
+}
+
+func (b backupRun) backupNode(instance placement.Instance) (backup.NodeBackupResult, error) {
+	host, _, err := net.SplitHostPort(instance.Endpoint())
+	if err != nil {
+		return backup.NodeBackupResult{}, err
+	}
+
+	var (
+		url    = fmt.Sprintf("http://%s/backup", net.JoinHostPort(host, strconv.Itoa(b.nodeHTTPPort)))
+		client = &http.Client{Timeout: b.snapshotTimeout + requestTimeout}
+		req    = backup.NodeBackupRequest{
+			Root:                   b.root,
+			Host:                   instance.ID(),
+			Namespaces:             b.namespaces,
+			SnapshotTimeoutSeconds: int64(b.snapshotTimeout / time.Second),
+		}
+		result backup.NodeBackupResult
+	)
+	if err := postJSON(client, url, req, &result); err != nil {
+		return backup.NodeBackupResult{}, err
+	}
+	return result, nil
+}
+
+// namespaceManifest picks a replica that owned each shard as available and
+// was backed up successfully to restore the shard from.
+func namespaceManifest(
+	namespace string,
+	p placement.Placement,
+	backups []nodeBackup,
+) (backup.NamespaceManifest, error) {
+	result := backup.NamespaceManifest{Namespace: namespace}
+
+	shards := p.Shards()
+	sort.Slice(shards, func(i, j int) bool { return shards[i] < shards[j] })
+	for _, id := range shards {
+		shardManifest, ok := availableShard(namespace, id, backups)
+		if !ok {
+			return backup.NamespaceManifest{}, fmt.Errorf(
+				"no available replica of shard %d of namespace %s was backed up", id, namespace)
+		}
+		result.Shards = append(result.Shards, shardManifest)
+	}
+
+	for _, nb := range backups {
+		if nb.err != nil {
+			continue
+		}
+		for _, nsResult := range nb.result.Namespaces {
+			if nsResult.Namespace == namespace {
+				result.IndexVolumes = append(result.IndexVolumes, nsResult.IndexVolumes...)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func availableShard(
+	namespace string,
+	id uint32,
+	backups []nodeBackup,
+) (backup.ShardManifest, bool) {
+	for _, nb := range backups {
+		if nb.err != nil {
+			continue
+		}
+		s, ok := nb.instance.Shards().Shard(id)
+		if !ok || s.State() != shard.Available {
+			continue
+		}
+		for _, nsResult := range nb.result.Namespaces {
+			if nsResult.Namespace != namespace {
+				continue
+			}
+			for _, shardResult := range nsResult.Shards {
+				if shardResult.Shard == id {
+					return shardResult, true
+				}
+			}
+		}
+	}
+	return backup.ShardManifest{}, false
+}
+
+func writeManifest(root string, manifest backup.Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a partial manifest is never read.
+	path := filepath.Join(root, backup.ManifestFileName)
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func readManifest(root string) (backup.Manifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(root, backup.ManifestFileName))
+	if err != nil {
+		return backup.Manifest{}, err
+	}
+	var manifest backup.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return backup.Manifest{}, err
+	}
+	return manifest, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	placementPath = "/api/v1/services/m3db/placement"
+	namespacePath = "/api/v1/services/m3db/namespace"
+
+	requestTimeout = time.Minute
+)
+
+type coordinatorClient struct {
+	url    string
+	client *http.Client
+}
+
+func newCoordinatorClient(url string) coordinatorClient {
+	return coordinatorClient{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// placement returns the M3DB placement along with its JSON representation.
+func (c coordinatorClient) placement() (placement.Placement, json.RawMessage, error) {
+	var resp admin.PlacementGetResponse
+	if err := c.get(placementPath, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	p, err := placement.NewPlacementFromProto(resp.Placement)
+	if err != nil {
+		return nil, nil, err
+	}
+	raw, err := marshalProtoJSON(resp.Placement)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, raw, nil
+}
+
+func (c coordinatorClient) namespaces() (map[string]*nsproto.NamespaceOptions, error) {
+	var resp admin.NamespaceGetResponse
+	if err := c.get(namespacePath, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Registry == nil {
+		return nil, nil
+	}
+	return resp.Registry.Namespaces, nil
+}
+
+func (c coordinatorClient) addNamespace(name string, opts *nsproto.NamespaceOptions) error {
+	body, err := marshalProtoJSON(&admin.NamespaceAddRequest{Name: name, Options: opts})
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Post(c.url+namespacePath, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(c.url+namespacePath, resp)
+}
+
+func (c coordinatorClient) get(path string, msg proto.Message) error {
+	resp, err := c.client.Get(c.url + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(c.url+path, resp); err != nil {
+		return err
+	}
+
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	return unmarshaler.Unmarshal(resp.Body, msg)
+}
+
+// postJSON posts a JSON request and decodes the JSON response.
+func postJSON(client *http.Client, url string, req, res interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(url, resp); err != nil {
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+func checkResponse(url string, resp *http.Response) error {
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+	return fmt.Errorf("request to %s failed: status=%d, body=%s",
+		url, resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func marshalProtoJSON(msg proto.Message) ([]byte, error) {
+	var (
+		buff      bytes.Buffer
+		marshaler = jsonpb.Marshaler{}
+	)
+	if err := marshaler.Marshal(&buff, msg); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
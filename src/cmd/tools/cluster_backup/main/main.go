@@ -0,0 +1,123 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// cluster_backup is a tool to back up namespaces of an M3DB cluster and to
+// restore them into a cluster with a possibly different placement.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	backupCommand  = "backup"
+	restoreCommand = "restore"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	rawLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("unable to create logger: %+v", err)
+	}
+	logger := rawLogger.Sugar()
+
+	switch os.Args[1] {
+	case backupCommand:
+		var (
+			flags          = flag.NewFlagSet(backupCommand, flag.ExitOnError)
+			optCoordinator = flags.String("coordinator", "http://localhost:7201", "Coordinator URL of the cluster to back up")
+			optRoot        = flags.String("root", "", "Backup root directory, must be reachable at the same path by every node")
+			optNamespaces  = flags.String("namespaces", "", "Comma separated namespaces to back up")
+			optNodePort    = flags.Int("node-http-port", 9002, "HTTP JSON node port of the nodes")
+			optTimeout     = flags.Duration("snapshot-timeout", 10*time.Minute, "How long nodes wait for a snapshot before copying filesets")
+		)
+		flags.Parse(os.Args[2:])
+		if *optRoot == "" || *optNamespaces == "" {
+			flags.Usage()
+			os.Exit(1)
+		}
+
+		b := backupRun{
+			logger:          logger,
+			coordinator:     newCoordinatorClient(*optCoordinator),
+			root:            *optRoot,
+			namespaces:      strings.Split(*optNamespaces, ","),
+			nodeHTTPPort:    *optNodePort,
+			snapshotTimeout: *optTimeout,
+		}
+		if err := b.run(); err != nil {
+			logger.Fatalf("unable to back up: %v", err)
+		}
+
+	case restoreCommand:
+		var (
+			flags              = flag.NewFlagSet(restoreCommand, flag.ExitOnError)
+			optCoordinator     = flags.String("coordinator", "http://localhost:7201", "Coordinator URL of the cluster to restore into")
+			optRoot            = flags.String("root", "", "Backup root directory")
+			optNamespace       = flags.String("namespace", "", "Backed up namespace to restore")
+			optDestNamespace   = flags.String("dest-namespace", "", "Namespace to restore into, defaults to the backed up namespace")
+			optDestPathPrefix  = flags.String("dest-path-prefix", "", "Directory to write a fileset tree per instance of the placement to")
+			optCreateNamespace = flags.Bool("create-namespace", false, "Create the namespace with the backed up options")
+			optMaxOpenWriters  = flags.Int("max-open-writers", 256, "Maximum number of shards written at a time")
+		)
+		flags.Parse(os.Args[2:])
+		if *optRoot == "" || *optNamespace == "" || *optDestPathPrefix == "" {
+			flags.Usage()
+			os.Exit(1)
+		}
+		if *optDestNamespace == "" {
+			*optDestNamespace = *optNamespace
+		}
+
+		r := restoreRun{
+			logger:          logger,
+			coordinator:     newCoordinatorClient(*optCoordinator),
+			root:            *optRoot,
+			namespace:       *optNamespace,
+			destNamespace:   *optDestNamespace,
+			destPathPrefix:  *optDestPathPrefix,
+			createNamespace: *optCreateNamespace,
+			maxOpenWriters:  *optMaxOpenWriters,
+		}
+		if err := r.run(); err != nil {
+			logger.Fatalf("unable to restore: %v", err)
+		}
+
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s <%s|%s> [flags]\n",
+		os.Args[0], backupCommand, restoreCommand)
+	os.Exit(1)
+}
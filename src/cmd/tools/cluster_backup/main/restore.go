@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+
+	"github.com/golang/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+type restoreRun struct {
+	logger          *zap.SugaredLogger
+	coordinator     coordinatorClient
+	root            string
+	namespace       string
+	destNamespace   string
+	destPathPrefix  string
+	createNamespace bool
+	maxOpenWriters  int
+}
+
+func (r restoreRun) run() error {
+	manifest, err := readManifest(r.root)
+	if err != nil {
+		return fmt.Errorf("unable to read manifest: %v", err)
+	}
+
+	var (
+		nsManifest backup.NamespaceManifest
+		found      bool
+	)
+	for _, ns := range manifest.Namespaces {
+		if ns.Namespace == r.namespace {
+			nsManifest, found = ns, true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("namespace %s is not in backup %s", r.namespace, manifest.ID)
+	}
+
+	p, _, err := r.coordinator.placement()
+	if err != nil {
+		return fmt.Errorf("unable to get placement: %v", err)
+	}
+
+	if r.createNamespace {
+		var opts nsproto.NamespaceOptions
+		if err := jsonpb.Unmarshal(bytes.NewReader(nsManifest.Metadata), &opts); err != nil {
+			return fmt.Errorf("unable to decode namespace options: %v", err)
+		}
+		if err := r.coordinator.addNamespace(r.destNamespace, &opts); err != nil {
+			return fmt.Errorf("unable to create namespace: %v", err)
+		}
+		r.logger.Infof("created namespace %s", r.destNamespace)
+	}
+
+	target := backup.RestoreTarget{
+		Namespace:      r.destNamespace,
+		NumShards:      p.NumShards(),
+		Owners:         make(map[uint32][]string, p.NumShards()),
+		PathPrefix:     r.destPathPrefix,
+		MaxOpenWriters: r.maxOpenWriters,
+	}
+	instances := p.Instances()
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].ID() < instances[j].ID()
+	})
+	for _, instance := range instances {
+		for _, id := range instance.Shards().AllIDs() {
+			target.Owners[id] = append(target.Owners[id], instance.ID())
+		}
+	}
+
+	result, err := backup.Restore(fs.NewOptions(), r.root, nsManifest, target)
+	if err != nil {
+		return err
+	}
+
+	r.logger.Infof("restored %d series into %d volumes of namespace %s",
+		result.Series, result.Volumes, r.destNamespace)
+	for _, instance := range instances {
+		r.logger.Infof("copy %s to the file path prefix of %s before it starts",
+			filepath.Join(r.destPathPrefix, instance.ID()), instance.ID())
+	}
+	return nil
+}
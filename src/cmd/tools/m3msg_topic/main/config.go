@@ -0,0 +1,64 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"github.com/m3db/m3/src/aggregator/sharding"
+	etcdclient "github.com/m3db/m3/src/cluster/client/etcd"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/msg/consumer"
+	producerconfig "github.com/m3db/m3/src/msg/producer/config"
+)
+
+// configuration is the configuration of the tool.
+type configuration struct {
+	// Etcd configures the cluster client the topic and placements are stored in.
+	Etcd etcdclient.Configuration `yaml:"etcd"`
+
+	// TopicName is the name of the topic to tail.
+	TopicName string `yaml:"topicName" validate:"nonzero"`
+
+	// TopicServiceOverride configures the kv namespace of the topic.
+	TopicServiceOverride kv.OverrideConfiguration `yaml:"topicServiceOverride"`
+
+	// PlacementServiceOverride configures the kv namespace of the placement
+	// of the temporary consumer service registered to tail the topic.
+	PlacementServiceOverride services.OverrideConfiguration `yaml:"placementServiceOverride"`
+
+	// Consumer configures the consumer used to tail the topic.
+	Consumer consumer.Configuration `yaml:"consumer"`
+
+	// Producer configures the producer used to replay messages, the topic
+	// messages are replayed into is configured by its writer.
+	Producer *producerconfig.ProducerConfiguration `yaml:"producer"`
+
+	// HashType is the hash used to shard replayed messages, it must match the
+	// hash used by the producers of the topic.
+	HashType sharding.HashType `yaml:"hashType"`
+}
+
+func (c configuration) shardFn() (sharding.ShardFn, error) {
+	if c.HashType == "" {
+		return sharding.DefaultHash.ShardFn()
+	}
+	return c.HashType.ShardFn()
+}
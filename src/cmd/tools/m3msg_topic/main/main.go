@@ -0,0 +1,166 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// m3msg_topic is a tool to tail the aggregated metrics flowing on an m3msg
+// topic, and to replay recorded metrics into a topic.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"regexp"
+	"syscall"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/services"
+	xconfig "github.com/m3db/m3/src/x/config"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"go.uber.org/zap"
+)
+
+const (
+	tailCommand   = "tail"
+	replayCommand = "replay"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	rawLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("unable to create logger: %+v", err)
+	}
+	logger := rawLogger.Sugar()
+	instrumentOpts := instrument.NewOptions().SetLogger(rawLogger)
+
+	stopCh := make(chan os.Signal, 1)
+	signal.Notify(stopCh, syscall.SIGINT, syscall.SIGTERM)
+
+	switch os.Args[1] {
+	case tailCommand:
+		var (
+			flags          = flag.NewFlagSet(tailCommand, flag.ExitOnError)
+			optConfig      = flags.String("f", "", "Configuration file")
+			optListen      = flags.String("listen", "0.0.0.0:0", "Address to listen for messages on")
+			optEndpoint    = flags.String("endpoint", "", "Endpoint producers connect to, defaults to the hostname and the listen port")
+			optServiceName = flags.String("service-name", "m3msg_topic_tail", "Name of the temporary consumer service")
+			optServiceEnv  = flags.String("service-env", "", "Environment of the temporary consumer service, defaults to the etcd env")
+			optServiceZone = flags.String("service-zone", "", "Zone of the temporary consumer service, defaults to the etcd zone")
+			optMessageTTL  = flags.Duration("message-ttl", time.Minute, "How long producers retry messages for the temporary consumer service")
+			optID          = flags.String("id", "", "Only print metrics with an ID matching this regexp")
+			optRecord      = flags.String("record", "", "File to record the messages matching the ID regexp to")
+			optDuration    = flags.Duration("duration", 0, "How long to tail the topic for, until interrupted by default")
+		)
+		flags.Parse(os.Args[2:])
+		if *optConfig == "" {
+			flags.Usage()
+			os.Exit(1)
+		}
+
+		cfg := loadConfig(logger, *optConfig)
+		if *optServiceEnv == "" {
+			*optServiceEnv = cfg.Etcd.Env
+		}
+		if *optServiceZone == "" {
+			*optServiceZone = cfg.Etcd.Zone
+		}
+		r := tailRun{
+			logger:         logger,
+			instrumentOpts: instrumentOpts,
+			cfg:            cfg,
+			serviceID: services.NewServiceID().
+				SetName(*optServiceName).
+				SetEnvironment(*optServiceEnv).
+				SetZone(*optServiceZone),
+			listenAddress: *optListen,
+			endpoint:      *optEndpoint,
+			messageTTL:    *optMessageTTL,
+			idFilter:      mustCompileRegexp(logger, *optID),
+			recordPath:    *optRecord,
+			duration:      *optDuration,
+			out:           os.Stdout,
+			stopCh:        stopCh,
+		}
+		if err := r.run(); err != nil {
+			logger.Fatalf("unable to tail topic: %v", err)
+		}
+
+	case replayCommand:
+		var (
+			flags     = flag.NewFlagSet(replayCommand, flag.ExitOnError)
+			optConfig = flags.String("f", "", "Configuration file")
+			optRecord = flags.String("record", "", "Recording to replay")
+			optID     = flags.String("id", "", "Only replay metrics with an ID matching this regexp")
+			optRate   = flags.Int("rate", 0, "Maximum number of messages replayed per second, unlimited by default")
+		)
+		flags.Parse(os.Args[2:])
+		if *optConfig == "" || *optRecord == "" {
+			flags.Usage()
+			os.Exit(1)
+		}
+
+		r := replayRun{
+			logger:         logger,
+			instrumentOpts: instrumentOpts,
+			cfg:            loadConfig(logger, *optConfig),
+			recordPath:     *optRecord,
+			idFilter:       mustCompileRegexp(logger, *optID),
+			rate:           *optRate,
+			stopCh:         stopCh,
+		}
+		if err := r.run(); err != nil {
+			logger.Fatalf("unable to replay recording: %v", err)
+		}
+
+	default:
+		usage()
+	}
+}
+
+func loadConfig(logger *zap.SugaredLogger, path string) configuration {
+	var cfg configuration
+	if err := xconfig.LoadFile(&cfg, path, xconfig.Options{}); err != nil {
+		logger.Fatalf("unable to load config from %s: %v", path, err)
+	}
+	return cfg
+}
+
+func mustCompileRegexp(logger *zap.SugaredLogger, expr string) *regexp.Regexp {
+	if expr == "" {
+		return nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		logger.Fatalf("invalid ID regexp %s: %v", expr, err)
+	}
+	return re
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s <%s|%s> [flags]\n",
+		os.Args[0], tailCommand, replayCommand)
+	os.Exit(1)
+}
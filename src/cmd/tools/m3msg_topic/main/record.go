@@ -0,0 +1,132 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// recordMagic is written at the beginning of recordings, a recording is a
+// sequence of message payloads each prefixed with its varint encoded length.
+const recordMagic = "m3msgrec"
+
+const maxRecordSize = 64 * 1024 * 1024
+
+var errInvalidRecording = errors.New("not a recording of m3msg messages")
+
+// recorder records message payloads to a file, it is safe for concurrent use.
+type recorder struct {
+	sync.Mutex
+
+	fd *os.File
+	w  *bufio.Writer
+	n  int
+}
+
+func newRecorder(path string) (*recorder, error) {
+	fd, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &recorder{fd: fd, w: bufio.NewWriter(fd)}
+	if _, err := r.w.WriteString(recordMagic); err != nil {
+		fd.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *recorder) Record(b []byte) error {
+	var lenBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuf[:], uint64(len(b)))
+
+	r.Lock()
+	defer r.Unlock()
+	if _, err := r.w.Write(lenBuf[:n]); err != nil {
+		return err
+	}
+	if _, err := r.w.Write(b); err != nil {
+		return err
+	}
+	r.n++
+	return nil
+}
+
+// NumRecorded returns the number of payloads recorded.
+func (r *recorder) NumRecorded() int {
+	r.Lock()
+	defer r.Unlock()
+	return r.n
+}
+
+func (r *recorder) Close() error {
+	r.Lock()
+	defer r.Unlock()
+	if err := r.w.Flush(); err != nil {
+		r.fd.Close()
+		return err
+	}
+	return r.fd.Close()
+}
+
+// recordingReader reads the message payloads of a recording.
+type recordingReader struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+func newRecordingReader(r io.Reader) (*recordingReader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(recordMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != recordMagic {
+		return nil, errInvalidRecording
+	}
+	return &recordingReader{r: br}, nil
+}
+
+// Next returns the next payload, which is only valid until the next call,
+// or io.EOF at the end of the recording.
+func (r *recordingReader) Next() ([]byte, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxRecordSize {
+		return nil, fmt.Errorf("recorded payload of %d bytes exceeds max size %d", size, maxRecordSize)
+	}
+	if uint64(cap(r.buf)) < size {
+		r.buf = make([]byte, size)
+	}
+	r.buf = r.buf[:size]
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return r.buf, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "m3msg_topic")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "recording")
+	r, err := newRecorder(path)
+	require.NoError(t, err)
+
+	payloads := [][]byte{[]byte("foo"), nil, bytes.Repeat([]byte("bar"), 1000)}
+	for _, p := range payloads {
+		require.NoError(t, r.Record(p))
+	}
+	require.Equal(t, len(payloads), r.NumRecorded())
+	require.NoError(t, r.Close())
+
+	fd, err := os.Open(path)
+	require.NoError(t, err)
+	defer fd.Close()
+
+	rr, err := newRecordingReader(fd)
+	require.NoError(t, err)
+	for _, p := range payloads {
+		b, err := rr.Next()
+		require.NoError(t, err)
+		require.Equal(t, len(p), len(b))
+		require.True(t, bytes.Equal(p, b))
+	}
+	_, err = rr.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestRecordingReaderInvalid(t *testing.T) {
+	_, err := newRecordingReader(bytes.NewBufferString("foo"))
+	require.Equal(t, errInvalidRecording, err)
+
+	rr, err := newRecordingReader(bytes.NewBufferString(recordMagic + "\x05ab"))
+	require.NoError(t, err)
+	_, err = rr.Next()
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/m3db/m3/src/aggregator/sharding"
+	"github.com/m3db/m3/src/metrics/encoding/protobuf"
+	"github.com/m3db/m3/src/msg/producer"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"go.uber.org/zap"
+)
+
+var errNoProducer = errors.New("producer must be configured to replay messages")
+
+// replayRun produces the messages of a recording into a topic.
+type replayRun struct {
+	logger         *zap.SugaredLogger
+	instrumentOpts instrument.Options
+	cfg            configuration
+	recordPath     string
+	idFilter       *regexp.Regexp
+	rate           int
+	stopCh         <-chan os.Signal
+}
+
+func (r replayRun) run() error {
+	if r.cfg.Producer == nil {
+		return errNoProducer
+	}
+	shardFn, err := r.cfg.shardFn()
+	if err != nil {
+		return err
+	}
+
+	fd, err := os.Open(r.recordPath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	reader, err := newRecordingReader(fd)
+	if err != nil {
+		return err
+	}
+
+	cs, err := r.cfg.Etcd.NewClient(r.instrumentOpts)
+	if err != nil {
+		return fmt.Errorf("unable to create cluster client: %v", err)
+	}
+	p, err := r.cfg.Producer.NewProducer(cs, r.instrumentOpts)
+	if err != nil {
+		return fmt.Errorf("unable to create producer: %v", err)
+	}
+	if err := p.Init(); err != nil {
+		return fmt.Errorf("unable to init producer: %v", err)
+	}
+	// Wait for the replayed messages to be consumed before returning.
+	defer p.Close(producer.WaitForConsumption)
+
+	var (
+		numShards = p.NumShards()
+		dec       = protobuf.NewAggregatedDecoder(nil)
+		start     = time.Now()
+		replayed  int
+	)
+	for {
+		select {
+		case <-r.stopCh:
+			r.logger.Infof("stopped after replaying %d messages", replayed)
+			return nil
+		default:
+		}
+
+		b, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read recording: %v", err)
+		}
+		if err := dec.Decode(b); err != nil {
+			return fmt.Errorf("unable to decode recorded message: %v", err)
+		}
+		if r.idFilter != nil && !r.idFilter.Match(dec.ID()) {
+			dec.Close()
+			continue
+		}
+
+		// The recording reader and the decoder reuse their buffers.
+		data := append([]byte(nil), b...)
+		id := append([]byte(nil), dec.ID()...)
+		dec.Close()
+		m := newReplayMessage(id, data, shardFn(id, numShards), shardFn)
+		if err := p.Produce(m); err != nil {
+			return fmt.Errorf("unable to produce message: %v", err)
+		}
+		replayed++
+
+		if r.rate > 0 {
+			next := start.Add(time.Duration(replayed) * time.Second / time.Duration(r.rate))
+			if wait := time.Until(next); wait > 0 {
+				time.Sleep(wait)
+			}
+		}
+	}
+
+	r.logger.Infof("replayed %d messages, waiting for them to be consumed", replayed)
+	return nil
+}
+
+// replayMessage is a recorded message, it can be sharded for any number of
+// shards so it may be replayed into a topic that is being resharded.
+type replayMessage struct {
+	id      []byte
+	data    []byte
+	shard   uint32
+	shardFn sharding.ShardFn
+}
+
+func newReplayMessage(
+	id []byte,
+	data []byte,
+	shard uint32,
+	shardFn sharding.ShardFn,
+) producer.ReshardableMessage {
+	return replayMessage{id: id, data: data, shard: shard, shardFn: shardFn}
+}
+
+func (m replayMessage) Shard() uint32 {
+	return m.shard
+}
+
+func (m replayMessage) ShardFor(numShards uint32) uint32 {
+	return m.shardFn(m.id, numShards)
+}
+
+func (m replayMessage) Bytes() []byte {
+	return m.data
+}
+
+func (m replayMessage) Size() int {
+	return len(m.data)
+}
+
+func (m replayMessage) Finalize(producer.FinalizeReason) {}
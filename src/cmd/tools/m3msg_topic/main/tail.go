@@ -0,0 +1,335 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/metrics/encoding/protobuf"
+	"github.com/m3db/m3/src/msg/consumer"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/x/instrument"
+	xserver "github.com/m3db/m3/src/x/server"
+
+	"go.uber.org/zap"
+)
+
+const (
+	maxTopicUpdateAttempts = 5
+	tailIsolationGroup     = "m3msg_topic"
+)
+
+// tailRun registers a temporary consumer service for a topic, and prints the
+// aggregated metrics it receives until it's stopped.
+type tailRun struct {
+	logger         *zap.SugaredLogger
+	instrumentOpts instrument.Options
+	cfg            configuration
+	serviceID      services.ServiceID
+	listenAddress  string
+	endpoint       string
+	messageTTL     time.Duration
+	idFilter       *regexp.Regexp
+	recordPath     string
+	duration       time.Duration
+	out            io.Writer
+	stopCh         <-chan os.Signal
+}
+
+func (r tailRun) run() error {
+	cs, err := r.cfg.Etcd.NewClient(r.instrumentOpts)
+	if err != nil {
+		return fmt.Errorf("unable to create cluster client: %v", err)
+	}
+	kvOpts, err := r.cfg.TopicServiceOverride.NewOverrideOptions()
+	if err != nil {
+		return err
+	}
+	ts, err := topic.NewService(
+		topic.NewServiceOptions().
+			SetConfigService(cs).
+			SetKVOverrideOptions(kvOpts),
+	)
+	if err != nil {
+		return err
+	}
+
+	var rec *recorder
+	if r.recordPath != "" {
+		if rec, err = newRecorder(r.recordPath); err != nil {
+			return fmt.Errorf("unable to create recording: %v", err)
+		}
+		defer func() {
+			if err := rec.Close(); err != nil {
+				r.logger.Errorf("unable to close recording: %v", err)
+				return
+			}
+			r.logger.Infof("recorded %d messages to %s", rec.NumRecorded(), r.recordPath)
+		}()
+	}
+
+	l, err := net.Listen("tcp", r.listenAddress)
+	if err != nil {
+		return err
+	}
+	endpoint := r.endpoint
+	if endpoint == "" {
+		if endpoint, err = defaultEndpoint(l.Addr()); err != nil {
+			l.Close()
+			return err
+		}
+	}
+
+	p := &tailProcessor{
+		idFilter: r.idFilter,
+		recorder: rec,
+		out:      r.out,
+		logger:   r.logger,
+	}
+	server := xserver.NewServer(
+		l.Addr().String(),
+		consumer.NewMessageHandler(p, r.cfg.Consumer.NewOptions(r.instrumentOpts)),
+		xserver.NewOptions().SetInstrumentOptions(r.instrumentOpts),
+	)
+	if err := server.Serve(l); err != nil {
+		return err
+	}
+	defer server.Close()
+
+	unregister, err := r.register(cs, ts, endpoint)
+	if err != nil {
+		return err
+	}
+	defer unregister()
+
+	r.logger.Infof("tailing topic %s as consumer service %s at %s",
+		r.cfg.TopicName, r.serviceID.String(), endpoint)
+	var timeoutCh <-chan time.Time
+	if r.duration > 0 {
+		timer := time.NewTimer(r.duration)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+	select {
+	case <-r.stopCh:
+	case <-timeoutCh:
+	}
+	return nil
+}
+
+// register adds a consumer service, with a placement of a single instance
+// owning every shard, to the topic. The consumer service replicates the
+// messages of the topic so the existing consumer services are unaffected.
+// Any consumer service and placement left behind by a previous run that did
+// not exit cleanly are removed first. The returned function removes the
+// consumer service again.
+func (r tailRun) register(
+	cs client.Client,
+	ts topic.Service,
+	endpoint string,
+) (func(), error) {
+	t, err := ts.Get(r.cfg.TopicName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get topic %s: %v", r.cfg.TopicName, err)
+	}
+	// Own the pending shards as well if the topic is being resharded.
+	numShards := t.NumberOfShards()
+	if pending := t.PendingNumberOfShards(); pending > numShards {
+		numShards = pending
+	}
+
+	sd, err := cs.Services(r.cfg.PlacementServiceOverride.NewOptions())
+	if err != nil {
+		return nil, err
+	}
+	ps, err := sd.PlacementService(r.serviceID, placement.NewOptions().
+		SetShardStateMode(placement.StableShardStateOnly).
+		SetIsSharded(true))
+	if err != nil {
+		return nil, err
+	}
+	if err := r.removeStale(ts, ps); err != nil {
+		return nil, err
+	}
+
+	instance := placement.NewInstance().
+		SetID(endpoint).
+		SetEndpoint(endpoint).
+		SetIsolationGroup(tailIsolationGroup).
+		SetZone(r.serviceID.Zone()).
+		SetWeight(1)
+	if _, err := ps.BuildInitialPlacement([]placement.Instance{instance}, int(numShards), 1); err != nil {
+		return nil, fmt.Errorf("unable to create placement for %s: %v", r.serviceID.String(), err)
+	}
+
+	consumerService := topic.NewConsumerService().
+		SetServiceID(r.serviceID).
+		SetConsumptionType(topic.Replicated).
+		SetMessageTTLNanos(r.messageTTL.Nanoseconds())
+	err = updateTopic(ts, r.cfg.TopicName, func(t topic.Topic) (topic.Topic, error) {
+		return t.AddConsumerService(consumerService)
+	})
+	if err != nil {
+		if err := ps.Delete(); err != nil {
+			r.logger.Errorf("unable to delete placement for %s: %v", r.serviceID.String(), err)
+		}
+		return nil, fmt.Errorf("unable to add consumer service to topic %s: %v", r.cfg.TopicName, err)
+	}
+
+	return func() {
+		err := updateTopic(ts, r.cfg.TopicName, func(t topic.Topic) (topic.Topic, error) {
+			return t.RemoveConsumerService(r.serviceID)
+		})
+		if err != nil {
+			r.logger.Errorf("unable to remove consumer service %s from topic %s: %v",
+				r.serviceID.String(), r.cfg.TopicName, err)
+			return
+		}
+		if err := ps.Delete(); err != nil {
+			r.logger.Errorf("unable to delete placement for %s: %v", r.serviceID.String(), err)
+		}
+	}, nil
+}
+
+// removeStale removes the consumer service and placement of a previous run
+// which was killed before it could unregister, otherwise the placement can
+// not be built again.
+func (r tailRun) removeStale(ts topic.Service, ps placement.Service) error {
+	t, err := ts.Get(r.cfg.TopicName)
+	if err != nil {
+		return fmt.Errorf("unable to get topic %s: %v", r.cfg.TopicName, err)
+	}
+	for _, cs := range t.ConsumerServices() {
+		if !cs.ServiceID().Equal(r.serviceID) {
+			continue
+		}
+		r.logger.Warnf("removing stale consumer service %s from topic %s",
+			r.serviceID.String(), r.cfg.TopicName)
+		err := updateTopic(ts, r.cfg.TopicName, func(t topic.Topic) (topic.Topic, error) {
+			return t.RemoveConsumerService(r.serviceID)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to remove stale consumer service %s from topic %s: %v",
+				r.serviceID.String(), r.cfg.TopicName, err)
+		}
+		break
+	}
+
+	if _, err := ps.Placement(); err == kv.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to get placement for %s: %v", r.serviceID.String(), err)
+	}
+	r.logger.Warnf("deleting stale placement for %s", r.serviceID.String())
+	if err := ps.Delete(); err != nil {
+		return fmt.Errorf("unable to delete stale placement for %s: %v", r.serviceID.String(), err)
+	}
+	return nil
+}
+
+// updateTopic applies an update to the current version of a topic, retrying
+// when the topic is updated concurrently.
+func updateTopic(
+	ts topic.Service,
+	name string,
+	fn func(t topic.Topic) (topic.Topic, error),
+) error {
+	var err error
+	for i := 0; i < maxTopicUpdateAttempts; i++ {
+		var t topic.Topic
+		if t, err = ts.Get(name); err != nil {
+			return err
+		}
+		if t, err = fn(t); err != nil {
+			return err
+		}
+		if _, err = ts.CheckAndSet(t, t.Version()); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func defaultEndpoint(addr net.Addr) (string, error) {
+	_, port, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return "", err
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(hostname, port), nil
+}
+
+// tailProcessor prints, and optionally records, the aggregated metrics it
+// receives.
+type tailProcessor struct {
+	sync.Mutex
+
+	idFilter *regexp.Regexp
+	recorder *recorder
+	out      io.Writer
+	logger   *zap.SugaredLogger
+}
+
+func (p *tailProcessor) Process(m consumer.Message) {
+	defer m.Ack()
+
+	dec := protobuf.NewAggregatedDecoder(nil)
+	defer dec.Close()
+	if err := dec.Decode(m.Bytes()); err != nil {
+		p.logger.Errorf("unable to decode message: %v", err)
+		return
+	}
+	id := dec.ID()
+	if p.idFilter != nil && !p.idFilter.Match(id) {
+		return
+	}
+	sp, err := dec.StoragePolicy()
+	if err != nil {
+		p.logger.Errorf("invalid storage policy for %q: %v", id, err)
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	fmt.Fprintf(p.out, "%s %q %v %s\n",
+		time.Unix(0, dec.TimeNanos()).UTC().Format(time.RFC3339Nano),
+		id, dec.Value(), sp.String())
+	if p.recorder != nil {
+		if err := p.recorder.Record(m.Bytes()); err != nil {
+			p.logger.Errorf("unable to record message: %v", err)
+		}
+	}
+}
+
+func (p *tailProcessor) Close() {}
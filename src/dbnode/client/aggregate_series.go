@@ -0,0 +1,249 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	"github.com/m3db/m3/src/dbnode/topology"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+
+	tchannel "github.com/uber/tchannel-go"
+)
+
+var errAggregateSeriesReadConsistencyLevel = errors.New("aggregate series " +
+	"requires a read consistency level of one or unstrict majority")
+
+type aggregateSeriesHostFn func(
+	ctx gocontext.Context,
+	hostID string,
+	shards []int32,
+) (*rpc.AggregateSeriesRawResult_, error)
+
+func (s *session) AggregateSeries(
+	ctx gocontext.Context, ns ident.ID, q index.Query, opts seriesagg.Options,
+) ([][]seriesagg.Group, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, xerrors.NewNonRetryableError(err)
+	}
+
+	// NB: every shard is aggregated by a single replica, which only
+	// satisfies the consistency of reads from a single node.
+	if !AggregateSeriesReadConsistencyLevel(s.ReadConsistencyLevel()) {
+		return nil, false, xerrors.NewInvalidParamsError(
+			errAggregateSeriesReadConsistencyLevel)
+	}
+
+	req, err := convert.ToRPCAggregateSeriesRawRequest(ns, q, opts)
+	if err != nil {
+		return nil, false, xerrors.NewInvalidParamsError(err)
+	}
+
+	topoMap, err := s.TopologyMap()
+	if err != nil {
+		return nil, false, err
+	}
+
+	replicas, err := aggregateSeriesReplicasByShard(topoMap)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return aggregateSeriesWithFailover(ctx, replicas,
+		func(
+			ctx gocontext.Context,
+			hostID string,
+			shards []int32,
+		) (*rpc.AggregateSeriesRawResult_, error) {
+			hostReq := req
+			hostReq.Shards = shards
+
+			var (
+				result  *rpc.AggregateSeriesRawResult_
+				callErr error
+			)
+			borrowErr := s.BorrowConnection(hostID, func(client rpc.TChanNode) {
+				tctx, cancel := tchannel.NewContextBuilder(s.opts.FetchRequestTimeout()).
+					SetParentContext(ctx).
+					Build()
+				defer cancel()
+				result, callErr = client.AggregateSeriesRaw(tctx, &hostReq)
+			})
+			if err := xerrors.FirstError(borrowErr, callErr); err != nil {
+				return nil, err
+			}
+			return result, nil
+		})
+}
+
+// AggregateSeriesReadConsistencyLevel returns whether series can be
+// aggregated by the nodes that own them at a read consistency level.
+func AggregateSeriesReadConsistencyLevel(level topology.ReadConsistencyLevel) bool {
+	switch level {
+	case topology.ReadConsistencyLevelOne,
+		topology.ReadConsistencyLevelUnstrictMajority:
+		return true
+	default:
+		return false
+	}
+}
+
+// aggregateSeriesWithFailover aggregates every shard exactly once, querying
+// a single replica per shard at a time. The shards of any host that fails
+// are retried against the remaining replicas until each shard has succeeded
+// or has no replica left to try.
+func aggregateSeriesWithFailover(
+	ctx gocontext.Context,
+	replicas map[uint32][]string,
+	fn aggregateSeriesHostFn,
+) ([][]seriesagg.Group, bool, error) {
+	pending := make([]uint32, 0, len(replicas))
+	for shardID := range replicas {
+		pending = append(pending, shardID)
+	}
+
+	var (
+		partials   [][]seriesagg.Group
+		exhaustive = true
+		failed     = make(map[string]struct{})
+		multiErr   xerrors.MultiError
+	)
+	for len(pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, false, xerrors.NewNonRetryableError(err)
+		}
+
+		shardsByHost, err := assignAggregateSeriesShards(pending, replicas, failed)
+		if err != nil {
+			return nil, false, multiErr.Add(err).FinalError()
+		}
+
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			retries []uint32
+		)
+		for hostID, shards := range shardsByHost {
+			hostID, shards := hostID, shards
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				result, err := fn(ctx, hostID, shards)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					multiErr = multiErr.Add(fmt.Errorf(
+						"unable to aggregate series on host %s: %v", hostID, err))
+					failed[hostID] = struct{}{}
+					for _, shardID := range shards {
+						retries = append(retries, uint32(shardID))
+					}
+					return
+				}
+
+				partials = append(partials,
+					convert.FromRPCAggregateSeriesRawResultGroups(result.Groups))
+				exhaustive = exhaustive && result.Exhaustive
+			}()
+		}
+		wg.Wait()
+
+		pending = retries
+	}
+
+	return partials, exhaustive, nil
+}
+
+// aggregateSeriesReplicasByShard returns the available replicas of each
+// shard in routing order.
+func aggregateSeriesReplicasByShard(
+	topoMap topology.Map,
+) (map[uint32][]string, error) {
+	replicas := make(map[uint32][]string)
+	for _, shardID := range topoMap.ShardSet().AllIDs() {
+		var lookupErr error
+		err := topoMap.RouteShardForEach(shardID, func(_ int, host topology.Host) {
+			hostShardSet, ok := topoMap.LookupHostShardSet(host.ID())
+			if !ok {
+				lookupErr = fmt.Errorf("could not find shard set for host ID: %s", host.ID())
+				return
+			}
+			state, err := hostShardSet.ShardSet().LookupStateByID(shardID)
+			if err != nil || state != shard.Available {
+				return
+			}
+			replicas[shardID] = append(replicas[shardID], host.ID())
+		})
+		if err := xerrors.FirstError(err, lookupErr); err != nil {
+			return nil, err
+		}
+		if len(replicas[shardID]) == 0 {
+			return nil, fmt.Errorf("no available replica for shard %d", shardID)
+		}
+	}
+	return replicas, nil
+}
+
+// assignAggregateSeriesShards selects a single replica that has not failed
+// for each shard so that every series is aggregated exactly once, spreading
+// the shards evenly across the hosts.
+func assignAggregateSeriesShards(
+	shards []uint32,
+	replicas map[uint32][]string,
+	failed map[string]struct{},
+) (map[string][]int32, error) {
+	sorted := append([]uint32(nil), shards...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	shardsByHost := make(map[string][]int32)
+	for _, shardID := range sorted {
+		var selected string
+		for _, hostID := range replicas[shardID] {
+			if _, ok := failed[hostID]; ok {
+				continue
+			}
+			if selected == "" ||
+				len(shardsByHost[hostID]) < len(shardsByHost[selected]) {
+				selected = hostID
+			}
+		}
+		if selected == "" {
+			return nil, fmt.Errorf("no remaining replica for shard %d", shardID)
+		}
+
+		shardsByHost[selected] = append(shardsByHost[selected], int32(shardID))
+	}
+	return shardsByHost, nil
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	"github.com/m3db/m3/src/dbnode/topology"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAggregateSeriesTopoMap(
+	t *testing.T,
+	states map[string][]shard.State,
+) topology.Map {
+	hashFn := func(id ident.ID) uint32 { return 0 }
+
+	var (
+		ids           []uint32
+		hostShardSets []topology.HostShardSet
+	)
+	for i := 0; i < len(states[testHostName(0)]); i++ {
+		ids = append(ids, uint32(i))
+	}
+	for i := 0; i < len(states); i++ {
+		id := testHostName(i)
+		var shards []shard.Shard
+		for shardID, state := range states[id] {
+			shards = append(shards, shard.NewShard(uint32(shardID)).SetState(state))
+		}
+		shardSet, err := sharding.NewShardSet(shards, hashFn)
+		require.NoError(t, err)
+		host := topology.NewHost(id, fmt.Sprintf("%s:9000", id))
+		hostShardSets = append(hostShardSets, topology.NewHostShardSet(host, shardSet))
+	}
+
+	shardSet, err := sharding.NewShardSet(sharding.NewShards(ids, shard.Available), hashFn)
+	require.NoError(t, err)
+	return topology.NewStaticMap(topology.NewStaticOptions().
+		SetReplicas(len(states)).
+		SetShardSet(shardSet).
+		SetHostShardSets(hostShardSets))
+}
+
+func TestAssignAggregateSeriesShards(t *testing.T) {
+	topoMap := newTestAggregateSeriesTopoMap(t, map[string][]shard.State{
+		testHostName(0): {shard.Available, shard.Available, shard.Initializing, shard.Available},
+		testHostName(1): {shard.Available, shard.Initializing, shard.Available, shard.Available},
+		testHostName(2): {shard.Initializing, shard.Available, shard.Available, shard.Available},
+	})
+
+	replicas, err := aggregateSeriesReplicasByShard(topoMap)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(replicas))
+
+	shardsByHost, err := assignAggregateSeriesShards(
+		[]uint32{0, 1, 2, 3}, replicas, map[string]struct{}{})
+	require.NoError(t, err)
+
+	seen := make(map[int32]string)
+	for hostID, shards := range shardsByHost {
+		hostShardSet, ok := topoMap.LookupHostShardSet(hostID)
+		require.True(t, ok)
+		assert.True(t, len(shards) <= 2)
+		for _, shardID := range shards {
+			_, dupe := seen[shardID]
+			require.False(t, dupe, "shard %d selected more than once", shardID)
+			seen[shardID] = hostID
+
+			state, err := hostShardSet.ShardSet().LookupStateByID(uint32(shardID))
+			require.NoError(t, err)
+			assert.Equal(t, shard.Available, state)
+		}
+	}
+	assert.Equal(t, 4, len(seen))
+}
+
+func TestAssignAggregateSeriesShardsSkipsFailedHosts(t *testing.T) {
+	replicas := map[uint32][]string{
+		0: {testHostName(0), testHostName(1)},
+		1: {testHostName(0)},
+	}
+
+	shardsByHost, err := assignAggregateSeriesShards([]uint32{0},
+		replicas, map[string]struct{}{testHostName(0): {}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]int32{testHostName(1): {0}}, shardsByHost)
+
+	_, err = assignAggregateSeriesShards([]uint32{1},
+		replicas, map[string]struct{}{testHostName(0): {}})
+	require.Error(t, err)
+}
+
+func TestAggregateSeriesReplicasByShardNoAvailableReplica(t *testing.T) {
+	topoMap := newTestAggregateSeriesTopoMap(t, map[string][]shard.State{
+		testHostName(0): {shard.Available, shard.Initializing},
+		testHostName(1): {shard.Available, shard.Leaving},
+	})
+
+	_, err := aggregateSeriesReplicasByShard(topoMap)
+	require.Error(t, err)
+}
+
+func TestAggregateSeriesWithFailover(t *testing.T) {
+	replicas := map[uint32][]string{
+		0: {testHostName(0), testHostName(1)},
+		1: {testHostName(0), testHostName(1)},
+		2: {testHostName(1), testHostName(0)},
+	}
+
+	var (
+		mu      sync.Mutex
+		queried = make(map[int32]string)
+	)
+	partials, exhaustive, err := aggregateSeriesWithFailover(
+		gocontext.Background(), replicas,
+		func(
+			_ gocontext.Context,
+			hostID string,
+			shards []int32,
+		) (*rpc.AggregateSeriesRawResult_, error) {
+			if hostID == testHostName(0) {
+				return nil, errors.New("host unavailable")
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, shardID := range shards {
+				queried[shardID] = hostID
+			}
+			return &rpc.AggregateSeriesRawResult_{Exhaustive: true}, nil
+		})
+	require.NoError(t, err)
+	assert.True(t, exhaustive)
+	assert.Equal(t, 2, len(partials))
+	assert.Equal(t, map[int32]string{
+		0: testHostName(1),
+		1: testHostName(1),
+		2: testHostName(1),
+	}, queried)
+}
+
+func TestAggregateSeriesWithFailoverNoRemainingReplica(t *testing.T) {
+	replicas := map[uint32][]string{
+		0: {testHostName(0), testHostName(1)},
+	}
+
+	_, _, err := aggregateSeriesWithFailover(gocontext.Background(), replicas,
+		func(
+			_ gocontext.Context,
+			_ string,
+			_ []int32,
+		) (*rpc.AggregateSeriesRawResult_, error) {
+			return nil, errors.New("host unavailable")
+		})
+	require.Error(t, err)
+}
+
+func TestAggregateSeriesWithFailoverContextCanceled(t *testing.T) {
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	cancel()
+
+	_, _, err := aggregateSeriesWithFailover(ctx, map[uint32][]string{
+		0: {testHostName(0)},
+	}, func(
+		_ gocontext.Context,
+		_ string,
+		_ []int32,
+	) (*rpc.AggregateSeriesRawResult_, error) {
+		require.FailNow(t, "host should not be queried")
+		return nil, nil
+	})
+	require.Error(t, err)
+	assert.True(t, xerrors.IsNonRetryableError(err))
+}
+
+func TestAggregateSeriesReadConsistencyLevel(t *testing.T) {
+	assert.False(t, AggregateSeriesReadConsistencyLevel(topology.ReadConsistencyLevelNone))
+	assert.True(t, AggregateSeriesReadConsistencyLevel(topology.ReadConsistencyLevelOne))
+	assert.True(t, AggregateSeriesReadConsistencyLevel(topology.ReadConsistencyLevelUnstrictMajority))
+	assert.False(t, AggregateSeriesReadConsistencyLevel(topology.ReadConsistencyLevelMajority))
+	assert.False(t, AggregateSeriesReadConsistencyLevel(topology.ReadConsistencyLevelAll))
+}
+
+func TestSessionAggregateSeriesMajorityReadConsistencyLevel(t *testing.T) {
+	opts := newSessionTestOptions().
+		SetReadConsistencyLevel(topology.ReadConsistencyLevelMajority)
+	s, err := newSession(opts)
+	require.NoError(t, err)
+
+	_, _, err = s.AggregateSeries(gocontext.Background(),
+		ident.StringID("namespace"), index.Query{}, seriesagg.Options{})
+	require.Error(t, err)
+	assert.True(t, xerrors.IsInvalidParams(err))
+}
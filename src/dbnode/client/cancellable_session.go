@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	gocontext "context"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+// FetchTaggedWithContext resolves the provided query to known IDs, and fetches
+// the data for them, cancelling the fetch once the context is done if the
+// session is a CancellableSession.
+func FetchTaggedWithContext(
+	ctx gocontext.Context,
+	session Session,
+	namespace ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
+) (encoding.SeriesIterators, bool, error) {
+	if s, ok := session.(CancellableSession); ok {
+		return s.FetchTaggedWithContext(ctx, namespace, q, opts)
+	}
+	return session.FetchTagged(namespace, q, opts)
+}
+
+// FetchTaggedIDsWithContext resolves the provided query to known IDs,
+// cancelling the fetch once the context is done if the session is a
+// CancellableSession.
+func FetchTaggedIDsWithContext(
+	ctx gocontext.Context,
+	session Session,
+	namespace ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
+) (TaggedIDsIterator, bool, error) {
+	if s, ok := session.(CancellableSession); ok {
+		return s.FetchTaggedIDsWithContext(ctx, namespace, q, opts)
+	}
+	return session.FetchTaggedIDs(namespace, q, opts)
+}
@@ -25,6 +25,7 @@
 package client
 
 import (
+	context0 "context"
 	"reflect"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
@@ -260,6 +262,36 @@ func (mr *MockSessionMockRecorder) Aggregate(namespace, q, opts interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockSession)(nil).Aggregate), namespace, q, opts)
 }
 
+// AggregateSeries mocks base method
+func (m *MockSession) AggregateSeries(ctx context0.Context, namespace ident.ID, q index.Query, opts seriesagg.Options) ([][]seriesagg.Group, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateSeries", ctx, namespace, q, opts)
+	ret0, _ := ret[0].([][]seriesagg.Group)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AggregateSeries indicates an expected call of AggregateSeries
+func (mr *MockSessionMockRecorder) AggregateSeries(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateSeries", reflect.TypeOf((*MockSession)(nil).AggregateSeries), ctx, namespace, q, opts)
+}
+
+// ReadConsistencyLevel mocks base method
+func (m *MockSession) ReadConsistencyLevel() topology.ReadConsistencyLevel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadConsistencyLevel")
+	ret0, _ := ret[0].(topology.ReadConsistencyLevel)
+	return ret0
+}
+
+// ReadConsistencyLevel indicates an expected call of ReadConsistencyLevel
+func (mr *MockSessionMockRecorder) ReadConsistencyLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockSession)(nil).ReadConsistencyLevel))
+}
+
 // ShardID mocks base method
 func (m *MockSession) ShardID(id ident.ID) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -848,6 +880,36 @@ func (mr *MockAdminSessionMockRecorder) Aggregate(namespace, q, opts interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockAdminSession)(nil).Aggregate), namespace, q, opts)
 }
 
+// AggregateSeries mocks base method
+func (m *MockAdminSession) AggregateSeries(ctx context0.Context, namespace ident.ID, q index.Query, opts seriesagg.Options) ([][]seriesagg.Group, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateSeries", ctx, namespace, q, opts)
+	ret0, _ := ret[0].([][]seriesagg.Group)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AggregateSeries indicates an expected call of AggregateSeries
+func (mr *MockAdminSessionMockRecorder) AggregateSeries(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateSeries", reflect.TypeOf((*MockAdminSession)(nil).AggregateSeries), ctx, namespace, q, opts)
+}
+
+// ReadConsistencyLevel mocks base method
+func (m *MockAdminSession) ReadConsistencyLevel() topology.ReadConsistencyLevel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadConsistencyLevel")
+	ret0, _ := ret[0].(topology.ReadConsistencyLevel)
+	return ret0
+}
+
+// ReadConsistencyLevel indicates an expected call of ReadConsistencyLevel
+func (mr *MockAdminSessionMockRecorder) ReadConsistencyLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockAdminSession)(nil).ReadConsistencyLevel))
+}
+
 // ShardID mocks base method
 func (m *MockAdminSession) ShardID(id ident.ID) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -2377,6 +2439,202 @@ func (mr *MockOptionsMockRecorder) UseV2BatchAPIs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseV2BatchAPIs", reflect.TypeOf((*MockOptions)(nil).UseV2BatchAPIs))
 }
 
+// SetReadRepairSampleRate mocks base method
+func (m *MockOptions) SetReadRepairSampleRate(value float64) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairSampleRate", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairSampleRate indicates an expected call of SetReadRepairSampleRate
+func (mr *MockOptionsMockRecorder) SetReadRepairSampleRate(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairSampleRate", reflect.TypeOf((*MockOptions)(nil).SetReadRepairSampleRate), value)
+}
+
+// ReadRepairSampleRate mocks base method
+func (m *MockOptions) ReadRepairSampleRate() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairSampleRate")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// ReadRepairSampleRate indicates an expected call of ReadRepairSampleRate
+func (mr *MockOptionsMockRecorder) ReadRepairSampleRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairSampleRate", reflect.TypeOf((*MockOptions)(nil).ReadRepairSampleRate))
+}
+
+// SetReadRepairMaxSeriesPerSecond mocks base method
+func (m *MockOptions) SetReadRepairMaxSeriesPerSecond(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairMaxSeriesPerSecond", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairMaxSeriesPerSecond indicates an expected call of SetReadRepairMaxSeriesPerSecond
+func (mr *MockOptionsMockRecorder) SetReadRepairMaxSeriesPerSecond(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairMaxSeriesPerSecond", reflect.TypeOf((*MockOptions)(nil).SetReadRepairMaxSeriesPerSecond), value)
+}
+
+// ReadRepairMaxSeriesPerSecond mocks base method
+func (m *MockOptions) ReadRepairMaxSeriesPerSecond() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairMaxSeriesPerSecond")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairMaxSeriesPerSecond indicates an expected call of ReadRepairMaxSeriesPerSecond
+func (mr *MockOptionsMockRecorder) ReadRepairMaxSeriesPerSecond() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairMaxSeriesPerSecond", reflect.TypeOf((*MockOptions)(nil).ReadRepairMaxSeriesPerSecond))
+}
+
+// SetReadRepairConcurrency mocks base method
+func (m *MockOptions) SetReadRepairConcurrency(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairConcurrency", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairConcurrency indicates an expected call of SetReadRepairConcurrency
+func (mr *MockOptionsMockRecorder) SetReadRepairConcurrency(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairConcurrency", reflect.TypeOf((*MockOptions)(nil).SetReadRepairConcurrency), value)
+}
+
+// ReadRepairConcurrency mocks base method
+func (m *MockOptions) ReadRepairConcurrency() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairConcurrency")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairConcurrency indicates an expected call of ReadRepairConcurrency
+func (mr *MockOptionsMockRecorder) ReadRepairConcurrency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairConcurrency", reflect.TypeOf((*MockOptions)(nil).ReadRepairConcurrency))
+}
+
+// SetHintedHandoffPath mocks base method
+func (m *MockOptions) SetHintedHandoffPath(value string) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHintedHandoffPath", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHintedHandoffPath indicates an expected call of SetHintedHandoffPath
+func (mr *MockOptionsMockRecorder) SetHintedHandoffPath(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffPath", reflect.TypeOf((*MockOptions)(nil).SetHintedHandoffPath), value)
+}
+
+// HintedHandoffPath mocks base method
+func (m *MockOptions) HintedHandoffPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HintedHandoffPath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HintedHandoffPath indicates an expected call of HintedHandoffPath
+func (mr *MockOptionsMockRecorder) HintedHandoffPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffPath", reflect.TypeOf((*MockOptions)(nil).HintedHandoffPath))
+}
+
+// SetHintedHandoffMaxBytes mocks base method
+func (m *MockOptions) SetHintedHandoffMaxBytes(value int64) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHintedHandoffMaxBytes", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHintedHandoffMaxBytes indicates an expected call of SetHintedHandoffMaxBytes
+func (mr *MockOptionsMockRecorder) SetHintedHandoffMaxBytes(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffMaxBytes", reflect.TypeOf((*MockOptions)(nil).SetHintedHandoffMaxBytes), value)
+}
+
+// HintedHandoffMaxBytes mocks base method
+func (m *MockOptions) HintedHandoffMaxBytes() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HintedHandoffMaxBytes")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// HintedHandoffMaxBytes indicates an expected call of HintedHandoffMaxBytes
+func (mr *MockOptionsMockRecorder) HintedHandoffMaxBytes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffMaxBytes", reflect.TypeOf((*MockOptions)(nil).HintedHandoffMaxBytes))
+}
+
+// SetHintedHandoffMaxHintAge mocks base method
+func (m *MockOptions) SetHintedHandoffMaxHintAge(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHintedHandoffMaxHintAge", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHintedHandoffMaxHintAge indicates an expected call of SetHintedHandoffMaxHintAge
+func (mr *MockOptionsMockRecorder) SetHintedHandoffMaxHintAge(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffMaxHintAge", reflect.TypeOf((*MockOptions)(nil).SetHintedHandoffMaxHintAge), value)
+}
+
+// HintedHandoffMaxHintAge mocks base method
+func (m *MockOptions) HintedHandoffMaxHintAge() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HintedHandoffMaxHintAge")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// HintedHandoffMaxHintAge indicates an expected call of HintedHandoffMaxHintAge
+func (mr *MockOptionsMockRecorder) HintedHandoffMaxHintAge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffMaxHintAge", reflect.TypeOf((*MockOptions)(nil).HintedHandoffMaxHintAge))
+}
+
+// SetHintedHandoffReplayInterval mocks base method
+func (m *MockOptions) SetHintedHandoffReplayInterval(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHintedHandoffReplayInterval", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHintedHandoffReplayInterval indicates an expected call of SetHintedHandoffReplayInterval
+func (mr *MockOptionsMockRecorder) SetHintedHandoffReplayInterval(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffReplayInterval", reflect.TypeOf((*MockOptions)(nil).SetHintedHandoffReplayInterval), value)
+}
+
+// HintedHandoffReplayInterval mocks base method
+func (m *MockOptions) HintedHandoffReplayInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HintedHandoffReplayInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// HintedHandoffReplayInterval indicates an expected call of HintedHandoffReplayInterval
+func (mr *MockOptionsMockRecorder) HintedHandoffReplayInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffReplayInterval", reflect.TypeOf((*MockOptions)(nil).HintedHandoffReplayInterval))
+}
+
 // MockAdminOptions is a mock of AdminOptions interface
 type MockAdminOptions struct {
 	ctrl     *gomock.Controller
@@ -3744,6 +4002,202 @@ func (mr *MockAdminOptionsMockRecorder) UseV2BatchAPIs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseV2BatchAPIs", reflect.TypeOf((*MockAdminOptions)(nil).UseV2BatchAPIs))
 }
 
+// SetReadRepairSampleRate mocks base method
+func (m *MockAdminOptions) SetReadRepairSampleRate(value float64) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairSampleRate", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairSampleRate indicates an expected call of SetReadRepairSampleRate
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairSampleRate(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairSampleRate", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairSampleRate), value)
+}
+
+// ReadRepairSampleRate mocks base method
+func (m *MockAdminOptions) ReadRepairSampleRate() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairSampleRate")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// ReadRepairSampleRate indicates an expected call of ReadRepairSampleRate
+func (mr *MockAdminOptionsMockRecorder) ReadRepairSampleRate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairSampleRate", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairSampleRate))
+}
+
+// SetReadRepairMaxSeriesPerSecond mocks base method
+func (m *MockAdminOptions) SetReadRepairMaxSeriesPerSecond(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairMaxSeriesPerSecond", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairMaxSeriesPerSecond indicates an expected call of SetReadRepairMaxSeriesPerSecond
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairMaxSeriesPerSecond(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairMaxSeriesPerSecond", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairMaxSeriesPerSecond), value)
+}
+
+// ReadRepairMaxSeriesPerSecond mocks base method
+func (m *MockAdminOptions) ReadRepairMaxSeriesPerSecond() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairMaxSeriesPerSecond")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairMaxSeriesPerSecond indicates an expected call of ReadRepairMaxSeriesPerSecond
+func (mr *MockAdminOptionsMockRecorder) ReadRepairMaxSeriesPerSecond() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairMaxSeriesPerSecond", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairMaxSeriesPerSecond))
+}
+
+// SetReadRepairConcurrency mocks base method
+func (m *MockAdminOptions) SetReadRepairConcurrency(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairConcurrency", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairConcurrency indicates an expected call of SetReadRepairConcurrency
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairConcurrency(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairConcurrency", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairConcurrency), value)
+}
+
+// ReadRepairConcurrency mocks base method
+func (m *MockAdminOptions) ReadRepairConcurrency() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairConcurrency")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairConcurrency indicates an expected call of ReadRepairConcurrency
+func (mr *MockAdminOptionsMockRecorder) ReadRepairConcurrency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairConcurrency", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairConcurrency))
+}
+
+// SetHintedHandoffPath mocks base method
+func (m *MockAdminOptions) SetHintedHandoffPath(value string) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHintedHandoffPath", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHintedHandoffPath indicates an expected call of SetHintedHandoffPath
+func (mr *MockAdminOptionsMockRecorder) SetHintedHandoffPath(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffPath", reflect.TypeOf((*MockAdminOptions)(nil).SetHintedHandoffPath), value)
+}
+
+// HintedHandoffPath mocks base method
+func (m *MockAdminOptions) HintedHandoffPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HintedHandoffPath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HintedHandoffPath indicates an expected call of HintedHandoffPath
+func (mr *MockAdminOptionsMockRecorder) HintedHandoffPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffPath", reflect.TypeOf((*MockAdminOptions)(nil).HintedHandoffPath))
+}
+
+// SetHintedHandoffMaxBytes mocks base method
+func (m *MockAdminOptions) SetHintedHandoffMaxBytes(value int64) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHintedHandoffMaxBytes", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHintedHandoffMaxBytes indicates an expected call of SetHintedHandoffMaxBytes
+func (mr *MockAdminOptionsMockRecorder) SetHintedHandoffMaxBytes(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffMaxBytes", reflect.TypeOf((*MockAdminOptions)(nil).SetHintedHandoffMaxBytes), value)
+}
+
+// HintedHandoffMaxBytes mocks base method
+func (m *MockAdminOptions) HintedHandoffMaxBytes() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HintedHandoffMaxBytes")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// HintedHandoffMaxBytes indicates an expected call of HintedHandoffMaxBytes
+func (mr *MockAdminOptionsMockRecorder) HintedHandoffMaxBytes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffMaxBytes", reflect.TypeOf((*MockAdminOptions)(nil).HintedHandoffMaxBytes))
+}
+
+// SetHintedHandoffMaxHintAge mocks base method
+func (m *MockAdminOptions) SetHintedHandoffMaxHintAge(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHintedHandoffMaxHintAge", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHintedHandoffMaxHintAge indicates an expected call of SetHintedHandoffMaxHintAge
+func (mr *MockAdminOptionsMockRecorder) SetHintedHandoffMaxHintAge(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffMaxHintAge", reflect.TypeOf((*MockAdminOptions)(nil).SetHintedHandoffMaxHintAge), value)
+}
+
+// HintedHandoffMaxHintAge mocks base method
+func (m *MockAdminOptions) HintedHandoffMaxHintAge() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HintedHandoffMaxHintAge")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// HintedHandoffMaxHintAge indicates an expected call of HintedHandoffMaxHintAge
+func (mr *MockAdminOptionsMockRecorder) HintedHandoffMaxHintAge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffMaxHintAge", reflect.TypeOf((*MockAdminOptions)(nil).HintedHandoffMaxHintAge))
+}
+
+// SetHintedHandoffReplayInterval mocks base method
+func (m *MockAdminOptions) SetHintedHandoffReplayInterval(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHintedHandoffReplayInterval", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetHintedHandoffReplayInterval indicates an expected call of SetHintedHandoffReplayInterval
+func (mr *MockAdminOptionsMockRecorder) SetHintedHandoffReplayInterval(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHintedHandoffReplayInterval", reflect.TypeOf((*MockAdminOptions)(nil).SetHintedHandoffReplayInterval), value)
+}
+
+// HintedHandoffReplayInterval mocks base method
+func (m *MockAdminOptions) HintedHandoffReplayInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HintedHandoffReplayInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// HintedHandoffReplayInterval indicates an expected call of HintedHandoffReplayInterval
+func (mr *MockAdminOptionsMockRecorder) HintedHandoffReplayInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HintedHandoffReplayInterval", reflect.TypeOf((*MockAdminOptions)(nil).HintedHandoffReplayInterval))
+}
+
 // SetOrigin mocks base method
 func (m *MockAdminOptions) SetOrigin(value topology.Host) AdminOptions {
 	m.ctrl.T.Helper()
@@ -4097,6 +4551,36 @@ func (mr *MockclientSessionMockRecorder) Aggregate(namespace, q, opts interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockclientSession)(nil).Aggregate), namespace, q, opts)
 }
 
+// AggregateSeries mocks base method
+func (m *MockclientSession) AggregateSeries(ctx context0.Context, namespace ident.ID, q index.Query, opts seriesagg.Options) ([][]seriesagg.Group, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateSeries", ctx, namespace, q, opts)
+	ret0, _ := ret[0].([][]seriesagg.Group)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AggregateSeries indicates an expected call of AggregateSeries
+func (mr *MockclientSessionMockRecorder) AggregateSeries(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateSeries", reflect.TypeOf((*MockclientSession)(nil).AggregateSeries), ctx, namespace, q, opts)
+}
+
+// ReadConsistencyLevel mocks base method
+func (m *MockclientSession) ReadConsistencyLevel() topology.ReadConsistencyLevel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadConsistencyLevel")
+	ret0, _ := ret[0].(topology.ReadConsistencyLevel)
+	return ret0
+}
+
+// ReadConsistencyLevel indicates an expected call of ReadConsistencyLevel
+func (mr *MockclientSessionMockRecorder) ReadConsistencyLevel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockclientSession)(nil).ReadConsistencyLevel))
+}
+
 // ShardID mocks base method
 func (m *MockclientSession) ShardID(id ident.ID) (uint32, error) {
 	m.ctrl.T.Helper()
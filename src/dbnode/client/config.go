@@ -100,6 +100,42 @@ type Configuration struct {
 	// UseV2BatchAPIs determines whether the V2 batch APIs are used. Note that the M3DB nodes must
 	// have support for the V2 APIs in order for this feature to be used.
 	UseV2BatchAPIs *bool `yaml:"useV2BatchAPIs"`
+
+	// ReadRepair configures repairing divergent replicas detected by reads at
+	// majority or all read consistency.
+	ReadRepair *ReadRepairConfiguration `yaml:"readRepair"`
+
+	// HintedHandoff configures queueing writes that failed against individual
+	// replicas on local disk and replaying them once the replica reconnects.
+	HintedHandoff *HintedHandoffConfiguration `yaml:"hintedHandoff"`
+}
+
+// HintedHandoffConfiguration is the configuration for hinted handoff.
+type HintedHandoffConfiguration struct {
+	// Path is the directory hints are stored in.
+	Path string `yaml:"path"`
+
+	// MaxBytes is the maximum number of bytes of hints stored across all hosts.
+	MaxBytes *int64 `yaml:"maxBytes"`
+
+	// MaxHintAge is the maximum age of a hint before it is dropped.
+	MaxHintAge *time.Duration `yaml:"maxHintAge"`
+
+	// ReplayInterval is the interval at which hints are synced and replayed.
+	ReplayInterval *time.Duration `yaml:"replayInterval"`
+}
+
+// ReadRepairConfiguration is the configuration for read repair.
+type ReadRepairConfiguration struct {
+	// SampleRate is the fraction of reads that are checked for divergent
+	// replicas, zero disables read repair.
+	SampleRate float64 `yaml:"sampleRate"`
+
+	// MaxSeriesPerSecond is the maximum number of series repaired per second.
+	MaxSeriesPerSecond *int `yaml:"maxSeriesPerSecond"`
+
+	// Concurrency is the number of reads that may be repaired concurrently.
+	Concurrency *int `yaml:"concurrency"`
 }
 
 // ProtoConfiguration is the configuration for running with ProtoDataMode enabled.
@@ -183,6 +219,39 @@ func (c *Configuration) Validate() error {
 			*c.AsyncWriteMaxConcurrency)
 	}
 
+	if c.ReadRepair != nil {
+		if c.ReadRepair.SampleRate < 0 || c.ReadRepair.SampleRate > 1 {
+			return fmt.Errorf("m3db client read repair sample rate was: %f but must be >= 0 and <= 1",
+				c.ReadRepair.SampleRate)
+		}
+		if c.ReadRepair.MaxSeriesPerSecond != nil && *c.ReadRepair.MaxSeriesPerSecond <= 0 {
+			return fmt.Errorf("m3db client read repair max series per second was: %d but must be >0",
+				*c.ReadRepair.MaxSeriesPerSecond)
+		}
+		if c.ReadRepair.Concurrency != nil && *c.ReadRepair.Concurrency <= 0 {
+			return fmt.Errorf("m3db client read repair concurrency was: %d but must be >0",
+				*c.ReadRepair.Concurrency)
+		}
+	}
+
+	if hh := c.HintedHandoff; hh != nil {
+		if hh.Path == "" {
+			return errors.New("m3db client hinted handoff path must be set")
+		}
+		if hh.MaxBytes != nil && *hh.MaxBytes <= 0 {
+			return fmt.Errorf("m3db client hinted handoff max bytes was: %d but must be >0",
+				*hh.MaxBytes)
+		}
+		if hh.MaxHintAge != nil && *hh.MaxHintAge <= 0 {
+			return fmt.Errorf("m3db client hinted handoff max hint age was: %v but must be >0",
+				*hh.MaxHintAge)
+		}
+		if hh.ReplayInterval != nil && *hh.ReplayInterval <= 0 {
+			return fmt.Errorf("m3db client hinted handoff replay interval was: %v but must be >0",
+				*hh.ReplayInterval)
+		}
+	}
+
 	if err := c.Proto.Validate(); err != nil {
 		return fmt.Errorf("error validating M3DB client proto configuration: %v", err)
 	}
@@ -328,6 +397,29 @@ func (c Configuration) NewAdminClient(
 		v = v.SetAsyncWriteMaxConcurrency(*c.AsyncWriteMaxConcurrency)
 	}
 
+	if rr := c.ReadRepair; rr != nil {
+		v = v.SetReadRepairSampleRate(rr.SampleRate)
+		if rr.MaxSeriesPerSecond != nil {
+			v = v.SetReadRepairMaxSeriesPerSecond(*rr.MaxSeriesPerSecond)
+		}
+		if rr.Concurrency != nil {
+			v = v.SetReadRepairConcurrency(*rr.Concurrency)
+		}
+	}
+
+	if hh := c.HintedHandoff; hh != nil {
+		v = v.SetHintedHandoffPath(hh.Path)
+		if hh.MaxBytes != nil {
+			v = v.SetHintedHandoffMaxBytes(*hh.MaxBytes)
+		}
+		if hh.MaxHintAge != nil {
+			v = v.SetHintedHandoffMaxHintAge(*hh.MaxHintAge)
+		}
+		if hh.ReplayInterval != nil {
+			v = v.SetHintedHandoffReplayInterval(*hh.ReplayInterval)
+		}
+	}
+
 	if c.WriteConsistencyLevel != nil {
 		v = v.SetWriteConsistencyLevel(*c.WriteConsistencyLevel)
 	}
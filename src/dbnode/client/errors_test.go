@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
 	"github.com/m3db/m3/src/dbnode/topology"
 	xerrors "github.com/m3db/m3/src/x/errors"
 
@@ -52,3 +53,17 @@ func TestConsistencyResultError(t *testing.T) {
 	assert.Equal(t, 1, NumSuccess(err))
 	assert.Equal(t, 2, NumError(err))
 }
+
+func TestIsResourceExhaustedError(t *testing.T) {
+	exhaustedErr := tterrors.NewResourceExhaustedError(fmt.Errorf("exhausted"))
+	level := topology.ReadConsistencyLevelMajority
+	err := error(newConsistencyResultError(level, 3, 3,
+		[]error{fmt.Errorf("another error"), exhaustedErr}))
+
+	assert.True(t, IsResourceExhaustedError(err))
+	assert.True(t, IsBadRequestError(err))
+
+	badReqErr := tterrors.NewBadRequestError(fmt.Errorf("bad request"))
+	assert.False(t, IsResourceExhaustedError(badReqErr))
+	assert.False(t, IsResourceExhaustedError(fmt.Errorf("another error")))
+}
@@ -21,6 +21,7 @@
 package client
 
 import (
+	gocontext "context"
 	"errors"
 	"fmt"
 	"sync"
@@ -29,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/serialize"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/dbnode/namespace"
@@ -169,6 +171,35 @@ func (f *fetchState) completionFn(
 	}
 }
 
+// WaitWithContext waits for the fetch to complete, failing it early with the
+// context error if the context is done first. Like Wait, it must be called
+// while holding the lock, which is held again once it returns.
+func (f *fetchState) WaitWithContext(ctx gocontext.Context) {
+	done := ctx.Done()
+	if done == nil {
+		f.Wait()
+		return
+	}
+
+	stop := make(chan struct{})
+	f.incRef() // take a ref for the go-routine watching the context
+	go func() {
+		select {
+		case <-done:
+			f.Lock()
+			if !f.done {
+				f.markDoneWithLock(xerrors.NewNonRetryableError(ctx.Err()))
+			}
+			f.Unlock()
+		case <-stop:
+		}
+		f.decRef() // release the ref for the go-routine watching the context
+	}()
+
+	f.Wait()
+	close(stop)
+}
+
 func (f *fetchState) markDoneWithLock(err error) {
 	f.done = true
 	f.err = err
@@ -219,6 +250,36 @@ func (f *fetchState) asEncodingSeriesIterators(pools fetchTaggedPools, descr nam
 	return f.tagResultAccumulator.AsEncodingSeriesIterators(limit, pools, descr)
 }
 
+// readRepairRequest returns the responses received from each host if the
+// fetch was sampled for read repair and completed successfully. Fetches for
+// which any host returned a non-exhaustive result are not repaired since a
+// series missing from a truncated response is not divergent.
+func (f *fetchState) readRepairRequest(
+	descr namespace.SchemaDescr,
+) (readRepairRequest, bool) {
+	f.Lock()
+	defer f.Unlock()
+
+	accum := &f.tagResultAccumulator
+	if !f.done || f.err != nil || !accum.recordHostResponses ||
+		!accum.exhaustive || len(accum.hostResponses) < 2 {
+		return readRepairRequest{}, false
+	}
+
+	// NB: copy the responses and namespace as the fetch state is returned to
+	// the pool before the repair runs.
+	responses := make([]fetchTaggedHostResponse, len(accum.hostResponses))
+	copy(responses, accum.hostResponses)
+	return readRepairRequest{
+		namespace: append([]byte(nil), f.nsID.Bytes()...),
+		topoMap:   accum.topoMap,
+		start:     accum.startTime,
+		end:       accum.endTime,
+		schema:    descr,
+		responses: responses,
+	}, true
+}
+
 func (f *fetchState) asAggregatedTagsIterator(pools fetchTaggedPools) (AggregatedTagsIterator, bool, error) {
 	f.Lock()
 	defer f.Unlock()
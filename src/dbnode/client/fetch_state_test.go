@@ -21,8 +21,11 @@
 package client
 
 import (
+	"context"
 	"testing"
 
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
 
 	"github.com/stretchr/testify/require"
@@ -59,6 +62,51 @@ func TestFetchStatePoolValidInteraction(t *testing.T) {
 	require.Nil(t, s.fetchTaggedOp)
 }
 
+func TestFetchStateWaitWithContextCancelled(t *testing.T) {
+	p := newFetchStatePool(pool.NewObjectPoolOptions().SetSize(1))
+	p.Init()
+	s := p.Get()
+
+	testPool := &testFetchStatePool{t, s, false}
+	s.pool = testPool
+
+	s.fetchTaggedOp = newFetchTaggedOp(nil)
+	s.fetchTaggedOp.incRef()
+	s.incRef()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.Lock()
+	s.WaitWithContext(ctx)
+	require.True(t, s.done)
+	require.Error(t, s.err)
+	require.True(t, xerrors.IsNonRetryableError(s.err))
+	require.Equal(t, context.Canceled, xerrors.GetInnerNonRetryableError(s.err))
+	s.Unlock()
+	s.decRef()
+}
+
+func TestFetchStateReadRepairRequestRequiresExhaustive(t *testing.T) {
+	s := newFetchState(nil)
+	s.nsID = ident.StringID("testNs")
+	s.done = true
+
+	accum := &s.tagResultAccumulator
+	accum.recordHostResponses = true
+	accum.hostResponses = make([]fetchTaggedHostResponse, 2)
+
+	accum.exhaustive = true
+	_, ok := s.readRepairRequest(nil)
+	require.True(t, ok)
+
+	// A series may be missing from a truncated response without the host
+	// having diverged.
+	accum.exhaustive = false
+	_, ok = s.readRepairRequest(nil)
+	require.False(t, ok)
+}
+
 type testFetchStatePool struct {
 	t             *testing.T
 	expectedState *fetchState
@@ -21,6 +21,8 @@
 package client
 
 import (
+	gocontext "context"
+
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/x/ident"
@@ -45,6 +47,7 @@ type fetchTaggedAttempt struct {
 }
 
 type fetchTaggedAttemptArgs struct {
+	ctx   gocontext.Context
 	ns    ident.ID
 	query index.Query
 	opts  index.QueryOptions
@@ -62,14 +65,14 @@ func (f *fetchTaggedAttempt) reset() {
 func (f *fetchTaggedAttempt) performIDsAttempt() error {
 	var err error
 	f.idsResultIter, f.idsResultExhaustive, err = f.session.fetchTaggedIDsAttempt(
-		f.args.ns, f.args.query, f.args.opts)
+		f.args.ctx, f.args.ns, f.args.query, f.args.opts)
 	return err
 }
 
 func (f *fetchTaggedAttempt) performDataAttempt() error {
 	var err error
 	f.dataResultIters, f.dataResultExhaustive, err = f.session.fetchTaggedAttempt(
-		f.args.ns, f.args.query, f.args.opts)
+		f.args.ctx, f.args.ns, f.args.query, f.args.opts)
 	return err
 }
 
@@ -21,6 +21,8 @@
 package client
 
 import (
+	gocontext "context"
+
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/x/pool"
 )
@@ -34,6 +36,10 @@ type fetchTaggedOp struct {
 	request      rpc.FetchTaggedRequest
 	completionFn completionFn
 
+	// ctx is the context of the fetch, if set outstanding requests are
+	// cancelled once it is done.
+	ctx gocontext.Context
+
 	pool fetchTaggedOpPool
 }
 
@@ -55,6 +61,7 @@ func (f *fetchTaggedOp) requestLimit(defaultValue int) int {
 func (f *fetchTaggedOp) close() {
 	f.completionFn = nil
 	f.request = fetchTaggedOpRequestZeroed
+	f.ctx = nil
 	// return to pool
 	if f.pool == nil {
 		return
@@ -69,6 +69,11 @@ type fetchTaggedResultAccumulator struct {
 	majority         int
 	consistencyLevel topology.ReadConsistencyLevel
 	topoMap          topology.Map
+
+	// NB: the per host responses are only retained when the fetch has been
+	// sampled for read repair.
+	recordHostResponses bool
+	hostResponses       []fetchTaggedHostResponse
 }
 
 type fetchTaggedShardConsistencyResult struct {
@@ -91,6 +96,12 @@ func (accum *fetchTaggedResultAccumulator) AddFetchTaggedResponse(
 		for _, elem := range opts.response.Elements {
 			accum.fetchResponses = append(accum.fetchResponses, elem)
 		}
+		if accum.recordHostResponses {
+			accum.hostResponses = append(accum.hostResponses, fetchTaggedHostResponse{
+				host:     opts.host,
+				elements: opts.response.Elements,
+			})
+		}
 	}
 
 	return accum.accumulatedResult(opts.host, resultErr)
@@ -202,6 +213,11 @@ func (accum *fetchTaggedResultAccumulator) Clear() {
 		accum.aggResponses[i] = nil
 	}
 	accum.aggResponses = accum.aggResponses[:0]
+	for i := range accum.hostResponses {
+		accum.hostResponses[i] = fetchTaggedHostResponse{}
+	}
+	accum.hostResponses = accum.hostResponses[:0]
+	accum.recordHostResponses = false
 	for i := range accum.errors {
 		accum.errors[i] = nil
 	}
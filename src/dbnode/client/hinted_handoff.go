@@ -0,0 +1,718 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	hintFileSuffix       = ".hints"
+	hintReplayFileSuffix = ".hints.replay"
+
+	// hintHeaderLen is the length of the record header, the length of the
+	// record followed by its checksum.
+	hintHeaderLen = 8
+
+	// hintMaxRecordLen is the maximum length of a hint record payload, larger
+	// lengths can only be read from a corrupt record.
+	hintMaxRecordLen = 64 * 1024 * 1024
+
+	// hintQueueSize is the number of hints that may be waiting to be written
+	// to disk before further hints are dropped.
+	hintQueueSize = 4096
+
+	hintFlagTagged byte = 1 << 0
+)
+
+var (
+	errHintCorrupt       = errors.New("hint record is corrupt")
+	errHintedHandoffOpen = errors.New("hinted handoff is already open")
+)
+
+// hint is a write that failed against a single replica and is queued to be
+// replayed to it once it is reachable again.
+type hint struct {
+	created     time.Time
+	tagged      bool
+	namespace   []byte
+	id          []byte
+	encodedTags []byte
+	value       float64
+	timestamp   int64
+	timeType    rpc.TimeType
+	annotation  []byte
+}
+
+// newHint returns the hint for a write op, the hint references the op's
+// buffers and must be encoded before the op is closed.
+func newHint(op writeOp, created time.Time) (hint, bool) {
+	switch w := op.(type) {
+	case *writeOperation:
+		return hint{
+			created:    created,
+			namespace:  w.namespace.Bytes(),
+			id:         w.request.ID,
+			value:      w.request.Datapoint.Value,
+			timestamp:  w.request.Datapoint.Timestamp,
+			timeType:   w.request.Datapoint.TimestampTimeType,
+			annotation: w.request.Datapoint.Annotation,
+		}, true
+	case *writeTaggedOperation:
+		return hint{
+			created:     created,
+			tagged:      true,
+			namespace:   w.namespace.Bytes(),
+			id:          w.request.ID,
+			encodedTags: w.request.EncodedTags,
+			value:       w.request.Datapoint.Value,
+			timestamp:   w.request.Datapoint.Timestamp,
+			timeType:    w.request.Datapoint.TimestampTimeType,
+			annotation:  w.request.Datapoint.Annotation,
+		}, true
+	}
+	return hint{}, false
+}
+
+// encodeHint encodes a hint as a length prefixed and checksummed record.
+func encodeHint(h hint) []byte {
+	size := hintHeaderLen + 8 + 1 + 8 + 8 + 8 +
+		4*binary.MaxVarintLen64 + len(h.namespace) + len(h.id) +
+		len(h.encodedTags) + len(h.annotation)
+	buf := make([]byte, hintHeaderLen, size)
+
+	var scratch [binary.MaxVarintLen64]byte
+	putUint64 := func(v uint64) {
+		binary.BigEndian.PutUint64(scratch[:8], v)
+		buf = append(buf, scratch[:8]...)
+	}
+	putBytes := func(b []byte) {
+		n := binary.PutUvarint(scratch[:], uint64(len(b)))
+		buf = append(buf, scratch[:n]...)
+		buf = append(buf, b...)
+	}
+
+	var flags byte
+	if h.tagged {
+		flags |= hintFlagTagged
+	}
+	putUint64(uint64(h.created.UnixNano()))
+	buf = append(buf, flags)
+	putUint64(uint64(h.timestamp))
+	putUint64(uint64(h.timeType))
+	putUint64(math.Float64bits(h.value))
+	putBytes(h.namespace)
+	putBytes(h.id)
+	putBytes(h.encodedTags)
+	putBytes(h.annotation)
+
+	payload := buf[hintHeaderLen:]
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], digest.Checksum(payload))
+	return buf
+}
+
+// decodeHint decodes the payload of a hint record, the returned hint
+// references the payload.
+func decodeHint(payload []byte) (hint, error) {
+	var (
+		h   hint
+		err error
+	)
+	getUint64 := func() uint64 {
+		if err != nil || len(payload) < 8 {
+			err = errHintCorrupt
+			return 0
+		}
+		v := binary.BigEndian.Uint64(payload)
+		payload = payload[8:]
+		return v
+	}
+	getBytes := func() []byte {
+		if err != nil {
+			return nil
+		}
+		l, n := binary.Uvarint(payload)
+		if n <= 0 || uint64(len(payload)-n) < l {
+			err = errHintCorrupt
+			return nil
+		}
+		b := payload[n : n+int(l)]
+		payload = payload[n+int(l):]
+		if len(b) == 0 {
+			return nil
+		}
+		return b
+	}
+
+	h.created = time.Unix(0, int64(getUint64()))
+	if err == nil && len(payload) < 1 {
+		err = errHintCorrupt
+	}
+	if err == nil {
+		h.tagged = payload[0]&hintFlagTagged != 0
+		payload = payload[1:]
+	}
+	h.timestamp = int64(getUint64())
+	h.timeType = rpc.TimeType(getUint64())
+	h.value = math.Float64frombits(getUint64())
+	h.namespace = getBytes()
+	h.id = getBytes()
+	h.encodedTags = getBytes()
+	h.annotation = getBytes()
+	if err != nil {
+		return hint{}, err
+	}
+	return h, nil
+}
+
+// readHint reads the next hint record and returns the hint along with the
+// raw record, io.EOF is returned at the end of the file and errHintCorrupt
+// for a torn or corrupt record.
+func readHint(r *bufio.Reader) (hint, []byte, error) {
+	header := make([]byte, hintHeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = errHintCorrupt
+		}
+		return hint{}, nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[0:4])
+	if size > hintMaxRecordLen {
+		return hint{}, nil, errHintCorrupt
+	}
+	record := make([]byte, hintHeaderLen+int(size))
+	copy(record, header)
+	payload := record[hintHeaderLen:]
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return hint{}, nil, errHintCorrupt
+	}
+	if digest.Checksum(payload) != binary.BigEndian.Uint32(header[4:8]) {
+		return hint{}, nil, errHintCorrupt
+	}
+
+	h, err := decodeHint(payload)
+	if err != nil {
+		return hint{}, nil, err
+	}
+	return h, record, nil
+}
+
+type hintWriteFn func(hostID string, h hint, completionFn func(err error)) error
+
+type hintConnectedFn func(hostID string) bool
+
+type hintRemovedFn func(hostID string) bool
+
+type hintedHandoffMetrics struct {
+	hinted         tally.Counter
+	replayed       tally.Counter
+	replayErrors   tally.Counter
+	droppedFull    tally.Counter
+	droppedQueue   tally.Counter
+	droppedExpired tally.Counter
+	droppedCorrupt tally.Counter
+	droppedRemoved tally.Counter
+	writeErrors    tally.Counter
+	pendingBytes   tally.Gauge
+}
+
+func newHintedHandoffMetrics(scope tally.Scope) hintedHandoffMetrics {
+	dropped := func(reason string) tally.Counter {
+		return scope.Tagged(map[string]string{"reason": reason}).Counter("dropped")
+	}
+	return hintedHandoffMetrics{
+		hinted:         scope.Counter("hinted"),
+		replayed:       scope.Counter("replayed"),
+		replayErrors:   scope.Counter("replay-errors"),
+		droppedFull:    dropped("max-bytes"),
+		droppedQueue:   dropped("queue-full"),
+		droppedExpired: dropped("max-age"),
+		droppedCorrupt: dropped("corrupt"),
+		droppedRemoved: dropped("host-removed"),
+		writeErrors:    scope.Counter("write-errors"),
+		pendingBytes:   scope.Gauge("pending-bytes"),
+	}
+}
+
+type pendingHint struct {
+	hostID string
+	record []byte
+}
+
+type hintFile struct {
+	fd         *os.File
+	size       int64
+	replaySize int64
+	dirty      bool
+}
+
+// hintedHandoff durably queues writes that failed against individual
+// replicas in an append only file per host and replays them once the host
+// has open connections again. Disk usage is bounded by a maximum number of
+// bytes across all hosts, hints that cannot be stored are dropped and hints
+// older than the maximum hint age are dropped instead of being replayed.
+// The hints of hosts that are removed from the topology are dropped.
+type hintedHandoff struct {
+	sync.Mutex
+
+	dir            string
+	maxBytes       int64
+	maxAge         time.Duration
+	replayInterval time.Duration
+	writeFn        hintWriteFn
+	connectedFn    hintConnectedFn
+	removedFn      hintRemovedFn
+	nowFn          clock.NowFn
+	logger         *zap.Logger
+	metrics        hintedHandoffMetrics
+
+	files      map[string]*hintFile
+	totalBytes int64
+	pending    chan pendingHint
+	opened     bool
+	closed     bool
+	doneCh     chan struct{}
+	wg         sync.WaitGroup
+}
+
+// newHintedHandoff returns a new hinted handoff, or nil if hinted handoff
+// is disabled.
+func newHintedHandoff(
+	opts Options,
+	writeFn hintWriteFn,
+	connectedFn hintConnectedFn,
+	removedFn hintRemovedFn,
+) (*hintedHandoff, error) {
+	dir := opts.HintedHandoffPath()
+	if dir == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	h := &hintedHandoff{
+		dir:            dir,
+		maxBytes:       opts.HintedHandoffMaxBytes(),
+		maxAge:         opts.HintedHandoffMaxHintAge(),
+		replayInterval: opts.HintedHandoffReplayInterval(),
+		writeFn:        writeFn,
+		connectedFn:    connectedFn,
+		removedFn:      removedFn,
+		nowFn:          opts.ClockOptions().NowFn(),
+		logger:         opts.InstrumentOptions().Logger(),
+		metrics: newHintedHandoffMetrics(
+			opts.InstrumentOptions().MetricsScope().SubScope("hinted-handoff")),
+		files:   make(map[string]*hintFile),
+		pending: make(chan pendingHint, hintQueueSize),
+		doneCh:  make(chan struct{}),
+	}
+	if err := h.load(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// load accounts for the hints left on disk by a previous process so they
+// are replayed and count towards the maximum bytes.
+func (h *hintedHandoff) load() error {
+	entries, err := ioutil.ReadDir(h.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		var (
+			name   = entry.Name()
+			replay = strings.HasSuffix(name, hintReplayFileSuffix)
+			suffix = hintFileSuffix
+		)
+		if replay {
+			suffix = hintReplayFileSuffix
+		} else if !strings.HasSuffix(name, hintFileSuffix) {
+			continue
+		}
+		hostID, err := url.PathUnescape(strings.TrimSuffix(name, suffix))
+		if err != nil {
+			h.logger.Warn("skipping unrecognized hint file",
+				zap.String("file", name), zap.Error(err))
+			continue
+		}
+
+		f := h.fileWithLock(hostID)
+		if replay {
+			f.replaySize = entry.Size()
+		} else {
+			f.size = entry.Size()
+		}
+		h.totalBytes += entry.Size()
+	}
+	h.metrics.pendingBytes.Update(float64(h.totalBytes))
+	return nil
+}
+
+// Open starts writing queued hints to disk and replaying hints.
+func (h *hintedHandoff) Open() error {
+	if h == nil {
+		return nil
+	}
+	h.Lock()
+	defer h.Unlock()
+	if h.opened || h.closed {
+		return errHintedHandoffOpen
+	}
+	h.opened = true
+	h.wg.Add(1)
+	go h.run()
+	return nil
+}
+
+// Close stops replaying hints and writes any queued hints to disk.
+func (h *hintedHandoff) Close() error {
+	if h == nil {
+		return nil
+	}
+	h.Lock()
+	if h.closed {
+		h.Unlock()
+		return nil
+	}
+	h.closed = true
+	h.Unlock()
+
+	close(h.doneCh)
+	h.wg.Wait()
+
+	h.Lock()
+	defer h.Unlock()
+
+	h.drainWithLock()
+	var multiErr error
+	for _, f := range h.files {
+		if f.fd == nil {
+			continue
+		}
+		if err := f.fd.Sync(); err != nil && multiErr == nil {
+			multiErr = err
+		}
+		if err := f.fd.Close(); err != nil && multiErr == nil {
+			multiErr = err
+		}
+		f.fd = nil
+	}
+	return multiErr
+}
+
+// add queues a hint for a write op that failed against the host, it never
+// blocks and drops the hint if too many hints are waiting to be written.
+func (h *hintedHandoff) add(hostID string, op writeOp) {
+	if h == nil {
+		return
+	}
+	hn, ok := newHint(op, h.nowFn())
+	if !ok {
+		return
+	}
+	h.enqueue(pendingHint{hostID: hostID, record: encodeHint(hn)})
+}
+
+func (h *hintedHandoff) enqueue(p pendingHint) {
+	select {
+	case h.pending <- p:
+	default:
+		h.metrics.droppedQueue.Inc(1)
+	}
+}
+
+func (h *hintedHandoff) run() {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(h.replayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.doneCh:
+			return
+		case p := <-h.pending:
+			h.Lock()
+			h.appendWithLock(p)
+			h.drainWithLock()
+			h.Unlock()
+		case <-ticker.C:
+			h.Lock()
+			h.drainWithLock()
+			h.syncWithLock()
+			h.Unlock()
+			h.replay()
+		}
+	}
+}
+
+func (h *hintedHandoff) drainWithLock() {
+	for {
+		select {
+		case p := <-h.pending:
+			h.appendWithLock(p)
+		default:
+			return
+		}
+	}
+}
+
+func (h *hintedHandoff) fileWithLock(hostID string) *hintFile {
+	f, ok := h.files[hostID]
+	if !ok {
+		f = &hintFile{}
+		h.files[hostID] = f
+	}
+	return f
+}
+
+func (h *hintedHandoff) pathFor(hostID, suffix string) string {
+	return filepath.Join(h.dir, url.PathEscape(hostID)+suffix)
+}
+
+func (h *hintedHandoff) appendWithLock(p pendingHint) {
+	size := int64(len(p.record))
+	if h.totalBytes+size > h.maxBytes {
+		h.metrics.droppedFull.Inc(1)
+		return
+	}
+
+	f := h.fileWithLock(p.hostID)
+	if f.fd == nil {
+		fd, err := os.OpenFile(h.pathFor(p.hostID, hintFileSuffix),
+			os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			h.metrics.writeErrors.Inc(1)
+			h.logger.Error("unable to open hint file",
+				zap.String("host", p.hostID), zap.Error(err))
+			return
+		}
+		f.fd = fd
+	}
+	if _, err := f.fd.Write(p.record); err != nil {
+		h.metrics.writeErrors.Inc(1)
+		h.logger.Error("unable to write hint",
+			zap.String("host", p.hostID), zap.Error(err))
+		return
+	}
+
+	f.size += size
+	f.dirty = true
+	h.totalBytes += size
+	h.metrics.hinted.Inc(1)
+	h.metrics.pendingBytes.Update(float64(h.totalBytes))
+}
+
+func (h *hintedHandoff) syncWithLock() {
+	for hostID, f := range h.files {
+		if f.fd == nil || !f.dirty {
+			continue
+		}
+		if err := f.fd.Sync(); err != nil {
+			h.metrics.writeErrors.Inc(1)
+			h.logger.Error("unable to sync hint file",
+				zap.String("host", hostID), zap.Error(err))
+			continue
+		}
+		f.dirty = false
+	}
+}
+
+// replay replays the hints of every host that has open connections and
+// drops the hints of hosts that are no longer in the topology. The hints of
+// a host are first moved aside to a replay file so that new hints and hints
+// that fail to replay are appended to a fresh file while the replay file is
+// read.
+func (h *hintedHandoff) replay() {
+	h.Lock()
+	h.drainWithLock()
+	var hostIDs []string
+	for hostID, f := range h.files {
+		if f.size > 0 || f.replaySize > 0 {
+			hostIDs = append(hostIDs, hostID)
+		}
+	}
+	h.Unlock()
+
+	for _, hostID := range hostIDs {
+		if h.removedFn(hostID) {
+			if err := h.prune(hostID); err != nil {
+				h.logger.Error("unable to drop hints of removed host",
+					zap.String("host", hostID), zap.Error(err))
+			}
+			continue
+		}
+		if !h.connectedFn(hostID) {
+			continue
+		}
+		if err := h.replayHost(hostID); err != nil {
+			h.metrics.replayErrors.Inc(1)
+			h.logger.Error("unable to replay hints",
+				zap.String("host", hostID), zap.Error(err))
+		}
+	}
+}
+
+// prune drops the hints of a host that is no longer in the topology.
+func (h *hintedHandoff) prune(hostID string) error {
+	h.Lock()
+	defer h.Unlock()
+
+	f, ok := h.files[hostID]
+	if !ok {
+		return nil
+	}
+
+	var multiErr error
+	if f.fd != nil {
+		if err := f.fd.Close(); err != nil {
+			multiErr = err
+		}
+		f.fd = nil
+	}
+	for _, suffix := range []string{hintFileSuffix, hintReplayFileSuffix} {
+		err := os.Remove(h.pathFor(hostID, suffix))
+		if err != nil && !os.IsNotExist(err) && multiErr == nil {
+			multiErr = err
+		}
+	}
+
+	delete(h.files, hostID)
+	h.totalBytes -= f.size + f.replaySize
+	h.metrics.droppedRemoved.Inc(1)
+	h.metrics.pendingBytes.Update(float64(h.totalBytes))
+	return multiErr
+}
+
+func (h *hintedHandoff) replayHost(hostID string) error {
+	replayPath := h.pathFor(hostID, hintReplayFileSuffix)
+
+	h.Lock()
+	f := h.fileWithLock(hostID)
+	if f.replaySize == 0 && f.size > 0 {
+		if f.fd != nil {
+			if err := f.fd.Close(); err != nil {
+				h.Unlock()
+				return err
+			}
+			f.fd = nil
+		}
+		if err := os.Rename(h.pathFor(hostID, hintFileSuffix), replayPath); err != nil {
+			h.Unlock()
+			return err
+		}
+		f.replaySize, f.size, f.dirty = f.size, 0, false
+	}
+	replaySize := f.replaySize
+	h.Unlock()
+
+	fd, err := os.Open(replayPath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	var (
+		now    = h.nowFn()
+		reader = bufio.NewReader(fd)
+	)
+	for {
+		// Append hints queued while replaying to avoid dropping them when
+		// the queue fills up.
+		h.Lock()
+		h.drainWithLock()
+		h.Unlock()
+
+		hn, record, err := readHint(reader)
+		if err == io.EOF {
+			break
+		}
+		if err == errHintCorrupt {
+			// The remainder of the file cannot be framed past a corrupt
+			// record, most likely a torn write, so the rest is dropped.
+			h.metrics.droppedCorrupt.Inc(1)
+			h.logger.Warn("dropping corrupt hints",
+				zap.String("host", hostID), zap.Error(err))
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if now.Sub(hn.created) > h.maxAge {
+			h.metrics.droppedExpired.Inc(1)
+			continue
+		}
+
+		err = h.writeFn(hostID, hn, func(err error) {
+			if err != nil {
+				// Append the hint directly rather than queueing it, which
+				// drops hints when the queue is full.
+				h.metrics.replayErrors.Inc(1)
+				h.Lock()
+				h.appendWithLock(pendingHint{hostID: hostID, record: record})
+				h.Unlock()
+				return
+			}
+			h.metrics.replayed.Inc(1)
+		})
+		if err != nil {
+			h.metrics.replayErrors.Inc(1)
+			h.Lock()
+			h.appendWithLock(pendingHint{hostID: hostID, record: record})
+			h.Unlock()
+		}
+	}
+
+	if err := os.Remove(replayPath); err != nil {
+		return err
+	}
+
+	h.Lock()
+	h.drainWithLock()
+	f.replaySize = 0
+	h.totalBytes -= replaySize
+	h.metrics.pendingBytes.Update(float64(h.totalBytes))
+	h.Unlock()
+	return nil
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type testHintWrites struct {
+	connected bool
+	removed   bool
+	err       error
+	writes    []hint
+	// beforeCompletionFn is called before the completion of each write.
+	beforeCompletionFn func()
+}
+
+func (w *testHintWrites) write(
+	hostID string,
+	h hint,
+	completionFn func(err error),
+) error {
+	w.writes = append(w.writes, h)
+	if w.beforeCompletionFn != nil {
+		w.beforeCompletionFn()
+	}
+	completionFn(w.err)
+	return nil
+}
+
+func (w *testHintWrites) hostConnected(hostID string) bool {
+	return w.connected
+}
+
+func (w *testHintWrites) hostRemoved(hostID string) bool {
+	return w.removed
+}
+
+func newTestHintedHandoff(
+	t *testing.T,
+	dir string,
+	maxBytes int64,
+	now *time.Time,
+) (*hintedHandoff, *testHintWrites, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	opts := newSessionTestOptions().
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)).
+		SetClockOptions(newSessionTestOptions().ClockOptions().SetNowFn(func() time.Time {
+			return *now
+		})).
+		SetHintedHandoffPath(dir).
+		SetHintedHandoffMaxBytes(maxBytes).
+		SetHintedHandoffMaxHintAge(time.Minute)
+
+	writes := &testHintWrites{connected: true}
+	h, err := newHintedHandoff(opts, writes.write,
+		writes.hostConnected, writes.hostRemoved)
+	require.NoError(t, err)
+	require.NotNil(t, h)
+	return h, writes, scope
+}
+
+func newTestHintOp(id string, value float64) writeOp {
+	op := &writeTaggedOperation{}
+	op.reset()
+	op.namespace = ident.StringID("testNs")
+	op.request.ID = []byte(id)
+	op.request.EncodedTags = []byte(id + "-tags")
+	op.request.Datapoint.Value = value
+	op.request.Datapoint.Timestamp = 1000
+	op.request.Datapoint.TimestampTimeType = rpc.TimeType_UNIX_SECONDS
+	return op
+}
+
+// addTestHint adds a hint and writes it to disk synchronously.
+func addTestHint(h *hintedHandoff, hostID string, op writeOp) {
+	h.add(hostID, op)
+	h.Lock()
+	h.drainWithLock()
+	h.Unlock()
+}
+
+func TestHintEncodeDecodeRoundTrip(t *testing.T) {
+	expected := hint{
+		created:     time.Unix(0, 1234),
+		tagged:      true,
+		namespace:   []byte("testNs"),
+		id:          []byte("foo"),
+		encodedTags: []byte("foo-tags"),
+		value:       42.5,
+		timestamp:   1000,
+		timeType:    rpc.TimeType_UNIX_MILLISECONDS,
+		annotation:  []byte("annotation"),
+	}
+
+	record := encodeHint(expected)
+	actual, err := decodeHint(record[hintHeaderLen:])
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	_, err = decodeHint(record[hintHeaderLen : len(record)-1])
+	assert.Equal(t, errHintCorrupt, err)
+}
+
+func TestHintedHandoffReplaysHintsWhenConnected(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted-handoff")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	h, writes, scope := newTestHintedHandoff(t, dir, 1<<20, &now)
+	defer h.Close()
+
+	writes.connected = false
+	addTestHint(h, "testhost0", newTestHintOp("foo", 1))
+	addTestHint(h, "testhost0", newTestHintOp("bar", 2))
+
+	h.replay()
+	assert.Len(t, writes.writes, 0)
+
+	writes.connected = true
+	h.replay()
+	require.Len(t, writes.writes, 2)
+	assert.Equal(t, []byte("foo"), writes.writes[0].id)
+	assert.Equal(t, []byte("foo-tags"), writes.writes[0].encodedTags)
+	assert.Equal(t, 1.0, writes.writes[0].value)
+	assert.True(t, writes.writes[0].tagged)
+	assert.Equal(t, []byte("bar"), writes.writes[1].id)
+
+	h.Lock()
+	assert.Equal(t, int64(0), h.totalBytes)
+	h.Unlock()
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(2), counters["hinted-handoff.hinted+"].Value())
+	assert.Equal(t, int64(2), counters["hinted-handoff.replayed+"].Value())
+
+	// Nothing is left to replay.
+	h.replay()
+	assert.Len(t, writes.writes, 2)
+}
+
+func TestHintedHandoffRequeuesFailedReplays(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted-handoff")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	h, writes, scope := newTestHintedHandoff(t, dir, 1<<20, &now)
+	defer h.Close()
+
+	addTestHint(h, "testhost0", newTestHintOp("foo", 1))
+
+	// Failed replays are not dropped when the queue is full.
+	writes.err = errors.New("an error")
+	writes.beforeCompletionFn = func() {
+		for len(h.pending) < cap(h.pending) {
+			h.pending <- pendingHint{hostID: "testhost1"}
+		}
+	}
+	h.replay()
+	require.Len(t, writes.writes, 1)
+
+	writes.err = nil
+	writes.beforeCompletionFn = nil
+	h.replay()
+	require.Len(t, writes.writes, 2)
+	assert.Equal(t, []byte("foo"), writes.writes[1].id)
+
+	counters := scope.Snapshot().Counters()
+	_, dropped := counters["hinted-handoff.dropped+reason=queue-full"]
+	assert.False(t, dropped)
+}
+
+func TestHintedHandoffPrunesRemovedHosts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted-handoff")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	h, writes, scope := newTestHintedHandoff(t, dir, 1<<20, &now)
+	defer h.Close()
+
+	addTestHint(h, "testhost0", newTestHintOp("foo", 1))
+
+	writes.removed = true
+	h.replay()
+	assert.Len(t, writes.writes, 0)
+
+	h.Lock()
+	assert.Equal(t, int64(0), h.totalBytes)
+	assert.Len(t, h.files, 0)
+	h.Unlock()
+
+	_, err = os.Stat(h.pathFor("testhost0", hintFileSuffix))
+	assert.True(t, os.IsNotExist(err))
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(1), counters["hinted-handoff.dropped+reason=host-removed"].Value())
+}
+
+func TestHintedHandoffDropsExpiredHints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted-handoff")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	h, writes, scope := newTestHintedHandoff(t, dir, 1<<20, &now)
+	defer h.Close()
+
+	addTestHint(h, "testhost0", newTestHintOp("foo", 1))
+
+	now = now.Add(2 * time.Minute)
+	h.replay()
+	assert.Len(t, writes.writes, 0)
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(1), counters["hinted-handoff.dropped+reason=max-age"].Value())
+}
+
+func TestHintedHandoffDropsHintsOverMaxBytes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted-handoff")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	record := encodeHint(hint{})
+	h, writes, scope := newTestHintedHandoff(t, dir, int64(len(record)+64), &now)
+	defer h.Close()
+
+	addTestHint(h, "testhost0", newTestHintOp("foo", 1))
+	addTestHint(h, "testhost0", newTestHintOp("bar", 2))
+
+	h.replay()
+	require.Len(t, writes.writes, 1)
+	assert.Equal(t, []byte("foo"), writes.writes[0].id)
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(1), counters["hinted-handoff.dropped+reason=max-bytes"].Value())
+}
+
+func TestHintedHandoffLoadsHintsFromDisk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hinted-handoff")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	h, writes, _ := newTestHintedHandoff(t, dir, 1<<20, &now)
+	writes.connected = false
+	addTestHint(h, "testhost0", newTestHintOp("foo", 1))
+	require.NoError(t, h.Close())
+
+	h, writes, _ = newTestHintedHandoff(t, dir, 1<<20, &now)
+	defer h.Close()
+
+	h.replay()
+	require.Len(t, writes.writes, 1)
+	assert.Equal(t, []byte("foo"), writes.writes[0].id)
+}
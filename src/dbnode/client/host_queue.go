@@ -22,6 +22,7 @@ package client
 
 import (
 	"bytes"
+	gocontext "context"
 	"fmt"
 	"math"
 	"sync"
@@ -35,6 +36,7 @@ import (
 	xsync "github.com/m3db/m3/src/x/sync"
 
 	"github.com/uber-go/tally"
+	tchannel "github.com/uber/tchannel-go"
 	"github.com/uber/tchannel-go/thrift"
 )
 
@@ -880,7 +882,7 @@ func (q *queue) asyncFetchTagged(op *fetchTaggedOp) {
 			return
 		}
 
-		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		ctx := q.newFetchContext(op.ctx)
 		result, err := client.FetchTagged(ctx, &op.request)
 		if err != nil {
 			op.CompletionFn()(fetchTaggedResultAccumulatorOpts{host: q.host}, err)
@@ -896,6 +898,20 @@ func (q *queue) asyncFetchTagged(op *fetchTaggedOp) {
 	})
 }
 
+// newFetchContext returns the context for a fetch request, which is cancelled
+// early if the parent context, if any, is done.
+func (q *queue) newFetchContext(parent gocontext.Context) thrift.Context {
+	if parent == nil {
+		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		return ctx
+	}
+
+	ctx, _ := tchannel.NewContextBuilder(q.opts.FetchRequestTimeout()).
+		SetParentContext(parent).
+		Build()
+	return ctx
+}
+
 func (q *queue) asyncAggregate(op *aggregateOp) {
 	q.Add(1)
 	q.workerPool.Go(func() {
@@ -160,6 +160,30 @@ const (
 	// defaultUseV2BatchAPIs is the default setting for whether the v2 version of the batch APIs should
 	// be used.
 	defaultUseV2BatchAPIs = false
+
+	// defaultReadRepairSampleRate is the default read repair sample rate,
+	// read repair is disabled by default.
+	defaultReadRepairSampleRate = 0.0
+
+	// defaultReadRepairMaxSeriesPerSecond is the default maximum number of
+	// series repaired per second.
+	defaultReadRepairMaxSeriesPerSecond = 100
+
+	// defaultReadRepairConcurrency is the default read repair concurrency.
+	defaultReadRepairConcurrency = 4
+
+	// defaultHintedHandoffMaxBytes is the default maximum number of bytes of
+	// hints stored on disk.
+	defaultHintedHandoffMaxBytes = 1 << 30
+
+	// defaultHintedHandoffMaxHintAge is the default maximum age of a hint,
+	// this matches the default namespace buffer past as older writes are
+	// rejected unless cold writes are enabled.
+	defaultHintedHandoffMaxHintAge = 10 * time.Minute
+
+	// defaultHintedHandoffReplayInterval is the default interval at which
+	// hints are synced to disk and replayed.
+	defaultHintedHandoffReplayInterval = 10 * time.Second
 )
 
 var (
@@ -201,6 +225,8 @@ var (
 
 	errNoTopologyInitializerSet    = errors.New("no topology initializer set")
 	errNoReaderIteratorAllocateSet = errors.New("no reader iterator allocator set, encoding not set")
+	errInvalidReadRepairSampleRate = errors.New("read repair sample rate must be between 0 and 1")
+	errInvalidHintedHandoffOptions = errors.New("hinted handoff max bytes, max hint age and replay interval must be positive")
 )
 
 type options struct {
@@ -259,6 +285,13 @@ type options struct {
 	asyncWriteWorkerPool                    xsync.PooledWorkerPool
 	asyncWriteMaxConcurrency                int
 	useV2BatchAPIs                          bool
+	readRepairSampleRate                    float64
+	readRepairMaxSeriesPerSecond            int
+	readRepairConcurrency                   int
+	hintedHandoffPath                       string
+	hintedHandoffMaxBytes                   int64
+	hintedHandoffMaxHintAge                 time.Duration
+	hintedHandoffReplayInterval             time.Duration
 }
 
 // NewOptions creates a new set of client options with defaults
@@ -358,6 +391,12 @@ func newOptions() *options {
 		asyncTopologyInitializers:               []topology.Initializer{},
 		asyncWriteMaxConcurrency:                defaultAsyncWriteMaxConcurrency,
 		useV2BatchAPIs:                          defaultUseV2BatchAPIs,
+		readRepairSampleRate:                    defaultReadRepairSampleRate,
+		readRepairMaxSeriesPerSecond:            defaultReadRepairMaxSeriesPerSecond,
+		readRepairConcurrency:                   defaultReadRepairConcurrency,
+		hintedHandoffMaxBytes:                   defaultHintedHandoffMaxBytes,
+		hintedHandoffMaxHintAge:                 defaultHintedHandoffMaxHintAge,
+		hintedHandoffReplayInterval:             defaultHintedHandoffReplayInterval,
 	}
 	return opts.SetEncodingM3TSZ().(*options)
 }
@@ -384,6 +423,13 @@ func validate(opts *options) error {
 	); err != nil {
 		return err
 	}
+	if opts.readRepairSampleRate < 0 || opts.readRepairSampleRate > 1 {
+		return errInvalidReadRepairSampleRate
+	}
+	if opts.hintedHandoffPath != "" && (opts.hintedHandoffMaxBytes <= 0 ||
+		opts.hintedHandoffMaxHintAge <= 0 || opts.hintedHandoffReplayInterval <= 0) {
+		return errInvalidHintedHandoffOptions
+	}
 	return topology.ValidateConnectConsistencyLevel(
 		opts.clusterConnectConsistencyLevel,
 	)
@@ -954,3 +1000,73 @@ func (o *options) SetUseV2BatchAPIs(value bool) Options {
 func (o *options) UseV2BatchAPIs() bool {
 	return o.useV2BatchAPIs
 }
+
+func (o *options) SetReadRepairSampleRate(value float64) Options {
+	opts := *o
+	opts.readRepairSampleRate = value
+	return &opts
+}
+
+func (o *options) ReadRepairSampleRate() float64 {
+	return o.readRepairSampleRate
+}
+
+func (o *options) SetReadRepairMaxSeriesPerSecond(value int) Options {
+	opts := *o
+	opts.readRepairMaxSeriesPerSecond = value
+	return &opts
+}
+
+func (o *options) ReadRepairMaxSeriesPerSecond() int {
+	return o.readRepairMaxSeriesPerSecond
+}
+
+func (o *options) SetReadRepairConcurrency(value int) Options {
+	opts := *o
+	opts.readRepairConcurrency = value
+	return &opts
+}
+
+func (o *options) ReadRepairConcurrency() int {
+	return o.readRepairConcurrency
+}
+
+func (o *options) SetHintedHandoffPath(value string) Options {
+	opts := *o
+	opts.hintedHandoffPath = value
+	return &opts
+}
+
+func (o *options) HintedHandoffPath() string {
+	return o.hintedHandoffPath
+}
+
+func (o *options) SetHintedHandoffMaxBytes(value int64) Options {
+	opts := *o
+	opts.hintedHandoffMaxBytes = value
+	return &opts
+}
+
+func (o *options) HintedHandoffMaxBytes() int64 {
+	return o.hintedHandoffMaxBytes
+}
+
+func (o *options) SetHintedHandoffMaxHintAge(value time.Duration) Options {
+	opts := *o
+	opts.hintedHandoffMaxHintAge = value
+	return &opts
+}
+
+func (o *options) HintedHandoffMaxHintAge() time.Duration {
+	return o.hintedHandoffMaxHintAge
+}
+
+func (o *options) SetHintedHandoffReplayInterval(value time.Duration) Options {
+	opts := *o
+	opts.hintedHandoffReplayInterval = value
+	return &opts
+}
+
+func (o *options) HintedHandoffReplayInterval() time.Duration {
+	return o.hintedHandoffReplayInterval
+}
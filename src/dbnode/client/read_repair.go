@@ -0,0 +1,439 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/sampler"
+	xsync "github.com/m3db/m3/src/x/sync"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+// fetchTaggedHostResponse is the response received from a single host for a
+// fetch tagged request that was sampled for read repair.
+type fetchTaggedHostResponse struct {
+	host     topology.Host
+	elements []*rpc.FetchTaggedIDResult_
+}
+
+// readRepairRequest is the set of responses of a fetch tagged request to
+// check for divergent replicas.
+type readRepairRequest struct {
+	namespace []byte
+	topoMap   topology.Map
+	start     time.Time
+	end       time.Time
+	schema    namespace.SchemaDescr
+	responses []fetchTaggedHostResponse
+}
+
+// readRepairDatapoint is a datapoint missing from a replica.
+type readRepairDatapoint struct {
+	ts.Datapoint
+	unit       xtime.Unit
+	annotation ts.Annotation
+}
+
+// readRepairWrite is a write of a datapoint to a single replica.
+type readRepairWrite struct {
+	host        topology.Host
+	namespace   []byte
+	id          []byte
+	encodedTags []byte
+	datapoint   readRepairDatapoint
+}
+
+type readRepairWriteFn func(w readRepairWrite, completionFn func(err error)) error
+
+type readRepairMetrics struct {
+	sampled            tally.Counter
+	skipped            tally.Counter
+	divergentSeries    tally.Counter
+	rateLimited        tally.Counter
+	repairedSeries     tally.Counter
+	repairedDatapoints tally.Counter
+	writeErrors        tally.Counter
+	decodeErrors       tally.Counter
+}
+
+func newReadRepairMetrics(scope tally.Scope) readRepairMetrics {
+	return readRepairMetrics{
+		sampled:            scope.Counter("sampled"),
+		skipped:            scope.Counter("skipped"),
+		divergentSeries:    scope.Counter("divergent-series"),
+		rateLimited:        scope.Counter("rate-limited"),
+		repairedSeries:     scope.Counter("repaired-series"),
+		repairedDatapoints: scope.Counter("repaired-datapoints"),
+		writeErrors:        scope.Counter("write-errors"),
+		decodeErrors:       scope.Counter("decode-errors"),
+	}
+}
+
+// readRepairer compares the replicas returned by reads at majority or all
+// read consistency and writes the datapoints missing from lagging replicas
+// back to them asynchronously. Only datapoints missing from a replica are
+// repaired, conflicting values for the same timestamp are left as is.
+type readRepairer struct {
+	sync.Mutex
+
+	sampler            *sampler.Sampler
+	maxSeriesPerSecond int
+	workers            xsync.WorkerPool
+	iterAlloc          encoding.ReaderIteratorAllocate
+	writeFn            readRepairWriteFn
+	nowFn              clock.NowFn
+	logger             *zap.Logger
+	scope              tally.Scope
+
+	window           time.Time
+	repairedInWindow int
+
+	metricsLock sync.RWMutex
+	metrics     map[string]readRepairMetrics
+}
+
+// newReadRepairer returns a new read repairer, or nil if read repair is
+// disabled.
+func newReadRepairer(opts Options, writeFn readRepairWriteFn) (*readRepairer, error) {
+	sampleRate := opts.ReadRepairSampleRate()
+	if sampleRate <= 0 {
+		return nil, nil
+	}
+
+	r := &readRepairer{
+		maxSeriesPerSecond: opts.ReadRepairMaxSeriesPerSecond(),
+		workers:            xsync.NewWorkerPool(opts.ReadRepairConcurrency()),
+		iterAlloc:          opts.ReaderIteratorAllocate(),
+		writeFn:            writeFn,
+		nowFn:              opts.ClockOptions().NowFn(),
+		logger:             opts.InstrumentOptions().Logger(),
+		scope:              opts.InstrumentOptions().MetricsScope().SubScope("read-repair"),
+		metrics:            make(map[string]readRepairMetrics),
+	}
+	if sampleRate < 1 {
+		s, err := sampler.NewSampler(sampleRate)
+		if err != nil {
+			return nil, err
+		}
+		r.sampler = s
+	}
+	r.workers.Init()
+	return r, nil
+}
+
+// shouldSample returns whether a read at the consistency level should be
+// checked for divergent replicas.
+func (r *readRepairer) shouldSample(level topology.ReadConsistencyLevel) bool {
+	if r == nil {
+		return false
+	}
+	switch level {
+	case topology.ReadConsistencyLevelMajority, topology.ReadConsistencyLevelAll:
+	default:
+		// Reads at lower consistency levels may only see a single replica.
+		return false
+	}
+	return r.sampler == nil || r.sampler.Sample()
+}
+
+// repairAsync checks the responses for divergent replicas in the background,
+// the request is dropped if all read repair workers are busy.
+func (r *readRepairer) repairAsync(req readRepairRequest) {
+	m := r.metricsFor(req.namespace)
+	m.sampled.Inc(1)
+	if !r.workers.GoIfAvailable(func() {
+		r.repair(req, m)
+	}) {
+		m.skipped.Inc(1)
+	}
+}
+
+type readRepairReplica struct {
+	host topology.Host
+	elem *rpc.FetchTaggedIDResult_
+}
+
+func (r *readRepairer) repair(req readRepairRequest, m readRepairMetrics) {
+	byID := make(map[string][]readRepairReplica)
+	for _, resp := range req.responses {
+		for _, elem := range resp.elements {
+			if elem.Err != nil {
+				continue
+			}
+			id := string(elem.ID)
+			byID[id] = append(byID[id], readRepairReplica{host: resp.host, elem: elem})
+		}
+	}
+
+	hostsByShard := make(map[uint32][]topology.Host)
+	for id, found := range byID {
+		shardID := req.topoMap.ShardSet().Lookup(ident.StringID(id))
+		hosts, ok := hostsByShard[shardID]
+		if !ok {
+			hosts = availableHosts(req, shardID)
+			hostsByShard[shardID] = hosts
+		}
+		if len(hosts) < 2 {
+			continue
+		}
+
+		replicas := make(map[string]readRepairReplica, len(hosts))
+		for _, replica := range found {
+			for _, host := range hosts {
+				if replica.host.ID() == host.ID() {
+					replicas[host.ID()] = replica
+					break
+				}
+			}
+		}
+		if len(replicas) == 0 || !diverged(hosts, replicas) {
+			continue
+		}
+
+		m.divergentSeries.Inc(1)
+		if !r.allowRepair() {
+			m.rateLimited.Inc(1)
+			continue
+		}
+		r.repairSeries(req, m, hosts, replicas)
+	}
+}
+
+// availableHosts returns the hosts that responded to the request and own the
+// shard in the available state, only these are compared and repaired.
+func availableHosts(req readRepairRequest, shardID uint32) []topology.Host {
+	var hosts []topology.Host
+	for _, resp := range req.responses {
+		hostShardSet, ok := req.topoMap.LookupHostShardSet(resp.host.ID())
+		if !ok {
+			continue
+		}
+		state, err := hostShardSet.ShardSet().LookupStateByID(shardID)
+		if err != nil || state != shard.Available {
+			continue
+		}
+		hosts = append(hosts, resp.host)
+	}
+	return hosts
+}
+
+// diverged returns whether a series is missing from any of the hosts or the
+// data returned by the hosts differs.
+func diverged(hosts []topology.Host, replicas map[string]readRepairReplica) bool {
+	if len(replicas) != len(hosts) {
+		return true
+	}
+	var (
+		first    = true
+		checksum uint32
+	)
+	for _, replica := range replicas {
+		c := replicaChecksum(replica.elem)
+		if first {
+			checksum, first = c, false
+			continue
+		}
+		if c != checksum {
+			return true
+		}
+	}
+	return false
+}
+
+func replicaChecksum(elem *rpc.FetchTaggedIDResult_) uint32 {
+	d := digest.NewDigest()
+	update := func(s *rpc.Segment) {
+		if s == nil {
+			return
+		}
+		d = d.Update(s.Head)
+		d = d.Update(s.Tail)
+	}
+	for _, segments := range elem.Segments {
+		update(segments.Merged)
+		for _, s := range segments.Unmerged {
+			update(s)
+		}
+	}
+	return d.Sum32()
+}
+
+func (r *readRepairer) repairSeries(
+	req readRepairRequest,
+	m readRepairMetrics,
+	hosts []topology.Host,
+	replicas map[string]readRepairReplica,
+) {
+	var (
+		union       = make(map[int64]readRepairDatapoint)
+		hostDps     = make(map[string]map[int64]struct{}, len(replicas))
+		id          []byte
+		encodedTags []byte
+	)
+	for hostID, replica := range replicas {
+		dps, err := r.decode(req, replica.elem)
+		if err != nil {
+			m.decodeErrors.Inc(1)
+			r.logger.Warn("unable to decode replica for read repair",
+				zap.String("host", hostID), zap.Error(err))
+			return
+		}
+
+		timestamps := make(map[int64]struct{}, len(dps))
+		for _, dp := range dps {
+			nanos := dp.Timestamp.UnixNano()
+			timestamps[nanos] = struct{}{}
+			if _, ok := union[nanos]; !ok {
+				union[nanos] = dp
+			}
+		}
+		hostDps[hostID] = timestamps
+		id, encodedTags = replica.elem.ID, replica.elem.EncodedTags
+	}
+
+	repaired := false
+	for _, host := range hosts {
+		var missing []readRepairDatapoint
+		existing := hostDps[host.ID()]
+		for nanos, dp := range union {
+			if _, ok := existing[nanos]; !ok {
+				missing = append(missing, dp)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		sort.Slice(missing, func(i, j int) bool {
+			return missing[i].Timestamp.Before(missing[j].Timestamp)
+		})
+		for _, dp := range missing {
+			err := r.writeFn(readRepairWrite{
+				host:        host,
+				namespace:   req.namespace,
+				id:          id,
+				encodedTags: encodedTags,
+				datapoint:   dp,
+			}, func(err error) {
+				if err != nil {
+					m.writeErrors.Inc(1)
+					return
+				}
+				m.repairedDatapoints.Inc(1)
+			})
+			if err != nil {
+				m.writeErrors.Inc(1)
+				r.logger.Warn("unable to enqueue read repair write",
+					zap.String("host", host.ID()), zap.Error(err))
+				break
+			}
+			repaired = true
+		}
+	}
+
+	if repaired {
+		m.repairedSeries.Inc(1)
+	}
+}
+
+func (r *readRepairer) decode(
+	req readRepairRequest,
+	elem *rpc.FetchTaggedIDResult_,
+) ([]readRepairDatapoint, error) {
+	iter := encoding.NewMultiReaderIterator(r.iterAlloc, nil)
+	defer iter.Close()
+
+	iter.ResetSliceOfSlices(newReaderSliceOfSlicesIterator(elem.Segments, nil), req.schema)
+
+	var dps []readRepairDatapoint
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if dp.Timestamp.Before(req.start) || !dp.Timestamp.Before(req.end) {
+			continue
+		}
+		// Take a copy of the annotation as it is only valid until the next
+		// call to Next.
+		if len(annotation) > 0 {
+			annotation = append(ts.Annotation(nil), annotation...)
+		}
+		dps = append(dps, readRepairDatapoint{
+			Datapoint:  dp,
+			unit:       unit,
+			annotation: annotation,
+		})
+	}
+	return dps, iter.Err()
+}
+
+// allowRepair returns whether another series may be repaired within the
+// current second.
+func (r *readRepairer) allowRepair() bool {
+	now := r.nowFn().Truncate(time.Second)
+
+	r.Lock()
+	defer r.Unlock()
+
+	if now.After(r.window) {
+		r.window = now
+		r.repairedInWindow = 0
+	}
+	if r.repairedInWindow >= r.maxSeriesPerSecond {
+		return false
+	}
+	r.repairedInWindow++
+	return true
+}
+
+func (r *readRepairer) metricsFor(ns []byte) readRepairMetrics {
+	r.metricsLock.RLock()
+	m, ok := r.metrics[string(ns)]
+	r.metricsLock.RUnlock()
+	if ok {
+		return m
+	}
+
+	r.metricsLock.Lock()
+	defer r.metricsLock.Unlock()
+
+	if m, ok := r.metrics[string(ns)]; ok {
+		return m
+	}
+	m = newReadRepairMetrics(r.scope.Tagged(map[string]string{
+		"namespace": string(ns),
+	}))
+	r.metrics[string(ns)] = m
+	return m
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type testReadRepairWrites struct {
+	writes []readRepairWrite
+}
+
+func (w *testReadRepairWrites) write(
+	write readRepairWrite,
+	completionFn func(err error),
+) error {
+	w.writes = append(w.writes, write)
+	completionFn(nil)
+	return nil
+}
+
+func newTestReadRepairer(
+	t *testing.T,
+	maxSeriesPerSecond int,
+) (*readRepairer, *testReadRepairWrites, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	opts := newSessionTestOptions().
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)).
+		SetReadRepairSampleRate(1).
+		SetReadRepairMaxSeriesPerSecond(maxSeriesPerSecond)
+
+	writes := &testReadRepairWrites{}
+	r, err := newReadRepairer(opts, writes.write)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	return r, writes, scope
+}
+
+func newTestReadRepairTopoMap(t *testing.T) topology.Map {
+	opts := newSessionTestOptions()
+	topo, err := opts.TopologyInitializer().Init()
+	require.NoError(t, err)
+	watch, err := topo.Watch()
+	require.NoError(t, err)
+	return watch.Get()
+}
+
+func testReadRepairElement(
+	id string,
+	start time.Time,
+	values ...float64,
+) *rpc.FetchTaggedIDResult_ {
+	encoder := m3tsz.NewEncoder(start, nil, true, nil)
+	for i, v := range values {
+		dp := ts.Datapoint{Timestamp: start.Add(time.Duration(i) * time.Second), Value: v}
+		if err := encoder.Encode(dp, xtime.Second, nil); err != nil {
+			panic(err)
+		}
+	}
+	seg := encoder.Discard()
+	return &rpc.FetchTaggedIDResult_{
+		ID:          []byte(id),
+		EncodedTags: []byte(id + "-tags"),
+		Segments: []*rpc.Segments{&rpc.Segments{
+			Merged: &rpc.Segment{Head: bytesIfNotNil(seg.Head), Tail: bytesIfNotNil(seg.Tail)},
+		}},
+	}
+}
+
+func testReadRepairRequest(
+	topoMap topology.Map,
+	start time.Time,
+	elements ...[]*rpc.FetchTaggedIDResult_,
+) readRepairRequest {
+	req := readRepairRequest{
+		namespace: []byte("testNs"),
+		topoMap:   topoMap,
+		start:     start,
+		end:       start.Add(time.Hour),
+	}
+	for i, elems := range elements {
+		host, ok := topoMap.LookupHostShardSet(testHostName(i))
+		if !ok {
+			panic("missing test host")
+		}
+		req.responses = append(req.responses, fetchTaggedHostResponse{
+			host:     host.Host(),
+			elements: elems,
+		})
+	}
+	return req
+}
+
+func TestReadRepairShouldSample(t *testing.T) {
+	var nilRepairer *readRepairer
+	assert.False(t, nilRepairer.shouldSample(topology.ReadConsistencyLevelMajority))
+
+	r, _, _ := newTestReadRepairer(t, 100)
+	assert.False(t, r.shouldSample(topology.ReadConsistencyLevelOne))
+	assert.False(t, r.shouldSample(topology.ReadConsistencyLevelUnstrictMajority))
+	assert.True(t, r.shouldSample(topology.ReadConsistencyLevelMajority))
+	assert.True(t, r.shouldSample(topology.ReadConsistencyLevelAll))
+}
+
+func TestReadRepairDisabled(t *testing.T) {
+	r, err := newReadRepairer(newSessionTestOptions(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, r)
+}
+
+func TestReadRepairNoDivergence(t *testing.T) {
+	var (
+		r, writes, _ = newTestReadRepairer(t, 100)
+		topoMap      = newTestReadRepairTopoMap(t)
+		start        = time.Now().Truncate(time.Hour)
+	)
+	req := testReadRepairRequest(topoMap, start,
+		[]*rpc.FetchTaggedIDResult_{testReadRepairElement("foo", start, 1, 2, 3)},
+		[]*rpc.FetchTaggedIDResult_{testReadRepairElement("foo", start, 1, 2, 3)},
+		[]*rpc.FetchTaggedIDResult_{testReadRepairElement("foo", start, 1, 2, 3)},
+	)
+	r.repair(req, r.metricsFor(req.namespace))
+	assert.Empty(t, writes.writes)
+}
+
+func TestReadRepairWritesMissingDatapoints(t *testing.T) {
+	var (
+		r, writes, scope = newTestReadRepairer(t, 100)
+		topoMap          = newTestReadRepairTopoMap(t)
+		start            = time.Now().Truncate(time.Hour)
+	)
+	req := testReadRepairRequest(topoMap, start,
+		[]*rpc.FetchTaggedIDResult_{testReadRepairElement("foo", start, 1, 2, 3)},
+		[]*rpc.FetchTaggedIDResult_{testReadRepairElement("foo", start, 1, 2)},
+		nil,
+	)
+	r.repair(req, r.metricsFor(req.namespace))
+
+	// Host 1 is missing the last datapoint and host 2 the entire series.
+	var (
+		byHost     = make(map[string][]float64)
+		timestamps = make(map[string][]time.Time)
+	)
+	for _, w := range writes.writes {
+		assert.Equal(t, "foo", string(w.id))
+		assert.Equal(t, "foo-tags", string(w.encodedTags))
+		assert.Equal(t, xtime.Second, w.datapoint.unit)
+		byHost[w.host.ID()] = append(byHost[w.host.ID()], w.datapoint.Value)
+		timestamps[w.host.ID()] = append(timestamps[w.host.ID()], w.datapoint.Timestamp)
+	}
+	assert.Equal(t, map[string][]float64{
+		testHostName(1): {3},
+		testHostName(2): {1, 2, 3},
+	}, byHost)
+	assert.Equal(t, []time.Time{start.Add(2 * time.Second)}, timestamps[testHostName(1)])
+
+	counters := scope.Snapshot().Counters()
+	divergent, ok := counters["read-repair.divergent-series+namespace=testNs"]
+	require.True(t, ok)
+	assert.Equal(t, int64(1), divergent.Value())
+	repaired, ok := counters["read-repair.repaired-datapoints+namespace=testNs"]
+	require.True(t, ok)
+	assert.Equal(t, int64(4), repaired.Value())
+}
+
+func TestReadRepairRateLimited(t *testing.T) {
+	var (
+		r, writes, scope = newTestReadRepairer(t, 1)
+		topoMap          = newTestReadRepairTopoMap(t)
+		start            = time.Now().Truncate(time.Hour)
+		now              = start
+	)
+	r.nowFn = func() time.Time { return now }
+
+	req := testReadRepairRequest(topoMap, start,
+		[]*rpc.FetchTaggedIDResult_{
+			testReadRepairElement("foo", start, 1, 2),
+			testReadRepairElement("bar", start, 1, 2),
+		},
+		[]*rpc.FetchTaggedIDResult_{
+			testReadRepairElement("foo", start, 1),
+			testReadRepairElement("bar", start, 1),
+		},
+	)
+	r.repair(req, r.metricsFor(req.namespace))
+	require.Len(t, writes.writes, 1)
+
+	counters := scope.Snapshot().Counters()
+	limited, ok := counters["read-repair.rate-limited+namespace=testNs"]
+	require.True(t, ok)
+	assert.Equal(t, int64(1), limited.Value())
+
+	// The limit resets once the next second starts.
+	now = now.Add(time.Second)
+	r.repair(req, r.metricsFor(req.namespace))
+	assert.Len(t, writes.writes, 2)
+}
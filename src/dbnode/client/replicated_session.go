@@ -21,6 +21,7 @@
 package client
 
 import (
+	gocontext "context"
 	"fmt"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/x/ident"
 	m3sync "github.com/m3db/m3/src/x/sync"
@@ -227,6 +229,19 @@ func (s replicatedSession) Aggregate(
 	return s.session.Aggregate(ns, q, opts)
 }
 
+// AggregateSeries evaluates a temporal function followed by a grouping
+// aggregation over the series matching the query on the nodes that own them.
+func (s replicatedSession) AggregateSeries(
+	ctx gocontext.Context, ns ident.ID, q index.Query, opts seriesagg.Options,
+) ([][]seriesagg.Group, bool, error) {
+	return s.session.AggregateSeries(ctx, ns, q, opts)
+}
+
+// ReadConsistencyLevel returns the current read consistency level of the session.
+func (s replicatedSession) ReadConsistencyLevel() topology.ReadConsistencyLevel {
+	return s.session.ReadConsistencyLevel()
+}
+
 // FetchTagged resolves the provided query to known IDs, and fetches the data for them.
 func (s replicatedSession) FetchTagged(namespace ident.ID, q index.Query, opts index.QueryOptions) (results encoding.SeriesIterators, exhaustive bool, err error) {
 	return s.session.FetchTagged(namespace, q, opts)
@@ -237,6 +252,18 @@ func (s replicatedSession) FetchTaggedIDs(namespace ident.ID, q index.Query, opt
 	return s.session.FetchTaggedIDs(namespace, q, opts)
 }
 
+// FetchTaggedWithContext resolves the provided query to known IDs, and fetches the data for them,
+// cancelling the fetch once the context is done.
+func (s replicatedSession) FetchTaggedWithContext(ctx gocontext.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (results encoding.SeriesIterators, exhaustive bool, err error) {
+	return FetchTaggedWithContext(ctx, s.session, namespace, q, opts)
+}
+
+// FetchTaggedIDsWithContext resolves the provided query to known IDs, cancelling the fetch
+// once the context is done.
+func (s replicatedSession) FetchTaggedIDsWithContext(ctx gocontext.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (iter TaggedIDsIterator, exhaustive bool, err error) {
+	return FetchTaggedIDsWithContext(ctx, s.session, namespace, q, opts)
+}
+
 // ShardID returns the given shard for an ID for callers
 // to easily discern what shard is failing when operations
 // for given IDs begin failing.
@@ -22,6 +22,7 @@ package client
 
 import (
 	"bytes"
+	gocontext "context"
 	"errors"
 	"fmt"
 	"math"
@@ -157,6 +158,8 @@ type session struct {
 	streamBlocksMetadataBatchTimeout time.Duration
 	streamBlocksBatchTimeout         time.Duration
 	metrics                          sessionMetrics
+	readRepair                       *readRepairer
+	hints                            *hintedHandoff
 }
 
 type shardMetricsKey struct {
@@ -265,6 +268,13 @@ func newSession(opts Options) (clientSession, error) {
 	}
 	s.reattemptStreamBlocksFromPeersFn = s.streamBlocksReattemptFromPeers
 	s.pickBestPeerFn = s.streamBlocksPickBestPeer
+	if s.readRepair, err = newReadRepairer(opts, s.writeReadRepair); err != nil {
+		return nil, err
+	}
+	if s.hints, err = newHintedHandoff(opts, s.writeHint,
+		s.hostConnected, s.hostRemoved); err != nil {
+		return nil, err
+	}
 	writeAttemptPoolOpts := pool.NewObjectPoolOptions().
 		SetSize(opts.WriteOpPoolSize()).
 		SetInstrumentOptions(opts.InstrumentOptions().SetMetricsScope(
@@ -347,6 +357,13 @@ func (s *session) SetRuntimeOptions(value runtime.Options) {
 	s.state.Unlock()
 }
 
+func (s *session) ReadConsistencyLevel() topology.ReadConsistencyLevel {
+	s.state.RLock()
+	value := s.state.readLevel
+	s.state.RUnlock()
+	return value
+}
+
 func (s *session) ShardID(id ident.ID) (uint32, error) {
 	s.state.RLock()
 	if s.state.status != statusOpen {
@@ -559,6 +576,10 @@ func (s *session) Open() error {
 	s.state.status = statusOpen
 	s.state.Unlock()
 
+	if err := s.hints.Open(); err != nil {
+		return err
+	}
+
 	go func() {
 		for range watch.C() {
 			s.log.Info("received update for topology")
@@ -1097,6 +1118,7 @@ func (s *session) writeAttemptWithRLock(
 	// todo@bl: Can we combine the writeOpPool and the writeStatePool?
 	state.op, state.majority = op, majority
 	state.nsID, state.tsID, state.tagEncoder = nsID, tsID, tagEncoder
+	state.hints = s.hints
 	op.SetCompletionFn(state.completionFn)
 
 	if err := s.state.topoMap.RouteForEach(tsID, func(idx int, host topology.Host) {
@@ -1129,6 +1151,142 @@ func (s *session) writeAttemptWithRLock(
 	return state, majority, enqueued, nil
 }
 
+// writeReadRepair writes a single datapoint missing from a replica directly
+// to the host, bypassing the write consistency level.
+func (s *session) writeReadRepair(
+	w readRepairWrite,
+	completionFn func(err error),
+) error {
+	timeType, err := convert.ToTimeType(w.datapoint.unit)
+	if err != nil {
+		return err
+	}
+	timestamp, err := convert.ToValue(w.datapoint.Timestamp, timeType)
+	if err != nil {
+		return err
+	}
+
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if s.state.status != statusOpen {
+		return errSessionStatusNotOpen
+	}
+	queue, ok := s.state.queuesByHostID[w.host.ID()]
+	if !ok {
+		return fmt.Errorf("no queue for read repair host: %s", w.host.ID())
+	}
+
+	wop := s.pools.writeTaggedOperation.Get()
+	wop.namespace = ident.BytesID(w.namespace)
+	wop.shardID = s.state.topoMap.ShardSet().Lookup(ident.BytesID(w.id))
+	wop.request.ID = w.id
+	wop.request.EncodedTags = w.encodedTags
+	wop.request.Datapoint.Value = w.datapoint.Value
+	wop.request.Datapoint.Timestamp = timestamp
+	wop.request.Datapoint.TimestampTimeType = timeType
+	wop.request.Datapoint.Annotation = w.datapoint.annotation
+	wop.requestV2.ID = wop.request.ID
+	wop.requestV2.EncodedTags = wop.request.EncodedTags
+	wop.requestV2.Datapoint = wop.request.Datapoint
+	wop.SetCompletionFn(func(_ interface{}, err error) {
+		wop.Close()
+		completionFn(err)
+	})
+
+	if err := queue.Enqueue(wop); err != nil {
+		wop.Close()
+		return err
+	}
+	return nil
+}
+
+// writeHint replays a hinted write directly to the host it failed against,
+// bypassing the write consistency level.
+func (s *session) writeHint(
+	hostID string,
+	h hint,
+	completionFn func(err error),
+) error {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if s.state.status != statusOpen {
+		return errSessionStatusNotOpen
+	}
+	queue, ok := s.state.queuesByHostID[hostID]
+	if !ok {
+		return fmt.Errorf("no queue for hinted host: %s", hostID)
+	}
+
+	var (
+		id = ident.BytesID(h.id)
+		op writeOp
+	)
+	if h.tagged {
+		wop := s.pools.writeTaggedOperation.Get()
+		wop.namespace = ident.BytesID(h.namespace)
+		wop.shardID = s.state.topoMap.ShardSet().Lookup(id)
+		wop.request.ID = h.id
+		wop.request.EncodedTags = h.encodedTags
+		wop.request.Datapoint.Value = h.value
+		wop.request.Datapoint.Timestamp = h.timestamp
+		wop.request.Datapoint.TimestampTimeType = h.timeType
+		wop.request.Datapoint.Annotation = h.annotation
+		wop.requestV2.ID = wop.request.ID
+		wop.requestV2.EncodedTags = wop.request.EncodedTags
+		wop.requestV2.Datapoint = wop.request.Datapoint
+		op = wop
+	} else {
+		wop := s.pools.writeOperation.Get()
+		wop.namespace = ident.BytesID(h.namespace)
+		wop.shardID = s.state.topoMap.ShardSet().Lookup(id)
+		wop.request.ID = h.id
+		wop.request.Datapoint.Value = h.value
+		wop.request.Datapoint.Timestamp = h.timestamp
+		wop.request.Datapoint.TimestampTimeType = h.timeType
+		wop.request.Datapoint.Annotation = h.annotation
+		wop.requestV2.ID = wop.request.ID
+		wop.requestV2.Datapoint = wop.request.Datapoint
+		op = wop
+	}
+	op.SetCompletionFn(func(_ interface{}, err error) {
+		op.Close()
+		completionFn(err)
+	})
+
+	if err := queue.Enqueue(op); err != nil {
+		op.Close()
+		return err
+	}
+	return nil
+}
+
+// hostConnected returns whether the host has a queue with open connections.
+func (s *session) hostConnected(hostID string) bool {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if s.state.status != statusOpen {
+		return false
+	}
+	queue, ok := s.state.queuesByHostID[hostID]
+	return ok && queue.ConnectionCount() > 0
+}
+
+// hostRemoved returns whether the host is not in the topology of the open
+// session, every host in the topology has a queue.
+func (s *session) hostRemoved(hostID string) bool {
+	s.state.RLock()
+	defer s.state.RUnlock()
+
+	if s.state.status != statusOpen {
+		return false
+	}
+	_, ok := s.state.queuesByHostID[hostID]
+	return !ok
+}
+
 func (s *session) Fetch(
 	nsID ident.ID,
 	id ident.ID,
@@ -1225,8 +1383,15 @@ func (s *session) aggregateAttempt(
 
 func (s *session) FetchTagged(
 	ns ident.ID, q index.Query, opts index.QueryOptions,
+) (encoding.SeriesIterators, bool, error) {
+	return s.FetchTaggedWithContext(gocontext.Background(), ns, q, opts)
+}
+
+func (s *session) FetchTaggedWithContext(
+	ctx gocontext.Context, ns ident.ID, q index.Query, opts index.QueryOptions,
 ) (encoding.SeriesIterators, bool, error) {
 	f := s.pools.fetchTaggedAttempt.Get()
+	f.args.ctx = ctx
 	f.args.ns = ns
 	f.args.query = q
 	f.args.opts = opts
@@ -1238,8 +1403,15 @@ func (s *session) FetchTagged(
 
 func (s *session) FetchTaggedIDs(
 	ns ident.ID, q index.Query, opts index.QueryOptions,
+) (TaggedIDsIterator, bool, error) {
+	return s.FetchTaggedIDsWithContext(gocontext.Background(), ns, q, opts)
+}
+
+func (s *session) FetchTaggedIDsWithContext(
+	ctx gocontext.Context, ns ident.ID, q index.Query, opts index.QueryOptions,
 ) (TaggedIDsIterator, bool, error) {
 	f := s.pools.fetchTaggedAttempt.Get()
+	f.args.ctx = ctx
 	f.args.ns = ns
 	f.args.query = q
 	f.args.opts = opts
@@ -1250,8 +1422,12 @@ func (s *session) FetchTaggedIDs(
 }
 
 func (s *session) fetchTaggedAttempt(
-	ns ident.ID, q index.Query, opts index.QueryOptions,
+	ctx gocontext.Context, ns ident.ID, q index.Query, opts index.QueryOptions,
 ) (encoding.SeriesIterators, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, xerrors.NewNonRetryableError(err)
+	}
+
 	nsCtx, err := s.nsCtxFor(ns)
 	if err != nil {
 		return nil, false, err
@@ -1281,6 +1457,7 @@ func (s *session) fetchTaggedAttempt(
 	fetchState, err := s.newFetchStateWithRLock(nsClone, newFetchStateOpts{
 		stateType:          fetchTaggedFetchState,
 		fetchTaggedRequest: req,
+		fetchTaggedContext: ctx,
 		startInclusive:     opts.StartInclusive,
 		endExclusive:       opts.EndExclusive,
 	})
@@ -1292,12 +1469,17 @@ func (s *session) fetchTaggedAttempt(
 
 	// it's safe to Wait() here, as we still hold the lock on fetchState, after it's
 	// returned from newFetchStateWithRLock.
-	fetchState.Wait()
+	fetchState.WaitWithContext(ctx)
 
 	// must Unlock before calling `asEncodingSeriesIterators` as the latter needs to acquire
 	// the fetchState Lock
 	fetchState.Unlock()
 	iters, exhaustive, err := fetchState.asEncodingSeriesIterators(s.pools, nsCtx.Schema)
+	if err == nil {
+		if req, ok := fetchState.readRepairRequest(nsCtx.Schema); ok {
+			s.readRepair.repairAsync(req)
+		}
+	}
 
 	// must Unlock() before decRef'ing, as the latter releases the fetchState back into a
 	// pool if ref count == 0.
@@ -1307,8 +1489,12 @@ func (s *session) fetchTaggedAttempt(
 }
 
 func (s *session) fetchTaggedIDsAttempt(
-	ns ident.ID, q index.Query, opts index.QueryOptions,
+	ctx gocontext.Context, ns ident.ID, q index.Query, opts index.QueryOptions,
 ) (TaggedIDsIterator, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, xerrors.NewNonRetryableError(err)
+	}
+
 	s.state.RLock()
 	if s.state.status != statusOpen {
 		s.state.RUnlock()
@@ -1334,6 +1520,7 @@ func (s *session) fetchTaggedIDsAttempt(
 	fetchState, err := s.newFetchStateWithRLock(nsClone, newFetchStateOpts{
 		stateType:          fetchTaggedFetchState,
 		fetchTaggedRequest: req,
+		fetchTaggedContext: ctx,
 		startInclusive:     opts.StartInclusive,
 		endExclusive:       opts.EndExclusive,
 	})
@@ -1345,7 +1532,7 @@ func (s *session) fetchTaggedIDsAttempt(
 
 	// it's safe to Wait() here, as we still hold the lock on fetchState, after it's
 	// returned from newFetchStateWithRLock.
-	fetchState.Wait()
+	fetchState.WaitWithContext(ctx)
 
 	// must Unlock before calling `asTaggedIDsIterator` as the latter needs to acquire
 	// the fetchState Lock
@@ -1366,6 +1553,7 @@ type newFetchStateOpts struct {
 
 	// only valid if stateType == fetchTaggedFetchState
 	fetchTaggedRequest rpc.FetchTaggedRequest
+	fetchTaggedContext gocontext.Context
 
 	// only valid if stateType == aggregateFetchState
 	aggregateRequest rpc.AggregateQueryRawRequest
@@ -1397,8 +1585,11 @@ func (s *session) newFetchStateWithRLock(
 		fetchOp.incRef()        // indicate current go-routine has a reference to the op
 		closer = fetchOp.decRef // release the ref for the current go-routine
 		fetchOp.update(opts.fetchTaggedRequest, fetchState.completionFn)
+		fetchOp.ctx = opts.fetchTaggedContext
 		fetchState.ResetFetchTagged(opts.startInclusive, opts.endExclusive,
 			fetchOp, topoMap, s.state.majority, s.state.readLevel)
+		fetchState.tagResultAccumulator.recordHostResponses =
+			opts.fetchTaggedRequest.FetchData && s.readRepair.shouldSample(s.state.readLevel)
 		op = fetchOp
 
 	case aggregateFetchState:
@@ -1784,6 +1975,10 @@ func (s *session) Close() error {
 		q.Close()
 	}
 
+	if err := s.hints.Close(); err != nil {
+		s.log.Error("could not close hinted handoff", zap.Error(err))
+	}
+
 	topoWatch.Close()
 	topo.Close()
 
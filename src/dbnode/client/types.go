@@ -21,6 +21,7 @@
 package client
 
 import (
+	gocontext "context"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/clock"
@@ -31,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
@@ -82,6 +84,14 @@ type Session interface {
 	// Aggregate aggregates values from the database for the given set of constraints.
 	Aggregate(namespace ident.ID, q index.Query, opts index.AggregationOptions) (iter AggregatedTagsIterator, exhaustive bool, err error)
 
+	// AggregateSeries evaluates a temporal function followed by a grouping
+	// aggregation over the series matching the query on the nodes that own
+	// them, returning the partially aggregated groups of each node.
+	AggregateSeries(ctx gocontext.Context, namespace ident.ID, q index.Query, opts seriesagg.Options) (partials [][]seriesagg.Group, exhaustive bool, err error)
+
+	// ReadConsistencyLevel returns the current read consistency level of the session.
+	ReadConsistencyLevel() topology.ReadConsistencyLevel
+
 	// ShardID returns the given shard for an ID for callers
 	// to easily discern what shard is failing when operations
 	// for given IDs begin failing.
@@ -94,6 +104,17 @@ type Session interface {
 	Close() error
 }
 
+// CancellableSession is implemented by sessions whose tagged fetches can be
+// cancelled by a context, abandoning the outstanding requests to nodes once
+// the context is done.
+type CancellableSession interface {
+	// FetchTaggedWithContext resolves the provided query to known IDs, and fetches the data for them.
+	FetchTaggedWithContext(ctx gocontext.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (results encoding.SeriesIterators, exhaustive bool, err error)
+
+	// FetchTaggedIDsWithContext resolves the provided query to known IDs.
+	FetchTaggedIDsWithContext(ctx gocontext.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (iter TaggedIDsIterator, exhaustive bool, err error)
+}
+
 // AggregatedTagsIterator iterates over a collection of tag names with optionally
 // associated values.
 type AggregatedTagsIterator interface {
@@ -533,6 +554,64 @@ type Options interface {
 
 	// UseV2BatchAPIs returns whether the V2 batch APIs should be used.
 	UseV2BatchAPIs() bool
+
+	// SetReadRepairSampleRate sets the fraction of reads at majority or all
+	// read consistency that are checked for divergent replicas, zero disables
+	// read repair.
+	SetReadRepairSampleRate(value float64) Options
+
+	// ReadRepairSampleRate returns the fraction of reads at majority or all
+	// read consistency that are checked for divergent replicas.
+	ReadRepairSampleRate() float64
+
+	// SetReadRepairMaxSeriesPerSecond sets the maximum number of series that
+	// read repair will repair per second.
+	SetReadRepairMaxSeriesPerSecond(value int) Options
+
+	// ReadRepairMaxSeriesPerSecond returns the maximum number of series that
+	// read repair will repair per second.
+	ReadRepairMaxSeriesPerSecond() int
+
+	// SetReadRepairConcurrency sets the number of reads that may be checked
+	// and repaired concurrently, sampled reads are skipped when all workers
+	// are busy.
+	SetReadRepairConcurrency(value int) Options
+
+	// ReadRepairConcurrency returns the number of reads that may be checked
+	// and repaired concurrently.
+	ReadRepairConcurrency() int
+
+	// SetHintedHandoffPath sets the directory in which writes that failed
+	// against individual replicas are queued to be replayed once the replica
+	// reconnects, an empty path disables hinted handoff.
+	SetHintedHandoffPath(value string) Options
+
+	// HintedHandoffPath returns the directory in which writes that failed
+	// against individual replicas are queued.
+	HintedHandoffPath() string
+
+	// SetHintedHandoffMaxBytes sets the maximum number of bytes of hints
+	// stored on disk across all hosts, further hints are dropped.
+	SetHintedHandoffMaxBytes(value int64) Options
+
+	// HintedHandoffMaxBytes returns the maximum number of bytes of hints
+	// stored on disk across all hosts.
+	HintedHandoffMaxBytes() int64
+
+	// SetHintedHandoffMaxHintAge sets the maximum age of a hint, older hints
+	// are dropped instead of being replayed.
+	SetHintedHandoffMaxHintAge(value time.Duration) Options
+
+	// HintedHandoffMaxHintAge returns the maximum age of a hint.
+	HintedHandoffMaxHintAge() time.Duration
+
+	// SetHintedHandoffReplayInterval sets the interval at which hints are
+	// synced to disk and replayed to reconnected hosts.
+	SetHintedHandoffReplayInterval(value time.Duration) Options
+
+	// HintedHandoffReplayInterval returns the interval at which hints are
+	// synced to disk and replayed to reconnected hosts.
+	HintedHandoffReplayInterval() time.Duration
 }
 
 // AdminOptions is a set of administration client options.
@@ -59,6 +59,7 @@ type writeState struct {
 	errors            []error
 
 	queues         []hostQueue
+	hints          *hintedHandoff
 	tagEncoderPool serialize.TagEncoderPool
 	pool           *writeStatePool
 }
@@ -88,6 +89,7 @@ func (w *writeState) close() {
 
 	w.op, w.majority, w.pending, w.success = nil, 0, 0, 0
 	w.nsID, w.tsID, w.tagEncoder = nil, nil, nil
+	w.hints = nil
 
 	for i := range w.errors {
 		w.errors[i] = nil
@@ -116,6 +118,12 @@ func (w *writeState) completionFn(result interface{}, err error) {
 
 	if err != nil {
 		wErr = xerrors.NewRenamedError(err, fmt.Errorf("error writing to host %s: %v", hostID, err))
+		if !IsBadRequestError(err) {
+			// NB: hint the write before the op is released so that the replica
+			// receives it once it reconnects, regardless of whether the
+			// consistency level is met by the other replicas.
+			w.hints.add(hostID, w.op)
+		}
 	} else if hostShardSet, ok := w.topoMap.LookupHostShardSet(hostID); !ok {
 		errStr := "missing host shard in writeState completionFn: %s"
 		wErr = xerrors.NewRetryableError(fmt.Errorf(errStr, hostID))
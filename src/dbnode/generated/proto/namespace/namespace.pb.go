@@ -33,6 +33,7 @@
 		IndexOptions
 		NamespaceOptions
 		Registry
+		RollupOptions
 		SchemaOptions
 		SchemaHistory
 		FileDescriptorSet
@@ -155,6 +156,7 @@ type NamespaceOptions struct {
 	IndexOptions      *IndexOptions     `protobuf:"bytes,8,opt,name=indexOptions" json:"indexOptions,omitempty"`
 	SchemaOptions     *SchemaOptions    `protobuf:"bytes,9,opt,name=schemaOptions" json:"schemaOptions,omitempty"`
 	ColdWritesEnabled bool              `protobuf:"varint,10,opt,name=coldWritesEnabled,proto3" json:"coldWritesEnabled,omitempty"`
+	RollupOptions     *RollupOptions    `protobuf:"bytes,11,opt,name=rollupOptions" json:"rollupOptions,omitempty"`
 }
 
 func (m *NamespaceOptions) Reset()                    { *m = NamespaceOptions{} }
@@ -232,6 +234,13 @@ func (m *NamespaceOptions) GetColdWritesEnabled() bool {
 	return false
 }
 
+func (m *NamespaceOptions) GetRollupOptions() *RollupOptions {
+	if m != nil {
+		return m.RollupOptions
+	}
+	return nil
+}
+
 type Registry struct {
 	Namespaces map[string]*NamespaceOptions `protobuf:"bytes,1,rep,name=namespaces" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -248,11 +257,52 @@ func (m *Registry) GetNamespaces() map[string]*NamespaceOptions {
 	return nil
 }
 
+type RollupOptions struct {
+	Enabled         bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	SourceNamespace string   `protobuf:"bytes,2,opt,name=sourceNamespace,proto3" json:"sourceNamespace,omitempty"`
+	ResolutionNanos int64    `protobuf:"varint,3,opt,name=resolutionNanos,proto3" json:"resolutionNanos,omitempty"`
+	Aggregations    []string `protobuf:"bytes,4,rep,name=aggregations" json:"aggregations,omitempty"`
+}
+
+func (m *RollupOptions) Reset()                    { *m = RollupOptions{} }
+func (m *RollupOptions) String() string            { return proto.CompactTextString(m) }
+func (*RollupOptions) ProtoMessage()               {}
+func (*RollupOptions) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{4} }
+
+func (m *RollupOptions) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *RollupOptions) GetSourceNamespace() string {
+	if m != nil {
+		return m.SourceNamespace
+	}
+	return ""
+}
+
+func (m *RollupOptions) GetResolutionNanos() int64 {
+	if m != nil {
+		return m.ResolutionNanos
+	}
+	return 0
+}
+
+func (m *RollupOptions) GetAggregations() []string {
+	if m != nil {
+		return m.Aggregations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RetentionOptions)(nil), "namespace.RetentionOptions")
 	proto.RegisterType((*IndexOptions)(nil), "namespace.IndexOptions")
 	proto.RegisterType((*NamespaceOptions)(nil), "namespace.NamespaceOptions")
 	proto.RegisterType((*Registry)(nil), "namespace.Registry")
+	proto.RegisterType((*RollupOptions)(nil), "namespace.RollupOptions")
 }
 func (m *RetentionOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -460,6 +510,16 @@ func (m *NamespaceOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.RollupOptions != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.RollupOptions.Size()))
+		n4, err := m.RollupOptions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	return i, nil
 }
 
@@ -509,6 +569,60 @@ func (m *Registry) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RollupOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollupOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.SourceNamespace) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.SourceNamespace)))
+		i += copy(dAtA[i:], m.SourceNamespace)
+	}
+	if m.ResolutionNanos != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.ResolutionNanos))
+	}
+	if len(m.Aggregations) > 0 {
+		for _, s := range m.Aggregations {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -593,6 +707,10 @@ func (m *NamespaceOptions) Size() (n int) {
 	if m.ColdWritesEnabled {
 		n += 2
 	}
+	if m.RollupOptions != nil {
+		l = m.RollupOptions.Size()
+		n += 1 + l + sovNamespace(uint64(l))
+	}
 	return n
 }
 
@@ -615,6 +733,28 @@ func (m *Registry) Size() (n int) {
 	return n
 }
 
+func (m *RollupOptions) Size() (n int) {
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.SourceNamespace)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if m.ResolutionNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.ResolutionNanos))
+	}
+	if len(m.Aggregations) > 0 {
+		for _, s := range m.Aggregations {
+			l = len(s)
+			n += 1 + l + sovNamespace(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovNamespace(x uint64) (n int) {
 	for {
 		n++
@@ -1169,6 +1309,39 @@ func (m *NamespaceOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ColdWritesEnabled = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollupOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollupOptions == nil {
+				m.RollupOptions = &RollupOptions{}
+			}
+			if err := m.RollupOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespace(dAtA[iNdEx:])
@@ -1363,6 +1536,153 @@ func (m *Registry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RollupOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollupOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollupOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionNanos", wireType)
+			}
+			m.ResolutionNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregations = append(m.Aggregations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNamespace(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1473,41 +1793,45 @@ func init() {
 }
 
 var fileDescriptorNamespace = []byte{
-	// 575 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x51, 0x6b, 0xd3, 0x50,
-	0x14, 0xc7, 0x4d, 0xb3, 0xad, 0xed, 0x59, 0xe7, 0xe2, 0x45, 0xb0, 0x54, 0x28, 0xa3, 0x8a, 0x14,
-	0x91, 0x06, 0xdb, 0x17, 0x51, 0x18, 0xcc, 0xad, 0x0e, 0x41, 0x6a, 0xb9, 0x13, 0x84, 0xbd, 0xdd,
-	0x24, 0xa7, 0x6d, 0x58, 0x92, 0x1b, 0xee, 0xbd, 0xd1, 0xd5, 0xcf, 0xe0, 0x83, 0xdf, 0xc3, 0x2f,
-	0xe2, 0xa3, 0x1f, 0x41, 0xea, 0xd7, 0xf0, 0x41, 0x72, 0x63, 0xba, 0x24, 0x1d, 0x32, 0xf6, 0x52,
-	0x6e, 0xff, 0xe7, 0x77, 0xce, 0xb9, 0x3d, 0xff, 0x73, 0x0b, 0xa7, 0x73, 0x5f, 0x2d, 0x12, 0x67,
-	0xe0, 0xf2, 0xd0, 0x0e, 0x47, 0x9e, 0x63, 0x87, 0x23, 0x5b, 0x0a, 0xd7, 0xf6, 0x9c, 0x88, 0x7b,
-	0x68, 0xcf, 0x31, 0x42, 0xc1, 0x14, 0x7a, 0x76, 0x2c, 0xb8, 0xe2, 0x76, 0xc4, 0x42, 0x94, 0x31,
-	0x73, 0xf1, 0xea, 0x34, 0xd0, 0x11, 0xd2, 0x5c, 0x0b, 0x9d, 0x93, 0xdb, 0xd6, 0x94, 0xee, 0x02,
-	0x43, 0x96, 0x15, 0xec, 0x7d, 0x35, 0xc1, 0xa2, 0xa8, 0x30, 0x52, 0x3e, 0x8f, 0xde, 0xc7, 0xe9,
-	0xa7, 0x24, 0x43, 0xb8, 0x2f, 0x72, 0x6d, 0x8a, 0xc2, 0xe7, 0xde, 0x84, 0x45, 0x5c, 0xb6, 0x8d,
-	0x03, 0xa3, 0x6f, 0xd2, 0x6b, 0x63, 0xe4, 0x09, 0xdc, 0x75, 0x02, 0xee, 0x5e, 0x9c, 0xf9, 0x5f,
-	0x30, 0xa3, 0x6b, 0x9a, 0xae, 0xa8, 0xe4, 0x19, 0xdc, 0x73, 0x92, 0xd9, 0x0c, 0xc5, 0x9b, 0x44,
-	0x25, 0xe2, 0x1f, 0x6a, 0x6a, 0x74, 0x33, 0x40, 0xfa, 0xb0, 0x9f, 0x89, 0x53, 0x26, 0x55, 0xc6,
-	0x6e, 0x69, 0xb6, 0x2a, 0x6b, 0x32, 0xed, 0x74, 0xc2, 0x14, 0x1b, 0x5f, 0xc6, 0xbe, 0x58, 0xb6,
-	0xb7, 0x0f, 0x8c, 0x7e, 0x83, 0x56, 0x65, 0x72, 0x0e, 0xfd, 0x8a, 0x74, 0x34, 0x53, 0x28, 0x26,
-	0x5c, 0x1d, 0xb9, 0x2e, 0x4a, 0x59, 0xfc, 0xc5, 0x3b, 0xba, 0xd9, 0x8d, 0x79, 0x72, 0x08, 0x9d,
-	0x99, 0xbe, 0x3e, 0xbd, 0x6e, 0x7e, 0x75, 0x5d, 0xed, 0x3f, 0x44, 0x6f, 0x0a, 0xad, 0xb7, 0x91,
-	0x87, 0x97, 0xb9, 0x13, 0x6d, 0xa8, 0x63, 0xc4, 0x9c, 0x00, 0x3d, 0x3d, 0xfc, 0x06, 0xcd, 0xbf,
-	0xde, 0x74, 0xde, 0xbd, 0x3f, 0x26, 0x58, 0x93, 0xdc, 0xfb, 0xbc, 0xec, 0x53, 0xb0, 0x1c, 0xce,
-	0x95, 0x54, 0x82, 0xc5, 0xe3, 0x52, 0xfd, 0x0d, 0x9d, 0xf4, 0xa0, 0x35, 0x0b, 0x12, 0xb9, 0xc8,
-	0xb9, 0x9a, 0xe6, 0x4a, 0x5a, 0x6a, 0xea, 0x67, 0xe1, 0x2b, 0x94, 0x1f, 0xf8, 0x31, 0x0f, 0x43,
-	0x5f, 0xbd, 0xe3, 0x73, 0x6d, 0x6a, 0x83, 0x6e, 0x06, 0xd2, 0xab, 0xbb, 0x01, 0xb2, 0x28, 0x59,
-	0xf7, 0xde, 0xd2, 0x68, 0x45, 0x25, 0x8f, 0x61, 0x4f, 0x60, 0xcc, 0x7c, 0x91, 0x63, 0x99, 0xa1,
-	0x65, 0x91, 0x9c, 0x82, 0x25, 0x2a, 0x0b, 0xac, 0x6d, 0xdb, 0x1d, 0x3e, 0x1c, 0x5c, 0x3d, 0x9f,
-	0xea, 0x8e, 0xd3, 0x8d, 0xa4, 0x74, 0x83, 0x64, 0xc4, 0x62, 0xb9, 0xe0, 0x2a, 0x6f, 0x58, 0xcf,
-	0x36, 0xa8, 0x22, 0x93, 0x57, 0xd0, 0xf2, 0x0b, 0x2e, 0xb5, 0x1b, 0xba, 0xdd, 0x83, 0x42, 0xbb,
-	0xa2, 0x89, 0xb4, 0x04, 0x93, 0x43, 0xd8, 0xcb, 0x5e, 0x60, 0x9e, 0xdd, 0xd4, 0xd9, 0xed, 0x42,
-	0xf6, 0x59, 0x31, 0x4e, 0xcb, 0x78, 0x3a, 0x6b, 0x97, 0x07, 0xde, 0x47, 0x3d, 0xd6, 0xfc, 0xa2,
-	0x90, 0xcd, 0x7a, 0x23, 0xd0, 0xfb, 0x6e, 0x40, 0x83, 0xe2, 0xdc, 0x97, 0x4a, 0x2c, 0xc9, 0x31,
-	0xc0, 0xba, 0x49, 0xfa, 0x9a, 0xcd, 0xfe, 0xee, 0xf0, 0x51, 0x69, 0x48, 0x19, 0x38, 0x58, 0x2f,
-	0x8c, 0x1c, 0x47, 0x4a, 0x2c, 0x69, 0x21, 0xad, 0x73, 0x0e, 0xfb, 0x95, 0x30, 0xb1, 0xc0, 0xbc,
-	0xc0, 0xa5, 0xde, 0xa0, 0x26, 0x4d, 0x8f, 0xe4, 0x39, 0x6c, 0x7f, 0x62, 0x41, 0x82, 0x7a, 0x5b,
-	0xca, 0x4e, 0x54, 0x97, 0x91, 0x66, 0xe4, 0xcb, 0xda, 0x0b, 0xe3, 0xb5, 0xf5, 0x63, 0xd5, 0x35,
-	0x7e, 0xae, 0xba, 0xc6, 0xaf, 0x55, 0xd7, 0xf8, 0xf6, 0xbb, 0x7b, 0xc7, 0xd9, 0xd1, 0x7f, 0x53,
-	0xa3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xfa, 0xeb, 0x0c, 0x42, 0x05, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x54, 0xdd, 0x6a, 0xd4, 0x40,
+	0x14, 0x36, 0xbb, 0xdb, 0xee, 0xee, 0x74, 0xd7, 0xc6, 0x41, 0x70, 0x59, 0xa1, 0x48, 0x14, 0x59,
+	0x44, 0x36, 0xd8, 0xde, 0x88, 0x82, 0x50, 0xdb, 0x5a, 0x04, 0x59, 0x97, 0xa9, 0x20, 0xf4, 0x6e,
+	0x92, 0x9c, 0xcd, 0x86, 0x26, 0x99, 0x30, 0x33, 0xd1, 0xae, 0xcf, 0xe0, 0x85, 0xaf, 0xe0, 0xb5,
+	0xaf, 0xe0, 0x03, 0x78, 0xe9, 0x23, 0x88, 0xbe, 0x88, 0x99, 0x89, 0xd9, 0xe6, 0xa7, 0x94, 0xe2,
+	0x45, 0x42, 0xe6, 0x3b, 0xdf, 0x39, 0xdf, 0xe4, 0x7c, 0x67, 0x06, 0x1d, 0xfb, 0x81, 0x5c, 0xa6,
+	0xce, 0xd4, 0x65, 0x91, 0x1d, 0xed, 0x79, 0x4e, 0xf6, 0xb2, 0x05, 0x77, 0x6d, 0xcf, 0x89, 0x99,
+	0x07, 0xb6, 0x0f, 0x31, 0x70, 0x2a, 0xc1, 0xb3, 0x13, 0xce, 0x24, 0xb3, 0x63, 0x1a, 0x81, 0x48,
+	0xa8, 0x0b, 0x17, 0x5f, 0x53, 0x1d, 0xc1, 0xfd, 0x35, 0x30, 0x3e, 0xfc, 0xdf, 0x9a, 0xc2, 0x5d,
+	0x42, 0x44, 0xf3, 0x82, 0xd6, 0xe7, 0x36, 0x32, 0x09, 0x48, 0x88, 0x65, 0xc0, 0xe2, 0xb7, 0x89,
+	0x7a, 0x0b, 0xbc, 0x8b, 0x6e, 0xf3, 0x02, 0x9b, 0x03, 0x0f, 0x98, 0x37, 0xa3, 0x31, 0x13, 0x23,
+	0xe3, 0x9e, 0x31, 0x69, 0x93, 0x4b, 0x63, 0xf8, 0x21, 0xba, 0xe9, 0x84, 0xcc, 0x3d, 0x3b, 0x09,
+	0x3e, 0x41, 0xce, 0x6e, 0x69, 0x76, 0x0d, 0xc5, 0x8f, 0xd1, 0x2d, 0x27, 0x5d, 0x2c, 0x80, 0xbf,
+	0x4a, 0x65, 0xca, 0xff, 0x51, 0xdb, 0x9a, 0xda, 0x0c, 0xe0, 0x09, 0xda, 0xce, 0xc1, 0x39, 0x15,
+	0x32, 0xe7, 0x76, 0x34, 0xb7, 0x0e, 0x6b, 0xa6, 0x52, 0x3a, 0xa4, 0x92, 0x1e, 0x9d, 0x27, 0x01,
+	0x5f, 0x8d, 0x36, 0x32, 0x66, 0x8f, 0xd4, 0x61, 0x7c, 0x8a, 0x26, 0x35, 0x68, 0x7f, 0x21, 0x81,
+	0xcf, 0x98, 0xdc, 0x77, 0x5d, 0x10, 0xa2, 0xfc, 0xc7, 0x9b, 0x5a, 0xec, 0xda, 0x7c, 0xfc, 0x02,
+	0x8d, 0x17, 0x7a, 0xfb, 0xe4, 0xb2, 0xfe, 0x75, 0x75, 0xb5, 0x2b, 0x18, 0xd6, 0x1c, 0x0d, 0x5e,
+	0xc7, 0x1e, 0x9c, 0x17, 0x4e, 0x8c, 0x50, 0x17, 0x62, 0xea, 0x84, 0xe0, 0xe9, 0xe6, 0xf7, 0x48,
+	0xb1, 0xbc, 0x6e, 0xbf, 0xad, 0xef, 0x1d, 0x64, 0xce, 0x0a, 0xef, 0x8b, 0xb2, 0x8f, 0x90, 0xe9,
+	0x30, 0x26, 0x85, 0xe4, 0x34, 0x39, 0xaa, 0xd4, 0x6f, 0xe0, 0xd8, 0x42, 0x83, 0x45, 0x98, 0x8a,
+	0x65, 0xc1, 0x6b, 0x69, 0x5e, 0x05, 0x53, 0xa6, 0x7e, 0xe4, 0x81, 0x04, 0xf1, 0x8e, 0x1d, 0xb0,
+	0x28, 0x0a, 0xe4, 0x1b, 0xe6, 0x6b, 0x53, 0x7b, 0xa4, 0x19, 0x50, 0x5b, 0x77, 0x43, 0xa0, 0x71,
+	0xba, 0xd6, 0xee, 0x68, 0x6a, 0x0d, 0xc5, 0x0f, 0xd0, 0x90, 0x43, 0x42, 0x03, 0x5e, 0xd0, 0x72,
+	0x43, 0xab, 0x20, 0x3e, 0x46, 0x26, 0xaf, 0x0d, 0xb0, 0xb6, 0x6d, 0x6b, 0xf7, 0xee, 0xf4, 0xe2,
+	0xf8, 0xd4, 0x67, 0x9c, 0x34, 0x92, 0xd4, 0x04, 0x89, 0x98, 0x26, 0x62, 0xc9, 0x64, 0x21, 0xd8,
+	0xcd, 0x27, 0xa8, 0x06, 0xe3, 0xe7, 0x68, 0x10, 0x94, 0x5c, 0x1a, 0xf5, 0xb4, 0xdc, 0x9d, 0x92,
+	0x5c, 0xd9, 0x44, 0x52, 0x21, 0x67, 0x23, 0x32, 0xcc, 0x4f, 0x60, 0x91, 0xdd, 0xd7, 0xd9, 0xa3,
+	0x52, 0xf6, 0x49, 0x39, 0x4e, 0xaa, 0x74, 0xd5, 0x6b, 0x97, 0x85, 0xde, 0x7b, 0xdd, 0xd6, 0x62,
+	0xa3, 0x28, 0xef, 0x75, 0x23, 0xa0, 0xd4, 0x38, 0x0b, 0xc3, 0x34, 0x29, 0xd4, 0xb6, 0x1a, 0x6a,
+	0xa4, 0x1c, 0x27, 0x55, 0xba, 0xf5, 0xcd, 0x40, 0x3d, 0x02, 0x7e, 0x90, 0x8d, 0xc4, 0x0a, 0x1f,
+	0x20, 0xb4, 0x4e, 0x53, 0xb7, 0x41, 0x3b, 0xab, 0x74, 0xbf, 0xd2, 0xe4, 0x9c, 0x38, 0x5d, 0x0f,
+	0x5c, 0xb6, 0x8f, 0x6c, 0x4d, 0x4a, 0x69, 0xe3, 0x53, 0xb4, 0x5d, 0x0b, 0x63, 0x13, 0xb5, 0xcf,
+	0x60, 0xa5, 0x27, 0xb0, 0x4f, 0xd4, 0x27, 0x7e, 0x82, 0x36, 0x3e, 0xd0, 0x30, 0x05, 0x3d, 0x6d,
+	0x55, 0x27, 0xeb, 0xc3, 0x4c, 0x72, 0xe6, 0xb3, 0xd6, 0x53, 0xc3, 0xfa, 0x6a, 0xa0, 0x61, 0xe5,
+	0x77, 0xae, 0x38, 0x40, 0xca, 0x6e, 0x96, 0x72, 0x17, 0xd6, 0x05, 0xb5, 0x58, 0x9f, 0xd4, 0x61,
+	0xc5, 0xe4, 0x20, 0x58, 0x98, 0xaa, 0x92, 0xe5, 0x0b, 0xab, 0x0e, 0xab, 0xb3, 0x42, 0x7d, 0x9f,
+	0x83, 0x4f, 0xf3, 0x66, 0x77, 0xb2, 0x16, 0xf5, 0x49, 0x05, 0x7b, 0x69, 0xfe, 0xf8, 0xbd, 0x63,
+	0xfc, 0xcc, 0x9e, 0x5f, 0xd9, 0xf3, 0xe5, 0xcf, 0xce, 0x0d, 0x67, 0x53, 0x5f, 0xc5, 0x7b, 0x7f,
+	0x01, 0x38, 0x5c, 0xa1, 0x0a, 0x26, 0x06, 0x00, 0x00,
 }
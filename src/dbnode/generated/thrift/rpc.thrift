@@ -32,9 +32,15 @@ enum ErrorType {
 	BAD_REQUEST
 }
 
+enum ErrorFlags {
+	NONE = 0x00,
+	RESOURCE_EXHAUSTED = 0x01
+}
+
 exception Error {
 	1: required ErrorType type = ErrorType.INTERNAL_ERROR
 	2: required string message
+	3: optional i64 flags = 0
 }
 
 exception WriteBatchRawErrors {
@@ -45,6 +51,7 @@ service Node {
 	// Friendly not highly performant read/write endpoints
 	QueryResult query(1: QueryRequest req) throws (1: Error err)
 	AggregateQueryRawResult aggregateRaw(1: AggregateQueryRawRequest req) throws (1: Error err)
+	AggregateSeriesRawResult aggregateSeriesRaw(1: AggregateSeriesRawRequest req) throws (1: Error err)
 	AggregateQueryResult aggregate(1: AggregateQueryRequest req) throws (1: Error err)
 	FetchResult fetch(1: FetchRequest req) throws (1: Error err)
 	FetchTaggedResult fetchTagged(1: FetchTaggedRequest req) throws (1: Error err)
@@ -395,6 +402,53 @@ struct AggregateQueryRawResultTagValueElement {
 	1: required binary tagValue
 }
 
+enum TemporalFunctionType {
+	RATE,
+	INCREASE,
+	DELTA,
+	AVG_OVER_TIME,
+	COUNT_OVER_TIME,
+	MIN_OVER_TIME,
+	MAX_OVER_TIME,
+	SUM_OVER_TIME,
+}
+
+enum SeriesAggregationType {
+	SUM,
+	MIN,
+	MAX,
+	COUNT,
+}
+
+// AggregateSeriesRawRequest pushes a temporal function followed by a grouping
+// aggregation down to the node, e.g. sum by (tags) (rate(series[range])).
+// Each node returns the partial aggregates for the shards it was asked to
+// evaluate and the caller merges the partials across nodes.
+struct AggregateSeriesRawRequest {
+	1: required binary query
+	2: required i64 rangeStart
+	3: required i64 rangeEnd
+	4: required binary nameSpace
+	5: required i64 stepDuration
+	6: required i64 temporalDuration
+	7: required TemporalFunctionType temporalFunction
+	8: required SeriesAggregationType aggregation
+	9: optional list<binary> groupByTags
+	10: optional list<i32> shards
+	11: optional i64 limit
+	12: optional TimeType rangeType = TimeType.UNIX_SECONDS
+}
+
+struct AggregateSeriesRawResult {
+	1: required list<AggregateSeriesRawResultGroup> groups
+	2: required bool exhaustive
+}
+
+struct AggregateSeriesRawResultGroup {
+	1: required list<Tag> tags
+	2: required list<double> values
+}
+
 // AggregateQueryRequest is identical to AggregateQueryRawRequest save for using string instead of binary for types.
 struct AggregateQueryRequest {
 	1: optional Query query
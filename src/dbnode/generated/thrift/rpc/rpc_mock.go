@@ -210,6 +210,21 @@ func (mr *MockTChanNodeMockRecorder) AggregateRaw(ctx, req interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateRaw", reflect.TypeOf((*MockTChanNode)(nil).AggregateRaw), ctx, req)
 }
 
+// AggregateSeriesRaw mocks base method
+func (m *MockTChanNode) AggregateSeriesRaw(ctx thrift.Context, req *AggregateSeriesRawRequest) (*AggregateSeriesRawResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateSeriesRaw", ctx, req)
+	ret0, _ := ret[0].(*AggregateSeriesRawResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateSeriesRaw indicates an expected call of AggregateSeriesRaw
+func (mr *MockTChanNodeMockRecorder) AggregateSeriesRaw(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateSeriesRaw", reflect.TypeOf((*MockTChanNode)(nil).AggregateSeriesRaw), ctx, req)
+}
+
 // Bootstrapped mocks base method
 func (m *MockTChanNode) Bootstrapped(ctx thrift.Context) (*NodeBootstrappedResult_, error) {
 	m.ctrl.T.Helper()
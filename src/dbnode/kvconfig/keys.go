@@ -33,6 +33,16 @@ const (
 	// configuration specifying a hard limit for a cluster new series insertions.
 	ClusterNewSeriesInsertLimitKey = "m3db.node.cluster-new-series-insert-limit"
 
+	// ClusterMaxActiveSeriesPerNamespaceKey is the KV config key for the runtime
+	// configuration specifying a quota for the number of active series of each
+	// namespace across the cluster.
+	ClusterMaxActiveSeriesPerNamespaceKey = "m3db.node.cluster-max-active-series-per-namespace"
+
+	// NodeMaxActiveSeriesPerNamespaceKey is the KV config key for the runtime
+	// configuration specifying a quota for the number of active series of each
+	// namespace on a single node.
+	NodeMaxActiveSeriesPerNamespaceKey = "m3db.node.max-active-series-per-namespace"
+
 	// ClientBootstrapConsistencyLevel is the KV config key for the runtime
 	// configuration specifying the client bootstrap consistency level
 	ClientBootstrapConsistencyLevel = "m3db.client.bootstrap-consistency-level"
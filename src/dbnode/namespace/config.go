@@ -57,6 +57,7 @@ type MetadataConfiguration struct {
 	ColdWritesEnabled *bool                   `yaml:"coldWritesEnabled"`
 	Retention         retention.Configuration `yaml:"retention" validate:"nonzero"`
 	Index             IndexConfiguration      `yaml:"index"`
+	Rollup            *RollupConfiguration    `yaml:"rollup"`
 }
 
 // Metadata returns a Metadata corresponding to the receiver struct
@@ -84,6 +85,13 @@ func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	if v := mc.ColdWritesEnabled; v != nil {
 		opts = opts.SetColdWritesEnabled(*v)
 	}
+	if v := mc.Rollup; v != nil {
+		rollupOpts, err := v.Options()
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetRollupOptions(rollupOpts)
+	}
 	return NewMetadata(ident.StringID(mc.ID), opts)
 }
 
@@ -99,3 +107,28 @@ func (ic *IndexConfiguration) Options() IndexOptions {
 		SetEnabled(ic.Enabled).
 		SetBlockSize(ic.BlockSize)
 }
+
+// RollupConfiguration controls populating a namespace by rolling up
+// the flushed blocks of another namespace.
+type RollupConfiguration struct {
+	SourceNamespace string        `yaml:"sourceNamespace" validate:"nonzero"`
+	Resolution      time.Duration `yaml:"resolution" validate:"nonzero"`
+	Aggregations    []string      `yaml:"aggregations" validate:"nonzero"`
+}
+
+// Options returns the RollupOptions corresponding to the receiver struct.
+func (rc *RollupConfiguration) Options() (RollupOptions, error) {
+	aggs := make([]RollupAggregation, 0, len(rc.Aggregations))
+	for _, str := range rc.Aggregations {
+		agg, err := ParseRollupAggregation(str)
+		if err != nil {
+			return nil, err
+		}
+		aggs = append(aggs, agg)
+	}
+	return NewRollupOptions().
+		SetEnabled(true).
+		SetSourceNamespace(ident.StringID(rc.SourceNamespace)).
+		SetResolution(rc.Resolution).
+		SetAggregations(aggs), nil
+}
@@ -79,6 +79,34 @@ func ToIndexOptions(
 	return iopts, nil
 }
 
+// ToRollupOptions converts nsproto.RollupOptions to RollupOptions
+func ToRollupOptions(
+	ro *nsproto.RollupOptions,
+) (RollupOptions, error) {
+	ropts := NewRollupOptions().SetEnabled(false)
+	if ro == nil {
+		return ropts, nil
+	}
+
+	aggs := make([]RollupAggregation, 0, len(ro.Aggregations))
+	for _, str := range ro.Aggregations {
+		agg, err := ParseRollupAggregation(str)
+		if err != nil {
+			return nil, err
+		}
+		aggs = append(aggs, agg)
+	}
+
+	ropts = ropts.SetEnabled(ro.Enabled).
+		SetResolution(fromNanos(ro.ResolutionNanos)).
+		SetAggregations(aggs)
+	if ro.SourceNamespace != "" {
+		ropts = ropts.SetSourceNamespace(ident.StringID(ro.SourceNamespace))
+	}
+
+	return ropts, nil
+}
+
 // ToMetadata converts nsproto.Options to Metadata
 func ToMetadata(
 	id string,
@@ -98,6 +126,11 @@ func ToMetadata(
 		return nil, err
 	}
 
+	rollupOpts, err := ToRollupOptions(opts.RollupOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	sr, err := LoadSchemaHistory(opts.GetSchemaOptions())
 	if err != nil {
 		return nil, err
@@ -113,6 +146,7 @@ func ToMetadata(
 		SetSchemaHistory(sr).
 		SetRetentionOptions(ropts).
 		SetIndexOptions(iopts).
+		SetRollupOptions(rollupOpts).
 		SetColdWritesEnabled(opts.ColdWritesEnabled)
 
 	return NewMetadata(ident.StringID(id), mopts)
@@ -171,5 +205,30 @@ func OptionsToProto(opts Options) *nsproto.NamespaceOptions {
 			BlockSizeNanos: iopts.BlockSize().Nanoseconds(),
 		},
 		ColdWritesEnabled: opts.ColdWritesEnabled(),
+		RollupOptions:     toRollupOptions(opts.RollupOptions()),
+	}
+}
+
+// toRollupOptions converts RollupOptions -> nsproto.RollupOptions, returning
+// nil when rollup is disabled.
+func toRollupOptions(ropts RollupOptions) *nsproto.RollupOptions {
+	if !ropts.Enabled() {
+		return nil
+	}
+
+	var sourceNamespace string
+	if id := ropts.SourceNamespace(); id != nil {
+		sourceNamespace = id.String()
+	}
+	aggs := make([]string, 0, len(ropts.Aggregations()))
+	for _, agg := range ropts.Aggregations() {
+		aggs = append(aggs, agg.String())
+	}
+
+	return &nsproto.RollupOptions{
+		Enabled:         true,
+		SourceNamespace: sourceNamespace,
+		ResolutionNanos: ropts.Resolution().Nanoseconds(),
+		Aggregations:    aggs,
 	}
 }
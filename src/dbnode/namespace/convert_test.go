@@ -271,6 +271,36 @@ func TestFromProtoSnapshotEnabled(t *testing.T) {
 	require.Equal(t, !namespace.NewOptions().SnapshotEnabled(), md.Options().SnapshotEnabled())
 }
 
+func TestRollupOptionsProtoRoundTrip(t *testing.T) {
+	rollupOpts := namespace.NewRollupOptions().
+		SetEnabled(true).
+		SetSourceNamespace(ident.StringID("raw")).
+		SetResolution(time.Minute).
+		SetAggregations([]namespace.RollupAggregation{
+			namespace.RollupAggregationMax,
+			namespace.RollupAggregationSum,
+		})
+	md, err := namespace.NewMetadata(ident.StringID("ns1"),
+		namespace.NewOptions().SetRollupOptions(rollupOpts))
+	require.NoError(t, err)
+
+	nsOpts := namespace.OptionsToProto(md.Options())
+	require.Equal(t, &nsproto.RollupOptions{
+		Enabled:         true,
+		SourceNamespace: "raw",
+		ResolutionNanos: int64(time.Minute),
+		Aggregations:    []string{"max", "sum"},
+	}, nsOpts.RollupOptions)
+
+	observed, err := namespace.ToMetadata("ns1", nsOpts)
+	require.NoError(t, err)
+	require.True(t, rollupOpts.Equal(observed.Options().RollupOptions()))
+
+	nsOpts.RollupOptions.Aggregations = []string{"p99"}
+	_, err = namespace.ToMetadata("ns1", nsOpts)
+	require.Error(t, err)
+}
+
 func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOptions, observed namespace.Metadata) {
 	require.Equal(t, name, observed.ID().String())
 	opts := observed.Options()
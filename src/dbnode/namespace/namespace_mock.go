@@ -340,6 +340,34 @@ func (mr *MockOptionsMockRecorder) IndexOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexOptions", reflect.TypeOf((*MockOptions)(nil).IndexOptions))
 }
 
+// SetRollupOptions mocks base method
+func (m *MockOptions) SetRollupOptions(value RollupOptions) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRollupOptions", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetRollupOptions indicates an expected call of SetRollupOptions
+func (mr *MockOptionsMockRecorder) SetRollupOptions(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRollupOptions", reflect.TypeOf((*MockOptions)(nil).SetRollupOptions), value)
+}
+
+// RollupOptions mocks base method
+func (m *MockOptions) RollupOptions() RollupOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollupOptions")
+	ret0, _ := ret[0].(RollupOptions)
+	return ret0
+}
+
+// RollupOptions indicates an expected call of RollupOptions
+func (mr *MockOptionsMockRecorder) RollupOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollupOptions", reflect.TypeOf((*MockOptions)(nil).RollupOptions))
+}
+
 // SetSchemaHistory mocks base method
 func (m *MockOptions) SetSchemaHistory(value SchemaHistory) Options {
 	m.ctrl.T.Helper()
@@ -461,6 +489,169 @@ func (mr *MockIndexOptionsMockRecorder) BlockSize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSize", reflect.TypeOf((*MockIndexOptions)(nil).BlockSize))
 }
 
+// MockRollupOptions is a mock of RollupOptions interface
+type MockRollupOptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockRollupOptionsMockRecorder
+}
+
+// MockRollupOptionsMockRecorder is the mock recorder for MockRollupOptions
+type MockRollupOptionsMockRecorder struct {
+	mock *MockRollupOptions
+}
+
+// NewMockRollupOptions creates a new mock instance
+func NewMockRollupOptions(ctrl *gomock.Controller) *MockRollupOptions {
+	mock := &MockRollupOptions{ctrl: ctrl}
+	mock.recorder = &MockRollupOptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRollupOptions) EXPECT() *MockRollupOptionsMockRecorder {
+	return m.recorder
+}
+
+// Validate mocks base method
+func (m *MockRollupOptions) Validate() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate
+func (mr *MockRollupOptionsMockRecorder) Validate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockRollupOptions)(nil).Validate))
+}
+
+// Equal mocks base method
+func (m *MockRollupOptions) Equal(value RollupOptions) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", value)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Equal indicates an expected call of Equal
+func (mr *MockRollupOptionsMockRecorder) Equal(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockRollupOptions)(nil).Equal), value)
+}
+
+// SetEnabled mocks base method
+func (m *MockRollupOptions) SetEnabled(value bool) RollupOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnabled", value)
+	ret0, _ := ret[0].(RollupOptions)
+	return ret0
+}
+
+// SetEnabled indicates an expected call of SetEnabled
+func (mr *MockRollupOptionsMockRecorder) SetEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnabled", reflect.TypeOf((*MockRollupOptions)(nil).SetEnabled), value)
+}
+
+// Enabled mocks base method
+func (m *MockRollupOptions) Enabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Enabled indicates an expected call of Enabled
+func (mr *MockRollupOptionsMockRecorder) Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enabled", reflect.TypeOf((*MockRollupOptions)(nil).Enabled))
+}
+
+// SetSourceNamespace mocks base method
+func (m *MockRollupOptions) SetSourceNamespace(value ident.ID) RollupOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSourceNamespace", value)
+	ret0, _ := ret[0].(RollupOptions)
+	return ret0
+}
+
+// SetSourceNamespace indicates an expected call of SetSourceNamespace
+func (mr *MockRollupOptionsMockRecorder) SetSourceNamespace(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSourceNamespace", reflect.TypeOf((*MockRollupOptions)(nil).SetSourceNamespace), value)
+}
+
+// SourceNamespace mocks base method
+func (m *MockRollupOptions) SourceNamespace() ident.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SourceNamespace")
+	ret0, _ := ret[0].(ident.ID)
+	return ret0
+}
+
+// SourceNamespace indicates an expected call of SourceNamespace
+func (mr *MockRollupOptionsMockRecorder) SourceNamespace() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SourceNamespace", reflect.TypeOf((*MockRollupOptions)(nil).SourceNamespace))
+}
+
+// SetResolution mocks base method
+func (m *MockRollupOptions) SetResolution(value time.Duration) RollupOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetResolution", value)
+	ret0, _ := ret[0].(RollupOptions)
+	return ret0
+}
+
+// SetResolution indicates an expected call of SetResolution
+func (mr *MockRollupOptionsMockRecorder) SetResolution(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResolution", reflect.TypeOf((*MockRollupOptions)(nil).SetResolution), value)
+}
+
+// Resolution mocks base method
+func (m *MockRollupOptions) Resolution() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolution")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// Resolution indicates an expected call of Resolution
+func (mr *MockRollupOptionsMockRecorder) Resolution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolution", reflect.TypeOf((*MockRollupOptions)(nil).Resolution))
+}
+
+// SetAggregations mocks base method
+func (m *MockRollupOptions) SetAggregations(value []RollupAggregation) RollupOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAggregations", value)
+	ret0, _ := ret[0].(RollupOptions)
+	return ret0
+}
+
+// SetAggregations indicates an expected call of SetAggregations
+func (mr *MockRollupOptionsMockRecorder) SetAggregations(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAggregations", reflect.TypeOf((*MockRollupOptions)(nil).SetAggregations), value)
+}
+
+// Aggregations mocks base method
+func (m *MockRollupOptions) Aggregations() []RollupAggregation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregations")
+	ret0, _ := ret[0].([]RollupAggregation)
+	return ret0
+}
+
+// Aggregations indicates an expected call of Aggregations
+func (mr *MockRollupOptionsMockRecorder) Aggregations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregations", reflect.TypeOf((*MockRollupOptions)(nil).Aggregations))
+}
+
 // MockSchemaDescr is a mock of SchemaDescr interface
 type MockSchemaDescr struct {
 	ctrl     *gomock.Controller
@@ -53,6 +53,7 @@ var (
 	errIndexBlockSizePositive                       = errors.New("index block size must positive")
 	errIndexBlockSizeTooLarge                       = errors.New("index block size needs to be <= namespace retention period")
 	errIndexBlockSizeMustBeAMultipleOfDataBlockSize = errors.New("index block size must be a multiple of data block size")
+	errDataBlockSizeMustBeAMultipleOfRollupRes      = errors.New("data block size must be a multiple of rollup resolution")
 )
 
 type options struct {
@@ -65,6 +66,7 @@ type options struct {
 	coldWritesEnabled bool
 	retentionOpts     retention.Options
 	indexOpts         IndexOptions
+	rollupOpts        RollupOptions
 	schemaHis         SchemaHistory
 }
 
@@ -85,6 +87,7 @@ func NewOptions() Options {
 		coldWritesEnabled: defaultColdWritesEnabled,
 		retentionOpts:     retention.NewOptions(),
 		indexOpts:         NewIndexOptions(),
+		rollupOpts:        NewRollupOptions(),
 		schemaHis:         NewSchemaHistory(),
 	}
 }
@@ -93,6 +96,14 @@ func (o *options) Validate() error {
 	if err := o.retentionOpts.Validate(); err != nil {
 		return err
 	}
+	if o.rollupOpts.Enabled() {
+		if err := o.rollupOpts.Validate(); err != nil {
+			return err
+		}
+		if o.retentionOpts.BlockSize()%o.rollupOpts.Resolution() != 0 {
+			return errDataBlockSizeMustBeAMultipleOfRollupRes
+		}
+	}
 	if !o.indexOpts.Enabled() {
 		return nil
 	}
@@ -124,6 +135,7 @@ func (o *options) Equal(value Options) bool {
 		o.coldWritesEnabled == value.ColdWritesEnabled() &&
 		o.retentionOpts.Equal(value.RetentionOptions()) &&
 		o.indexOpts.Equal(value.IndexOptions()) &&
+		o.rollupOpts.Equal(value.RollupOptions()) &&
 		o.schemaHis.Equal(value.SchemaHistory())
 }
 
@@ -217,6 +229,16 @@ func (o *options) IndexOptions() IndexOptions {
 	return o.indexOpts
 }
 
+func (o *options) SetRollupOptions(value RollupOptions) Options {
+	opts := *o
+	opts.rollupOpts = value
+	return &opts
+}
+
+func (o *options) RollupOptions() RollupOptions {
+	return o.rollupOpts
+}
+
 func (o *options) SetSchemaHistory(value SchemaHistory) Options {
 	opts := *o
 	opts.schemaHis = value
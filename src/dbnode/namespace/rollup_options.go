@@ -0,0 +1,208 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/x/ident"
+)
+
+var (
+	// defaultRollupEnabled disables rollup by default.
+	defaultRollupEnabled = false
+
+	errRollupSourceNamespaceMissing = errors.New("rollup source namespace must be set")
+	errRollupResolutionPositive     = errors.New("rollup resolution must be positive")
+	errRollupAggregationsMissing    = errors.New("rollup must specify at least one aggregation")
+)
+
+// RollupAggregation is an aggregation computed over the datapoints that
+// fall within a single rollup resolution bucket.
+type RollupAggregation int
+
+// List of supported rollup aggregations.
+const (
+	RollupAggregationUnknown RollupAggregation = iota
+	RollupAggregationLast
+	RollupAggregationMin
+	RollupAggregationMax
+	RollupAggregationMean
+	RollupAggregationCount
+	RollupAggregationSum
+	RollupAggregationSumSq
+	RollupAggregationStdev
+)
+
+var (
+	validRollupAggregations = []RollupAggregation{
+		RollupAggregationLast,
+		RollupAggregationMin,
+		RollupAggregationMax,
+		RollupAggregationMean,
+		RollupAggregationCount,
+		RollupAggregationSum,
+		RollupAggregationSumSq,
+		RollupAggregationStdev,
+	}
+)
+
+// ValidRollupAggregations returns the supported rollup aggregations.
+func ValidRollupAggregations() []RollupAggregation {
+	return validRollupAggregations
+}
+
+func (a RollupAggregation) String() string {
+	switch a {
+	case RollupAggregationLast:
+		return "last"
+	case RollupAggregationMin:
+		return "min"
+	case RollupAggregationMax:
+		return "max"
+	case RollupAggregationMean:
+		return "mean"
+	case RollupAggregationCount:
+		return "count"
+	case RollupAggregationSum:
+		return "sum"
+	case RollupAggregationSumSq:
+		return "sumsq"
+	case RollupAggregationStdev:
+		return "stdev"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseRollupAggregation parses a rollup aggregation from its string form.
+func ParseRollupAggregation(str string) (RollupAggregation, error) {
+	for _, valid := range validRollupAggregations {
+		if str == valid.String() {
+			return valid, nil
+		}
+	}
+	return RollupAggregationUnknown, fmt.Errorf("invalid rollup aggregation '%s': should be one of %v",
+		str, validRollupAggregations)
+}
+
+type rollupOpts struct {
+	enabled         bool
+	sourceNamespace ident.ID
+	resolution      time.Duration
+	aggregations    []RollupAggregation
+}
+
+// NewRollupOptions returns a new RollupOptions.
+func NewRollupOptions() RollupOptions {
+	return &rollupOpts{
+		enabled: defaultRollupEnabled,
+	}
+}
+
+func (r *rollupOpts) Validate() error {
+	if r.sourceNamespace == nil || len(r.sourceNamespace.Bytes()) == 0 {
+		return errRollupSourceNamespaceMissing
+	}
+	if r.resolution <= 0 {
+		return errRollupResolutionPositive
+	}
+	if len(r.aggregations) == 0 {
+		return errRollupAggregationsMissing
+	}
+	seen := make(map[RollupAggregation]struct{}, len(r.aggregations))
+	for _, agg := range r.aggregations {
+		if agg <= RollupAggregationUnknown || agg > RollupAggregationStdev {
+			return fmt.Errorf("invalid rollup aggregation: %d", int(agg))
+		}
+		if _, ok := seen[agg]; ok {
+			return fmt.Errorf("duplicate rollup aggregation: %s", agg.String())
+		}
+		seen[agg] = struct{}{}
+	}
+	return nil
+}
+
+func (r *rollupOpts) Equal(value RollupOptions) bool {
+	if r.Enabled() != value.Enabled() ||
+		r.Resolution() != value.Resolution() {
+		return false
+	}
+	source, otherSource := r.SourceNamespace(), value.SourceNamespace()
+	if (source == nil) != (otherSource == nil) {
+		return false
+	}
+	if source != nil && !source.Equal(otherSource) {
+		return false
+	}
+	aggs, otherAggs := r.Aggregations(), value.Aggregations()
+	if len(aggs) != len(otherAggs) {
+		return false
+	}
+	for i := range aggs {
+		if aggs[i] != otherAggs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *rollupOpts) SetEnabled(value bool) RollupOptions {
+	ro := *r
+	ro.enabled = value
+	return &ro
+}
+
+func (r *rollupOpts) Enabled() bool {
+	return r.enabled
+}
+
+func (r *rollupOpts) SetSourceNamespace(value ident.ID) RollupOptions {
+	ro := *r
+	ro.sourceNamespace = value
+	return &ro
+}
+
+func (r *rollupOpts) SourceNamespace() ident.ID {
+	return r.sourceNamespace
+}
+
+func (r *rollupOpts) SetResolution(value time.Duration) RollupOptions {
+	ro := *r
+	ro.resolution = value
+	return &ro
+}
+
+func (r *rollupOpts) Resolution() time.Duration {
+	return r.resolution
+}
+
+func (r *rollupOpts) SetAggregations(value []RollupAggregation) RollupOptions {
+	ro := *r
+	ro.aggregations = append([]RollupAggregation(nil), value...)
+	return &ro
+}
+
+func (r *rollupOpts) Aggregations() []RollupAggregation {
+	return r.aggregations
+}
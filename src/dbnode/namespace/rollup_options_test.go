@@ -0,0 +1,77 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+func validRollupOptions() RollupOptions {
+	return NewRollupOptions().
+		SetEnabled(true).
+		SetSourceNamespace(ident.StringID("raw")).
+		SetResolution(time.Minute).
+		SetAggregations([]RollupAggregation{RollupAggregationMean, RollupAggregationMax})
+}
+
+func TestRollupOptionsEqual(t *testing.T) {
+	opts := validRollupOptions()
+	require.True(t, opts.Equal(validRollupOptions()))
+	require.False(t, opts.Equal(opts.SetEnabled(false)))
+	require.False(t, opts.Equal(opts.SetSourceNamespace(ident.StringID("other"))))
+	require.False(t, opts.Equal(opts.SetResolution(time.Hour)))
+	require.False(t, opts.Equal(opts.SetAggregations([]RollupAggregation{RollupAggregationMean})))
+	require.False(t, opts.Equal(NewRollupOptions()))
+}
+
+func TestRollupOptionsValidate(t *testing.T) {
+	require.NoError(t, validRollupOptions().Validate())
+	require.Error(t, validRollupOptions().SetSourceNamespace(nil).Validate())
+	require.Error(t, validRollupOptions().SetResolution(0).Validate())
+	require.Error(t, validRollupOptions().SetAggregations(nil).Validate())
+	require.Error(t, validRollupOptions().SetAggregations(
+		[]RollupAggregation{RollupAggregationMax, RollupAggregationMax}).Validate())
+	require.Error(t, validRollupOptions().SetAggregations(
+		[]RollupAggregation{RollupAggregationUnknown}).Validate())
+}
+
+func TestRollupAggregationParse(t *testing.T) {
+	for _, agg := range ValidRollupAggregations() {
+		parsed, err := ParseRollupAggregation(agg.String())
+		require.NoError(t, err)
+		require.Equal(t, agg, parsed)
+	}
+	_, err := ParseRollupAggregation("p99")
+	require.Error(t, err)
+}
+
+func TestOptionsValidateRollupResolution(t *testing.T) {
+	opts := NewOptions().SetRollupOptions(validRollupOptions())
+	require.NoError(t, opts.Validate())
+
+	opts = opts.SetRollupOptions(validRollupOptions().SetResolution(7 * time.Minute))
+	require.Equal(t, errDataBlockSizeMustBeAMultipleOfRollupRes, opts.Validate())
+}
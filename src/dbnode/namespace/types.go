@@ -92,6 +92,12 @@ type Options interface {
 	// IndexOptions returns the IndexOptions.
 	IndexOptions() IndexOptions
 
+	// SetRollupOptions sets the RollupOptions.
+	SetRollupOptions(value RollupOptions) Options
+
+	// RollupOptions returns the RollupOptions.
+	RollupOptions() RollupOptions
+
 	// SetSchemaHistory sets the schema registry for this namespace.
 	SetSchemaHistory(value SchemaHistory) Options
 
@@ -117,6 +123,40 @@ type IndexOptions interface {
 	BlockSize() time.Duration
 }
 
+// RollupOptions controls how a namespace is populated by downsampling
+// the flushed blocks of another namespace.
+type RollupOptions interface {
+	// Validate validates the options.
+	Validate() error
+
+	// Equal returns true if the provide value is equal to this one.
+	Equal(value RollupOptions) bool
+
+	// SetEnabled sets whether the namespace is populated by rollup.
+	SetEnabled(value bool) RollupOptions
+
+	// Enabled returns whether the namespace is populated by rollup.
+	Enabled() bool
+
+	// SetSourceNamespace sets the namespace whose flushed blocks are rolled up.
+	SetSourceNamespace(value ident.ID) RollupOptions
+
+	// SourceNamespace returns the namespace whose flushed blocks are rolled up.
+	SourceNamespace() ident.ID
+
+	// SetResolution sets the resolution of the rolled up series.
+	SetResolution(value time.Duration) RollupOptions
+
+	// Resolution returns the resolution of the rolled up series.
+	Resolution() time.Duration
+
+	// SetAggregations sets the aggregations computed for each resolution bucket.
+	SetAggregations(value []RollupAggregation) RollupOptions
+
+	// Aggregations returns the aggregations computed for each resolution bucket.
+	Aggregations() []RollupAggregation
+}
+
 // SchemaDescr describes the schema for a complex type value.
 type SchemaDescr interface {
 	// DeployId returns the deploy id of the schema.
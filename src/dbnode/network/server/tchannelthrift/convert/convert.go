@@ -28,7 +28,10 @@ import (
 	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
 	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
@@ -187,6 +190,10 @@ func ToRPCError(err error) *rpc.Error {
 	if err == nil {
 		return nil
 	}
+	if limits.IsQueryLimitExceededError(err) ||
+		dberrors.IsSeriesQuotaExceededError(err) {
+		return tterrors.NewResourceExhaustedError(err)
+	}
 	if xerrors.IsInvalidParams(err) {
 		return tterrors.NewBadRequestError(err)
 	}
@@ -418,6 +425,172 @@ func ToRPCAggregateQueryRawRequest(
 	return request, nil
 }
 
+var (
+	temporalFunctionsToRPC = map[seriesagg.TemporalFunction]rpc.TemporalFunctionType{
+		seriesagg.Rate:          rpc.TemporalFunctionType_RATE,
+		seriesagg.Increase:      rpc.TemporalFunctionType_INCREASE,
+		seriesagg.Delta:         rpc.TemporalFunctionType_DELTA,
+		seriesagg.AvgOverTime:   rpc.TemporalFunctionType_AVG_OVER_TIME,
+		seriesagg.CountOverTime: rpc.TemporalFunctionType_COUNT_OVER_TIME,
+		seriesagg.MinOverTime:   rpc.TemporalFunctionType_MIN_OVER_TIME,
+		seriesagg.MaxOverTime:   rpc.TemporalFunctionType_MAX_OVER_TIME,
+		seriesagg.SumOverTime:   rpc.TemporalFunctionType_SUM_OVER_TIME,
+	}
+	aggregationsToRPC = map[seriesagg.Aggregation]rpc.SeriesAggregationType{
+		seriesagg.Sum:   rpc.SeriesAggregationType_SUM,
+		seriesagg.Min:   rpc.SeriesAggregationType_MIN,
+		seriesagg.Max:   rpc.SeriesAggregationType_MAX,
+		seriesagg.Count: rpc.SeriesAggregationType_COUNT,
+	}
+)
+
+// FromRPCAggregateSeriesRawRequest converts an rpc AggregateSeriesRawRequest
+// into the namespace, index query, query options and aggregation options.
+func FromRPCAggregateSeriesRawRequest(
+	req *rpc.AggregateSeriesRawRequest,
+	pools FetchTaggedConversionPools,
+) (ident.ID, index.Query, index.QueryOptions, seriesagg.Options, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, fetchTaggedTimeType)
+	if rangeStartErr != nil {
+		return nil, index.Query{}, index.QueryOptions{}, seriesagg.Options{}, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, fetchTaggedTimeType)
+	if rangeEndErr != nil {
+		return nil, index.Query{}, index.QueryOptions{}, seriesagg.Options{}, rangeEndErr
+	}
+
+	// NB: the window of the first step starts the temporal duration before
+	// the first step, series with datapoints only within that window must be
+	// queried and read for the results to match the coordinator evaluating
+	// the temporal function.
+	opts := index.QueryOptions{
+		StartInclusive: start.Add(-time.Duration(req.TemporalDuration)),
+		EndExclusive:   end,
+	}
+	if l := req.Limit; l != nil {
+		opts.Limit = int(*l)
+	}
+
+	aggOpts := seriesagg.Options{
+		Start:   start,
+		End:     end,
+		Step:    time.Duration(req.StepDuration),
+		Range:   time.Duration(req.TemporalDuration),
+		GroupBy: req.GroupByTags,
+	}
+	for fn, rpcFn := range temporalFunctionsToRPC {
+		if rpcFn == req.TemporalFunction {
+			aggOpts.TemporalFunction = fn
+		}
+	}
+	for agg, rpcAgg := range aggregationsToRPC {
+		if rpcAgg == req.Aggregation {
+			aggOpts.Aggregation = agg
+		}
+	}
+	if err := aggOpts.Validate(); err != nil {
+		return nil, index.Query{}, index.QueryOptions{}, seriesagg.Options{}, err
+	}
+
+	query, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, index.QueryOptions{}, seriesagg.Options{}, err
+	}
+
+	var ns ident.ID
+	if pools != nil {
+		nsBytes := pools.CheckedBytesWrapper().Get(req.NameSpace)
+		ns = pools.ID().BinaryID(nsBytes)
+	} else {
+		ns = ident.StringID(string(req.NameSpace))
+	}
+	return ns, index.Query{Query: query}, opts, aggOpts, nil
+}
+
+// ToRPCAggregateSeriesRawRequest converts the namespace, index query and
+// aggregation options into an rpc AggregateSeriesRawRequest.
+func ToRPCAggregateSeriesRawRequest(
+	ns ident.ID,
+	q index.Query,
+	opts seriesagg.Options,
+) (rpc.AggregateSeriesRawRequest, error) {
+	if err := opts.Validate(); err != nil {
+		return rpc.AggregateSeriesRawRequest{}, err
+	}
+
+	rangeStart, tsErr := ToValue(opts.Start, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.AggregateSeriesRawRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(opts.End, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.AggregateSeriesRawRequest{}, tsErr
+	}
+
+	query, queryErr := idx.Marshal(q.Query)
+	if queryErr != nil {
+		return rpc.AggregateSeriesRawRequest{}, queryErr
+	}
+
+	groupBy := make([][]byte, 0, len(opts.GroupBy))
+	for _, tag := range opts.GroupBy {
+		copied := append([]byte(nil), tag...)
+		groupBy = append(groupBy, copied)
+	}
+
+	return rpc.AggregateSeriesRawRequest{
+		Query:            query,
+		RangeStart:       rangeStart,
+		RangeEnd:         rangeEnd,
+		NameSpace:        ns.Bytes(),
+		StepDuration:     int64(opts.Step),
+		TemporalDuration: int64(opts.Range),
+		TemporalFunction: temporalFunctionsToRPC[opts.TemporalFunction],
+		Aggregation:      aggregationsToRPC[opts.Aggregation],
+		GroupByTags:      groupBy,
+	}, nil
+}
+
+// ToRPCAggregateSeriesRawResultGroups converts aggregated groups into rpc
+// result groups.
+func ToRPCAggregateSeriesRawResultGroups(
+	groups []seriesagg.Group,
+) []*rpc.AggregateSeriesRawResultGroup {
+	result := make([]*rpc.AggregateSeriesRawResultGroup, 0, len(groups))
+	for _, group := range groups {
+		tags := make([]*rpc.Tag, 0, len(group.Tags))
+		for _, tag := range group.Tags {
+			tags = append(tags, &rpc.Tag{Name: tag.Name, Value: tag.Value})
+		}
+		result = append(result, &rpc.AggregateSeriesRawResultGroup{
+			Tags:   tags,
+			Values: group.Values,
+		})
+	}
+	return result
+}
+
+// FromRPCAggregateSeriesRawResultGroups converts rpc result groups into
+// aggregated groups.
+func FromRPCAggregateSeriesRawResultGroups(
+	groups []*rpc.AggregateSeriesRawResultGroup,
+) []seriesagg.Group {
+	result := make([]seriesagg.Group, 0, len(groups))
+	for _, group := range groups {
+		tags := make([]seriesagg.Tag, 0, len(group.Tags))
+		for _, tag := range group.Tags {
+			tags = append(tags, seriesagg.Tag{Name: tag.Name, Value: tag.Value})
+		}
+		result = append(result, seriesagg.Group{
+			Tags:   tags,
+			Values: group.Values,
+		})
+	}
+	return result
+}
+
 // ToTagsIter returns a tag iterator over the given request.
 func ToTagsIter(r *rpc.WriteTaggedRequest) (ident.TagIterator, error) {
 	if r == nil {
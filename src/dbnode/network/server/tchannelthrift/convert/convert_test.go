@@ -27,9 +27,14 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
 	"github.com/m3db/m3/src/m3ninx/idx"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
 
@@ -240,6 +245,84 @@ func TestConvertAggregateRawQueryRequest(t *testing.T) {
 	}
 }
 
+func TestConvertAggregateSeriesRawRequest(t *testing.T) {
+	ns := ident.StringID("abc")
+	end := time.Now().Truncate(time.Minute)
+	opts := seriesagg.Options{
+		Start:            end.Add(-time.Hour),
+		End:              end,
+		Step:             time.Minute,
+		Range:            5 * time.Minute,
+		TemporalFunction: seriesagg.Rate,
+		Aggregation:      seriesagg.Sum,
+		GroupBy:          [][]byte{[]byte("region")},
+	}
+
+	q, rpcQ := termQueryTestCase(t)
+	expectedReq := rpc.AggregateSeriesRawRequest{
+		Query:            rpcQ,
+		NameSpace:        ns.Bytes(),
+		RangeStart:       mustToRpcTime(t, opts.Start),
+		RangeEnd:         mustToRpcTime(t, opts.End),
+		StepDuration:     int64(time.Minute),
+		TemporalDuration: int64(5 * time.Minute),
+		TemporalFunction: rpc.TemporalFunctionType_RATE,
+		Aggregation:      rpc.SeriesAggregationType_SUM,
+		GroupByTags:      [][]byte{[]byte("region")},
+	}
+
+	observedReq, err := convert.ToRPCAggregateSeriesRawRequest(ns, index.Query{Query: q}, opts)
+	require.NoError(t, err)
+	require.Equal(t, expectedReq, observedReq)
+
+	for _, pools := range []struct {
+		name string
+		pool convert.FetchTaggedConversionPools
+	}{
+		{"nil pools", nil},
+		{"valid pools", newTestPools()},
+	} {
+		t.Run(pools.name, func(t *testing.T) {
+			id, observedQuery, observedQueryOpts, observedOpts, err :=
+				convert.FromRPCAggregateSeriesRawRequest(&observedReq, pools.pool)
+			require.NoError(t, err)
+			require.Equal(t, ns.String(), id.String())
+			require.True(t, index.NewQueryMatcher(index.Query{Query: q}).Matches(observedQuery))
+			require.True(t, opts.Start.Add(-opts.Range).Equal(observedQueryOpts.StartInclusive))
+			require.True(t, opts.End.Equal(observedQueryOpts.EndExclusive))
+			require.True(t, opts.Start.Equal(observedOpts.Start))
+			require.True(t, opts.End.Equal(observedOpts.End))
+			require.Equal(t, opts.Step, observedOpts.Step)
+			require.Equal(t, opts.Range, observedOpts.Range)
+			require.Equal(t, opts.TemporalFunction, observedOpts.TemporalFunction)
+			require.Equal(t, opts.Aggregation, observedOpts.Aggregation)
+			require.Equal(t, opts.GroupBy, observedOpts.GroupBy)
+		})
+	}
+
+	observedReq.TemporalFunction = rpc.TemporalFunctionType(-1)
+	_, _, _, _, err = convert.FromRPCAggregateSeriesRawRequest(&observedReq, nil)
+	require.Error(t, err)
+}
+
+func TestConvertAggregateSeriesRawResultGroups(t *testing.T) {
+	groups := []seriesagg.Group{
+		{
+			Tags:   []seriesagg.Tag{{Name: "region", Value: "us"}},
+			Values: []float64{1, 2},
+		},
+		{
+			Tags:   []seriesagg.Tag{},
+			Values: []float64{3},
+		},
+	}
+
+	rpcGroups := convert.ToRPCAggregateSeriesRawResultGroups(groups)
+	require.Len(t, rpcGroups, 2)
+	require.Equal(t, []*rpc.Tag{{Name: "region", Value: "us"}}, rpcGroups[0].Tags)
+	require.Equal(t, groups, convert.FromRPCAggregateSeriesRawResultGroups(rpcGroups))
+}
+
 type testPools struct {
 	id      ident.Pool
 	wrapper xpool.CheckedBytesWrapperPool
@@ -261,3 +344,24 @@ var _ convert.FetchTaggedConversionPools = &testPools{}
 
 func (t *testPools) ID() ident.Pool                                     { return t.id }
 func (t *testPools) CheckedBytesWrapper() xpool.CheckedBytesWrapperPool { return t.wrapper }
+
+func TestToRPCError(t *testing.T) {
+	assert.Nil(t, convert.ToRPCError(nil))
+
+	rpcErr := convert.ToRPCError(fmt.Errorf("internal"))
+	assert.True(t, tterrors.IsInternalError(rpcErr))
+	assert.False(t, tterrors.IsResourceExhaustedErrorFlag(rpcErr))
+
+	rpcErr = convert.ToRPCError(xerrors.NewInvalidParamsError(fmt.Errorf("bad")))
+	assert.True(t, tterrors.IsBadRequestError(rpcErr))
+	assert.False(t, tterrors.IsResourceExhaustedErrorFlag(rpcErr))
+
+	rpcErr = convert.ToRPCError(limits.NewQueryLimitExceededError("exceeded"))
+	assert.True(t, tterrors.IsBadRequestError(rpcErr))
+	assert.True(t, tterrors.IsResourceExhaustedErrorFlag(rpcErr))
+	assert.Equal(t, "exceeded", rpcErr.Message)
+
+	rpcErr = convert.ToRPCError(dberrors.NewSeriesQuotaExceededError("ns", 10))
+	assert.True(t, tterrors.IsBadRequestError(rpcErr))
+	assert.True(t, tterrors.IsResourceExhaustedErrorFlag(rpcErr))
+}
@@ -43,6 +43,13 @@ func IsBadRequestError(err *rpc.Error) bool {
 	return err != nil && err.Type == rpc.ErrorType_BAD_REQUEST
 }
 
+// IsResourceExhaustedErrorFlag returns whether the error has the resource
+// exhausted flag set
+func IsResourceExhaustedErrorFlag(err *rpc.Error) bool {
+	return err != nil &&
+		err.Flags&int64(rpc.ErrorFlags_RESOURCE_EXHAUSTED) != 0
+}
+
 // NewInternalError creates a new internal error
 func NewInternalError(err error) *rpc.Error {
 	return newError(rpc.ErrorType_INTERNAL_ERROR, err)
@@ -53,6 +60,15 @@ func NewBadRequestError(err error) *rpc.Error {
 	return newError(rpc.ErrorType_BAD_REQUEST, err)
 }
 
+// NewResourceExhaustedError creates a new bad request error with the resource
+// exhausted flag set, since retrying the request will not succeed until
+// resources are freed
+func NewResourceExhaustedError(err error) *rpc.Error {
+	rpcErr := newError(rpc.ErrorType_BAD_REQUEST, err)
+	rpcErr.Flags |= int64(rpc.ErrorFlags_RESOURCE_EXHAUSTED)
+	return rpcErr
+}
+
 // NewWriteBatchRawError creates a new write batch error
 func NewWriteBatchRawError(index int, err error) *rpc.WriteBatchRawError {
 	batchErr := rpc.NewWriteBatchRawError()
@@ -61,6 +77,15 @@ func NewWriteBatchRawError(index int, err error) *rpc.WriteBatchRawError {
 	return batchErr
 }
 
+// NewResourceExhaustedWriteBatchRawError creates a new bad request write batch
+// error with the resource exhausted flag set
+func NewResourceExhaustedWriteBatchRawError(index int, err error) *rpc.WriteBatchRawError {
+	batchErr := rpc.NewWriteBatchRawError()
+	batchErr.Index = int64(index)
+	batchErr.Err = NewResourceExhaustedError(err)
+	return batchErr
+}
+
 // NewBadRequestWriteBatchRawError creates a new bad request write batch error
 func NewBadRequestWriteBatchRawError(index int, err error) *rpc.WriteBatchRawError {
 	batchErr := rpc.NewWriteBatchRawError()
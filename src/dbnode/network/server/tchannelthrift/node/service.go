@@ -33,9 +33,15 @@ import (
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xio"
@@ -70,6 +76,9 @@ const (
 	maxSegmentArrayPooledLength = 32
 	// Any pooled error slices that grow beyond this capcity will be thrown away.
 	writeBatchPooledReqPoolMaxErrorsSliceSize = 4096
+	// defaultBackupSnapshotTimeout is how long a backup waits for a snapshot
+	// when the request does not specify a timeout.
+	defaultBackupSnapshotTimeout = 10 * time.Minute
 )
 
 var (
@@ -97,12 +106,28 @@ var (
 
 	// errHealthNotSet is raised when server health data structure is not set.
 	errHealthNotSet = errors.New("server health not set")
+
+	// errBackupRequiresRootAndHost raised when a backup request is missing its root or host.
+	errBackupRequiresRootAndHost = errors.New("backup requires root and host")
+
+	// errRollupRequiresNamespace raised when a rollup request is missing its namespace.
+	errRollupRequiresNamespace = errors.New("rollup requires namespace")
 )
 
+// RollupRequest is a request to roll up the source namespace blocks in the
+// range [RangeStart, RangeEnd) into a namespace with rollup enabled.
+type RollupRequest struct {
+	NameSpace  string       `json:"nameSpace"`
+	RangeStart int64        `json:"rangeStart"`
+	RangeEnd   int64        `json:"rangeEnd"`
+	RangeType  rpc.TimeType `json:"rangeType"`
+}
+
 type serviceMetrics struct {
 	fetch                   instrument.MethodMetrics
 	fetchTagged             instrument.MethodMetrics
 	aggregate               instrument.MethodMetrics
+	aggregateSeries         instrument.MethodMetrics
 	write                   instrument.MethodMetrics
 	writeTagged             instrument.MethodMetrics
 	fetchBlocks             instrument.MethodMetrics
@@ -123,6 +148,7 @@ func newServiceMetrics(scope tally.Scope, samplingRate float64) serviceMetrics {
 		fetch:                   instrument.NewMethodMetrics(scope, "fetch", samplingRate),
 		fetchTagged:             instrument.NewMethodMetrics(scope, "fetchTagged", samplingRate),
 		aggregate:               instrument.NewMethodMetrics(scope, "aggregate", samplingRate),
+		aggregateSeries:         instrument.NewMethodMetrics(scope, "aggregateSeries", samplingRate),
 		write:                   instrument.NewMethodMetrics(scope, "write", samplingRate),
 		writeTagged:             instrument.NewMethodMetrics(scope, "writeTagged", samplingRate),
 		fetchBlocks:             instrument.NewMethodMetrics(scope, "fetchBlocks", samplingRate),
@@ -406,6 +432,86 @@ func (s *service) BootstrappedInPlacementOrNoPlacement(ctx thrift.Context) (*rpc
 	return &rpc.NodeBootstrappedInPlacementOrNoPlacementResult_{}, nil
 }
 
+// BootstrapProgress returns the progress of the current bootstrap, or of the
+// last completed bootstrap if the node is not bootstrapping. It is not part of
+// the thrift node service and is only served by the HTTP JSON node server.
+func (s *service) BootstrapProgress(ctx thrift.Context) (*bootstrap.Progress, error) {
+	db, ok := s.state.DB()
+	if !ok {
+		return nil, convert.ToRPCError(errDatabaseIsNotInitializedYet)
+	}
+
+	progress := db.Options().BootstrapProcessProvider().Progress()
+	return &progress, nil
+}
+
+// Backup requests a snapshot of the in-memory data of the node, waits for it
+// to complete and then copies the filesets of the shards it owns for the
+// requested namespaces to a backup. Like BootstrapProgress it is only served
+// by the HTTP JSON node server.
+func (s *service) Backup(ctx thrift.Context, req *backup.NodeBackupRequest) (*backup.NodeBackupResult, error) {
+	db, ok := s.state.DB()
+	if !ok {
+		return nil, convert.ToRPCError(errDatabaseIsNotInitializedYet)
+	}
+
+	if req.Root == "" || req.Host == "" {
+		return nil, tterrors.NewBadRequestError(errBackupRequiresRootAndHost)
+	}
+	for _, name := range req.Namespaces {
+		if _, ok := db.Namespace(ident.StringID(name)); !ok {
+			return nil, tterrors.NewBadRequestError(fmt.Errorf("unknown namespace: %s", name))
+		}
+	}
+
+	snapshotTimeout := defaultBackupSnapshotTimeout
+	if req.SnapshotTimeoutSeconds > 0 {
+		snapshotTimeout = time.Duration(req.SnapshotTimeoutSeconds) * time.Second
+	}
+	snapshotStartedAt, err := db.WaitForSnapshot(snapshotTimeout)
+	if err != nil {
+		return nil, convert.ToRPCError(err)
+	}
+
+	var (
+		fsOpts = db.Options().CommitLogOptions().FilesystemOptions()
+		shards = db.ShardSet().AllIDs()
+		result = &backup.NodeBackupResult{SnapshotStartedAt: snapshotStartedAt}
+	)
+	for _, name := range req.Namespaces {
+		nsResult, err := backup.CopyNamespace(fsOpts, req.Root, req.Host,
+			ident.StringID(name), shards)
+		if err != nil {
+			return nil, convert.ToRPCError(err)
+		}
+		result.Namespaces = append(result.Namespaces, nsResult)
+	}
+
+	return result, nil
+}
+
+func (s *service) Rollup(ctx thrift.Context, req *RollupRequest) error {
+	db, ok := s.state.DB()
+	if !ok {
+		return convert.ToRPCError(errDatabaseIsNotInitializedYet)
+	}
+
+	if req.NameSpace == "" {
+		return tterrors.NewBadRequestError(errRollupRequiresNamespace)
+	}
+
+	start, rangeStartErr := convert.ToTime(req.RangeStart, req.RangeType)
+	end, rangeEndErr := convert.ToTime(req.RangeEnd, req.RangeType)
+	if rangeStartErr != nil || rangeEndErr != nil {
+		return tterrors.NewBadRequestError(xerrors.FirstError(rangeStartErr, rangeEndErr))
+	}
+
+	if err := db.Rollup(ident.StringID(req.NameSpace), start, end); err != nil {
+		return convert.ToRPCError(err)
+	}
+	return nil
+}
+
 func (s *service) Query(tctx thrift.Context, req *rpc.QueryRequest) (*rpc.QueryResult_, error) {
 	db, err := s.startReadRPCWithDB()
 	if err != nil {
@@ -631,7 +737,12 @@ func (s *service) fetchTagged(ctx context.Context, db storage.Database, req *rpc
 	// NB(r): Step 1 if reading data then read using an asychronuous block reader,
 	// but don't serialize yet so that all block reader requests can
 	// be issued at once before waiting for their results.
-	var encodedDataResults [][][]xio.BlockReader
+	var (
+		encodedDataResults [][][]xio.BlockReader
+		queryLimits        = db.Options().QueryLimits()
+		seriesReadTracker  = limits.NewQueryTracker(queryLimits.SeriesReadLimit())
+		bytesReadTracker   = limits.NewQueryTracker(queryLimits.BytesReadLimit())
+	)
 	if fetchData {
 		encodedDataResults = make([][][]xio.BlockReader, results.Size())
 	}
@@ -661,6 +772,11 @@ func (s *service) fetchTagged(ctx context.Context, db storage.Database, req *rpc
 			continue
 		}
 
+		if err := seriesReadTracker.Inc(1); err != nil {
+			s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+			return nil, convert.ToRPCError(err)
+		}
+
 		encoded, err := db.ReadEncoded(ctx, nsID, tsID,
 			opts.StartInclusive, opts.EndExclusive)
 		if err != nil {
@@ -683,6 +799,11 @@ func (s *service) fetchTagged(ctx context.Context, db storage.Database, req *rpc
 				continue
 			}
 
+			if err := bytesReadTracker.Inc(segmentsLen(segments)); err != nil {
+				s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+				return nil, convert.ToRPCError(err)
+			}
+
 			response.Elements[idx].Segments = segments
 		}
 	}
@@ -779,6 +900,149 @@ func (s *service) AggregateRaw(tctx thrift.Context, req *rpc.AggregateQueryRawRe
 	return response, nil
 }
 
+func (s *service) AggregateSeriesRaw(tctx thrift.Context, req *rpc.AggregateSeriesRawRequest) (*rpc.AggregateSeriesRawResult_, error) {
+	db, err := s.startReadRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+	defer s.readRPCCompleted()
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	ns, query, opts, aggOpts, err := convert.FromRPCAggregateSeriesRawRequest(req, s.pools)
+	if err != nil {
+		s.metrics.aggregateSeries.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	// NB: the caller selects a single replica for each shard so that series
+	// are only aggregated once across the cluster, skip series of shards
+	// that were not requested from this node. The series limit only applies
+	// to the series of the requested shards, so it is enforced after
+	// filtering, the index query is still bounded by the limit scaled to
+	// the shards owned by this node.
+	var (
+		shards   map[uint32]struct{}
+		shardSet sharding.ShardSet
+		limit    int
+	)
+	if req.Shards != nil {
+		shards = make(map[uint32]struct{}, len(req.Shards))
+		for _, shard := range req.Shards {
+			shards[uint32(shard)] = struct{}{}
+		}
+		shardSet = db.ShardSet()
+		limit = opts.Limit
+		opts.Limit = scaleShardsLimit(limit, len(shardSet.AllIDs()), len(shards))
+	}
+
+	queryResult, err := db.QueryIDs(ctx, ns, query, opts)
+	if err != nil {
+		s.metrics.aggregateSeries.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	var (
+		results           = queryResult.Results
+		nsID              = results.Namespace()
+		exhaustive        = queryResult.Exhaustive
+		accumulator       = seriesagg.NewAccumulator(aggOpts)
+		seriesReadTracker = limits.NewQueryTracker(db.Options().QueryLimits().SeriesReadLimit())
+		numSeries         int
+		datapoints        []ts.Datapoint
+	)
+	for _, entry := range results.Map().Iter() {
+		tsID := entry.Key()
+		if shards != nil {
+			if _, ok := shards[shardSet.Lookup(tsID)]; !ok {
+				continue
+			}
+		}
+
+		numSeries++
+		if limit > 0 && numSeries > limit {
+			exhaustive = false
+			break
+		}
+
+		if err := seriesReadTracker.Inc(1); err != nil {
+			s.metrics.aggregateSeries.ReportError(s.nowFn().Sub(callStart))
+			return nil, convert.ToRPCError(err)
+		}
+
+		datapoints, err = s.readSeriesDatapoints(ctx, db, nsID, tsID,
+			opts.StartInclusive, opts.EndExclusive, datapoints[:0])
+		if err != nil {
+			s.metrics.aggregateSeries.ReportError(s.nowFn().Sub(callStart))
+			return nil, convert.ToRPCError(err)
+		}
+
+		tags := entry.Value().Duplicate()
+		err = accumulator.Add(tags, datapoints)
+		tags.Close()
+		if err != nil {
+			s.metrics.aggregateSeries.ReportError(s.nowFn().Sub(callStart))
+			return nil, convert.ToRPCError(err)
+		}
+	}
+
+	s.metrics.aggregateSeries.ReportSuccess(s.nowFn().Sub(callStart))
+	return &rpc.AggregateSeriesRawResult_{
+		Groups:     convert.ToRPCAggregateSeriesRawResultGroups(accumulator.Groups()),
+		Exhaustive: exhaustive,
+	}, nil
+}
+
+// scaleShardsLimit scales a series limit that applies to the requested
+// shards to a limit for an index query across all the owned shards.
+func scaleShardsLimit(limit, numOwnedShards, numRequestedShards int) int {
+	if limit <= 0 || numRequestedShards == 0 ||
+		numOwnedShards <= numRequestedShards {
+		return limit
+	}
+	// Round up so the scaled limit is never lower than the series limit.
+	return (limit*numOwnedShards + numRequestedShards - 1) / numRequestedShards
+}
+
+// readSeriesDatapoints reads and decodes the datapoints of a series,
+// appending them to the provided slice.
+func (s *service) readSeriesDatapoints(
+	ctx context.Context,
+	db storage.Database,
+	nsID, tsID ident.ID,
+	start, end time.Time,
+	datapoints []ts.Datapoint,
+) ([]ts.Datapoint, error) {
+	encoded, err := db.ReadEncoded(ctx, nsID, tsID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	filteredBlockReaderSliceOfSlices, err := xio.FilterEmptyBlockReadersSliceOfSlicesInPlace(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	multiIt := db.Options().MultiReaderIteratorPool().Get()
+	nsCtx := namespace.NewContextFor(nsID, db.Options().SchemaRegistry())
+	multiIt.ResetSliceOfSlices(
+		xio.NewReaderSliceOfSlicesFromBlockReadersIterator(
+			filteredBlockReaderSliceOfSlices), nsCtx.Schema)
+	defer multiIt.Close()
+
+	for multiIt.Next() {
+		dp, _, _ := multiIt.Current()
+		datapoints = append(datapoints, dp)
+	}
+
+	if err := multiIt.Err(); err != nil {
+		return nil, err
+	}
+
+	return datapoints, nil
+}
+
 func (s *service) encodeTags(
 	enc serialize.TagEncoder,
 	tags ident.TagIterator,
@@ -2023,6 +2287,20 @@ func (s *service) readEncodedResult(
 	return segments, nil
 }
 
+// segmentsLen returns the number of encoded bytes in the segments.
+func segmentsLen(segments []*rpc.Segments) int {
+	n := 0
+	for _, s := range segments {
+		if s.Merged != nil {
+			n += len(s.Merged.Head) + len(s.Merged.Tail)
+		}
+		for _, segment := range s.Unmerged {
+			n += len(segment.Head) + len(segment.Tail)
+		}
+	}
+	return n
+}
+
 func (s *service) newTagsDecoder(ctx context.Context, encodedTags []byte) (serialize.TagDecoder, error) {
 	checkedBytes := s.pools.checkedBytesWrapper.Get(encodedTags)
 	dec := s.pools.tagDecoder.Get()
@@ -2192,6 +2470,14 @@ func (r *writeBatchPooledReq) HandleError(index int, err error) {
 		return
 	}
 
+	if dberrors.IsSeriesQuotaExceededError(err) {
+		r.nonRetryableErrors++
+		r.errs = append(
+			r.errs,
+			tterrors.NewResourceExhaustedWriteBatchRawError(index, err))
+		return
+	}
+
 	if xerrors.IsInvalidParams(err) {
 		r.nonRetryableErrors++
 		r.errs = append(
@@ -25,10 +25,12 @@ import (
 	gocontext "context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/m3db/m3/src/cluster/shard"
 	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/namespace"
@@ -36,9 +38,11 @@ import (
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
 	"github.com/m3db/m3/src/dbnode/runtime"
+	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -1784,6 +1788,72 @@ func TestServiceFetchTaggedNoData(t *testing.T) {
 	}
 }
 
+func TestServiceFetchTaggedSeriesReadLimitExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	queryLimits, err := limits.NewQueryLimits(limits.NewOptions().
+		SetSeriesReadLimitOptions(limits.LookbackLimitOptions{
+			PerQueryLimit: 1,
+		}))
+	require.NoError(t, err)
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().
+		Return(testStorageOpts.SetQueryLimits(queryLimits)).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	start, end = start.Truncate(time.Second), end.Truncate(time.Second)
+	nsID := "metrics"
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+
+	resMap := index.NewQueryResults(ident.StringID(nsID),
+		index.QueryResultsOptions{}, testIndexOptions)
+	resMap.Map().Set(ident.StringID("foo"), ident.NewTagsIterator(ident.Tags{}))
+	resMap.Map().Set(ident.StringID("bar"), ident.NewTagsIterator(ident.Tags{}))
+	mockDB.EXPECT().
+		QueryIDs(gomock.Any(), ident.NewIDMatcher(nsID), gomock.Any(), gomock.Any()).
+		Return(index.QueryResult{Results: resMap, Exhaustive: true}, nil)
+
+	// Only the first series is read before the per query limit is exceeded.
+	mockDB.EXPECT().
+		ReadEncoded(gomock.Any(), ident.NewIDMatcher(nsID), gomock.Any(), start, end).
+		Return(nil, nil)
+
+	startNanos, err := convert.ToValue(start, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	endNanos, err := convert.ToValue(end, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	var limit int64 = 10
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	_, err = service.FetchTagged(tctx, &rpc.FetchTaggedRequest{
+		NameSpace:  []byte(nsID),
+		Query:      data,
+		RangeStart: startNanos,
+		RangeEnd:   endNanos,
+		FetchData:  true,
+		Limit:      &limit,
+	})
+	require.Error(t, err)
+
+	rpcErr, ok := err.(*rpc.Error)
+	require.True(t, ok)
+	assert.True(t, tterrors.IsBadRequestError(rpcErr))
+	assert.True(t, tterrors.IsResourceExhaustedErrorFlag(rpcErr))
+}
+
 func TestServiceFetchTaggedErrs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1920,6 +1990,153 @@ func TestServiceAggregate(t *testing.T) {
 	require.Equal(t, 0, len(r.Results[1].TagValues))
 }
 
+func TestServiceAggregateSeriesRaw(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	shardSet, err := sharding.NewShardSet(
+		sharding.NewShards([]uint32{0, 1}, shard.Available),
+		func(id ident.ID) uint32 {
+			if id.String() == "qux" {
+				return 1
+			}
+			return 0
+		})
+	require.NoError(t, err)
+	mockDB.EXPECT().ShardSet().Return(shardSet)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Minute)
+	end := start.Add(time.Minute)
+	// The window of the first step starts the temporal duration before it.
+	queryStart := start.Add(-30 * time.Second)
+	nsID := "metrics"
+
+	series := map[string][]struct {
+		t time.Time
+		v float64
+	}{
+		"foo": {
+			{start.Add(10 * time.Second), 1.0},
+			{start.Add(20 * time.Second), 2.0},
+		},
+		"bar": {
+			{start.Add(20 * time.Second), 3.0},
+			{start.Add(30 * time.Second), 4.0},
+		},
+		"baz": {
+			{start.Add(10 * time.Second), 5.0},
+		},
+	}
+	for id, s := range series {
+		enc := testStorageOpts.EncoderPool().Get()
+		enc.Reset(start, 0, nil)
+		for _, v := range s {
+			dp := ts.Datapoint{
+				Timestamp: v.t,
+				Value:     v.v,
+			}
+			require.NoError(t, enc.Encode(dp, xtime.Second, nil))
+		}
+
+		stream, _ := enc.Stream(ctx)
+		mockDB.EXPECT().
+			ReadEncoded(gomock.Any(), ident.NewIDMatcher(nsID), ident.NewIDMatcher(id), queryStart, end).
+			Return([][]xio.BlockReader{{
+				xio.BlockReader{
+					SegmentReader: stream,
+				},
+			}}, nil)
+	}
+
+	req, err := idx.NewRegexpQuery([]byte("__name__"), []byte("requests"))
+	require.NoError(t, err)
+	qry := index.Query{Query: req}
+
+	resMap := index.NewQueryResults(ident.StringID(nsID),
+		index.QueryResultsOptions{}, testIndexOptions)
+	resMap.Map().Set(ident.StringID("foo"), ident.NewTagsIterator(ident.NewTags(
+		ident.StringTag("__name__", "requests"),
+		ident.StringTag("region", "us"),
+		ident.StringTag("host", "a"),
+	)))
+	resMap.Map().Set(ident.StringID("bar"), ident.NewTagsIterator(ident.NewTags(
+		ident.StringTag("__name__", "requests"),
+		ident.StringTag("region", "us"),
+		ident.StringTag("host", "b"),
+	)))
+	resMap.Map().Set(ident.StringID("baz"), ident.NewTagsIterator(ident.NewTags(
+		ident.StringTag("__name__", "requests"),
+		ident.StringTag("region", "eu"),
+		ident.StringTag("host", "c"),
+	)))
+	resMap.Map().Set(ident.StringID("qux"), ident.NewTagsIterator(ident.NewTags(
+		ident.StringTag("__name__", "requests"),
+		ident.StringTag("region", "eu"),
+		ident.StringTag("host", "d"),
+	)))
+
+	mockDB.EXPECT().QueryIDs(
+		gomock.Any(),
+		ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(qry),
+		index.QueryOptions{
+			StartInclusive: queryStart,
+			EndExclusive:   end,
+			// The limit is scaled to the two shards owned by the node.
+			Limit: 6,
+		}).Return(index.QueryResult{Results: resMap, Exhaustive: true}, nil)
+
+	startNanos, err := convert.ToValue(start, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	endNanos, err := convert.ToValue(end, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	// The limit applies to the series of the requested shards only, the
+	// series of other shards returned by the index query do not count
+	// towards it.
+	limit := int64(3)
+	r, err := service.AggregateSeriesRaw(tctx, &rpc.AggregateSeriesRawRequest{
+		NameSpace:        []byte(nsID),
+		Query:            data,
+		RangeStart:       startNanos,
+		RangeEnd:         endNanos,
+		StepDuration:     int64(30 * time.Second),
+		TemporalDuration: int64(30 * time.Second),
+		TemporalFunction: rpc.TemporalFunctionType_SUM_OVER_TIME,
+		Aggregation:      rpc.SeriesAggregationType_SUM,
+		GroupByTags:      [][]byte{[]byte("region")},
+		Shards:           []int32{0},
+		Limit:            &limit,
+	})
+	require.NoError(t, err)
+	require.True(t, r.Exhaustive)
+
+	// sort to order results to make test deterministic.
+	sort.Slice(r.Groups, func(i, j int) bool {
+		return r.Groups[i].Tags[0].Value < r.Groups[j].Tags[0].Value
+	})
+	require.Equal(t, 2, len(r.Groups))
+	require.Equal(t, []*rpc.Tag{{Name: "region", Value: "eu"}}, r.Groups[0].Tags)
+	require.Equal(t, 2, len(r.Groups[0].Values))
+	require.True(t, math.IsNaN(r.Groups[0].Values[0]))
+	require.Equal(t, 5.0, r.Groups[0].Values[1])
+	require.Equal(t, []*rpc.Tag{{Name: "region", Value: "us"}}, r.Groups[1].Tags)
+	require.Equal(t, 2, len(r.Groups[1].Values))
+	require.True(t, math.IsNaN(r.Groups[1].Values[0]))
+	require.Equal(t, 10.0, r.Groups[1].Values[1])
+}
+
 func TestServiceWrite(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,263 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+const checkpointFileSuffix = "checkpoint"
+
+// CopyNamespace copies the latest complete fileset volume of every block of
+// the given shards of a namespace, as well as its index filesets, from the
+// filesystem the options point at into a directory named after the host
+// under the backup root. Blocks that have not been flushed yet are copied
+// from their latest snapshot. File paths in the returned manifest are
+// relative to the backup root.
+func CopyNamespace(
+	opts fs.Options,
+	root string,
+	host string,
+	namespace ident.ID,
+	shards []uint32,
+) (NamespaceManifest, error) {
+	result := NamespaceManifest{
+		Namespace: namespace.String(),
+		Shards:    make([]ShardManifest, 0, len(shards)),
+	}
+
+	for _, shard := range shards {
+		shardResult, err := copyShard(opts, root, host, namespace, shard)
+		if err != nil {
+			return NamespaceManifest{}, err
+		}
+		result.Shards = append(result.Shards, shardResult)
+	}
+
+	indexVolumes, err := copyIndex(opts, root, host, namespace)
+	if err != nil {
+		return NamespaceManifest{}, err
+	}
+	result.IndexVolumes = indexVolumes
+
+	return result, nil
+}
+
+func copyShard(
+	opts fs.Options,
+	root string,
+	host string,
+	namespace ident.ID,
+	shard uint32,
+) (ShardManifest, error) {
+	prefix := opts.FilePathPrefix()
+	flushed, err := fs.DataFiles(prefix, namespace, shard)
+	if err != nil {
+		return ShardManifest{}, err
+	}
+	snapshots, err := fs.SnapshotFiles(prefix, namespace, shard)
+	if err != nil {
+		return ShardManifest{}, err
+	}
+
+	result := ShardManifest{Shard: shard, Host: host}
+	flushedBlockStarts := make(map[int64]struct{})
+	for _, blockStart := range blockStarts(flushed) {
+		fileset, ok := flushed.LatestVolumeForBlock(blockStart)
+		if !ok {
+			continue
+		}
+		volume, err := copyVolume(opts, root, host, fileset, persist.FileSetFlushType)
+		if err != nil {
+			return ShardManifest{}, err
+		}
+		result.Volumes = append(result.Volumes, volume)
+		flushedBlockStarts[blockStart.UnixNano()] = struct{}{}
+	}
+	for _, blockStart := range blockStarts(snapshots) {
+		if _, ok := flushedBlockStarts[blockStart.UnixNano()]; ok {
+			continue
+		}
+		fileset, ok := snapshots.LatestVolumeForBlock(blockStart)
+		if !ok {
+			continue
+		}
+		volume, err := copyVolume(opts, root, host, fileset, persist.FileSetSnapshotType)
+		if err != nil {
+			return ShardManifest{}, err
+		}
+		result.Volumes = append(result.Volumes, volume)
+	}
+
+	sort.Slice(result.Volumes, func(i, j int) bool {
+		return result.Volumes[i].BlockStart.Before(result.Volumes[j].BlockStart)
+	})
+	return result, nil
+}
+
+func copyIndex(
+	opts fs.Options,
+	root string,
+	host string,
+	namespace ident.ID,
+) ([]IndexVolumeManifest, error) {
+	prefix := opts.FilePathPrefix()
+	filesets, err := fs.IndexFiles(prefix, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []IndexVolumeManifest
+	infoFiles := fs.ReadIndexInfoFiles(prefix, namespace, opts.InfoReaderBufferSize())
+	for _, infoFile := range infoFiles {
+		if err := infoFile.Err.Error(); err != nil {
+			return nil, fmt.Errorf("unable to read index info file %s: %v",
+				infoFile.Err.Filepath(), err)
+		}
+
+		for _, fileset := range filesets {
+			if !fileset.ID.BlockStart.Equal(infoFile.ID.BlockStart) ||
+				fileset.ID.VolumeIndex != infoFile.ID.VolumeIndex ||
+				!fileset.HasCompleteCheckpointFile() {
+				continue
+			}
+			files, err := copyFileSet(opts, root, host, fileset)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, IndexVolumeManifest{
+				Host:        host,
+				BlockStart:  fileset.ID.BlockStart,
+				VolumeIndex: fileset.ID.VolumeIndex,
+				Shards:      infoFile.Info.Shards,
+				Files:       files,
+			})
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func copyVolume(
+	opts fs.Options,
+	root string,
+	host string,
+	fileset fs.FileSetFile,
+	fileSetType persist.FileSetType,
+) (VolumeManifest, error) {
+	files, err := copyFileSet(opts, root, host, fileset)
+	if err != nil {
+		return VolumeManifest{}, err
+	}
+	return VolumeManifest{
+		BlockStart:  fileset.ID.BlockStart,
+		VolumeIndex: fileset.ID.VolumeIndex,
+		FileSetType: fileSetType.String(),
+		Files:       files,
+	}, nil
+}
+
+// copyFileSet copies the files of a fileset from the filesystem the options
+// point at to the same relative paths under the host's directory in the
+// backup root, returning the copied paths relative to the root. The checkpoint
+// file is copied last so that an interrupted copy is never read as complete.
+func copyFileSet(
+	opts fs.Options,
+	root string,
+	host string,
+	fileset fs.FileSetFile,
+) ([]string, error) {
+	srcs := append([]string(nil), fileset.AbsoluteFilepaths...)
+	sort.SliceStable(srcs, func(i, j int) bool {
+		return !isCheckpointFile(srcs[i]) && isCheckpointFile(srcs[j])
+	})
+
+	files := make([]string, 0, len(srcs))
+	for _, src := range srcs {
+		rel, err := filepath.Rel(opts.FilePathPrefix(), src)
+		if err != nil {
+			return nil, err
+		}
+		file := filepath.Join(host, rel)
+		if err := copyFile(opts, src, filepath.Join(root, file)); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func copyFile(opts fs.Options, src, dest string) error {
+	if err := os.MkdirAll(filepath.Dir(dest), opts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := fs.OpenWritable(dest, opts.NewFileMode())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return fmt.Errorf("unable to copy %s: %v", src, err)
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func isCheckpointFile(path string) bool {
+	return strings.Contains(filepath.Base(path), checkpointFileSuffix)
+}
+
+func blockStarts(filesets fs.FileSetFilesSlice) []time.Time {
+	var (
+		result []time.Time
+		seen   = make(map[int64]struct{}, len(filesets))
+	)
+	for _, fileset := range filesets {
+		key := fileset.ID.BlockStart.UnixNano()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, fileset.ID.BlockStart)
+	}
+	return result
+}
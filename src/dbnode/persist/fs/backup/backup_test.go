@@ -0,0 +1,195 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBlockSize = time.Hour
+
+type testFileSet struct {
+	shard       uint32
+	blockStart  time.Time
+	fileSetType persist.FileSetType
+	ids         []string
+}
+
+func writeTestFileSet(t *testing.T, opts fs.Options, namespace string, f testFileSet) {
+	w, err := fs.NewWriter(opts)
+	require.NoError(t, err)
+	require.NoError(t, w.Open(fs.DataWriterOpenOptions{
+		FileSetType: f.fileSetType,
+		BlockSize:   testBlockSize,
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  ident.StringID(namespace),
+			Shard:      f.shard,
+			BlockStart: f.blockStart,
+		},
+		Snapshot: fs.DataWriterSnapshotOptions{
+			SnapshotTime: f.blockStart,
+		},
+	}))
+	data := checked.NewBytes([]byte("somedata"), nil)
+	data.IncRef()
+	defer data.DecRef()
+	for _, id := range f.ids {
+		tags := ident.NewTags(ident.StringTag("name", id))
+		require.NoError(t, w.Write(ident.StringID(id), tags, data, 1234))
+	}
+	require.NoError(t, w.Close())
+}
+
+func readTestFileSet(
+	t *testing.T,
+	opts fs.Options,
+	namespace string,
+	shard uint32,
+	blockStart time.Time,
+) []string {
+	r, err := fs.NewReader(nil, opts)
+	require.NoError(t, err)
+	err = r.Open(fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  ident.StringID(namespace),
+			Shard:      shard,
+			BlockStart: blockStart,
+		},
+		FileSetType: persist.FileSetFlushType,
+	})
+	if err != nil {
+		return nil
+	}
+	defer r.Close()
+
+	var ids []string
+	for {
+		id, tags, _, _, err := r.Read()
+		if err == io.EOF {
+			return ids
+		}
+		require.NoError(t, err)
+		require.True(t, tags.Next())
+		assert.Equal(t, id.String(), tags.Current().Value.String())
+		ids = append(ids, id.String())
+	}
+}
+
+func TestBackupAndRestoreIntoNewPlacement(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		srcOpts = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "src"))
+		root    = filepath.Join(dir, "backup")
+		start   = time.Now().Truncate(testBlockSize).Add(-2 * testBlockSize)
+		next    = start.Add(testBlockSize)
+		ids     []string
+	)
+	for i := 0; i < 20; i++ {
+		ids = append(ids, fmt.Sprintf("series.%d", i))
+	}
+
+	for _, f := range []testFileSet{
+		{shard: 0, blockStart: start, fileSetType: persist.FileSetFlushType, ids: ids[:10]},
+		{shard: 1, blockStart: start, fileSetType: persist.FileSetFlushType, ids: ids[10:]},
+		// Unflushed block of shard 0 is only backed up from its snapshot.
+		{shard: 0, blockStart: next, fileSetType: persist.FileSetSnapshotType, ids: ids[:5]},
+		// Flushed block of shard 1 is backed up from its flush and not its snapshot.
+		{shard: 1, blockStart: next, fileSetType: persist.FileSetFlushType, ids: ids[10:15]},
+		{shard: 1, blockStart: next, fileSetType: persist.FileSetSnapshotType, ids: ids[10:12]},
+	} {
+		writeTestFileSet(t, srcOpts, "testns", f)
+	}
+
+	manifest, err := CopyNamespace(srcOpts, root, "host0", ident.StringID("testns"), []uint32{0, 1})
+	require.NoError(t, err)
+	require.Len(t, manifest.Shards, 2)
+
+	shard0 := manifest.Shards[0]
+	assert.Equal(t, "host0", shard0.Host)
+	require.Len(t, shard0.Volumes, 2)
+	assert.Equal(t, "flush", shard0.Volumes[0].FileSetType)
+	assert.Equal(t, "snapshot", shard0.Volumes[1].FileSetType)
+	for _, file := range shard0.Volumes[0].Files {
+		_, err := os.Stat(filepath.Join(root, file))
+		require.NoError(t, err)
+	}
+
+	shard1 := manifest.Shards[1]
+	require.Len(t, shard1.Volumes, 2)
+	assert.Equal(t, "flush", shard1.Volumes[1].FileSetType)
+
+	var (
+		numShards = 4
+		owners    = map[uint32][]string{
+			0: {"a", "b"},
+			1: {"b", "a"},
+			2: {"a", "b"},
+			3: {"b", "a"},
+		}
+		target = RestoreTarget{
+			Namespace:      "restored",
+			NumShards:      numShards,
+			Owners:         owners,
+			PathPrefix:     filepath.Join(dir, "restored"),
+			MaxOpenWriters: 3,
+		}
+	)
+	result, err := Restore(fs.NewOptions(), root, manifest, target)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), result.Series)
+
+	shardFn := sharding.DefaultHashFn(numShards)
+	for _, owner := range []string{"a", "b"} {
+		opts := fs.NewOptions().SetFilePathPrefix(filepath.Join(target.PathPrefix, owner))
+		for blockStart, expected := range map[time.Time][]string{
+			start: ids,
+			next:  append(append([]string(nil), ids[:5]...), ids[10:15]...),
+		} {
+			var restored []string
+			for shard := uint32(0); shard < uint32(numShards); shard++ {
+				shardIDs := readTestFileSet(t, opts, "restored", shard, blockStart)
+				for _, id := range shardIDs {
+					assert.Equal(t, shard, shardFn(ident.StringID(id)))
+				}
+				restored = append(restored, shardIDs...)
+			}
+			assert.ElementsMatch(t, expected, restored)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+const defaultMaxOpenWriters = 256
+
+var errInvalidNumShards = errors.New("number of shards must be positive")
+
+type sourceVolume struct {
+	host   string
+	shard  uint32
+	volume VolumeManifest
+}
+
+// Restore rewrites the backed up data filesets of a namespace into the
+// shards of the target placement, hashing every series into its shard in the
+// target placement. A fileset tree is written for every instance of the
+// target placement, and every block is written as a flushed volume regardless
+// of whether it was backed up from a snapshot. Index filesets are not
+// restored since they are built for a set of shards of the backed up
+// placement, the filesystem bootstrapper rebuilds and persists them from the
+// restored data filesets instead.
+func Restore(
+	opts fs.Options,
+	root string,
+	manifest NamespaceManifest,
+	target RestoreTarget,
+) (RestoreResult, error) {
+	if target.NumShards <= 0 {
+		return RestoreResult{}, errInvalidNumShards
+	}
+	for shard := 0; shard < target.NumShards; shard++ {
+		if len(target.Owners[uint32(shard)]) == 0 {
+			return RestoreResult{}, fmt.Errorf("no owners for shard %d", shard)
+		}
+	}
+	maxOpenWriters := target.MaxOpenWriters
+	if maxOpenWriters <= 0 {
+		maxOpenWriters = defaultMaxOpenWriters
+	}
+
+	var (
+		blockStarts []int64
+		byBlock     = make(map[int64][]sourceVolume)
+	)
+	for _, shard := range manifest.Shards {
+		for _, volume := range shard.Volumes {
+			key := volume.BlockStart.UnixNano()
+			if _, ok := byBlock[key]; !ok {
+				blockStarts = append(blockStarts, key)
+			}
+			byBlock[key] = append(byBlock[key], sourceVolume{
+				host:   shard.Host,
+				shard:  shard.Shard,
+				volume: volume,
+			})
+		}
+	}
+	sort.Slice(blockStarts, func(i, j int) bool {
+		return blockStarts[i] < blockStarts[j]
+	})
+
+	var (
+		result  RestoreResult
+		shardFn = sharding.DefaultHashFn(target.NumShards)
+	)
+	// Only a batch of the target shards is written at a time to bound the
+	// number of open files, at the cost of reading the backup once per batch.
+	for lo := 0; lo < target.NumShards; lo += maxOpenWriters {
+		hi := lo + maxOpenWriters
+		if hi > target.NumShards {
+			hi = target.NumShards
+		}
+		for _, blockStart := range blockStarts {
+			r := restorer{
+				opts:       opts,
+				root:       root,
+				namespace:  ident.StringID(manifest.Namespace),
+				target:     target,
+				shardFn:    shardFn,
+				blockStart: time.Unix(0, blockStart),
+				lo:         uint32(lo),
+				hi:         uint32(hi),
+				writers:    make(map[uint32]fs.DataFileSetWriter),
+			}
+			series, volumes, err := r.restore(byBlock[blockStart])
+			if err != nil {
+				return RestoreResult{}, err
+			}
+			result.Series += series
+			result.Volumes += volumes
+		}
+	}
+
+	return result, nil
+}
+
+// restorer restores a single block into the target shards in [lo, hi).
+type restorer struct {
+	opts       fs.Options
+	root       string
+	namespace  ident.ID
+	target     RestoreTarget
+	shardFn    sharding.HashFn
+	blockStart time.Time
+	lo, hi     uint32
+	writers    map[uint32]fs.DataFileSetWriter
+}
+
+func (r *restorer) restore(sources []sourceVolume) (int64, int, error) {
+	var series int64
+	for _, src := range sources {
+		// NB: writers are not closed on error since closing a writer marks
+		// its partially written fileset as complete.
+		n, err := r.restoreVolume(src)
+		if err != nil {
+			return 0, 0, err
+		}
+		series += n
+	}
+
+	if err := r.closeWriters(); err != nil {
+		return 0, 0, err
+	}
+
+	// Each shard was written for its first owner, copy it to the others.
+	for shard := range r.writers {
+		owners := r.target.Owners[shard]
+		primaryOpts := r.opts.SetFilePathPrefix(r.ownerPathPrefix(owners[0]))
+		fileset, ok, err := fs.FileSetAt(primaryOpts.FilePathPrefix(),
+			ident.StringID(r.target.Namespace), shard, r.blockStart, 0)
+		if err != nil {
+			return 0, 0, err
+		}
+		if !ok {
+			return 0, 0, fmt.Errorf("restored fileset missing: shard=%d, blockStart=%v",
+				shard, r.blockStart)
+		}
+		for _, owner := range owners[1:] {
+			if _, err := copyFileSet(primaryOpts, r.target.PathPrefix, owner, fileset); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+
+	return series, len(r.writers), nil
+}
+
+func (r *restorer) restoreVolume(src sourceVolume) (int64, error) {
+	fileSetType, err := parseFileSetType(src.volume.FileSetType)
+	if err != nil {
+		return 0, err
+	}
+
+	reader, err := fs.NewReader(nil, r.opts.SetFilePathPrefix(filepath.Join(r.root, src.host)))
+	if err != nil {
+		return 0, fmt.Errorf("unable to create fileset reader: %v", err)
+	}
+	err = reader.Open(fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   r.namespace,
+			Shard:       src.shard,
+			BlockStart:  src.volume.BlockStart,
+			VolumeIndex: src.volume.VolumeIndex,
+		},
+		FileSetType: fileSetType,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to open fileset: host=%s, shard=%d, blockStart=%v: %v",
+			src.host, src.shard, src.volume.BlockStart, err)
+	}
+	defer reader.Close()
+
+	var (
+		series    int64
+		blockSize = reader.Range().End.Sub(reader.Range().Start)
+	)
+	for {
+		id, tagsIter, data, checksum, err := reader.Read()
+		if err == io.EOF {
+			return series, nil
+		}
+		if err != nil {
+			return series, fmt.Errorf("unexpected error while reading data: %v", err)
+		}
+
+		shard := r.shardFn(id)
+		if shard < r.lo || shard >= r.hi {
+			tagsIter.Close()
+			data.Finalize()
+			continue
+		}
+
+		writer, err := r.writer(shard, blockSize)
+		if err != nil {
+			return series, err
+		}
+
+		// NB: tags reference the bytes of the ID where possible, both remain
+		// valid until the series has been written.
+		tags, err := convert.TagsFromTagsIter(id, tagsIter, nil)
+		tagsIter.Close()
+		if err != nil {
+			return series, err
+		}
+
+		data.IncRef()
+		err = writer.Write(id, tags, data, checksum)
+		data.DecRef()
+		data.Finalize()
+		if err != nil {
+			return series, fmt.Errorf("unexpected error while writing data: %v", err)
+		}
+		series++
+	}
+}
+
+func (r *restorer) writer(
+	shard uint32,
+	blockSize time.Duration,
+) (fs.DataFileSetWriter, error) {
+	if writer, ok := r.writers[shard]; ok {
+		return writer, nil
+	}
+
+	owner := r.target.Owners[shard][0]
+	writer, err := fs.NewWriter(r.opts.SetFilePathPrefix(r.ownerPathPrefix(owner)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create fileset writer: %v", err)
+	}
+	err = writer.Open(fs.DataWriterOpenOptions{
+		FileSetType: persist.FileSetFlushType,
+		BlockSize:   blockSize,
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  ident.StringID(r.target.Namespace),
+			Shard:      shard,
+			BlockStart: r.blockStart,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open fileset writer: %v", err)
+	}
+
+	r.writers[shard] = writer
+	return writer, nil
+}
+
+func (r *restorer) closeWriters() error {
+	var firstErr error
+	for _, writer := range r.writers {
+		if err := writer.Close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("unable to finalize writer: %v", err)
+		}
+	}
+	return firstErr
+}
+
+func (r *restorer) ownerPathPrefix(owner string) string {
+	return filepath.Join(r.target.PathPrefix, owner)
+}
+
+func parseFileSetType(value string) (persist.FileSetType, error) {
+	for _, t := range []persist.FileSetType{
+		persist.FileSetFlushType,
+		persist.FileSetSnapshotType,
+	} {
+		if t.String() == value {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown fileset type: %s", value)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package backup copies the filesets of a node to a backup destination and
+// restores backed up filesets into a cluster with a possibly different
+// placement.
+package backup
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// ManifestFileName is the name of the manifest file written to the root
+	// of a backup.
+	ManifestFileName = "manifest.json"
+)
+
+// Manifest describes a cluster-wide backup.
+type Manifest struct {
+	ID                string              `json:"id"`
+	CreatedAt         time.Time           `json:"createdAt"`
+	SnapshotStartedAt time.Time           `json:"snapshotStartedAt"`
+	Placement         json.RawMessage     `json:"placement,omitempty"`
+	Namespaces        []NamespaceManifest `json:"namespaces"`
+}
+
+// NamespaceManifest describes the backed up filesets of a namespace.
+type NamespaceManifest struct {
+	Namespace    string                `json:"namespace"`
+	Metadata     json.RawMessage       `json:"metadata,omitempty"`
+	Shards       []ShardManifest       `json:"shards"`
+	IndexVolumes []IndexVolumeManifest `json:"indexVolumes,omitempty"`
+}
+
+// ShardManifest describes the backed up fileset volumes of a shard, copied
+// from a single replica.
+type ShardManifest struct {
+	Shard   uint32           `json:"shard"`
+	Host    string           `json:"host"`
+	Volumes []VolumeManifest `json:"volumes"`
+}
+
+// VolumeManifest describes a backed up data fileset volume.
+type VolumeManifest struct {
+	BlockStart  time.Time `json:"blockStart"`
+	VolumeIndex int       `json:"volumeIndex"`
+	// FileSetType is either "flush" or "snapshot", snapshot volumes are only
+	// backed up for blocks that had not been flushed yet.
+	FileSetType string   `json:"fileSetType"`
+	Files       []string `json:"files"`
+}
+
+// IndexVolumeManifest describes a backed up index fileset volume.
+type IndexVolumeManifest struct {
+	Host        string    `json:"host"`
+	BlockStart  time.Time `json:"blockStart"`
+	VolumeIndex int       `json:"volumeIndex"`
+	Shards      []uint32  `json:"shards"`
+	Files       []string  `json:"files"`
+}
+
+// NodeBackupRequest is a request for a node to copy its filesets to a backup.
+type NodeBackupRequest struct {
+	// Root is the root directory of the backup, it must be reachable by the
+	// node, usually through a shared mount.
+	Root string `json:"root"`
+	// Host is the ID of the node in the placement, filesets are copied
+	// to a directory named after it under the root.
+	Host       string   `json:"host"`
+	Namespaces []string `json:"namespaces"`
+	// SnapshotTimeoutSeconds is how long to wait for the requested snapshot
+	// of the node's in-memory data before copying any filesets. The snapshot
+	// is taken once the current tick completes, so this should exceed the
+	// time a tick and a flush take on the node.
+	SnapshotTimeoutSeconds int64 `json:"snapshotTimeoutSeconds"`
+}
+
+// NodeBackupResult is the result of a node copying its filesets to a backup.
+type NodeBackupResult struct {
+	SnapshotStartedAt time.Time           `json:"snapshotStartedAt"`
+	Namespaces        []NamespaceManifest `json:"namespaces"`
+}
+
+// RestoreTarget describes the placement a namespace is restored into.
+type RestoreTarget struct {
+	// Namespace is the namespace to restore into, it may differ from the
+	// backed up namespace.
+	Namespace string
+	// NumShards is the number of shards of the target placement.
+	NumShards int
+	// Owners are the IDs of the instances that own each shard of the
+	// target placement.
+	Owners map[uint32][]string
+	// PathPrefix is the directory under which a fileset tree is written for
+	// each instance, in a directory named after its ID.
+	PathPrefix string
+	// MaxOpenWriters is the maximum number of shards written concurrently,
+	// the backup is read once per batch of shards.
+	MaxOpenWriters int
+}
+
+// RestoreResult is the result of restoring a namespace.
+type RestoreResult struct {
+	Series  int64 `json:"series"`
+	Volumes int   `json:"volumes"`
+}
@@ -184,6 +184,62 @@ func (mr *MockOptionsMockRecorder) WriteNewSeriesLimitPerShardPerSecond() *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteNewSeriesLimitPerShardPerSecond", reflect.TypeOf((*MockOptions)(nil).WriteNewSeriesLimitPerShardPerSecond))
 }
 
+// SetMaxActiveSeriesPerNamespacePerShard mocks base method
+func (m *MockOptions) SetMaxActiveSeriesPerNamespacePerShard(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxActiveSeriesPerNamespacePerShard", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetMaxActiveSeriesPerNamespacePerShard indicates an expected call of SetMaxActiveSeriesPerNamespacePerShard
+func (mr *MockOptionsMockRecorder) SetMaxActiveSeriesPerNamespacePerShard(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxActiveSeriesPerNamespacePerShard", reflect.TypeOf((*MockOptions)(nil).SetMaxActiveSeriesPerNamespacePerShard), value)
+}
+
+// MaxActiveSeriesPerNamespacePerShard mocks base method
+func (m *MockOptions) MaxActiveSeriesPerNamespacePerShard() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxActiveSeriesPerNamespacePerShard")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MaxActiveSeriesPerNamespacePerShard indicates an expected call of MaxActiveSeriesPerNamespacePerShard
+func (mr *MockOptionsMockRecorder) MaxActiveSeriesPerNamespacePerShard() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxActiveSeriesPerNamespacePerShard", reflect.TypeOf((*MockOptions)(nil).MaxActiveSeriesPerNamespacePerShard))
+}
+
+// SetMaxActiveSeriesPerNamespacePerNode mocks base method
+func (m *MockOptions) SetMaxActiveSeriesPerNamespacePerNode(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxActiveSeriesPerNamespacePerNode", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetMaxActiveSeriesPerNamespacePerNode indicates an expected call of SetMaxActiveSeriesPerNamespacePerNode
+func (mr *MockOptionsMockRecorder) SetMaxActiveSeriesPerNamespacePerNode(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxActiveSeriesPerNamespacePerNode", reflect.TypeOf((*MockOptions)(nil).SetMaxActiveSeriesPerNamespacePerNode), value)
+}
+
+// MaxActiveSeriesPerNamespacePerNode mocks base method
+func (m *MockOptions) MaxActiveSeriesPerNamespacePerNode() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxActiveSeriesPerNamespacePerNode")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MaxActiveSeriesPerNamespacePerNode indicates an expected call of MaxActiveSeriesPerNamespacePerNode
+func (mr *MockOptionsMockRecorder) MaxActiveSeriesPerNamespacePerNode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxActiveSeriesPerNamespacePerNode", reflect.TypeOf((*MockOptions)(nil).MaxActiveSeriesPerNamespacePerNode))
+}
+
 // SetTickSeriesBatchSize mocks base method
 func (m *MockOptions) SetTickSeriesBatchSize(value int) Options {
 	m.ctrl.T.Helper()
@@ -51,6 +51,8 @@ const (
 	defaultWriteNewSeriesAsync                  = false
 	defaultWriteNewSeriesBackoffDuration        = time.Duration(0)
 	defaultWriteNewSeriesLimitPerShardPerSecond = 0
+	defaultMaxActiveSeriesPerNamespacePerShard  = 0
+	defaultMaxActiveSeriesPerNamespacePerNode   = 0
 	defaultTickSeriesBatchSize                  = 512
 	defaultTickPerSeriesSleepDuration           = 100 * time.Microsecond
 	defaultTickMinimumInterval                  = 10 * time.Second
@@ -62,6 +64,10 @@ var (
 		"write new series backoff duration cannot be negative")
 	errWriteNewSeriesLimitPerShardPerSecondIsNegative = errors.New(
 		"write new series limit per shard per cannot be negative")
+	errMaxActiveSeriesPerNamespacePerShardIsNegative = errors.New(
+		"max active series per namespace per shard cannot be negative")
+	errMaxActiveSeriesPerNamespacePerNodeIsNegative = errors.New(
+		"max active series per namespace per node cannot be negative")
 	errTickSeriesBatchSizeMustBePositive = errors.New(
 		"tick series batch size must be positive")
 	errTickPerSeriesSleepDurationMustBePositive = errors.New(
@@ -73,6 +79,8 @@ type options struct {
 	writeNewSeriesAsync                  bool
 	writeNewSeriesBackoffDuration        time.Duration
 	writeNewSeriesLimitPerShardPerSecond int
+	maxActiveSeriesPerNamespacePerShard  int
+	maxActiveSeriesPerNamespacePerNode   int
 	tickSeriesBatchSize                  int
 	tickPerSeriesSleepDuration           time.Duration
 	tickMinimumInterval                  time.Duration
@@ -91,6 +99,8 @@ func NewOptions() Options {
 		writeNewSeriesAsync:                  defaultWriteNewSeriesAsync,
 		writeNewSeriesBackoffDuration:        defaultWriteNewSeriesBackoffDuration,
 		writeNewSeriesLimitPerShardPerSecond: defaultWriteNewSeriesLimitPerShardPerSecond,
+		maxActiveSeriesPerNamespacePerShard:  defaultMaxActiveSeriesPerNamespacePerShard,
+		maxActiveSeriesPerNamespacePerNode:   defaultMaxActiveSeriesPerNamespacePerNode,
 		tickSeriesBatchSize:                  defaultTickSeriesBatchSize,
 		tickPerSeriesSleepDuration:           defaultTickPerSeriesSleepDuration,
 		tickMinimumInterval:                  defaultTickMinimumInterval,
@@ -115,6 +125,14 @@ func (o *options) Validate() error {
 		return errWriteNewSeriesLimitPerShardPerSecondIsNegative
 	}
 
+	// Series quotas can be zero to specify that no quota should be enforced
+	if o.maxActiveSeriesPerNamespacePerShard < 0 {
+		return errMaxActiveSeriesPerNamespacePerShardIsNegative
+	}
+	if o.maxActiveSeriesPerNamespacePerNode < 0 {
+		return errMaxActiveSeriesPerNamespacePerNodeIsNegative
+	}
+
 	if !(o.tickSeriesBatchSize > 0) {
 		return errTickSeriesBatchSizeMustBePositive
 	}
@@ -168,6 +186,26 @@ func (o *options) WriteNewSeriesLimitPerShardPerSecond() int {
 	return o.writeNewSeriesLimitPerShardPerSecond
 }
 
+func (o *options) SetMaxActiveSeriesPerNamespacePerShard(value int) Options {
+	opts := *o
+	opts.maxActiveSeriesPerNamespacePerShard = value
+	return &opts
+}
+
+func (o *options) MaxActiveSeriesPerNamespacePerShard() int {
+	return o.maxActiveSeriesPerNamespacePerShard
+}
+
+func (o *options) SetMaxActiveSeriesPerNamespacePerNode(value int) Options {
+	opts := *o
+	opts.maxActiveSeriesPerNamespacePerNode = value
+	return &opts
+}
+
+func (o *options) MaxActiveSeriesPerNamespacePerNode() int {
+	return o.maxActiveSeriesPerNamespacePerNode
+}
+
 func (o *options) SetTickSeriesBatchSize(value int) Options {
 	opts := *o
 	opts.tickSeriesBatchSize = value
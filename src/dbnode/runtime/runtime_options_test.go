@@ -30,3 +30,18 @@ func TestRuntimeOptionsDefaultsIsValid(t *testing.T) {
 	v := NewOptions()
 	assert.NoError(t, v.Validate())
 }
+
+func TestRuntimeOptionsSeriesQuotasMustNotBeNegative(t *testing.T) {
+	v := NewOptions().SetMaxActiveSeriesPerNamespacePerShard(-1)
+	assert.Equal(t, errMaxActiveSeriesPerNamespacePerShardIsNegative, v.Validate())
+
+	v = NewOptions().SetMaxActiveSeriesPerNamespacePerNode(-1)
+	assert.Equal(t, errMaxActiveSeriesPerNamespacePerNodeIsNegative, v.Validate())
+
+	v = NewOptions().
+		SetMaxActiveSeriesPerNamespacePerShard(10).
+		SetMaxActiveSeriesPerNamespacePerNode(100)
+	assert.NoError(t, v.Validate())
+	assert.Equal(t, 10, v.MaxActiveSeriesPerNamespacePerShard())
+	assert.Equal(t, 100, v.MaxActiveSeriesPerNamespacePerNode())
+}
@@ -77,6 +77,30 @@ type Options interface {
 	// time series being inserted.
 	WriteNewSeriesLimitPerShardPerSecond() int
 
+	// SetMaxActiveSeriesPerNamespacePerShard sets the max number of active
+	// series each shard of a namespace may hold, setting to zero disables the
+	// quota. Writes for new series beyond the quota are rejected while writes
+	// for existing series continue to be accepted.
+	SetMaxActiveSeriesPerNamespacePerShard(value int) Options
+
+	// MaxActiveSeriesPerNamespacePerShard returns the max number of active
+	// series each shard of a namespace may hold, setting to zero disables the
+	// quota. Writes for new series beyond the quota are rejected while writes
+	// for existing series continue to be accepted.
+	MaxActiveSeriesPerNamespacePerShard() int
+
+	// SetMaxActiveSeriesPerNamespacePerNode sets the max number of active
+	// series a namespace may hold across all shards owned by this node, setting
+	// to zero disables the quota. Writes for new series beyond the quota are
+	// rejected while writes for existing series continue to be accepted.
+	SetMaxActiveSeriesPerNamespacePerNode(value int) Options
+
+	// MaxActiveSeriesPerNamespacePerNode returns the max number of active
+	// series a namespace may hold across all shards owned by this node, setting
+	// to zero disables the quota. Writes for new series beyond the quota are
+	// rejected while writes for existing series continue to be accepted.
+	MaxActiveSeriesPerNamespacePerNode() int
+
 	// SetTickSeriesBatchSize sets the batch size to process series together
 	// during a tick before yielding and sleeping the per series duration
 	// multiplied by the batch size.
@@ -64,6 +64,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/cluster"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -319,6 +320,15 @@ func Run(runOpts RunOptions) {
 		opts = opts.SetMemoryTracker(memTracker)
 	}
 
+	queryLimits, err := limits.NewQueryLimits(cfg.Limits.QueryLimitsOptions().
+		SetInstrumentOptions(iopts))
+	if err != nil {
+		logger.Fatal("could not construct query limits", zap.Error(err))
+	}
+	queryLimits.Start()
+	defer queryLimits.Stop()
+	opts = opts.SetQueryLimits(queryLimits)
+
 	opentracing.SetGlobalTracer(tracer)
 
 	if cfg.Index.MaxQueryIDsConcurrency != 0 {
@@ -867,6 +877,8 @@ func Run(runOpts RunOptions) {
 		// Only set the write new series limit after bootstrapping
 		kvWatchNewSeriesLimitPerShard(syncCfg.KVStore, logger, topo,
 			runtimeOptsMgr, cfg.WriteNewSeriesLimitPerSecond)
+		kvWatchSeriesQuotas(syncCfg.KVStore, logger, topo,
+			runtimeOptsMgr, cfg.Limits.SeriesQuota)
 	}()
 
 	// Wait for process interrupt.
@@ -983,6 +995,103 @@ func kvWatchNewSeriesLimitPerShard(
 	}()
 }
 
+func kvWatchSeriesQuotas(
+	store kv.Store,
+	logger *zap.Logger,
+	topo topology.Topology,
+	runtimeOptsMgr m3dbruntime.OptionsManager,
+	cfg *config.SeriesQuotaConfiguration,
+) {
+	var defaultClusterQuota, defaultNodeQuota int
+	if cfg != nil {
+		defaultClusterQuota = cfg.ClusterMaxActiveSeriesPerNamespace
+		defaultNodeQuota = cfg.NodeMaxActiveSeriesPerNamespace
+	}
+
+	kvWatchIntValue(store, logger,
+		kvconfig.ClusterMaxActiveSeriesPerNamespaceKey,
+		defaultClusterQuota,
+		func(value int) error {
+			perShardQuota := clusterLimitToShardLimit(topo, value)
+			runtimeOpts := runtimeOptsMgr.Get()
+			if runtimeOpts.MaxActiveSeriesPerNamespacePerShard() == perShardQuota {
+				// Not changed, no need to set the value and trigger a runtime options update
+				return nil
+			}
+			return runtimeOptsMgr.Update(runtimeOpts.
+				SetMaxActiveSeriesPerNamespacePerShard(perShardQuota))
+		})
+
+	kvWatchIntValue(store, logger,
+		kvconfig.NodeMaxActiveSeriesPerNamespaceKey,
+		defaultNodeQuota,
+		func(value int) error {
+			if value < 0 {
+				value = 0
+			}
+			runtimeOpts := runtimeOptsMgr.Get()
+			if runtimeOpts.MaxActiveSeriesPerNamespacePerNode() == value {
+				// Not changed, no need to set the value and trigger a runtime options update
+				return nil
+			}
+			return runtimeOptsMgr.Update(runtimeOpts.
+				SetMaxActiveSeriesPerNamespacePerNode(value))
+		})
+}
+
+// kvWatchIntValue sets the value of an integer KV key and watches it for
+// changes, falling back to the default value if the key is not set or deleted.
+func kvWatchIntValue(
+	store kv.Store,
+	logger *zap.Logger,
+	key string,
+	defaultValue int,
+	onValue func(value int) error,
+) {
+	initValue := defaultValue
+	value, err := store.Get(key)
+	if err == nil {
+		protoValue := &commonpb.Int64Proto{}
+		if err := value.Unmarshal(protoValue); err != nil {
+			logger.Warn("could not unmarshal KV key", zap.String("key", key), zap.Error(err))
+		} else {
+			initValue = int(protoValue.Value)
+		}
+	} else if err != kv.ErrNotFound {
+		logger.Warn("could not resolve KV", zap.String("key", key), zap.Error(err))
+	}
+
+	if err := onValue(initValue); err != nil {
+		logger.Warn("could not process value of KV", zap.String("key", key), zap.Error(err))
+	}
+
+	watch, err := store.Watch(key)
+	if err != nil {
+		logger.Error("could not watch KV key", zap.String("key", key), zap.Error(err))
+		return
+	}
+
+	go func() {
+		protoValue := &commonpb.Int64Proto{}
+		for range watch.C() {
+			value := defaultValue
+			if newValue := watch.Get(); newValue != nil {
+				if err := newValue.Unmarshal(protoValue); err != nil {
+					logger.Warn("could not unmarshal KV key", zap.String("key", key), zap.Error(err))
+					continue
+				}
+				value = int(protoValue.Value)
+			}
+
+			if err := onValue(value); err != nil {
+				logger.Warn("could not process change for KV key", zap.String("key", key), zap.Error(err))
+				continue
+			}
+			logger.Info("set KV key", zap.String("key", key), zap.Int("value", value))
+		}
+	}()
+}
+
 func kvWatchClientConsistencyLevels(
 	store kv.Store,
 	logger *zap.Logger,
@@ -1142,6 +1251,19 @@ func clusterLimitToPlacedShardLimit(topo topology.Topology, clusterLimit int) in
 	return nodeLimit
 }
 
+func clusterLimitToShardLimit(topo topology.Topology, clusterLimit int) int {
+	if clusterLimit < 1 {
+		return 0
+	}
+	// NB: Unlike insert rates each replica of a shard holds the same set of
+	// series, so a cluster wide quota is divided only by the number of shards.
+	numShards := len(topo.Get().ShardSet().AllIDs())
+	if numShards < 1 {
+		return 0
+	}
+	return int(math.Ceil(float64(clusterLimit) / float64(numShards)))
+}
+
 // this function will block for at most waitTimeout to try to get an initial value
 // before we kick off the bootstrap
 func kvWatchBootstrappers(
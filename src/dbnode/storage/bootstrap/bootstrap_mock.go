@@ -100,6 +100,20 @@ func (mr *MockProcessProviderMockRecorder) Provide() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Provide", reflect.TypeOf((*MockProcessProvider)(nil).Provide))
 }
 
+// Progress mocks base method
+func (m *MockProcessProvider) Progress() Progress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Progress")
+	ret0, _ := ret[0].(Progress)
+	return ret0
+}
+
+// Progress indicates an expected call of Progress
+func (mr *MockProcessProviderMockRecorder) Progress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Progress", reflect.TypeOf((*MockProcessProvider)(nil).Progress))
+}
+
 // MockProcess is a mock of Process interface
 type MockProcess struct {
 	ctrl     *gomock.Controller
@@ -433,6 +447,117 @@ func (mr *MockRunOptionsMockRecorder) InitialTopologyState() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialTopologyState", reflect.TypeOf((*MockRunOptions)(nil).InitialTopologyState))
 }
 
+// SetProgressReporter mocks base method
+func (m *MockRunOptions) SetProgressReporter(value ProgressReporter) RunOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProgressReporter", value)
+	ret0, _ := ret[0].(RunOptions)
+	return ret0
+}
+
+// SetProgressReporter indicates an expected call of SetProgressReporter
+func (mr *MockRunOptionsMockRecorder) SetProgressReporter(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProgressReporter", reflect.TypeOf((*MockRunOptions)(nil).SetProgressReporter), value)
+}
+
+// ProgressReporter mocks base method
+func (m *MockRunOptions) ProgressReporter() ProgressReporter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProgressReporter")
+	ret0, _ := ret[0].(ProgressReporter)
+	return ret0
+}
+
+// ProgressReporter indicates an expected call of ProgressReporter
+func (mr *MockRunOptionsMockRecorder) ProgressReporter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProgressReporter", reflect.TypeOf((*MockRunOptions)(nil).ProgressReporter))
+}
+
+// MockProgressReporter is a mock of ProgressReporter interface
+type MockProgressReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockProgressReporterMockRecorder
+}
+
+// MockProgressReporterMockRecorder is the mock recorder for MockProgressReporter
+type MockProgressReporterMockRecorder struct {
+	mock *MockProgressReporter
+}
+
+// NewMockProgressReporter creates a new mock instance
+func NewMockProgressReporter(ctrl *gomock.Controller) *MockProgressReporter {
+	mock := &MockProgressReporter{ctrl: ctrl}
+	mock.recorder = &MockProgressReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProgressReporter) EXPECT() *MockProgressReporterMockRecorder {
+	return m.recorder
+}
+
+// BootstrapperStarted mocks base method
+func (m *MockProgressReporter) BootstrapperStarted(bootstrapper string, namespaces Namespaces) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BootstrapperStarted", bootstrapper, namespaces)
+}
+
+// BootstrapperStarted indicates an expected call of BootstrapperStarted
+func (mr *MockProgressReporterMockRecorder) BootstrapperStarted(bootstrapper, namespaces interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapperStarted", reflect.TypeOf((*MockProgressReporter)(nil).BootstrapperStarted), bootstrapper, namespaces)
+}
+
+// BootstrapperCompleted mocks base method
+func (m *MockProgressReporter) BootstrapperCompleted(bootstrapper string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BootstrapperCompleted", bootstrapper)
+}
+
+// BootstrapperCompleted indicates an expected call of BootstrapperCompleted
+func (mr *MockProgressReporterMockRecorder) BootstrapperCompleted(bootstrapper interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapperCompleted", reflect.TypeOf((*MockProgressReporter)(nil).BootstrapperCompleted), bootstrapper)
+}
+
+// RangesFulfilled mocks base method
+func (m *MockProgressReporter) RangesFulfilled(bootstrapper string, namespace ident.ID, fulfilled result.ShardTimeRanges) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RangesFulfilled", bootstrapper, namespace, fulfilled)
+}
+
+// RangesFulfilled indicates an expected call of RangesFulfilled
+func (mr *MockProgressReporterMockRecorder) RangesFulfilled(bootstrapper, namespace, fulfilled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangesFulfilled", reflect.TypeOf((*MockProgressReporter)(nil).RangesFulfilled), bootstrapper, namespace, fulfilled)
+}
+
+// SeriesLoaded mocks base method
+func (m *MockProgressReporter) SeriesLoaded(namespace ident.ID, shard uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SeriesLoaded", namespace, shard)
+}
+
+// SeriesLoaded indicates an expected call of SeriesLoaded
+func (mr *MockProgressReporterMockRecorder) SeriesLoaded(namespace, shard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesLoaded", reflect.TypeOf((*MockProgressReporter)(nil).SeriesLoaded), namespace, shard)
+}
+
+// BytesLoaded mocks base method
+func (m *MockProgressReporter) BytesLoaded(namespace ident.ID, shard uint32, bytes int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BytesLoaded", namespace, shard, bytes)
+}
+
+// BytesLoaded indicates an expected call of BytesLoaded
+func (mr *MockProgressReporterMockRecorder) BytesLoaded(namespace, shard, bytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BytesLoaded", reflect.TypeOf((*MockProgressReporter)(nil).BytesLoaded), namespace, shard, bytes)
+}
+
 // MockBootstrapperProvider is a mock of BootstrapperProvider interface
 type MockBootstrapperProvider struct {
 	ctrl     *gomock.Controller
@@ -123,11 +123,15 @@ func (b baseBootstrapper) Bootstrap(
 			logFields, currNamespace)
 	}
 
+	progress := progressReporter(curr)
+	progress.BootstrapperStarted(b.name, curr)
+
 	nowFn := b.opts.ClockOptions().NowFn()
 	begin := nowFn()
 
 	b.log.Info("bootstrap from source started", logFields...)
 	currResults, err := b.src.Read(curr)
+	progress.BootstrapperCompleted(b.name)
 
 	logFields = append(logFields, zap.Duration("took", nowFn().Sub(begin)))
 	if err != nil {
@@ -139,7 +143,7 @@ func (b baseBootstrapper) Bootstrap(
 	b.log.Info("bootstrap from source completed", logFields...)
 	// Determine the unfulfilled and the unattempted ranges to execute next.
 	next, err := b.logSuccessAndDetermineCurrResultsUnfulfilledAndNextBootstrapRanges(namespaces,
-		curr, currResults, logFields)
+		curr, currResults, progress, logFields)
 	if err != nil {
 		return bootstrap.NamespaceResults{}, err
 	}
@@ -186,6 +190,7 @@ func (b baseBootstrapper) logSuccessAndDetermineCurrResultsUnfulfilledAndNextBoo
 	requested bootstrap.Namespaces,
 	curr bootstrap.Namespaces,
 	currResults bootstrap.NamespaceResults,
+	progress bootstrap.ProgressReporter,
 	baseLogFields []zapcore.Field,
 ) (bootstrap.Namespaces, error) {
 	next := bootstrap.Namespaces{
@@ -219,6 +224,8 @@ func (b baseBootstrapper) logSuccessAndDetermineCurrResultsUnfulfilledAndNextBoo
 		dataUnfulfilled := dataRequired.Copy()
 		dataUnfulfilled.Subtract(dataCurrFulfilled)
 
+		progress.RangesFulfilled(b.name, id, dataCurrFulfilled)
+
 		// Modify the unfulfilled result.
 		currResult.DataResult.SetUnfulfilled(dataUnfulfilled.Copy())
 
@@ -321,6 +328,17 @@ func (b baseBootstrapper) logShardTimeRanges(
 	b.log.Info(msg, logFields...)
 }
 
+// progressReporter returns the progress reporter of the namespaces, all
+// namespaces of a run share the same reporter.
+func progressReporter(namespaces bootstrap.Namespaces) bootstrap.ProgressReporter {
+	for _, elem := range namespaces.Namespaces.Iter() {
+		if runOpts := elem.Value().DataRunOptions.RunOptions; runOpts != nil {
+			return runOpts.ProgressReporter()
+		}
+	}
+	return bootstrap.NewNoOpProgressReporter()
+}
+
 func logFieldsCopy(logFields []zapcore.Field) []zapcore.Field {
 	return append(make([]zapcore.Field, 0, 2*len(logFields)), logFields...)
 }
@@ -22,6 +22,9 @@ package bootstrap
 
 import (
 	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/x/ident"
 )
 
 type noOpBootstrapProcessProvider struct{}
@@ -42,6 +45,10 @@ func (b noOpBootstrapProcessProvider) Provide() (Process, error) {
 	return noOpBootstrapProcess{}, nil
 }
 
+func (b noOpBootstrapProcessProvider) Progress() Progress {
+	return Progress{}
+}
+
 type noOpBootstrapProcess struct{}
 
 func (b noOpBootstrapProcess) Run(
@@ -50,3 +57,20 @@ func (b noOpBootstrapProcess) Run(
 ) (NamespaceResults, error) {
 	return NewNamespaceResults(NewNamespaces(namespaces)), nil
 }
+
+type noOpProgressReporter struct{}
+
+// NewNoOpProgressReporter creates a no-op bootstrap progress reporter.
+func NewNoOpProgressReporter() ProgressReporter {
+	return noOpProgressReporter{}
+}
+
+func (noOpProgressReporter) BootstrapperStarted(string, Namespaces) {}
+
+func (noOpProgressReporter) BootstrapperCompleted(string) {}
+
+func (noOpProgressReporter) RangesFulfilled(string, ident.ID, result.ShardTimeRanges) {}
+
+func (noOpProgressReporter) SeriesLoaded(ident.ID, uint32) {}
+
+func (noOpProgressReporter) BytesLoaded(ident.ID, uint32, int) {}
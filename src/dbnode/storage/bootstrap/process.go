@@ -44,6 +44,7 @@ type bootstrapProcessProvider struct {
 	resultOpts           result.Options
 	log                  *zap.Logger
 	bootstrapperProvider BootstrapperProvider
+	progress             *progressTracker
 }
 
 type bootstrapRunType string
@@ -68,6 +69,7 @@ func NewProcessProvider(
 		resultOpts:           resultOpts,
 		log:                  resultOpts.InstrumentOptions().Logger(),
 		bootstrapperProvider: bootstrapperProvider,
+		progress:             newProgressTracker(resultOpts),
 	}, nil
 }
 
@@ -103,9 +105,14 @@ func (b *bootstrapProcessProvider) Provide() (Process, error) {
 		log:                  b.log,
 		bootstrapper:         bootstrapper,
 		initialTopologyState: initialTopologyState,
+		progress:             b.progress,
 	}, nil
 }
 
+func (b *bootstrapProcessProvider) Progress() Progress {
+	return b.progress.snapshot()
+}
+
 func (b *bootstrapProcessProvider) newInitialTopologyState() (*topology.StateSnapshot, error) {
 	topoMap, err := b.processOpts.TopologyMapProvider().TopologyMap()
 	if err != nil {
@@ -148,6 +155,7 @@ type bootstrapProcess struct {
 	log                  *zap.Logger
 	bootstrapper         Bootstrapper
 	initialTopologyState *topology.StateSnapshot
+	progress             *progressTracker
 }
 
 func (b bootstrapProcess) Run(
@@ -160,16 +168,29 @@ func (b bootstrapProcess) Run(
 	namespacesRunSecond := Namespaces{
 		Namespaces: NewNamespacesMap(NamespacesMapOptions{}),
 	}
+	progressRequested := make(map[string]result.ShardTimeRanges, len(namespaces))
 	for _, namespace := range namespaces {
 		ropts := namespace.Metadata.Options().RetentionOptions()
 		idxopts := namespace.Metadata.Options().IndexOptions()
 		dataRanges := b.targetRangesForData(at, ropts)
 		indexRanges := b.targetRangesForIndex(at, ropts, idxopts)
 
+		requested := b.newShardTimeRanges(
+			dataRanges.firstRangeWithPersistTrue.Range, namespace.Shards)
+		requested.AddRanges(b.newShardTimeRanges(
+			dataRanges.secondRangeWithPersistFalse.Range, namespace.Shards))
+		progressRequested[namespace.Metadata.ID().String()] = requested
+
+		accumulator := namespace.DataAccumulator
+		if accumulator != nil {
+			accumulator = newProgressAccumulator(namespace.Metadata.ID(),
+				accumulator, b.progress)
+		}
+
 		namespacesRunFirst.Namespaces.Set(namespace.Metadata.ID(), Namespace{
 			Metadata:         namespace.Metadata,
 			Shards:           namespace.Shards,
-			DataAccumulator:  namespace.DataAccumulator,
+			DataAccumulator:  accumulator,
 			DataTargetRange:  dataRanges.firstRangeWithPersistTrue,
 			IndexTargetRange: indexRanges.firstRangeWithPersistTrue,
 			DataRunOptions: NamespaceRunOptions{
@@ -186,7 +207,7 @@ func (b bootstrapProcess) Run(
 		namespacesRunSecond.Namespaces.Set(namespace.Metadata.ID(), Namespace{
 			Metadata:         namespace.Metadata,
 			Shards:           namespace.Shards,
-			DataAccumulator:  namespace.DataAccumulator,
+			DataAccumulator:  accumulator,
 			DataTargetRange:  dataRanges.secondRangeWithPersistFalse,
 			IndexTargetRange: indexRanges.secondRangeWithPersistFalse,
 			DataRunOptions: NamespaceRunOptions{
@@ -202,6 +223,9 @@ func (b bootstrapProcess) Run(
 		})
 	}
 
+	b.progress.start(progressRequested)
+	defer b.progress.finish()
+
 	bootstrapResult := NewNamespaceResults(namespacesRunFirst)
 	for _, namespaces := range []Namespaces{
 		namespacesRunFirst,
@@ -393,7 +417,8 @@ func (b bootstrapProcess) newRunOptions() RunOptions {
 		SetCacheSeriesMetadata(
 			b.processOpts.CacheSeriesMetadata(),
 		).
-		SetInitialTopologyState(b.initialTopologyState)
+		SetInitialTopologyState(b.initialTopologyState).
+		SetProgressReporter(b.progress)
 }
 
 // NewNamespaces returns a new set of bootstrappable namespaces.
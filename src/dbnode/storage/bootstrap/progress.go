@@ -0,0 +1,472 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+)
+
+type shardProgress struct {
+	requested time.Duration
+	fulfilled time.Duration
+	current   *bootstrapperProgress
+
+	// Updated atomically as blocks are loaded concurrently.
+	seriesLoaded int64
+	bytesLoaded  int64
+}
+
+type namespaceProgress struct {
+	id     string
+	shards map[uint32]*shardProgress
+}
+
+type bootstrapperProgress struct {
+	name    string
+	running bool
+	started time.Time
+	elapsed time.Duration
+
+	// Updated atomically as blocks are loaded concurrently.
+	seriesLoaded int64
+	bytesLoaded  int64
+
+	seriesLoadedCounter tally.Counter
+	bytesLoadedCounter  tally.Counter
+	bytesPerSecond      tally.Gauge
+}
+
+type progressMetrics struct {
+	bootstrapping  tally.Gauge
+	fulfilledRatio tally.Gauge
+	etaSeconds     tally.Gauge
+}
+
+func newProgressMetrics(scope tally.Scope) progressMetrics {
+	return progressMetrics{
+		bootstrapping:  scope.Gauge("bootstrapping"),
+		fulfilledRatio: scope.Gauge("fulfilled-ratio"),
+		etaSeconds:     scope.Gauge("eta-seconds"),
+	}
+}
+
+// progressTracker tracks the progress of bootstrap runs, the data time
+// ranges requested of each namespace and shard are tracked against the
+// ranges reported fulfilled by the bootstrappers.
+type progressTracker struct {
+	sync.RWMutex
+
+	nowFn   clock.NowFn
+	scope   tally.Scope
+	metrics progressMetrics
+
+	bootstrapping bool
+	started       time.Time
+	finished      time.Time
+	requested     time.Duration
+	fulfilled     time.Duration
+	namespaces    map[string]*namespaceProgress
+	bootstrappers map[string]*bootstrapperProgress
+	order         []string
+}
+
+func newProgressTracker(opts result.Options) *progressTracker {
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("bootstrap-progress")
+	return &progressTracker{
+		nowFn:         opts.ClockOptions().NowFn(),
+		scope:         scope,
+		metrics:       newProgressMetrics(scope),
+		namespaces:    make(map[string]*namespaceProgress),
+		bootstrappers: make(map[string]*bootstrapperProgress),
+	}
+}
+
+// start resets the tracker for a new bootstrap run of the namespaces over
+// the data time ranges.
+func (t *progressTracker) start(requested map[string]result.ShardTimeRanges) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.bootstrapping = true
+	t.started = t.nowFn()
+	t.finished = time.Time{}
+	t.requested, t.fulfilled = 0, 0
+	t.namespaces = make(map[string]*namespaceProgress, len(requested))
+	t.bootstrappers = make(map[string]*bootstrapperProgress)
+	t.order = nil
+
+	for nsID, ranges := range requested {
+		ns := &namespaceProgress{
+			id:     nsID,
+			shards: make(map[uint32]*shardProgress, len(ranges)),
+		}
+		for shard, shardRanges := range ranges {
+			d := rangesDuration(shardRanges)
+			ns.shards[shard] = &shardProgress{requested: d}
+			t.requested += d
+		}
+		t.namespaces[nsID] = ns
+	}
+
+	t.metrics.bootstrapping.Update(1)
+	t.updateGaugesWithLock()
+}
+
+// finish marks the bootstrap run as no longer in progress.
+func (t *progressTracker) finish() {
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.nowFn()
+	t.bootstrapping = false
+	t.finished = now
+	for _, b := range t.bootstrappers {
+		if b.running {
+			b.running = false
+			b.elapsed += now.Sub(b.started)
+		}
+	}
+
+	t.metrics.bootstrapping.Update(0)
+	t.updateGaugesWithLock()
+}
+
+func (t *progressTracker) BootstrapperStarted(bootstrapper string, namespaces Namespaces) {
+	t.Lock()
+	defer t.Unlock()
+
+	b, ok := t.bootstrappers[bootstrapper]
+	if !ok {
+		scope := t.scope.Tagged(map[string]string{"bootstrapper": bootstrapper})
+		b = &bootstrapperProgress{
+			name:                bootstrapper,
+			seriesLoadedCounter: scope.Counter("series-loaded"),
+			bytesLoadedCounter:  scope.Counter("bytes-loaded"),
+			bytesPerSecond:      scope.Gauge("bytes-per-second"),
+		}
+		t.bootstrappers[bootstrapper] = b
+		t.order = append(t.order, bootstrapper)
+	}
+	b.running = true
+	b.started = t.nowFn()
+
+	for _, elem := range namespaces.Namespaces.Iter() {
+		ns, ok := t.namespaces[elem.Key().String()]
+		if !ok {
+			continue
+		}
+		for shard, ranges := range elem.Value().DataRunOptions.ShardTimeRanges {
+			if ranges.IsEmpty() {
+				continue
+			}
+			if s, ok := ns.shards[shard]; ok {
+				s.current = b
+			}
+		}
+	}
+}
+
+func (t *progressTracker) BootstrapperCompleted(bootstrapper string) {
+	t.Lock()
+	defer t.Unlock()
+
+	b, ok := t.bootstrappers[bootstrapper]
+	if !ok || !b.running {
+		return
+	}
+	b.running = false
+	b.elapsed += t.nowFn().Sub(b.started)
+	b.bytesPerSecond.Update(bytesPerSecond(atomic.LoadInt64(&b.bytesLoaded), b.elapsed))
+}
+
+func (t *progressTracker) RangesFulfilled(
+	bootstrapper string,
+	namespace ident.ID,
+	fulfilled result.ShardTimeRanges,
+) {
+	t.Lock()
+	defer t.Unlock()
+
+	ns, ok := t.namespaces[namespace.String()]
+	if !ok {
+		return
+	}
+	for shard, ranges := range fulfilled {
+		s, ok := ns.shards[shard]
+		if !ok {
+			continue
+		}
+		d := rangesDuration(ranges)
+		if remaining := s.requested - s.fulfilled; d > remaining {
+			// Ranges outside the requested ranges are not tracked.
+			d = remaining
+		}
+		s.fulfilled += d
+		t.fulfilled += d
+	}
+	t.updateGaugesWithLock()
+}
+
+func (t *progressTracker) SeriesLoaded(namespace ident.ID, shard uint32) {
+	s, b := t.shardProgress(namespace, shard)
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.seriesLoaded, 1)
+	if b != nil {
+		atomic.AddInt64(&b.seriesLoaded, 1)
+		b.seriesLoadedCounter.Inc(1)
+	}
+}
+
+func (t *progressTracker) BytesLoaded(namespace ident.ID, shard uint32, bytes int) {
+	s, b := t.shardProgress(namespace, shard)
+	if s == nil {
+		return
+	}
+	atomic.AddInt64(&s.bytesLoaded, int64(bytes))
+	if b != nil {
+		atomic.AddInt64(&b.bytesLoaded, int64(bytes))
+		b.bytesLoadedCounter.Inc(int64(bytes))
+	}
+}
+
+func (t *progressTracker) shardProgress(
+	namespace ident.ID,
+	shard uint32,
+) (*shardProgress, *bootstrapperProgress) {
+	t.RLock()
+	defer t.RUnlock()
+
+	ns, ok := t.namespaces[namespace.String()]
+	if !ok {
+		return nil, nil
+	}
+	s, ok := ns.shards[shard]
+	if !ok {
+		return nil, nil
+	}
+	return s, s.current
+}
+
+// snapshot returns a snapshot of the progress of the current or last run.
+func (t *progressTracker) snapshot() Progress {
+	t.RLock()
+	defer t.RUnlock()
+
+	now := t.nowFn()
+	p := Progress{
+		Bootstrapping:    t.bootstrapping,
+		StartedAt:        t.started,
+		ElapsedSeconds:   t.elapsedWithLock(now).Seconds(),
+		ETASeconds:       t.etaWithLock(now).Seconds(),
+		RequestedSeconds: t.requested.Seconds(),
+		FulfilledSeconds: t.fulfilled.Seconds(),
+		Bootstrappers:    make([]BootstrapperProgress, 0, len(t.order)),
+		Namespaces:       make([]NamespaceProgress, 0, len(t.namespaces)),
+	}
+
+	for _, name := range t.order {
+		b := t.bootstrappers[name]
+		elapsed := b.elapsed
+		if b.running {
+			elapsed += now.Sub(b.started)
+		}
+		bytes := atomic.LoadInt64(&b.bytesLoaded)
+		p.Bootstrappers = append(p.Bootstrappers, BootstrapperProgress{
+			Name:           b.name,
+			Running:        b.running,
+			ElapsedSeconds: elapsed.Seconds(),
+			SeriesLoaded:   atomic.LoadInt64(&b.seriesLoaded),
+			BytesLoaded:    bytes,
+			BytesPerSecond: bytesPerSecond(bytes, elapsed),
+		})
+	}
+
+	for _, ns := range t.namespaces {
+		nsProgress := NamespaceProgress{
+			Namespace: ns.id,
+			Shards:    make([]ShardProgress, 0, len(ns.shards)),
+		}
+		for shard, s := range ns.shards {
+			var bootstrapper string
+			if s.current != nil && s.fulfilled < s.requested {
+				bootstrapper = s.current.name
+			}
+			nsProgress.Shards = append(nsProgress.Shards, ShardProgress{
+				Shard:            shard,
+				Bootstrapper:     bootstrapper,
+				FulfilledSeconds: s.fulfilled.Seconds(),
+				RemainingSeconds: (s.requested - s.fulfilled).Seconds(),
+				SeriesLoaded:     atomic.LoadInt64(&s.seriesLoaded),
+				BytesLoaded:      atomic.LoadInt64(&s.bytesLoaded),
+			})
+		}
+		sort.Slice(nsProgress.Shards, func(i, j int) bool {
+			return nsProgress.Shards[i].Shard < nsProgress.Shards[j].Shard
+		})
+		p.Namespaces = append(p.Namespaces, nsProgress)
+	}
+	sort.Slice(p.Namespaces, func(i, j int) bool {
+		return p.Namespaces[i].Namespace < p.Namespaces[j].Namespace
+	})
+
+	return p
+}
+
+func (t *progressTracker) elapsedWithLock(now time.Time) time.Duration {
+	if t.started.IsZero() {
+		return 0
+	}
+	if !t.bootstrapping {
+		return t.finished.Sub(t.started)
+	}
+	return now.Sub(t.started)
+}
+
+// etaWithLock estimates the time remaining from the rate at which data time
+// ranges have been fulfilled since the run started.
+func (t *progressTracker) etaWithLock(now time.Time) time.Duration {
+	if !t.bootstrapping || t.fulfilled <= 0 {
+		return 0
+	}
+	remaining := t.requested - t.fulfilled
+	elapsed := t.elapsedWithLock(now)
+	return time.Duration(float64(elapsed) * float64(remaining) / float64(t.fulfilled))
+}
+
+func (t *progressTracker) updateGaugesWithLock() {
+	var ratio float64
+	if t.requested > 0 {
+		ratio = float64(t.fulfilled) / float64(t.requested)
+	}
+	t.metrics.fulfilledRatio.Update(ratio)
+	t.metrics.etaSeconds.Update(t.etaWithLock(t.nowFn()).Seconds())
+}
+
+func rangesDuration(ranges xtime.Ranges) time.Duration {
+	var (
+		duration time.Duration
+		it       = ranges.Iter()
+	)
+	for it.Next() {
+		curr := it.Value()
+		duration += curr.End.Sub(curr.Start)
+	}
+	return duration
+}
+
+func bytesPerSecond(bytes int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(bytes) / elapsed.Seconds()
+}
+
+// progressAccumulator reports the series and blocks loaded through a
+// namespace data accumulator.
+type progressAccumulator struct {
+	NamespaceDataAccumulator
+
+	namespace ident.ID
+	reporter  ProgressReporter
+}
+
+func newProgressAccumulator(
+	namespace ident.ID,
+	accumulator NamespaceDataAccumulator,
+	reporter ProgressReporter,
+) NamespaceDataAccumulator {
+	return progressAccumulator{
+		NamespaceDataAccumulator: accumulator,
+		namespace:                namespace,
+		reporter:                 reporter,
+	}
+}
+
+func (a progressAccumulator) CheckoutSeriesWithoutLock(
+	shardID uint32,
+	id ident.ID,
+	tags ident.TagIterator,
+) (CheckoutSeriesResult, error) {
+	res, err := a.NamespaceDataAccumulator.CheckoutSeriesWithoutLock(shardID, id, tags)
+	return a.wrap(res, err)
+}
+
+func (a progressAccumulator) CheckoutSeriesWithLock(
+	shardID uint32,
+	id ident.ID,
+	tags ident.TagIterator,
+) (CheckoutSeriesResult, error) {
+	res, err := a.NamespaceDataAccumulator.CheckoutSeriesWithLock(shardID, id, tags)
+	return a.wrap(res, err)
+}
+
+func (a progressAccumulator) wrap(
+	res CheckoutSeriesResult,
+	err error,
+) (CheckoutSeriesResult, error) {
+	if err != nil {
+		return res, err
+	}
+	a.reporter.SeriesLoaded(a.namespace, res.Shard)
+	res.Series = progressSeries{
+		DatabaseSeries: res.Series,
+		namespace:      a.namespace,
+		shard:          res.Shard,
+		reporter:       a.reporter,
+	}
+	return res, nil
+}
+
+// progressSeries reports the bytes of the blocks loaded into a series.
+type progressSeries struct {
+	series.DatabaseSeries
+
+	namespace ident.ID
+	shard     uint32
+	reporter  ProgressReporter
+}
+
+func (s progressSeries) LoadBlock(
+	block block.DatabaseBlock,
+	writeType series.WriteType,
+) error {
+	// NB: take the length before loading, the series owns the block after.
+	size := block.Len()
+	if err := s.DatabaseSeries.LoadBlock(block, writeType); err != nil {
+		return err
+	}
+	s.reporter.BytesLoaded(s.namespace, s.shard, size)
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bootstrap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressTrackerETA(t *testing.T) {
+	var (
+		start   = time.Now().Truncate(time.Hour)
+		now     = start
+		nsID    = ident.StringID("testNs")
+		tracker = newProgressTracker(result.NewOptions())
+	)
+	tracker.nowFn = func() time.Time { return now }
+
+	tracker.start(map[string]result.ShardTimeRanges{
+		nsID.String(): result.NewShardTimeRanges(start.Add(-2*time.Hour), start, 0, 1),
+	})
+	tracker.BootstrapperStarted("filesystem", Namespaces{
+		Namespaces: NewNamespacesMap(NamespacesMapOptions{}),
+	})
+
+	now = now.Add(10 * time.Minute)
+	tracker.RangesFulfilled("filesystem", nsID,
+		result.NewShardTimeRanges(start.Add(-2*time.Hour), start, 0))
+	tracker.SeriesLoaded(nsID, 0)
+	tracker.BytesLoaded(nsID, 0, 128)
+
+	progress := tracker.snapshot()
+	assert.True(t, progress.Bootstrapping)
+	assert.Equal(t, (4 * time.Hour).Seconds(), progress.RequestedSeconds)
+	assert.Equal(t, (2 * time.Hour).Seconds(), progress.FulfilledSeconds)
+	assert.Equal(t, (10 * time.Minute).Seconds(), progress.ElapsedSeconds)
+	assert.Equal(t, (10 * time.Minute).Seconds(), progress.ETASeconds)
+
+	require.Len(t, progress.Bootstrappers, 1)
+	assert.Equal(t, "filesystem", progress.Bootstrappers[0].Name)
+	assert.True(t, progress.Bootstrappers[0].Running)
+
+	require.Len(t, progress.Namespaces, 1)
+	shards := progress.Namespaces[0].Shards
+	require.Len(t, shards, 2)
+	assert.Equal(t, uint32(0), shards[0].Shard)
+	assert.Equal(t, float64(0), shards[0].RemainingSeconds)
+	assert.Equal(t, int64(1), shards[0].SeriesLoaded)
+	assert.Equal(t, int64(128), shards[0].BytesLoaded)
+	assert.Equal(t, (2 * time.Hour).Seconds(), shards[1].RemainingSeconds)
+
+	tracker.BootstrapperCompleted("filesystem")
+	tracker.finish()
+
+	progress = tracker.snapshot()
+	assert.False(t, progress.Bootstrapping)
+	assert.Equal(t, float64(0), progress.ETASeconds)
+	assert.False(t, progress.Bootstrappers[0].Running)
+	assert.Equal(t, (10 * time.Minute).Seconds(), progress.Bootstrappers[0].ElapsedSeconds)
+}
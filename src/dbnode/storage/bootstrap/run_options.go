@@ -34,6 +34,7 @@ type runOptions struct {
 	persistConfig        PersistConfig
 	cacheSeriesMetadata  bool
 	initialTopologyState *topology.StateSnapshot
+	progressReporter     ProgressReporter
 }
 
 // NewRunOptions creates new bootstrap run options
@@ -42,6 +43,7 @@ func NewRunOptions() RunOptions {
 		persistConfig:        defaultPersistConfig,
 		cacheSeriesMetadata:  defaultCacheSeriesMetadata,
 		initialTopologyState: nil,
+		progressReporter:     NewNoOpProgressReporter(),
 	}
 }
 
@@ -74,3 +76,13 @@ func (o *runOptions) SetInitialTopologyState(value *topology.StateSnapshot) RunO
 func (o *runOptions) InitialTopologyState() *topology.StateSnapshot {
 	return o.initialTopologyState
 }
+
+func (o *runOptions) SetProgressReporter(value ProgressReporter) RunOptions {
+	opts := *o
+	opts.progressReporter = value
+	return &opts
+}
+
+func (o *runOptions) ProgressReporter() ProgressReporter {
+	return o.progressReporter
+}
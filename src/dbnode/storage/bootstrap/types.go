@@ -45,6 +45,10 @@ type ProcessProvider interface {
 
 	// Provide constructs a bootstrap process.
 	Provide() (Process, error)
+
+	// Progress returns a snapshot of the progress of the current bootstrap
+	// run, or of the last bootstrap run if none is in progress.
+	Progress() Progress
 }
 
 // Process represents the bootstrap process. Note that a bootstrap process can and will
@@ -228,6 +232,101 @@ type RunOptions interface {
 	// InitialTopologyState returns the initial topology as it was measured
 	// before the bootstrap process began.
 	InitialTopologyState() *topology.StateSnapshot
+
+	// SetProgressReporter sets the reporter that bootstrappers report their
+	// progress to.
+	SetProgressReporter(value ProgressReporter) RunOptions
+
+	// ProgressReporter returns the reporter that bootstrappers report their
+	// progress to.
+	ProgressReporter() ProgressReporter
+}
+
+// ProgressReporter receives progress updates from the bootstrappers of a
+// bootstrap run.
+type ProgressReporter interface {
+	// BootstrapperStarted reports that a bootstrapper started reading the
+	// data time ranges of the namespaces.
+	BootstrapperStarted(bootstrapper string, namespaces Namespaces)
+
+	// BootstrapperCompleted reports that a bootstrapper finished reading.
+	BootstrapperCompleted(bootstrapper string)
+
+	// RangesFulfilled reports the data time ranges of a namespace fulfilled
+	// by a bootstrapper.
+	RangesFulfilled(bootstrapper string, namespace ident.ID, fulfilled result.ShardTimeRanges)
+
+	// SeriesLoaded reports a series checked out for loading into a shard.
+	SeriesLoaded(namespace ident.ID, shard uint32)
+
+	// BytesLoaded reports bytes of series blocks loaded into a shard.
+	BytesLoaded(namespace ident.ID, shard uint32, bytes int)
+}
+
+// Progress is a snapshot of the progress of a bootstrap run.
+type Progress struct {
+	// Bootstrapping is whether a bootstrap run is in progress.
+	Bootstrapping bool `json:"bootstrapping"`
+	// StartedAt is when the bootstrap run started.
+	StartedAt time.Time `json:"startedAt"`
+	// ElapsedSeconds is how long the bootstrap run has taken so far.
+	ElapsedSeconds float64 `json:"elapsedSeconds"`
+	// ETASeconds is the estimated time remaining until all data time ranges
+	// are fulfilled, computed from the rate at which ranges have been
+	// fulfilled so far. It is zero when unknown or not bootstrapping.
+	ETASeconds float64 `json:"etaSeconds"`
+	// RequestedSeconds is the total data time range requested across all
+	// namespaces and shards.
+	RequestedSeconds float64 `json:"requestedSeconds"`
+	// FulfilledSeconds is the total data time range fulfilled across all
+	// namespaces and shards.
+	FulfilledSeconds float64 `json:"fulfilledSeconds"`
+	// Bootstrappers is the progress of each bootstrapper that has run.
+	Bootstrappers []BootstrapperProgress `json:"bootstrappers"`
+	// Namespaces is the progress of each namespace.
+	Namespaces []NamespaceProgress `json:"namespaces"`
+}
+
+// BootstrapperProgress is the progress of a single bootstrapper.
+type BootstrapperProgress struct {
+	// Name is the name of the bootstrapper.
+	Name string `json:"name"`
+	// Running is whether the bootstrapper is currently reading.
+	Running bool `json:"running"`
+	// ElapsedSeconds is how long the bootstrapper has spent reading.
+	ElapsedSeconds float64 `json:"elapsedSeconds"`
+	// SeriesLoaded is the number of series loaded by the bootstrapper, a
+	// series is counted once per block it is loaded for.
+	SeriesLoaded int64 `json:"seriesLoaded"`
+	// BytesLoaded is the number of bytes of series blocks loaded.
+	BytesLoaded int64 `json:"bytesLoaded"`
+	// BytesPerSecond is the throughput of the bootstrapper while reading.
+	BytesPerSecond float64 `json:"bytesPerSecond"`
+}
+
+// NamespaceProgress is the progress of a single namespace.
+type NamespaceProgress struct {
+	// Namespace is the ID of the namespace.
+	Namespace string `json:"namespace"`
+	// Shards is the progress of each shard of the namespace.
+	Shards []ShardProgress `json:"shards"`
+}
+
+// ShardProgress is the progress of a single shard of a namespace.
+type ShardProgress struct {
+	// Shard is the shard ID.
+	Shard uint32 `json:"shard"`
+	// Bootstrapper is the bootstrapper currently reading the shard.
+	Bootstrapper string `json:"bootstrapper"`
+	// FulfilledSeconds is the data time range fulfilled for the shard.
+	FulfilledSeconds float64 `json:"fulfilledSeconds"`
+	// RemainingSeconds is the data time range remaining for the shard.
+	RemainingSeconds float64 `json:"remainingSeconds"`
+	// SeriesLoaded is the number of series loaded into the shard.
+	SeriesLoaded int64 `json:"seriesLoaded"`
+	// BytesLoaded is the number of bytes of series blocks loaded into the
+	// shard.
+	BytesLoaded int64 `json:"bytesLoaded"`
 }
 
 // BootstrapperProvider constructs a bootstrapper.
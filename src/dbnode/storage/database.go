@@ -70,6 +70,12 @@ var (
 	// errDatabaseIsClosed raised when trying to perform an action that requires an open database.
 	errDatabaseIsClosed = errors.New("database is closed")
 
+	// errDatabaseNotBootstrapped raised when waiting for a snapshot before the database has bootstrapped.
+	errDatabaseNotBootstrapped = errors.New("database is not yet bootstrapped")
+
+	// errSnapshotTimeout raised when no snapshot completes before the timeout elapses.
+	errSnapshotTimeout = errors.New("timed out waiting for snapshot")
+
 	// errWriterDoesNotImplementWriteBatch is raised when the provided ts.BatchWriter does not implement
 	// ts.WriteBatch.
 	errWriterDoesNotImplementWriteBatch = errors.New("provided writer does not implement ts.WriteBatch")
@@ -933,6 +939,35 @@ func (d *db) FlushState(
 	return n.FlushState(shardID, blockStart)
 }
 
+func (d *db) WaitForSnapshot(timeout time.Duration) (time.Time, error) {
+	if !d.mediator.IsBootstrapped() {
+		return time.Time{}, errDatabaseNotBootstrapped
+	}
+
+	// Rather than taking a snapshot out of band, which would break the
+	// ordering guarantees between ticks and file operations, request the
+	// mediator to take one with the next run of the file system processes.
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-d.mediator.RequestSnapshot():
+		return result.startTime, result.err
+	case <-timer.C:
+		return time.Time{}, errSnapshotTimeout
+	}
+}
+
+func (d *db) Rollup(namespace ident.ID, start, end time.Time) error {
+	if !d.mediator.IsBootstrapped() {
+		return errDatabaseNotBootstrapped
+	}
+	if !start.Before(end) {
+		return fmt.Errorf("invalid rollup range: start %v must be before end %v", start, end)
+	}
+	return d.mediator.Rollup(namespace, start, end)
+}
+
 func (d *db) namespaceFor(namespace ident.ID) (databaseNamespace, error) {
 	d.RLock()
 	n, exists := d.namespaces.Get(namespace)
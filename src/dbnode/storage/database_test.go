@@ -1289,6 +1289,45 @@ func TestDatabaseIsBootstrappedAndDurable(t *testing.T) {
 	}
 }
 
+func TestDatabaseWaitForSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	d, mapCh, _ := defaultTestDatabase(t, ctrl, Bootstrapped)
+	defer func() {
+		close(mapCh)
+	}()
+
+	now := time.Now()
+	d.nowFn = func() time.Time { return now }
+	mediator := NewMockdatabaseMediator(ctrl)
+	d.mediator = mediator
+
+	mediator.EXPECT().IsBootstrapped().Return(false)
+	_, err := d.WaitForSnapshot(time.Minute)
+	require.Equal(t, errDatabaseNotBootstrapped, err)
+
+	mediator.EXPECT().IsBootstrapped().Return(true)
+	mediator.EXPECT().RequestSnapshot().Return(make(chan snapshotResult))
+	_, err = d.WaitForSnapshot(0)
+	require.Equal(t, errSnapshotTimeout, err)
+
+	requested := make(chan snapshotResult, 1)
+	requested <- snapshotResult{err: errSnapshotNotTaken}
+	mediator.EXPECT().IsBootstrapped().Return(true)
+	mediator.EXPECT().RequestSnapshot().Return(requested)
+	_, err = d.WaitForSnapshot(time.Minute)
+	require.Equal(t, errSnapshotNotTaken, err)
+
+	requested = make(chan snapshotResult, 1)
+	requested <- snapshotResult{startTime: now}
+	mediator.EXPECT().IsBootstrapped().Return(true)
+	mediator.EXPECT().RequestSnapshot().Return(requested)
+	snapshotStartTime, err := d.WaitForSnapshot(time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, now, snapshotStartTime)
+}
+
 func TestUpdateBatchWriterBasedOnShardResults(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -63,3 +63,33 @@ func IsUnknownNamespaceError(err error) bool {
 	_, ok := nsErr.(unknownNamespace)
 	return ok
 }
+
+// NewSeriesQuotaExceededError returns a new error indicating that a write
+// for a new series was rejected since the namespace has reached its quota
+// of active series.
+func NewSeriesQuotaExceededError(namespace string, quota int) error {
+	return xerrors.NewInvalidParamsError(seriesQuotaExceeded{
+		namespace: namespace,
+		quota:     quota,
+	})
+}
+
+type seriesQuotaExceeded struct {
+	namespace string
+	quota     int
+}
+
+func (e seriesQuotaExceeded) Error() string {
+	return fmt.Sprintf("namespace %s exceeded active series quota of %d: new series rejected",
+		e.namespace, e.quota)
+}
+
+// IsSeriesQuotaExceededError returns true if this is a series quota exceeded error.
+func IsSeriesQuotaExceededError(err error) bool {
+	quotaErr := xerrors.GetInnerInvalidParamsError(err)
+	if quotaErr == nil {
+		return false
+	}
+	_, ok := quotaErr.(seriesQuotaExceeded)
+	return ok
+}
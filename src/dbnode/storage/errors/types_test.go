@@ -31,3 +31,11 @@ func TestUnknownNamespaceError(t *testing.T) {
 	require.Equal(t, "unknown namespace: ns", err.Error())
 	require.True(t, IsUnknownNamespaceError(err))
 }
+
+func TestSeriesQuotaExceededError(t *testing.T) {
+	err := NewSeriesQuotaExceededError("ns", 10)
+	require.Equal(t, "namespace ns exceeded active series quota of 10: new series rejected", err.Error())
+	require.True(t, IsSeriesQuotaExceededError(err))
+	require.False(t, IsUnknownNamespaceError(err))
+	require.False(t, IsSeriesQuotaExceededError(NewUnknownNamespaceError("ns")))
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/retention"
+	dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
 	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
@@ -37,6 +39,8 @@ import (
 
 var (
 	errFlushOperationsInProgress = errors.New("flush operations already in progress")
+	errRollupSourceNotFound      = errors.New("rollup source namespace not found")
+	errSnapshotNotTaken          = errors.New("snapshot was not taken due to a flush error")
 )
 
 type flushManagerState int
@@ -72,6 +76,15 @@ type flushManager struct {
 	maxBlocksSnapshottedByNamespace tally.Gauge
 
 	lastSuccessfulSnapshotStartTime time.Time
+	// snapshotRequests are notified once the snapshot of the next flush
+	// completes.
+	snapshotRequests []chan snapshotResult
+}
+
+// snapshotResult is the result of a requested snapshot.
+type snapshotResult struct {
+	startTime time.Time
+	err       error
 }
 
 func newFlushManager(
@@ -101,10 +114,21 @@ func (m *flushManager) Flush(startTime time.Time) error {
 		return errFlushOperationsInProgress
 	}
 	m.state = flushManagerNotIdle
+	// Requests made once the flush has started are served by the next flush
+	// as the snapshot of this flush may have already started.
+	snapshotRequests := m.snapshotRequests
+	m.snapshotRequests = nil
 	m.Unlock()
 
 	defer m.setState(flushManagerIdle)
 
+	snapshotErr := errSnapshotNotTaken
+	defer func() {
+		for _, req := range snapshotRequests {
+			req <- snapshotResult{startTime: startTime, err: snapshotErr}
+		}
+	}()
+
 	namespaces, err := m.database.GetOwnedNamespaces()
 	if err != nil {
 		return err
@@ -165,8 +189,9 @@ func (m *flushManager) Flush(startTime time.Time) error {
 		// value by however many bytes had been tracked when the cold flush began.
 		memTracker.DecPendingLoadedBytes()
 
-		if err = m.dataSnapshot(namespaces, startTime, rotatedCommitlogID); err != nil {
-			multiErr = multiErr.Add(err)
+		snapshotErr = m.dataSnapshot(namespaces, startTime, rotatedCommitlogID)
+		if snapshotErr != nil {
+			multiErr = multiErr.Add(snapshotErr)
 		}
 	} else {
 		multiErr = multiErr.Add(fmt.Errorf("error rotating commitlog in mediator tick: %v", err))
@@ -189,8 +214,18 @@ func (m *flushManager) dataWarmFlush(
 	}
 
 	m.setState(flushManagerFlushInProgress)
-	multiErr := xerrors.NewMultiError()
+	var (
+		multiErr         = xerrors.NewMultiError()
+		rollupNamespaces []databaseNamespace
+	)
 	for _, ns := range namespaces {
+		if ns.Options().RollupOptions().Enabled() {
+			// Namespaces populated by rollup are persisted once their source
+			// namespaces have been flushed below.
+			rollupNamespaces = append(rollupNamespaces, ns)
+			continue
+		}
+
 		// Flush first because we will only snapshot if there are no outstanding flushes.
 		flushTimes, err := m.namespaceFlushTimes(ns, startTime)
 		if err != nil {
@@ -203,6 +238,23 @@ func (m *flushManager) dataWarmFlush(
 		}
 	}
 
+	for _, ns := range rollupNamespaces {
+		source, err := rollupSource(ns, namespaces)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		rollupTimes, err := m.namespaceRollupTimes(ns, source, startTime)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		err = m.rollupNamespaceWithTimes(ns, source, rollupTimes, flushPersist)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
 	err = flushPersist.DoneFlush()
 	if err != nil {
 		multiErr = multiErr.Add(err)
@@ -211,6 +263,68 @@ func (m *flushManager) dataWarmFlush(
 	return multiErr.FinalError()
 }
 
+func (m *flushManager) Rollup(
+	namespace ident.ID,
+	start time.Time,
+	end time.Time,
+) error {
+	// ensure rollups do not run concurrently with a flush
+	m.Lock()
+	if m.state != flushManagerIdle {
+		m.Unlock()
+		return errFlushOperationsInProgress
+	}
+	m.state = flushManagerNotIdle
+	m.Unlock()
+
+	defer m.setState(flushManagerIdle)
+
+	namespaces, err := m.database.GetOwnedNamespaces()
+	if err != nil {
+		return err
+	}
+	var ns databaseNamespace
+	for _, elem := range namespaces {
+		if elem.ID().Equal(namespace) {
+			ns = elem
+			break
+		}
+	}
+	if ns == nil {
+		return dberrors.NewUnknownNamespaceError(namespace.String())
+	}
+	if !ns.Options().RollupOptions().Enabled() {
+		return errNamespaceRollupNotEnabled
+	}
+	source, err := rollupSource(ns, namespaces)
+	if err != nil {
+		return err
+	}
+
+	var (
+		blockSize   = ns.Options().RetentionOptions().BlockSize()
+		rollupTimes = timesInRange(start.Truncate(blockSize), end.Add(-1).Truncate(blockSize), blockSize)
+	)
+	rollupTimes = filterTimes(rollupTimes, func(t time.Time) bool {
+		return t.Before(end)
+	})
+
+	flushPersist, err := m.pm.StartFlushPersist()
+	if err != nil {
+		return err
+	}
+
+	m.setState(flushManagerFlushInProgress)
+	multiErr := xerrors.NewMultiError()
+	if err := m.rollupNamespaceWithTimes(ns, source, rollupTimes, flushPersist); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	if err := flushPersist.DoneFlush(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	return multiErr.FinalError()
+}
+
 func (m *flushManager) dataColdFlush(
 	namespaces []databaseNamespace,
 ) error {
@@ -374,6 +488,37 @@ func (m *flushManager) namespaceFlushTimes(ns databaseNamespace, curr time.Time)
 	}), loopErr
 }
 
+// namespaceRollupTimes returns the block starts of a rollup namespace that
+// still need to be persisted and whose source blocks are all within the
+// retention of the source namespace.
+func (m *flushManager) namespaceRollupTimes(
+	ns databaseNamespace,
+	source databaseNamespace,
+	curr time.Time,
+) ([]time.Time, error) {
+	var (
+		rOpts            = ns.Options().RetentionOptions()
+		blockSize        = rOpts.BlockSize()
+		earliest, latest = m.flushRange(rOpts, curr)
+		sourceEarliest   = retention.FlushTimeStart(source.Options().RetentionOptions(), curr)
+	)
+	if sourceEarliest.After(earliest) {
+		// Round up so only blocks entirely within source retention are rolled up.
+		earliest = sourceEarliest.Add(blockSize - 1).Truncate(blockSize)
+	}
+
+	candidateTimes := timesInRange(earliest, latest, blockSize)
+	var loopErr error
+	return filterTimes(candidateTimes, func(t time.Time) bool {
+		needsFlush, err := ns.NeedsFlush(t, t)
+		if err != nil {
+			loopErr = err
+			return false
+		}
+		return needsFlush
+	}), loopErr
+}
+
 func (m *flushManager) namespaceSnapshotTimes(ns databaseNamespace, curr time.Time) ([]time.Time, error) {
 	var (
 		rOpts     = ns.Options().RetentionOptions()
@@ -422,6 +567,56 @@ func (m *flushManager) flushNamespaceWithTimes(
 	return multiErr.FinalError()
 }
 
+// rollupSource returns the source namespace of a rollup namespace.
+func rollupSource(
+	ns databaseNamespace,
+	namespaces []databaseNamespace,
+) (databaseNamespace, error) {
+	sourceID := ns.Options().RollupOptions().SourceNamespace()
+	for _, source := range namespaces {
+		if source.ID().Equal(sourceID) {
+			return source, nil
+		}
+	}
+	return nil, fmt.Errorf("namespace %s failed to rollup from %s: %v",
+		ns.ID().String(), sourceID.String(), errRollupSourceNotFound)
+}
+
+// rollupNamespaceWithTimes rolls up the source namespace blocks into the
+// given namespace at the given times, returning any error encountered.
+func (m *flushManager) rollupNamespaceWithTimes(
+	ns databaseNamespace,
+	source databaseNamespace,
+	times []time.Time,
+	flushPreparer persist.FlushPreparer,
+) error {
+	multiErr := xerrors.NewMultiError()
+	for _, t := range times {
+		// NB: we still want to proceed if a namespace fails to rollup a block.
+		if err := ns.Rollup(source, t, flushPreparer); err != nil {
+			detailedErr := fmt.Errorf("namespace %s failed to rollup data: %v",
+				ns.ID().String(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+	return multiErr.FinalError()
+}
+
 func (m *flushManager) LastSuccessfulSnapshotStartTime() (time.Time, bool) {
 	return m.lastSuccessfulSnapshotStartTime, !m.lastSuccessfulSnapshotStartTime.IsZero()
 }
+
+func (m *flushManager) RequestSnapshot() <-chan snapshotResult {
+	req := make(chan snapshotResult, 1)
+	m.Lock()
+	m.snapshotRequests = append(m.snapshotRequests, req)
+	m.Unlock()
+	return req
+}
+
+func (m *flushManager) SnapshotRequested() bool {
+	m.RLock()
+	requested := len(m.snapshotRequests) > 0
+	m.RUnlock()
+	return requested
+}
@@ -564,11 +564,20 @@ func TestFlushManagerFlushSnapshot(t *testing.T) {
 		}
 	}
 
+	requested := fm.RequestSnapshot()
+	require.True(t, fm.SnapshotRequested())
+
 	require.NoError(t, fm.Flush(now))
 
 	lastSuccessfulSnapshot, ok := fm.LastSuccessfulSnapshotStartTime()
 	require.True(t, ok)
 	require.Equal(t, now, lastSuccessfulSnapshot)
+
+	// The requested snapshot is served by the flush.
+	require.False(t, fm.SnapshotRequested())
+	result := <-requested
+	require.NoError(t, result.err)
+	require.Equal(t, now, result.startTime)
 }
 
 type timesInOrder []time.Time
@@ -42,6 +42,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/storage/index/compaction"
 	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/idx"
@@ -1134,6 +1135,44 @@ func (i *nsIndex) queryWithSpan(
 		wg       sync.WaitGroup
 	)
 
+	// Reject the query up front if the node has already exceeded its query
+	// limits within the current lookback window.
+	queryLimits := i.opts.QueryLimits()
+	if err := queryLimits.AnyExceeded(); err != nil {
+		return false, err
+	}
+
+	// Bound the number of queries executing concurrently, waiting for a
+	// permit at most until the query deadline, queries without a deadline
+	// fail immediately if no permit is available.
+	var permitTimeout time.Duration
+	if timeout > 0 {
+		permitTimeout = deadline.Sub(i.nowFn())
+		if permitTimeout <= 0 {
+			return false, fmt.Errorf("index query timed out: %s", timeout.String())
+		}
+	}
+
+	permits := queryLimits.QueryPermits()
+	if err := permits.Acquire(permitTimeout); err != nil {
+		return false, err
+	}
+	defer permits.Release()
+
+	// NB: the docs matched are only counted from this goroutine, between
+	// launching each block query and once all have completed, so that the
+	// query is aborted before querying further blocks once over the limit.
+	var (
+		docsTracker = limits.NewQueryTracker(queryLimits.DocsLimit())
+		docsCounted int
+	)
+	countDocsMatched := func() error {
+		size := results.Size()
+		err := docsTracker.Inc(size - docsCounted)
+		docsCounted = size
+		return err
+	}
+
 	// Create a cancellable lifetime and cancel it at end of this method so that
 	// no child async task modifies the result after this method returns.
 	cancellable := resource.NewCancellableLifetime()
@@ -1150,6 +1189,10 @@ func (i *nsIndex) queryWithSpan(
 		// number of results that we're allowed to return. If thats the case, there
 		// is no value in kicking off more parallel queries, so we break out of
 		// the loop.
+		if err := countDocsMatched(); err != nil {
+			return false, err
+		}
+
 		size := results.Size()
 		alreadyExceededLimit := opts.LimitExceeded(size)
 		if alreadyExceededLimit {
@@ -1237,6 +1280,10 @@ func (i *nsIndex) queryWithSpan(
 		return false, err
 	}
 
+	if err := countDocsMatched(); err != nil {
+		return false, err
+	}
+
 	return exhaustive, nil
 }
 
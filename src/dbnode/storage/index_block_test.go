@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/m3ninx/index/segment"
@@ -623,6 +624,96 @@ func TestNamespaceIndexBlockQuery(t *testing.T) {
 	require.Len(t, spans, 11)
 }
 
+func TestNamespaceIndexBlockQueryDocsLimitExceeded(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{T: t})
+	defer ctrl.Finish()
+
+	retention := 2 * time.Hour
+	blockSize := time.Hour
+	now := time.Now().Truncate(blockSize).Add(10 * time.Minute)
+	t0 := now.Truncate(blockSize)
+	t0Nanos := xtime.ToUnixNano(t0)
+	t1 := t0.Add(1 * blockSize)
+	nowFn := func() time.Time {
+		return now
+	}
+
+	queryLimits, err := limits.NewQueryLimits(limits.NewOptions().
+		SetDocsLimitOptions(limits.LookbackLimitOptions{
+			PerQueryLimit: 1,
+		}).
+		SetMaxConcurrentQueries(1))
+	require.NoError(t, err)
+
+	opts := DefaultTestOptions().SetQueryLimits(queryLimits)
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(nowFn))
+
+	b0 := index.NewMockBlock(ctrl)
+	b0.EXPECT().Stats(gomock.Any()).Return(nil).AnyTimes()
+	b0.EXPECT().Close().Return(nil)
+	b0.EXPECT().StartTime().Return(t0).AnyTimes()
+	b0.EXPECT().EndTime().Return(t0.Add(blockSize)).AnyTimes()
+	newBlockFn := func(
+		ts time.Time,
+		md namespace.Metadata,
+		_ index.BlockOptions,
+		io index.Options,
+	) (index.Block, error) {
+		if ts.Equal(t0) {
+			return b0, nil
+		}
+		panic("should never get here")
+	}
+	md := testNamespaceMetadata(blockSize, retention)
+	idx, err := newNamespaceIndexWithNewBlockFn(md, testShardSet, newBlockFn, opts)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, idx.Close())
+	}()
+
+	seg1 := segment.NewMockSegment(ctrl)
+	bootstrapResults := result.IndexResults{
+		t0Nanos: result.NewIndexBlock(t0, []segment.Segment{seg1}, result.NewShardTimeRanges(t0, t1, 1, 2, 3)),
+	}
+
+	b0.EXPECT().AddResults(bootstrapResults[t0Nanos]).Return(nil)
+	require.NoError(t, idx.Bootstrap(bootstrapResults))
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	q := defaultQuery
+	qOpts := index.QueryOptions{
+		StartInclusive: t0,
+		EndExclusive:   now.Add(time.Minute),
+	}
+
+	b0.EXPECT().Query(gomock.Any(), gomock.Any(), q, qOpts, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ interface{},
+			_ index.Query,
+			_ index.QueryOptions,
+			results index.BaseResults,
+			_ interface{},
+		) (bool, error) {
+			_, err := results.AddDocuments([]doc.Document{
+				{ID: []byte("foo")},
+				{ID: []byte("bar")},
+			})
+			return true, err
+		}).Times(2)
+
+	_, err = idx.Query(ctx, q, qOpts)
+	require.Error(t, err)
+	require.True(t, limits.IsQueryLimitExceededError(err))
+
+	// The query permit is released once the query completes.
+	_, err = idx.Query(ctx, q, qOpts)
+	require.True(t, limits.IsQueryLimitExceededError(err))
+}
+
 func TestNamespaceIndexBlockQueryReleasingContext(t *testing.T) {
 	ctrl := gomock.NewController(xtest.Reporter{T: t})
 	defer ctrl.Finish()
@@ -0,0 +1,134 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+var (
+	errNegativeLimit         = errors.New("limit must not be negative")
+	errNegativeLookback      = errors.New("lookback must not be negative")
+	errLimitWithoutLookback  = errors.New("lookback must be set when limit is set")
+	errNegativeMaxConcurrent = errors.New("max concurrent queries must not be negative")
+)
+
+type options struct {
+	iOpts                instrument.Options
+	docsLimitOpts        LookbackLimitOptions
+	seriesReadLimitOpts  LookbackLimitOptions
+	bytesReadLimitOpts   LookbackLimitOptions
+	maxConcurrentQueries int
+}
+
+// NewOptions creates new query limits options, all limits are disabled
+// by default.
+func NewOptions() Options {
+	return &options{
+		iOpts: instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	for _, opts := range []LookbackLimitOptions{
+		o.docsLimitOpts,
+		o.seriesReadLimitOpts,
+		o.bytesReadLimitOpts,
+	} {
+		if err := opts.validate(); err != nil {
+			return err
+		}
+	}
+
+	if o.maxConcurrentQueries < 0 {
+		return errNegativeMaxConcurrent
+	}
+
+	return nil
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.iOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.iOpts
+}
+
+func (o *options) SetDocsLimitOptions(value LookbackLimitOptions) Options {
+	opts := *o
+	opts.docsLimitOpts = value
+	return &opts
+}
+
+func (o *options) DocsLimitOptions() LookbackLimitOptions {
+	return o.docsLimitOpts
+}
+
+func (o *options) SetSeriesReadLimitOptions(value LookbackLimitOptions) Options {
+	opts := *o
+	opts.seriesReadLimitOpts = value
+	return &opts
+}
+
+func (o *options) SeriesReadLimitOptions() LookbackLimitOptions {
+	return o.seriesReadLimitOpts
+}
+
+func (o *options) SetBytesReadLimitOptions(value LookbackLimitOptions) Options {
+	opts := *o
+	opts.bytesReadLimitOpts = value
+	return &opts
+}
+
+func (o *options) BytesReadLimitOptions() LookbackLimitOptions {
+	return o.bytesReadLimitOpts
+}
+
+func (o *options) SetMaxConcurrentQueries(value int) Options {
+	opts := *o
+	opts.maxConcurrentQueries = value
+	return &opts
+}
+
+func (o *options) MaxConcurrentQueries() int {
+	return o.maxConcurrentQueries
+}
+
+func (o LookbackLimitOptions) validate() error {
+	if o.Limit < 0 || o.PerQueryLimit < 0 {
+		return errNegativeLimit
+	}
+
+	if o.Lookback < 0 {
+		return errNegativeLookback
+	}
+
+	if o.Limit > 0 && o.Lookback == 0 {
+		return errLimitWithoutLookback
+	}
+
+	return nil
+}
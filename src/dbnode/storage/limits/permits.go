@@ -0,0 +1,92 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber-go/tally"
+)
+
+type queryPermits struct {
+	max     int
+	permits chan struct{}
+
+	acquired tally.Counter
+	timedOut tally.Counter
+}
+
+func newQueryPermits(max int, scope tally.Scope) QueryPermits {
+	p := &queryPermits{
+		max:      max,
+		acquired: scope.Counter("permits-acquired"),
+		timedOut: scope.Counter("permits-timed-out"),
+	}
+	if max > 0 {
+		p.permits = make(chan struct{}, max)
+	}
+	return p
+}
+
+func (p *queryPermits) Acquire(timeout time.Duration) error {
+	if p.permits == nil {
+		// No limit on the number of concurrent queries.
+		return nil
+	}
+
+	select {
+	case p.permits <- struct{}{}:
+		p.acquired.Inc(1)
+		return nil
+	default:
+	}
+
+	if timeout <= 0 {
+		// Fail fast rather than block forever without a deadline.
+		p.timedOut.Inc(1)
+		return NewQueryLimitExceededError(fmt.Sprintf(
+			"query aborted, no permit available: max concurrent queries=%d",
+			p.max))
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case p.permits <- struct{}{}:
+		p.acquired.Inc(1)
+		return nil
+	case <-timer.C:
+		p.timedOut.Inc(1)
+		return NewQueryLimitExceededError(fmt.Sprintf(
+			"query aborted waiting for permit: max concurrent queries=%d, waited=%s",
+			p.max, timeout))
+	}
+}
+
+func (p *queryPermits) Release() {
+	if p.permits == nil {
+		return
+	}
+
+	<-p.permits
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestQueryPermits(t *testing.T) {
+	permits := newQueryPermits(2, tally.NoopScope)
+
+	require.NoError(t, permits.Acquire(time.Millisecond))
+	require.NoError(t, permits.Acquire(time.Millisecond))
+
+	err := permits.Acquire(time.Millisecond)
+	require.Error(t, err)
+	require.True(t, IsQueryLimitExceededError(err))
+
+	err = permits.Acquire(0)
+	require.Error(t, err)
+	require.True(t, IsQueryLimitExceededError(err))
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- permits.Acquire(time.Minute)
+	}()
+
+	permits.Release()
+	require.NoError(t, <-acquired)
+
+	permits.Release()
+	permits.Release()
+	require.NoError(t, permits.Acquire(0))
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	docsLimitName       = "docs-matched"
+	seriesReadLimitName = "series-read"
+	bytesReadLimitName  = "bytes-read"
+)
+
+type queryLimits struct {
+	docsLimit       *lookbackLimit
+	seriesReadLimit *lookbackLimit
+	bytesReadLimit  *lookbackLimit
+	permits         QueryPermits
+}
+
+type lookbackLimit struct {
+	name    string
+	options LookbackLimitOptions
+	metrics lookbackLimitMetrics
+	recent  int64
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+type lookbackLimitMetrics struct {
+	recent        tally.Gauge
+	total         tally.Counter
+	exceeded      tally.Counter
+	exceededQuery tally.Counter
+}
+
+var (
+	_ QueryLimits   = (*queryLimits)(nil)
+	_ LookbackLimit = (*lookbackLimit)(nil)
+)
+
+// NewQueryLimits returns new query limits.
+func NewQueryLimits(opts Options) (QueryLimits, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		iOpts = opts.InstrumentOptions()
+		scope = iOpts.MetricsScope().SubScope("query-limit")
+	)
+
+	return &queryLimits{
+		docsLimit: newLookbackLimit(docsLimitName,
+			opts.DocsLimitOptions(), scope),
+		seriesReadLimit: newLookbackLimit(seriesReadLimitName,
+			opts.SeriesReadLimitOptions(), scope),
+		bytesReadLimit: newLookbackLimit(bytesReadLimitName,
+			opts.BytesReadLimitOptions(), scope),
+		permits: newQueryPermits(opts.MaxConcurrentQueries(), scope),
+	}, nil
+}
+
+// NoOpQueryLimits returns query limits with all limits disabled.
+func NoOpQueryLimits() QueryLimits {
+	limits, _ := NewQueryLimits(NewOptions().
+		SetInstrumentOptions(instrument.NewOptions().
+			SetMetricsScope(tally.NoopScope)))
+	return limits
+}
+
+func newLookbackLimit(
+	name string,
+	opts LookbackLimitOptions,
+	scope tally.Scope,
+) *lookbackLimit {
+	scope = scope.Tagged(map[string]string{"limit": name})
+	return &lookbackLimit{
+		name:    name,
+		options: opts,
+		metrics: lookbackLimitMetrics{
+			recent:        scope.Gauge("recent"),
+			total:         scope.Counter("total"),
+			exceeded:      scope.Counter("exceeded"),
+			exceededQuery: scope.Counter("exceeded-per-query"),
+		},
+		stopCh: make(chan struct{}),
+	}
+}
+
+func (q *queryLimits) DocsLimit() LookbackLimit {
+	return q.docsLimit
+}
+
+func (q *queryLimits) SeriesReadLimit() LookbackLimit {
+	return q.seriesReadLimit
+}
+
+func (q *queryLimits) BytesReadLimit() LookbackLimit {
+	return q.bytesReadLimit
+}
+
+func (q *queryLimits) QueryPermits() QueryPermits {
+	return q.permits
+}
+
+func (q *queryLimits) AnyExceeded() error {
+	if err := q.docsLimit.Exceeded(); err != nil {
+		return err
+	}
+	if err := q.seriesReadLimit.Exceeded(); err != nil {
+		return err
+	}
+	return q.bytesReadLimit.Exceeded()
+}
+
+func (q *queryLimits) Start() {
+	q.docsLimit.start()
+	q.seriesReadLimit.start()
+	q.bytesReadLimit.start()
+}
+
+func (q *queryLimits) Stop() {
+	q.docsLimit.stop()
+	q.seriesReadLimit.stop()
+	q.bytesReadLimit.stop()
+}
+
+func (l *lookbackLimit) Options() LookbackLimitOptions {
+	return l.options
+}
+
+func (l *lookbackLimit) Inc(n int) error {
+	if n <= 0 {
+		return l.Exceeded()
+	}
+
+	recent := atomic.AddInt64(&l.recent, int64(n))
+	l.metrics.total.Inc(int64(n))
+	return l.checkLimit(recent)
+}
+
+func (l *lookbackLimit) Exceeded() error {
+	return l.checkLimit(atomic.LoadInt64(&l.recent))
+}
+
+func (l *lookbackLimit) checkLimit(recent int64) error {
+	if l.options.Limit > 0 && recent > l.options.Limit {
+		l.metrics.exceeded.Inc(1)
+		return NewQueryLimitExceededError(fmt.Sprintf(
+			"query aborted due to limit: name=%s, limit=%d, current=%d, within=%s",
+			l.name, l.options.Limit, recent, l.options.Lookback))
+	}
+	return nil
+}
+
+func (l *lookbackLimit) start() {
+	if l.options.Lookback <= 0 {
+		// Nothing to reset, the values are never checked against a limit
+		// unless there is a lookback window.
+		return
+	}
+
+	ticker := time.NewTicker(l.options.Lookback)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				l.reset()
+			case <-l.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (l *lookbackLimit) stop() {
+	l.stopOnce.Do(func() {
+		close(l.stopCh)
+	})
+}
+
+func (l *lookbackLimit) reset() {
+	recent := atomic.SwapInt64(&l.recent, 0)
+	l.metrics.recent.Update(float64(recent))
+}
+
+// QueryTracker tracks the usage of a single query against a lookback limit,
+// enforcing the per query limit as well as the lookback window limit.
+type QueryTracker struct {
+	limit LookbackLimit
+	total int64
+}
+
+// NewQueryTracker returns a new tracker for a single query.
+func NewQueryTracker(limit LookbackLimit) *QueryTracker {
+	return &QueryTracker{limit: limit}
+}
+
+// Inc increments the value for the query, returning an error if either the
+// per query limit or the lookback window limit has been exceeded.
+func (t *QueryTracker) Inc(n int) error {
+	total := atomic.AddInt64(&t.total, int64(n))
+	if err := t.limit.Inc(n); err != nil {
+		return err
+	}
+
+	opts := t.limit.Options()
+	if opts.PerQueryLimit > 0 && total > opts.PerQueryLimit {
+		if l, ok := t.limit.(*lookbackLimit); ok {
+			l.metrics.exceededQuery.Inc(1)
+		}
+		return NewQueryLimitExceededError(fmt.Sprintf(
+			"query aborted due to per query limit: limit=%d, current=%d",
+			opts.PerQueryLimit, total))
+	}
+
+	return nil
+}
+
+// Total returns the total value tracked for the query.
+func (t *QueryTracker) Total() int64 {
+	return atomic.LoadInt64(&t.total)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func testQueryLimitsOptions() Options {
+	return NewOptions().SetInstrumentOptions(
+		instrument.NewOptions().SetMetricsScope(tally.NewTestScope("", nil)))
+}
+
+func TestQueryLimitsValidate(t *testing.T) {
+	_, err := NewQueryLimits(testQueryLimitsOptions().
+		SetDocsLimitOptions(LookbackLimitOptions{Limit: 10}))
+	require.Equal(t, errLimitWithoutLookback, err)
+
+	_, err = NewQueryLimits(testQueryLimitsOptions().
+		SetBytesReadLimitOptions(LookbackLimitOptions{PerQueryLimit: -1}))
+	require.Equal(t, errNegativeLimit, err)
+
+	_, err = NewQueryLimits(testQueryLimitsOptions().
+		SetMaxConcurrentQueries(-1))
+	require.Equal(t, errNegativeMaxConcurrent, err)
+}
+
+func TestLookbackLimit(t *testing.T) {
+	limits, err := NewQueryLimits(testQueryLimitsOptions().
+		SetDocsLimitOptions(LookbackLimitOptions{
+			Limit:    10,
+			Lookback: time.Hour,
+		}))
+	require.NoError(t, err)
+
+	docs := limits.DocsLimit()
+	require.NoError(t, docs.Inc(5))
+	require.NoError(t, docs.Inc(5))
+	require.NoError(t, limits.AnyExceeded())
+
+	err = docs.Inc(1)
+	require.Error(t, err)
+	require.True(t, IsQueryLimitExceededError(err))
+	require.True(t, xerrors.IsInvalidParams(err))
+	require.Error(t, limits.AnyExceeded())
+
+	// Other limits are unaffected.
+	require.NoError(t, limits.SeriesReadLimit().Inc(100))
+
+	docs.(*lookbackLimit).reset()
+	require.NoError(t, limits.AnyExceeded())
+	require.NoError(t, docs.Inc(10))
+}
+
+func TestLookbackLimitResetsOnLookback(t *testing.T) {
+	limits, err := NewQueryLimits(testQueryLimitsOptions().
+		SetSeriesReadLimitOptions(LookbackLimitOptions{
+			Limit:    1,
+			Lookback: 10 * time.Millisecond,
+		}))
+	require.NoError(t, err)
+
+	limits.Start()
+	defer limits.Stop()
+
+	require.Error(t, limits.SeriesReadLimit().Inc(2))
+	for start := time.Now(); time.Since(start) < 5*time.Second; {
+		if limits.AnyExceeded() == nil {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	require.FailNow(t, "limit was not reset after lookback")
+}
+
+func TestQueryTracker(t *testing.T) {
+	limits, err := NewQueryLimits(testQueryLimitsOptions().
+		SetBytesReadLimitOptions(LookbackLimitOptions{
+			Limit:         100,
+			Lookback:      time.Hour,
+			PerQueryLimit: 10,
+		}))
+	require.NoError(t, err)
+
+	first := NewQueryTracker(limits.BytesReadLimit())
+	require.NoError(t, first.Inc(10))
+	err = first.Inc(1)
+	require.Error(t, err)
+	require.True(t, IsQueryLimitExceededError(err))
+	require.Equal(t, int64(11), first.Total())
+
+	// A new query starts from zero, but the lookback window is shared.
+	second := NewQueryTracker(limits.BytesReadLimit())
+	require.NoError(t, second.Inc(10))
+	require.Equal(t, int64(21), limits.BytesReadLimit().(*lookbackLimit).recent)
+}
+
+func TestNoOpQueryLimits(t *testing.T) {
+	limits := NoOpQueryLimits()
+	tracker := NewQueryTracker(limits.DocsLimit())
+	require.NoError(t, tracker.Inc(1<<30))
+	require.NoError(t, limits.AnyExceeded())
+	require.NoError(t, limits.QueryPermits().Acquire(0))
+	limits.QueryPermits().Release()
+}
+
+func TestIsQueryLimitExceededError(t *testing.T) {
+	err := NewQueryLimitExceededError("exceeded")
+	require.True(t, IsQueryLimitExceededError(err))
+	require.True(t, IsQueryLimitExceededError(xerrors.Wrap(err, "wrapped")))
+	require.False(t, IsQueryLimitExceededError(
+		xerrors.NewInvalidParamsError(errors.New("other"))))
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+// QueryLimits provides an interface for managing the resource limits that
+// are applied to queries.
+type QueryLimits interface {
+	// DocsLimit limits the number of index documents matched by queries.
+	DocsLimit() LookbackLimit
+
+	// SeriesReadLimit limits the number of series read by queries.
+	SeriesReadLimit() LookbackLimit
+
+	// BytesReadLimit limits the number of encoded bytes read by queries.
+	BytesReadLimit() LookbackLimit
+
+	// QueryPermits returns the permits that bound the number of queries
+	// executing concurrently.
+	QueryPermits() QueryPermits
+
+	// AnyExceeded returns an error if any of the limits have been exceeded
+	// within their current lookback window.
+	AnyExceeded() error
+
+	// Start begins resetting the limits at the end of each lookback window.
+	Start()
+
+	// Stop stops resetting the limits.
+	Stop()
+}
+
+// LookbackLimit tracks a value, such as the number of documents matched,
+// across all queries within a rolling lookback window.
+type LookbackLimit interface {
+	// Options returns the options of the limit.
+	Options() LookbackLimitOptions
+
+	// Inc increments the value within the current lookback window, returning
+	// an error if the limit has been exceeded.
+	Inc(n int) error
+
+	// Exceeded returns an error if the limit has been exceeded within the
+	// current lookback window.
+	Exceeded() error
+}
+
+// LookbackLimitOptions are the options for a lookback limit.
+type LookbackLimitOptions struct {
+	// Limit is the maximum value allowed across all queries within a single
+	// lookback window, a value of zero disables the limit.
+	Limit int64
+
+	// Lookback is the duration of the window after which the value is reset.
+	Lookback time.Duration
+
+	// PerQueryLimit is the maximum value allowed for a single query, a value
+	// of zero disables the limit.
+	PerQueryLimit int64
+}
+
+// QueryPermits bounds the number of queries executing concurrently.
+type QueryPermits interface {
+	// Acquire acquires a permit, waiting at most the given timeout for one to
+	// become available, a timeout of zero fails immediately if none is.
+	Acquire(timeout time.Duration) error
+
+	// Release releases a previously acquired permit.
+	Release()
+}
+
+// Options is a set of options for query limits.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+
+	// SetDocsLimitOptions sets the options for the docs matched limit.
+	SetDocsLimitOptions(value LookbackLimitOptions) Options
+
+	// DocsLimitOptions returns the options for the docs matched limit.
+	DocsLimitOptions() LookbackLimitOptions
+
+	// SetSeriesReadLimitOptions sets the options for the series read limit.
+	SetSeriesReadLimitOptions(value LookbackLimitOptions) Options
+
+	// SeriesReadLimitOptions returns the options for the series read limit.
+	SeriesReadLimitOptions() LookbackLimitOptions
+
+	// SetBytesReadLimitOptions sets the options for the bytes read limit.
+	SetBytesReadLimitOptions(value LookbackLimitOptions) Options
+
+	// BytesReadLimitOptions returns the options for the bytes read limit.
+	BytesReadLimitOptions() LookbackLimitOptions
+
+	// SetMaxConcurrentQueries sets the maximum number of queries that may
+	// execute concurrently, a value of zero disables the limit.
+	SetMaxConcurrentQueries(value int) Options
+
+	// MaxConcurrentQueries returns the maximum number of queries that may
+	// execute concurrently.
+	MaxConcurrentQueries() int
+}
@@ -189,7 +189,11 @@ func (m *mediator) ongoingFilesystemProcesses() {
 		case <-m.closedCh:
 			return
 		default:
-			m.sleepFn(tickCheckInterval)
+			// Run as soon as the current tick completes when a snapshot has
+			// been requested rather than waiting for the next check.
+			if !m.databaseFileSystemManager.SnapshotRequested() {
+				m.sleepFn(tickCheckInterval)
+			}
 			// See comment over mediatorTimeBarrier for an explanation of this logic.
 			mediatorTime, err := m.mediatorTimeBarrier.fsProcessesWait()
 			if err != nil {
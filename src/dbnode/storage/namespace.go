@@ -58,6 +58,8 @@ import (
 var (
 	errNamespaceAlreadyClosed    = errors.New("namespace already closed")
 	errNamespaceIndexingDisabled = errors.New("namespace indexing is disabled")
+	errNamespaceRollupNotEnabled = errors.New("namespace rollup is not enabled")
+	errNamespaceRollupReadOnly   = errors.New("namespace is populated by rollup and does not accept writes")
 )
 
 type commitLogWriter interface {
@@ -128,6 +130,7 @@ type dbNamespace struct {
 	increasingIndex increasingIndex
 	commitLogWriter commitLogWriter
 	reverseIndex    namespaceIndex
+	seriesQuota     *namespaceSeriesQuota
 
 	tickWorkers            xsync.WorkerPool
 	tickWorkersConcurrency int
@@ -154,6 +157,7 @@ type databaseNamespaceMetrics struct {
 	flushWarmData       instrument.MethodMetrics
 	flushColdData       instrument.MethodMetrics
 	flushIndex          instrument.MethodMetrics
+	rollup              instrument.MethodMetrics
 	snapshot            instrument.MethodMetrics
 	write               instrument.MethodMetrics
 	writeTagged         instrument.MethodMetrics
@@ -233,6 +237,7 @@ func newDatabaseNamespaceMetrics(scope tally.Scope, samplingRate float64) databa
 		flushWarmData:       instrument.NewMethodMetrics(scope, "flushWarmData", samplingRate),
 		flushColdData:       instrument.NewMethodMetrics(scope, "flushColdData", samplingRate),
 		flushIndex:          instrument.NewMethodMetrics(scope, "flushIndex", samplingRate),
+		rollup:              instrument.NewMethodMetrics(scope, "rollup", samplingRate),
 		snapshot:            instrument.NewMethodMetrics(scope, "snapshot", samplingRate),
 		write:               instrument.NewMethodMetrics(scope, "write", overrideWriteSamplingRate),
 		writeTagged:         instrument.NewMethodMetrics(scope, "write-tagged", overrideWriteSamplingRate),
@@ -347,6 +352,7 @@ func newDatabaseNamespace(
 		increasingIndex:        increasingIndex,
 		commitLogWriter:        commitLogWriter,
 		reverseIndex:           index,
+		seriesQuota:            newNamespaceSeriesQuota(id, scope, opts),
 		tickWorkers:            tickWorkers,
 		tickWorkersConcurrency: tickWorkersConcurrency,
 		metrics:                newDatabaseNamespaceMetrics(scope, iops.MetricsSamplingRate()),
@@ -479,7 +485,7 @@ func (n *dbNamespace) AssignShardSet(shardSet sharding.ShardSet) {
 			bootstrapEnabled := n.nopts.BootstrapEnabled()
 			n.shards[shard] = newDatabaseShard(metadata, shard, n.blockRetriever,
 				n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-				bootstrapEnabled, n.opts, n.seriesOpts)
+				n.seriesQuota, bootstrapEnabled, n.opts, n.seriesOpts)
 			n.metrics.shards.add.Inc(1)
 		}
 	}
@@ -624,6 +630,10 @@ func (n *dbNamespace) Write(
 	annotation []byte,
 ) (ts.Series, bool, error) {
 	callStart := n.nowFn()
+	if n.nopts.RollupOptions().Enabled() {
+		n.metrics.write.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, false, xerrors.NewInvalidParamsError(errNamespaceRollupReadOnly)
+	}
 	shard, nsCtx, err := n.shardFor(id)
 	if err != nil {
 		n.metrics.write.ReportError(n.nowFn().Sub(callStart))
@@ -653,6 +663,10 @@ func (n *dbNamespace) WriteTagged(
 		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
 		return ts.Series{}, false, errNamespaceIndexingDisabled
 	}
+	if n.nopts.RollupOptions().Enabled() {
+		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
+		return ts.Series{}, false, xerrors.NewInvalidParamsError(errNamespaceRollupReadOnly)
+	}
 	shard, nsCtx, err := n.shardFor(id)
 	if err != nil {
 		n.metrics.writeTagged.ReportError(n.nowFn().Sub(callStart))
@@ -1000,6 +1014,114 @@ func (n *dbNamespace) WarmFlush(
 	return res
 }
 
+func (n *dbNamespace) Rollup(
+	source databaseNamespace,
+	blockStart time.Time,
+	flushPersist persist.FlushPreparer,
+) error {
+	callStart := n.nowFn()
+
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		n.metrics.rollup.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceNotBootstrapped
+	}
+	n.RUnlock()
+
+	if !n.nopts.RollupOptions().Enabled() {
+		n.metrics.rollup.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceRollupNotEnabled
+	}
+
+	var (
+		bs       = n.nopts.RetentionOptions().BlockSize()
+		sourceBs = source.Options().RetentionOptions().BlockSize()
+	)
+	if t := blockStart.Truncate(bs); !blockStart.Equal(t) {
+		return fmt.Errorf("failed to rollup at time %v, not aligned to blockSize", blockStart.String())
+	}
+	if bs%sourceBs != 0 {
+		return fmt.Errorf("failed to rollup namespace %s: block size %v is not a multiple of source block size %v",
+			n.id.String(), bs, sourceBs)
+	}
+
+	sourceShards := make(map[uint32]databaseShard)
+	for _, shard := range source.GetOwnedShards() {
+		sourceShards[shard.ID()] = shard
+	}
+
+	multiErr := xerrors.NewMultiError()
+	shards := n.GetOwnedShards()
+	for _, shard := range shards {
+		if !shard.IsBootstrapped() {
+			n.log.
+				With(zap.Uint32("shard", shard.ID())).
+				Debug("skipping rollup due to shard not bootstrapped yet")
+			continue
+		}
+
+		flushState, err := shard.FlushState(blockStart)
+		if err != nil {
+			return err
+		}
+		// skip rolling up if the shard has already persisted data for the `blockStart`
+		if flushState.WarmStatus == fileOpSuccess {
+			continue
+		}
+
+		sourceShard, ok := sourceShards[shard.ID()]
+		if !ok {
+			n.log.
+				With(zap.Uint32("shard", shard.ID())).
+				Debug("skipping rollup due to source shard not owned")
+			continue
+		}
+		ready, err := sourceBlocksFlushed(sourceShard, blockStart, blockStart.Add(bs), sourceBs)
+		if err != nil {
+			return err
+		}
+		if !ready {
+			// The source blocks will be rolled up once they have all been flushed.
+			continue
+		}
+
+		// NB: as with warm flushes we still want to proceed if a shard fails to rollup.
+		if err := shard.Rollup(source.Metadata(), blockStart, flushPersist); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to rollup data: %v",
+				shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	res := multiErr.FinalError()
+	n.metrics.rollup.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
+	return res
+}
+
+// sourceBlocksFlushed returns whether all blocks in [start, end) of the
+// source shard have been successfully flushed.
+func sourceBlocksFlushed(
+	shard databaseShard,
+	start time.Time,
+	end time.Time,
+	blockSize time.Duration,
+) (bool, error) {
+	if !shard.IsBootstrapped() {
+		return false, nil
+	}
+	for t := start; t.Before(end); t = t.Add(blockSize) {
+		flushState, err := shard.FlushState(t)
+		if err != nil {
+			return false, err
+		}
+		if flushState.WarmStatus != fileOpSuccess {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // idAndBlockStart is the composite key for the genny map used to keep track of
 // dirty series that need to be ColdFlushed.
 type idAndBlockStart struct {
@@ -1394,7 +1516,7 @@ func (n *dbNamespace) initShards(needBootstrap bool) {
 	for _, shard := range shards {
 		dbShards[shard] = newDatabaseShard(n.metadata, shard, n.blockRetriever,
 			n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-			needBootstrap, n.opts, n.seriesOpts)
+			n.seriesQuota, needBootstrap, n.opts, n.seriesOpts)
 	}
 	n.shards = dbShards
 	n.Unlock()
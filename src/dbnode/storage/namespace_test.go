@@ -199,6 +199,25 @@ func TestNamespaceWriteShardNotOwned(t *testing.T) {
 	require.False(t, wasWritten)
 }
 
+func TestNamespaceWriteRollupNamespaceRejected(t *testing.T) {
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	opts := defaultTestNs1Opts.SetRollupOptions(namespace.NewRollupOptions().
+		SetEnabled(true).
+		SetSourceNamespace(defaultTestNs2ID).
+		SetResolution(time.Minute).
+		SetAggregations([]namespace.RollupAggregation{namespace.RollupAggregationLast}))
+	ns, closer := newTestNamespaceWithIDOpts(t, defaultTestNs1ID, opts)
+	defer closer()
+
+	now := time.Now()
+	_, wasWritten, err := ns.Write(ctx, ident.StringID("foo"), now, 0.0, xtime.Second, nil)
+	require.Error(t, err)
+	require.True(t, xerrors.IsInvalidParams(err))
+	require.False(t, wasWritten)
+}
+
 func TestNamespaceWriteShardOwned(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
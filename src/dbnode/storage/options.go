@@ -40,6 +40,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -156,6 +157,7 @@ type options struct {
 	schemaReg                      namespace.SchemaRegistry
 	blockLeaseManager              block.LeaseManager
 	memoryTracker                  MemoryTracker
+	queryLimits                    limits.QueryLimits
 }
 
 // NewOptions creates a new set of storage options with defaults
@@ -226,6 +228,7 @@ func newOptions(poolOpts pool.ObjectPoolOptions) Options {
 		checkedBytesWrapperPool:        bytesWrapperPool,
 		schemaReg:                      namespace.NewSchemaRegistry(false, nil),
 		memoryTracker:                  NewMemoryTracker(NewMemoryTrackerOptions(defaultNumLoadedBytesLimit)),
+		queryLimits:                    limits.NoOpQueryLimits(),
 	}
 	return o.SetEncodingM3TSZPooled()
 }
@@ -743,3 +746,13 @@ func (o *options) SetMemoryTracker(memTracker MemoryTracker) Options {
 func (o *options) MemoryTracker() MemoryTracker {
 	return o.memoryTracker
 }
+
+func (o *options) SetQueryLimits(value limits.QueryLimits) Options {
+	opts := *o
+	opts.queryLimits = value
+	return &opts
+}
+
+func (o *options) QueryLimits() limits.QueryLimits {
+	return o.queryLimits
+}
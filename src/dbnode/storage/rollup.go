@@ -0,0 +1,309 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	// rollupAggregationTagName is the tag added to rolled up series to
+	// distinguish between aggregations when more than one is configured,
+	// it matches the tag used by the coordinator downsampler.
+	rollupAggregationTagName = []byte("agg")
+)
+
+// rollupAccumulator accumulates the datapoints of source filesets into
+// resolution aligned buckets per series so they can be persisted as a
+// single rolled up block.
+type rollupAccumulator struct {
+	opts     namespace.RollupOptions
+	iterPool encoding.ReaderIteratorPool
+	series   map[string]*rollupSeries
+}
+
+type rollupSeries struct {
+	id      ident.ID
+	tags    ident.Tags
+	buckets map[xtime.UnixNano]*rollupBucket
+}
+
+type rollupBucket struct {
+	count float64
+	sum   float64
+	sumSq float64
+	min   float64
+	max   float64
+	last  float64
+}
+
+func newRollupAccumulator(
+	opts namespace.RollupOptions,
+	iterPool encoding.ReaderIteratorPool,
+) *rollupAccumulator {
+	return &rollupAccumulator{
+		opts:     opts,
+		iterPool: iterPool,
+		series:   make(map[string]*rollupSeries),
+	}
+}
+
+// AddFileSet accumulates all series of the given source fileset, filesets
+// must be added in ascending block start order.
+func (a *rollupAccumulator) AddFileSet(
+	fsOpts fs.Options,
+	fileSetID fs.FileSetFileIdentifier,
+) error {
+	reader, err := fs.NewReader(nil, fsOpts)
+	if err != nil {
+		return err
+	}
+	err = reader.Open(fs.DataReaderOpenOptions{
+		Identifier:  fileSetID,
+		FileSetType: persist.FileSetFlushType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to open source fileset: namespace=%s, shard=%d, blockStart=%v: %v",
+			fileSetID.Namespace.String(), fileSetID.Shard, fileSetID.BlockStart, err)
+	}
+	defer reader.Close()
+
+	for {
+		id, tagsIter, data, _, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		series, err := a.seriesFor(id, tagsIter)
+		if err != nil {
+			return err
+		}
+
+		data.IncRef()
+		err = a.addData(series, data.Bytes())
+		data.DecRef()
+		data.Finalize()
+		if err != nil {
+			return fmt.Errorf("unable to read series %s: %v", series.id.String(), err)
+		}
+	}
+}
+
+func (a *rollupAccumulator) seriesFor(
+	id ident.ID,
+	tagsIter ident.TagIterator,
+) (*rollupSeries, error) {
+	defer tagsIter.Close()
+
+	if series, ok := a.series[id.String()]; ok {
+		id.Finalize()
+		return series, nil
+	}
+
+	tags, err := convert.TagsFromTagsIter(id, tagsIter, nil)
+	if err != nil {
+		return nil, err
+	}
+	series := &rollupSeries{
+		id:      id,
+		tags:    tags,
+		buckets: make(map[xtime.UnixNano]*rollupBucket),
+	}
+	a.series[id.String()] = series
+	return series, nil
+}
+
+func (a *rollupAccumulator) addData(series *rollupSeries, data []byte) error {
+	iter := a.iterPool.Get()
+	iter.Reset(bytes.NewReader(data), nil)
+	defer iter.Close()
+
+	resolution := a.opts.Resolution()
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		if math.IsNaN(dp.Value) {
+			continue
+		}
+		bucketStart := xtime.ToUnixNano(dp.Timestamp.Truncate(resolution))
+		bucket, ok := series.buckets[bucketStart]
+		if !ok {
+			bucket = &rollupBucket{min: dp.Value, max: dp.Value}
+			series.buckets[bucketStart] = bucket
+		}
+		bucket.add(dp.Value)
+	}
+	return iter.Err()
+}
+
+// Persist encodes the accumulated buckets of each series for each of the
+// configured aggregations and persists them, returning the number of series
+// persisted.
+func (a *rollupAccumulator) Persist(
+	blockStart time.Time,
+	encoderPool encoding.EncoderPool,
+	persistFn persist.DataFn,
+) (int, error) {
+	var (
+		aggs        = a.opts.Aggregations()
+		unit        = rollupTimeUnit(a.opts.Resolution())
+		bucketTimes []xtime.UnixNano
+		numSeries   int
+	)
+	for _, series := range a.series {
+		bucketTimes = bucketTimes[:0]
+		for t := range series.buckets {
+			bucketTimes = append(bucketTimes, t)
+		}
+		if len(bucketTimes) == 0 {
+			continue
+		}
+		sort.Slice(bucketTimes, func(i, j int) bool {
+			return bucketTimes[i] < bucketTimes[j]
+		})
+
+		for _, agg := range aggs {
+			encoder := encoderPool.Get()
+			encoder.Reset(blockStart, len(bucketTimes), nil)
+			for _, t := range bucketTimes {
+				dp := ts.Datapoint{
+					Timestamp: t.ToTime(),
+					Value:     series.buckets[t].value(agg),
+				}
+				if err := encoder.Encode(dp, unit, nil); err != nil {
+					encoder.Close()
+					return numSeries, err
+				}
+			}
+
+			segment := encoder.Discard()
+			id, tags := series.id, series.tags
+			if len(aggs) > 1 {
+				id, tags = rollupAggregationIDAndTags(series, agg)
+			}
+			err := persistFn(id, tags, segment, digest.SegmentChecksum(segment))
+			segment.Finalize()
+			if err != nil {
+				return numSeries, err
+			}
+			numSeries++
+		}
+	}
+	return numSeries, nil
+}
+
+func (b *rollupBucket) add(value float64) {
+	b.count++
+	b.sum += value
+	b.sumSq += value * value
+	b.min = math.Min(b.min, value)
+	b.max = math.Max(b.max, value)
+	b.last = value
+}
+
+func (b *rollupBucket) value(agg namespace.RollupAggregation) float64 {
+	switch agg {
+	case namespace.RollupAggregationLast:
+		return b.last
+	case namespace.RollupAggregationMin:
+		return b.min
+	case namespace.RollupAggregationMax:
+		return b.max
+	case namespace.RollupAggregationMean:
+		return b.sum / b.count
+	case namespace.RollupAggregationCount:
+		return b.count
+	case namespace.RollupAggregationSum:
+		return b.sum
+	case namespace.RollupAggregationSumSq:
+		return b.sumSq
+	case namespace.RollupAggregationStdev:
+		if b.count <= 1 {
+			return 0
+		}
+		variance := (b.sumSq - b.sum*b.sum/b.count) / (b.count - 1)
+		if variance < 0 {
+			// Guard against floating point error for near constant series.
+			return 0
+		}
+		return math.Sqrt(variance)
+	default:
+		return math.NaN()
+	}
+}
+
+// rollupAggregationIDAndTags returns the ID and tags of the series holding
+// the given aggregation of a source series.
+func rollupAggregationIDAndTags(
+	series *rollupSeries,
+	agg namespace.RollupAggregation,
+) (ident.ID, ident.Tags) {
+	var (
+		aggName = agg.String()
+		values  = series.tags.Values()
+		tags    = make([]ident.Tag, 0, len(values)+1)
+	)
+	tags = append(tags, values...)
+	tags = append(tags, ident.Tag{
+		Name:  ident.BytesID(rollupAggregationTagName),
+		Value: ident.StringID(aggName),
+	})
+
+	id := make([]byte, 0, len(series.id.Bytes())+len(rollupAggregationTagName)+len(aggName)+2)
+	id = append(id, series.id.Bytes()...)
+	id = append(id, ',')
+	id = append(id, rollupAggregationTagName...)
+	id = append(id, '=')
+	id = append(id, aggName...)
+	return ident.BytesID(id), ident.NewTags(tags...)
+}
+
+// rollupTimeUnit returns the coarsest time unit that can represent
+// timestamps aligned to the given resolution.
+func rollupTimeUnit(resolution time.Duration) xtime.Unit {
+	switch {
+	case resolution%time.Second == 0:
+		return xtime.Second
+	case resolution%time.Millisecond == 0:
+		return xtime.Millisecond
+	case resolution%time.Microsecond == 0:
+		return xtime.Microsecond
+	default:
+		return xtime.Nanosecond
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupAccumulatorPersist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rollup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		opts            = DefaultTestOptions()
+		fsOpts          = fs.NewOptions().SetFilePathPrefix(dir)
+		sourceNs        = ident.StringID("raw")
+		sourceBlockSize = time.Hour
+		blockSize       = 2 * time.Hour
+		blockStart      = time.Now().Truncate(blockSize).Add(-blockSize)
+		id              = ident.StringID("foo")
+		tags            = ident.NewTags(ident.StringTag("city", "nyc"))
+	)
+
+	// Write a datapoint every minute valued by its minute offset in the block.
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		sourceStart := blockStart.Add(time.Duration(i) * sourceBlockSize)
+		require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+			FileSetType: persist.FileSetFlushType,
+			BlockSize:   sourceBlockSize,
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:  sourceNs,
+				Shard:      0,
+				BlockStart: sourceStart,
+			},
+		}))
+
+		encoder := opts.EncoderPool().Get()
+		encoder.Reset(sourceStart, 60, nil)
+		for j := 0; j < 60; j++ {
+			require.NoError(t, encoder.Encode(ts.Datapoint{
+				Timestamp: sourceStart.Add(time.Duration(j) * time.Minute),
+				Value:     float64(i*60 + j),
+			}, xtime.Second, nil))
+		}
+		segment := encoder.Discard()
+		require.NoError(t, writer.WriteAll(id, tags,
+			[]checked.Bytes{segment.Head, segment.Tail}, digest.SegmentChecksum(segment)))
+		require.NoError(t, writer.Close())
+	}
+
+	rollupOpts := namespace.NewRollupOptions().
+		SetEnabled(true).
+		SetSourceNamespace(sourceNs).
+		SetResolution(10 * time.Minute).
+		SetAggregations([]namespace.RollupAggregation{
+			namespace.RollupAggregationMax,
+			namespace.RollupAggregationCount,
+		})
+	accumulator := newRollupAccumulator(rollupOpts, opts.ReaderIteratorPool())
+	for i := 0; i < 2; i++ {
+		require.NoError(t, accumulator.AddFileSet(fsOpts, fs.FileSetFileIdentifier{
+			Namespace:  sourceNs,
+			Shard:      0,
+			BlockStart: blockStart.Add(time.Duration(i) * sourceBlockSize),
+		}))
+	}
+
+	persisted := make(map[string][]ts.Datapoint)
+	persistedTags := make(map[string]ident.Tags)
+	numSeries, err := accumulator.Persist(blockStart, opts.EncoderPool(),
+		func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
+			require.Equal(t, digest.SegmentChecksum(segment), checksum)
+
+			iter := opts.ReaderIteratorPool().Get()
+			iter.Reset(xio.NewSegmentReader(segment), nil)
+			defer iter.Close()
+
+			var dps []ts.Datapoint
+			for iter.Next() {
+				dp, _, _ := iter.Current()
+				dps = append(dps, dp)
+			}
+			require.NoError(t, iter.Err())
+			persisted[id.String()] = dps
+			persistedTags[id.String()] = tags
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, 2, numSeries)
+
+	maxDps, ok := persisted["foo,agg=max"]
+	require.True(t, ok)
+	countDps, ok := persisted["foo,agg=count"]
+	require.True(t, ok)
+	require.Len(t, maxDps, 12)
+	require.Len(t, countDps, 12)
+	for i := 0; i < 12; i++ {
+		bucketStart := blockStart.Add(time.Duration(i) * 10 * time.Minute)
+		require.True(t, bucketStart.Equal(maxDps[i].Timestamp))
+		require.Equal(t, float64(i*10+9), maxDps[i].Value)
+		require.True(t, bucketStart.Equal(countDps[i].Timestamp))
+		require.Equal(t, float64(10), countDps[i].Value)
+	}
+
+	maxTags := persistedTags["foo,agg=max"].Values()
+	require.Len(t, maxTags, 2)
+	require.Equal(t, "agg", maxTags[1].Name.String())
+	require.Equal(t, "max", maxTags[1].Value.String())
+}
+
+func TestRollupBucketStdev(t *testing.T) {
+	b := &rollupBucket{min: 2, max: 2}
+	for _, v := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		b.add(v)
+	}
+	require.Equal(t, float64(2), b.value(namespace.RollupAggregationMin))
+	require.Equal(t, float64(9), b.value(namespace.RollupAggregationMax))
+	require.Equal(t, float64(5), b.value(namespace.RollupAggregationMean))
+	require.Equal(t, float64(9), b.value(namespace.RollupAggregationLast))
+	require.InDelta(t, 2.138, b.value(namespace.RollupAggregationStdev), 0.001)
+}
+
+func TestAddRollupIndexResult(t *testing.T) {
+	var (
+		blockSize  = 2 * time.Hour
+		blockStart = time.Now().Truncate(blockSize)
+		idxOpts    = namespace.NewIndexOptions().SetEnabled(true).SetBlockSize(blockSize)
+		resultOpts = result.NewOptions()
+		results    = make(result.IndexResults)
+	)
+	for _, id := range []string{"foo", "bar", "foo"} {
+		tags := ident.NewTags(ident.StringTag("name", id))
+		err := addRollupIndexResult(results, ident.StringID(id), tags,
+			blockStart, idxOpts, resultOpts)
+		require.NoError(t, err)
+	}
+
+	block, ok := results[xtime.ToUnixNano(blockStart)]
+	require.True(t, ok)
+	require.Len(t, block.Segments(), 1)
+	require.Equal(t, int64(2), block.Segments()[0].Size())
+}
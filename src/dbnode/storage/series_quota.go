@@ -0,0 +1,137 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/clock"
+	dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	seriesQuotaRejectedLogInterval = 10 * time.Second
+)
+
+type seriesQuotaType string
+
+const (
+	seriesQuotaTypeShard seriesQuotaType = "shard"
+	seriesQuotaTypeNode  seriesQuotaType = "node"
+)
+
+// namespaceSeriesQuota tracks the number of active series across all the
+// shards of a namespace owned by this node so that shards can enforce the
+// per node series quota when inserting new series, the quota limits
+// themselves are read by each shard from the runtime options.
+type namespaceSeriesQuota struct {
+	activeSeries     int64
+	lastLoggedNanos  int64
+	rejectedSinceLog int64
+
+	namespace ident.ID
+	nowFn     clock.NowFn
+	logger    *zap.Logger
+	metrics   namespaceSeriesQuotaMetrics
+}
+
+type namespaceSeriesQuotaMetrics struct {
+	rejectedShard tally.Counter
+	rejectedNode  tally.Counter
+}
+
+func newNamespaceSeriesQuotaMetrics(scope tally.Scope) namespaceSeriesQuotaMetrics {
+	scope = scope.SubScope("series-quota")
+	return namespaceSeriesQuotaMetrics{
+		rejectedShard: scope.Tagged(map[string]string{
+			"quota_type": string(seriesQuotaTypeShard),
+		}).Counter("rejected"),
+		rejectedNode: scope.Tagged(map[string]string{
+			"quota_type": string(seriesQuotaTypeNode),
+		}).Counter("rejected"),
+	}
+}
+
+func newNamespaceSeriesQuota(
+	namespace ident.ID,
+	scope tally.Scope,
+	opts Options,
+) *namespaceSeriesQuota {
+	return &namespaceSeriesQuota{
+		namespace: namespace,
+		nowFn:     opts.ClockOptions().NowFn(),
+		logger:    opts.InstrumentOptions().Logger(),
+		metrics:   newNamespaceSeriesQuotaMetrics(scope),
+	}
+}
+
+// ActiveSeries returns the number of active series across all shards.
+func (q *namespaceSeriesQuota) ActiveSeries() int64 {
+	return atomic.LoadInt64(&q.activeSeries)
+}
+
+// Inserted records series that were inserted into a shard.
+func (q *namespaceSeriesQuota) Inserted(n int) {
+	atomic.AddInt64(&q.activeSeries, int64(n))
+}
+
+// Removed records series that were removed from a shard.
+func (q *namespaceSeriesQuota) Removed(n int) {
+	atomic.AddInt64(&q.activeSeries, -int64(n))
+}
+
+// Reject records a new series write that was rejected for exceeding the
+// quota and returns the error to return to the caller, the ID of rejected
+// series is logged at most once every log interval.
+func (q *namespaceSeriesQuota) Reject(
+	id ident.ID,
+	quotaType seriesQuotaType,
+	quota int,
+) error {
+	switch quotaType {
+	case seriesQuotaTypeShard:
+		q.metrics.rejectedShard.Inc(1)
+	case seriesQuotaTypeNode:
+		q.metrics.rejectedNode.Inc(1)
+	}
+
+	atomic.AddInt64(&q.rejectedSinceLog, 1)
+	now := q.nowFn().UnixNano()
+	last := atomic.LoadInt64(&q.lastLoggedNanos)
+	if now-last >= int64(seriesQuotaRejectedLogInterval) &&
+		atomic.CompareAndSwapInt64(&q.lastLoggedNanos, last, now) {
+		rejected := atomic.SwapInt64(&q.rejectedSinceLog, 0)
+		q.logger.Warn("rejected write for new series exceeding active series quota",
+			zap.Stringer("namespace", q.namespace),
+			zap.Stringer("sampleID", id),
+			zap.String("quotaType", string(quotaType)),
+			zap.Int("quota", quota),
+			zap.Int64("activeSeries", q.ActiveSeries()),
+			zap.Int64("rejectedSinceLastLog", rejected))
+	}
+
+	return dberrors.NewSeriesQuotaExceededError(q.namespace.String(), quota)
+}
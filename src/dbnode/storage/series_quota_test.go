@@ -0,0 +1,89 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/runtime"
+	dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardWriteNewSeriesShardQuota(t *testing.T) {
+	shard := testDatabaseShard(t, DefaultTestOptions())
+	shard.Bootstrap()
+	shard.SetRuntimeOptions(runtime.NewOptions().
+		SetMaxActiveSeriesPerNamespacePerShard(2))
+	defer shard.Close()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	writeShardAndVerify(ctx, t, shard, "foo", now, 1.0, true, 0)
+	writeShardAndVerify(ctx, t, shard, "bar", now, 2.0, true, 1)
+
+	// New series beyond the quota are rejected.
+	_, _, err := shard.Write(ctx, ident.StringID("baz"), now, 3.0,
+		xtime.Second, nil, series.WriteOptions{})
+	require.Error(t, err)
+	require.True(t, dberrors.IsSeriesQuotaExceededError(err))
+	require.Equal(t, int64(2), shard.NumSeries())
+
+	// Existing series continue to accept writes.
+	writeShardAndVerify(ctx, t, shard, "foo", now.Add(time.Second), 4.0, true, 0)
+}
+
+func TestShardWriteNewSeriesNodeQuota(t *testing.T) {
+	shard := testDatabaseShard(t, DefaultTestOptions())
+	shard.Bootstrap()
+	shard.SetRuntimeOptions(runtime.NewOptions().
+		SetMaxActiveSeriesPerNamespacePerNode(2))
+	defer shard.Close()
+
+	// Series inserted into other shards of the namespace count
+	// towards the per node quota.
+	shard.seriesQuota.Inserted(1)
+	require.Equal(t, int64(1), shard.seriesQuota.ActiveSeries())
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	writeShardAndVerify(ctx, t, shard, "foo", now, 1.0, true, 0)
+	require.Equal(t, int64(2), shard.seriesQuota.ActiveSeries())
+
+	_, _, err := shard.Write(ctx, ident.StringID("bar"), now, 2.0,
+		xtime.Second, nil, series.WriteOptions{})
+	require.Error(t, err)
+	require.True(t, dberrors.IsSeriesQuotaExceededError(err))
+
+	// Once series are removed new series are accepted again.
+	shard.seriesQuota.Removed(1)
+	writeShardAndVerify(ctx, t, shard, "bar", now, 2.0, true, 1)
+}
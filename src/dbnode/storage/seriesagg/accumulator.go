@@ -0,0 +1,223 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package seriesagg
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+var (
+	// metricNameTag is the tag holding the metric name, temporal functions
+	// drop the metric name so it is never used to group series.
+	metricNameTag = []byte("__name__")
+)
+
+// Accumulator evaluates series and aggregates them into groups.
+type Accumulator struct {
+	opts   Options
+	steps  int
+	groups map[string]*Group
+	keys   []string
+	values []float64
+	key    []byte
+}
+
+// NewAccumulator returns a new accumulator, the options must be valid.
+func NewAccumulator(opts Options) *Accumulator {
+	return &Accumulator{
+		opts:   opts,
+		steps:  opts.Steps(),
+		groups: make(map[string]*Group),
+	}
+}
+
+// Add evaluates the datapoints of a series, sorted by time, and aggregates
+// the result into the series' group.
+func (a *Accumulator) Add(tags ident.TagIterator, dps []ts.Datapoint) error {
+	groupTags, err := a.groupTags(tags)
+	if err != nil {
+		return err
+	}
+
+	a.key = groupKey(a.key[:0], groupTags)
+	group, ok := a.groups[string(a.key)]
+	if !ok {
+		group = &Group{
+			Tags:   groupTags,
+			Values: newValues(a.opts.Aggregation, a.steps),
+		}
+		key := string(a.key)
+		a.groups[key] = group
+		a.keys = append(a.keys, key)
+	}
+
+	a.values = evaluate(a.opts, dps, a.values[:0])
+	aggregate(a.opts.Aggregation, group.Values, a.values)
+	return nil
+}
+
+// Groups returns the aggregated groups in the order they were first seen.
+func (a *Accumulator) Groups() []Group {
+	groups := make([]Group, 0, len(a.keys))
+	for _, key := range a.keys {
+		groups = append(groups, *a.groups[key])
+	}
+	return groups
+}
+
+func (a *Accumulator) groupTags(tags ident.TagIterator) ([]Tag, error) {
+	var result []Tag
+	for tags.Next() {
+		tag := tags.Current()
+		name := tag.Name.Bytes()
+		if bytes.Equal(name, metricNameTag) {
+			continue
+		}
+		for _, groupBy := range a.opts.GroupBy {
+			if bytes.Equal(name, groupBy) {
+				result = append(result, Tag{
+					Name:  string(name),
+					Value: tag.Value.String(),
+				})
+				break
+			}
+		}
+	}
+	if err := tags.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// Merge merges the partial groups returned by separate accumulators, such
+// as accumulators evaluated on different nodes, into a single set of groups.
+func Merge(agg Aggregation, steps int, partials ...[]Group) []Group {
+	var (
+		key    []byte
+		keys   []string
+		groups = make(map[string]*Group)
+	)
+	for _, partial := range partials {
+		for _, p := range partial {
+			key = groupKey(key[:0], p.Tags)
+			group, ok := groups[string(key)]
+			if !ok {
+				group = &Group{
+					Tags:   p.Tags,
+					Values: newValues(agg, steps),
+				}
+				groups[string(key)] = group
+				keys = append(keys, string(key))
+			}
+
+			merge(agg, group.Values, p.Values)
+		}
+	}
+
+	result := make([]Group, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, *groups[key])
+	}
+	return result
+}
+
+func groupKey(dst []byte, tags []Tag) []byte {
+	for _, tag := range tags {
+		dst = append(dst, tag.Name...)
+		dst = append(dst, '=')
+		dst = append(dst, tag.Value...)
+		dst = append(dst, ',')
+	}
+	return dst
+}
+
+func newValues(agg Aggregation, steps int) []float64 {
+	init := math.NaN()
+	if agg == Count {
+		init = 0
+	}
+
+	values := make([]float64, steps)
+	for i := range values {
+		values[i] = init
+	}
+	return values
+}
+
+// aggregate aggregates the evaluated values of a single series into the
+// values of a group.
+func aggregate(agg Aggregation, dst []float64, values []float64) {
+	for i, v := range values {
+		if i >= len(dst) {
+			return
+		}
+		if math.IsNaN(v) {
+			continue
+		}
+		if agg == Count {
+			dst[i]++
+			continue
+		}
+		dst[i] = combine(agg, dst[i], v)
+	}
+}
+
+// merge merges the values of a partially aggregated group into the values
+// of a group.
+func merge(agg Aggregation, dst []float64, values []float64) {
+	for i, v := range values {
+		if i >= len(dst) {
+			return
+		}
+		if math.IsNaN(v) {
+			continue
+		}
+		if agg == Count {
+			dst[i] += v
+			continue
+		}
+		dst[i] = combine(agg, dst[i], v)
+	}
+}
+
+func combine(agg Aggregation, current, v float64) float64 {
+	if math.IsNaN(current) {
+		return v
+	}
+	switch agg {
+	case Sum:
+		return current + v
+	case Min:
+		return math.Min(current, v)
+	case Max:
+		return math.Max(current, v)
+	}
+	return current
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package seriesagg
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAccumulatorOptions(agg Aggregation) Options {
+	start := time.Unix(0, 0)
+	return Options{
+		Start:            start,
+		End:              start.Add(20 * time.Second),
+		Step:             10 * time.Second,
+		Range:            10 * time.Second,
+		TemporalFunction: SumOverTime,
+		Aggregation:      agg,
+		GroupBy:          [][]byte{[]byte("region"), []byte("__name__")},
+	}
+}
+
+func testTags(pairs ...string) ident.TagIterator {
+	tags := ident.NewTags(ident.StringTag("__name__", "requests"))
+	for i := 0; i+1 < len(pairs); i += 2 {
+		tags.Append(ident.StringTag(pairs[i], pairs[i+1]))
+	}
+	return ident.NewTagsIterator(tags)
+}
+
+func TestAccumulatorGroupsByTags(t *testing.T) {
+	opts := testAccumulatorOptions(Sum)
+	acc := NewAccumulator(opts)
+
+	start := opts.Start
+	require.NoError(t, acc.Add(testTags("region", "us", "host", "a"),
+		testDatapoints(start, 10*time.Second, 1, 2)))
+	require.NoError(t, acc.Add(testTags("region", "us", "host", "b"),
+		testDatapoints(start, 10*time.Second, 3, 4)))
+	require.NoError(t, acc.Add(testTags("region", "eu", "host", "c"),
+		testDatapoints(start, 10*time.Second, 5, math.NaN())))
+	require.NoError(t, acc.Add(testTags("host", "d"),
+		testDatapoints(start, 10*time.Second, 7, 8)))
+
+	groups := acc.Groups()
+	require.Len(t, groups, 3)
+	assert.Equal(t, []Tag{{Name: "region", Value: "us"}}, groups[0].Tags)
+	assert.Equal(t, []float64{4, 10}, groups[0].Values)
+	assert.Equal(t, []Tag{{Name: "region", Value: "eu"}}, groups[1].Tags)
+	assert.Equal(t, []float64{5, 5}, groups[1].Values)
+	assert.Empty(t, groups[2].Tags)
+	assert.Equal(t, []float64{7, 15}, groups[2].Values)
+}
+
+func TestAccumulatorCount(t *testing.T) {
+	opts := testAccumulatorOptions(Count)
+	opts.TemporalFunction = MaxOverTime
+	acc := NewAccumulator(opts)
+
+	start := opts.Start.Add(10 * time.Second)
+	require.NoError(t, acc.Add(testTags("region", "us"),
+		testDatapoints(start, 10*time.Second, 1)))
+	require.NoError(t, acc.Add(testTags("region", "us"),
+		testDatapoints(start, 10*time.Second, 2)))
+
+	groups := acc.Groups()
+	require.Len(t, groups, 1)
+	assert.Equal(t, []float64{0, 2}, groups[0].Values)
+}
+
+func TestMerge(t *testing.T) {
+	us := []Tag{{Name: "region", Value: "us"}}
+	eu := []Tag{{Name: "region", Value: "eu"}}
+	nan := math.NaN()
+
+	tests := []struct {
+		agg      Aggregation
+		expected [][]float64
+	}{
+		{agg: Sum, expected: [][]float64{{3, 2}, {5, nan}}},
+		{agg: Min, expected: [][]float64{{1, 2}, {5, nan}}},
+		{agg: Max, expected: [][]float64{{2, 2}, {5, nan}}},
+		{agg: Count, expected: [][]float64{{3, 2}, {5, 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.agg.String(), func(t *testing.T) {
+			var second []float64
+			if tt.agg == Count {
+				second = []float64{0, 0}
+			} else {
+				second = []float64{nan, nan}
+			}
+
+			groups := Merge(tt.agg, 2,
+				[]Group{{Tags: us, Values: []float64{1, 2}}},
+				[]Group{
+					{Tags: us, Values: []float64{2, nan}},
+					{Tags: eu, Values: []float64{5, nan}},
+				},
+				[]Group{{Tags: eu, Values: second}},
+			)
+
+			require.Len(t, groups, 2)
+			assert.Equal(t, us, groups[0].Tags)
+			assert.Equal(t, eu, groups[1].Tags)
+			for i, group := range groups {
+				for j, v := range group.Values {
+					expected := tt.expected[i][j]
+					if math.IsNaN(expected) {
+						assert.True(t, math.IsNaN(v))
+						continue
+					}
+					assert.Equal(t, expected, v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package seriesagg
+
+import (
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/ts"
+)
+
+// NB: the temporal functions mirror the semantics of the coordinator's
+// temporal functions (src/query/functions/temporal) so that pushed down
+// and coordinator evaluated queries return identical results.
+
+// evaluate applies the temporal function to the datapoints of a single
+// series, which must be sorted by time, appending one value per step.
+func evaluate(opts Options, dps []ts.Datapoint, values []float64) []float64 {
+	var (
+		steps = opts.Steps()
+		step  = int64(opts.Step)
+		end   = opts.Start.UnixNano()
+		start = end - int64(opts.Range)
+		init  = 0
+	)
+	for i := 0; i < steps; i++ {
+		var window []ts.Datapoint
+		if l, r, ok := windowIndices(dps, start, end, init); ok {
+			init = l
+			window = dps[l:r]
+		}
+
+		values = append(values, evaluateWindow(opts, window, start, end))
+		start += step
+		end += step
+	}
+	return values
+}
+
+// windowIndices returns the subslice indices of the datapoints within
+// [start, end] beginning the search at init.
+func windowIndices(
+	dps []ts.Datapoint,
+	start int64,
+	end int64,
+	init int,
+) (int, int, bool) {
+	if init >= len(dps) || init < 0 {
+		return -1, -1, false
+	}
+
+	l, r := -1, len(dps)
+	for i := init; i < len(dps); i++ {
+		t := dps[i].Timestamp.UnixNano()
+		if l < 0 {
+			if t < start {
+				continue
+			}
+			l = i
+		}
+		if t > end {
+			r = i
+			break
+		}
+	}
+
+	if l < 0 {
+		return init, r, false
+	}
+	return l, r, true
+}
+
+func evaluateWindow(
+	opts Options,
+	window []ts.Datapoint,
+	start int64,
+	end int64,
+) float64 {
+	switch opts.TemporalFunction {
+	case Rate:
+		return extrapolatedRate(window, true, true, start, end, opts.Range)
+	case Increase:
+		return extrapolatedRate(window, false, true, start, end, opts.Range)
+	case Delta:
+		return extrapolatedRate(window, false, false, start, end, opts.Range)
+	case AvgOverTime:
+		sum, count := sumAndCount(window)
+		return sum / count
+	case CountOverTime:
+		_, count := sumAndCount(window)
+		if count == 0 {
+			return math.NaN()
+		}
+		return count
+	case MinOverTime:
+		return minOrMax(window, math.Min)
+	case MaxOverTime:
+		return minOrMax(window, math.Max)
+	case SumOverTime:
+		sum, _ := sumAndCount(window)
+		return sum
+	}
+	return math.NaN()
+}
+
+func sumAndCount(window []ts.Datapoint) (float64, float64) {
+	var sum, count float64
+	for _, dp := range window {
+		if !math.IsNaN(dp.Value) {
+			sum += dp.Value
+			count++
+		}
+	}
+	if count == 0 {
+		return math.NaN(), 0
+	}
+	return sum, count
+}
+
+func minOrMax(window []ts.Datapoint, fn func(float64, float64) float64) float64 {
+	result := math.NaN()
+	for _, dp := range window {
+		if math.IsNaN(dp.Value) {
+			continue
+		}
+		if math.IsNaN(result) {
+			result = dp.Value
+			continue
+		}
+		result = fn(result, dp.Value)
+	}
+	return result
+}
+
+func extrapolatedRate(
+	window []ts.Datapoint,
+	isRate, isCounter bool,
+	rangeStart int64,
+	rangeEnd int64,
+	timeWindow time.Duration,
+) float64 {
+	if len(window) < 2 {
+		return math.NaN()
+	}
+
+	var (
+		counterCorrection   float64
+		firstVal, lastValue float64
+		firstIdx, lastIdx   int
+		firstTS, lastTS     int64
+		foundFirst          bool
+	)
+	for i, dp := range window {
+		if math.IsNaN(dp.Value) {
+			continue
+		}
+
+		if !foundFirst {
+			firstVal = dp.Value
+			firstTS = dp.Timestamp.UnixNano()
+			firstIdx = i
+			foundFirst = true
+		}
+
+		if isCounter && dp.Value < lastValue {
+			counterCorrection += lastValue
+		}
+
+		lastValue = dp.Value
+		lastTS = dp.Timestamp.UnixNano()
+		lastIdx = i
+	}
+
+	if firstIdx == lastIdx {
+		return math.NaN()
+	}
+
+	durationToStart := subSeconds(firstTS, rangeStart)
+	durationToEnd := subSeconds(rangeEnd, lastTS)
+	sampledInterval := subSeconds(lastTS, firstTS)
+	averageDurationBetweenSamples := sampledInterval / float64(lastIdx-firstIdx)
+
+	resultValue := lastValue - firstVal + counterCorrection
+	if isCounter && resultValue > 0 && firstVal >= 0 {
+		// Counters cannot be negative, extrapolate to the zero point of the
+		// counter if it is closer than the start of the range.
+		durationToZero := sampledInterval * (firstVal / resultValue)
+		if durationToZero < durationToStart {
+			durationToStart = durationToZero
+		}
+	}
+
+	extrapolationThreshold := averageDurationBetweenSamples * 1.1
+	extrapolateToInterval := sampledInterval
+	if durationToStart < extrapolationThreshold {
+		extrapolateToInterval += durationToStart
+	} else {
+		extrapolateToInterval += averageDurationBetweenSamples / 2
+	}
+	if durationToEnd < extrapolationThreshold {
+		extrapolateToInterval += durationToEnd
+	} else {
+		extrapolateToInterval += averageDurationBetweenSamples / 2
+	}
+
+	resultValue = resultValue * (extrapolateToInterval / sampledInterval)
+	if isRate {
+		resultValue /= timeWindow.Seconds()
+	}
+	return resultValue
+}
+
+func subSeconds(from int64, sub int64) float64 {
+	return float64(from-sub) / float64(time.Second)
+}
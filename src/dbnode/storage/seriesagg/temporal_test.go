@@ -0,0 +1,171 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package seriesagg
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/ts"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDatapoints(start time.Time, interval time.Duration, values ...float64) []ts.Datapoint {
+	dps := make([]ts.Datapoint, 0, len(values))
+	for i, v := range values {
+		dps = append(dps, ts.Datapoint{
+			Timestamp: start.Add(time.Duration(i) * interval),
+			Value:     v,
+		})
+	}
+	return dps
+}
+
+func TestOptionsValidate(t *testing.T) {
+	start := time.Unix(0, 0)
+	valid := Options{
+		Start:            start,
+		End:              start.Add(time.Minute),
+		Step:             10 * time.Second,
+		Range:            time.Minute,
+		TemporalFunction: Rate,
+		Aggregation:      Sum,
+	}
+	require.NoError(t, valid.Validate())
+	assert.Equal(t, 6, valid.Steps())
+
+	invalid := valid
+	invalid.Step = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.Range = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.End = start
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.TemporalFunction = UnknownTemporalFunction
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.Aggregation = UnknownAggregation
+	require.Error(t, invalid.Validate())
+}
+
+func TestEvaluateOverTime(t *testing.T) {
+	start := time.Unix(0, 0)
+	dps := testDatapoints(start, 10*time.Second, 1, 2, math.NaN(), 4, 5, 6)
+
+	tests := []struct {
+		fn       TemporalFunction
+		expected []float64
+	}{
+		{fn: SumOverTime, expected: []float64{1, 3, 3, 6, 9, 15}},
+		{fn: CountOverTime, expected: []float64{1, 2, 2, 2, 2, 3}},
+		{fn: AvgOverTime, expected: []float64{1, 1.5, 1.5, 3, 4.5, 5}},
+		{fn: MinOverTime, expected: []float64{1, 1, 1, 2, 4, 4}},
+		{fn: MaxOverTime, expected: []float64{1, 2, 2, 4, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fn.String(), func(t *testing.T) {
+			opts := Options{
+				Start:            start,
+				End:              start.Add(time.Minute),
+				Step:             10 * time.Second,
+				Range:            20 * time.Second,
+				TemporalFunction: tt.fn,
+				Aggregation:      Sum,
+			}
+			assert.Equal(t, tt.expected, evaluate(opts, dps, nil))
+		})
+	}
+}
+
+func TestEvaluateEmptyWindows(t *testing.T) {
+	start := time.Unix(0, 0)
+	dps := testDatapoints(start.Add(time.Minute), 10*time.Second, 1)
+	opts := Options{
+		Start:            start,
+		End:              start.Add(20 * time.Second),
+		Step:             10 * time.Second,
+		Range:            10 * time.Second,
+		TemporalFunction: CountOverTime,
+		Aggregation:      Sum,
+	}
+
+	values := evaluate(opts, dps, nil)
+	require.Len(t, values, 2)
+	for _, v := range values {
+		assert.True(t, math.IsNaN(v))
+	}
+}
+
+func TestEvaluateRate(t *testing.T) {
+	start := time.Unix(0, 0)
+	// A counter increasing by 10 every 10s with a reset.
+	dps := testDatapoints(start, 10*time.Second, 0, 10, 20, 5, 15, 25, 35)
+	opts := Options{
+		Start:            start.Add(time.Minute),
+		End:              start.Add(70 * time.Second),
+		Step:             10 * time.Second,
+		Range:            time.Minute,
+		TemporalFunction: Increase,
+		Aggregation:      Sum,
+	}
+
+	// Raw increase is 35 + 20 (reset correction) = 55 over 60s of samples,
+	// the window is fully covered so no extrapolation is applied.
+	values := evaluate(opts, dps, nil)
+	require.Len(t, values, 1)
+	assert.InDelta(t, 55, values[0], 1e-9)
+
+	opts.TemporalFunction = Rate
+	values = evaluate(opts, dps, nil)
+	assert.InDelta(t, 55.0/60, values[0], 1e-9)
+
+	opts.TemporalFunction = Delta
+	values = evaluate(opts, dps, nil)
+	assert.InDelta(t, 35, values[0], 1e-9)
+}
+
+func TestEvaluateRateSingleDatapoint(t *testing.T) {
+	start := time.Unix(0, 0)
+	dps := testDatapoints(start, 10*time.Second, 1)
+	opts := Options{
+		Start:            start,
+		End:              start.Add(10 * time.Second),
+		Step:             10 * time.Second,
+		Range:            time.Minute,
+		TemporalFunction: Rate,
+		Aggregation:      Sum,
+	}
+
+	values := evaluate(opts, dps, nil)
+	require.Len(t, values, 1)
+	assert.True(t, math.IsNaN(values[0]))
+}
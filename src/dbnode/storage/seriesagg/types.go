@@ -0,0 +1,206 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package seriesagg evaluates a temporal function followed by a grouping
+// aggregation over series stored on a node so that queries such as
+// sum by (tags) (rate(series[range])) can be pushed down to storage.
+package seriesagg
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	errStepNotPositive  = errors.New("step must be positive")
+	errRangeNotPositive = errors.New("range must be positive")
+	errEndBeforeStart   = errors.New("end must be after start")
+)
+
+// TemporalFunction is a function applied over a window of each series.
+type TemporalFunction int
+
+const (
+	// UnknownTemporalFunction is an unknown temporal function.
+	UnknownTemporalFunction TemporalFunction = iota
+	// Rate is the per-second average rate of increase of a counter.
+	Rate
+	// Increase is the increase of a counter.
+	Increase
+	// Delta is the difference between the first and last values of a gauge.
+	Delta
+	// AvgOverTime is the average of the values.
+	AvgOverTime
+	// CountOverTime is the number of values.
+	CountOverTime
+	// MinOverTime is the minimum of the values.
+	MinOverTime
+	// MaxOverTime is the maximum of the values.
+	MaxOverTime
+	// SumOverTime is the sum of the values.
+	SumOverTime
+)
+
+var validTemporalFunctions = []TemporalFunction{
+	Rate,
+	Increase,
+	Delta,
+	AvgOverTime,
+	CountOverTime,
+	MinOverTime,
+	MaxOverTime,
+	SumOverTime,
+}
+
+// Validate validates the temporal function.
+func (f TemporalFunction) Validate() error {
+	for _, valid := range validTemporalFunctions {
+		if f == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid temporal function: %d", f)
+}
+
+func (f TemporalFunction) String() string {
+	switch f {
+	case Rate:
+		return "rate"
+	case Increase:
+		return "increase"
+	case Delta:
+		return "delta"
+	case AvgOverTime:
+		return "avg_over_time"
+	case CountOverTime:
+		return "count_over_time"
+	case MinOverTime:
+		return "min_over_time"
+	case MaxOverTime:
+		return "max_over_time"
+	case SumOverTime:
+		return "sum_over_time"
+	}
+	return "unknown"
+}
+
+// Aggregation is an aggregation applied across the series of a group.
+type Aggregation int
+
+const (
+	// UnknownAggregation is an unknown aggregation.
+	UnknownAggregation Aggregation = iota
+	// Sum is the sum of the values of the group.
+	Sum
+	// Min is the minimum of the values of the group.
+	Min
+	// Max is the maximum of the values of the group.
+	Max
+	// Count is the number of values of the group.
+	Count
+)
+
+var validAggregations = []Aggregation{
+	Sum,
+	Min,
+	Max,
+	Count,
+}
+
+// Validate validates the aggregation.
+func (a Aggregation) Validate() error {
+	for _, valid := range validAggregations {
+		if a == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid aggregation: %d", a)
+}
+
+func (a Aggregation) String() string {
+	switch a {
+	case Sum:
+		return "sum"
+	case Min:
+		return "min"
+	case Max:
+		return "max"
+	case Count:
+		return "count"
+	}
+	return "unknown"
+}
+
+// Options describes an aggregation to evaluate.
+type Options struct {
+	// Start is the time of the first step.
+	Start time.Time
+	// End is the exclusive end of the steps.
+	End time.Time
+	// Step is the duration between steps.
+	Step time.Duration
+	// Range is the duration of the window the temporal function is
+	// evaluated over, the window of a step at t is [t-Range, t].
+	Range time.Duration
+	// TemporalFunction is the function applied to each series.
+	TemporalFunction TemporalFunction
+	// Aggregation is the aggregation applied across series of a group.
+	Aggregation Aggregation
+	// GroupBy is the set of tag names series are grouped by.
+	GroupBy [][]byte
+}
+
+// Validate validates the options.
+func (o Options) Validate() error {
+	if o.Step <= 0 {
+		return errStepNotPositive
+	}
+	if o.Range <= 0 {
+		return errRangeNotPositive
+	}
+	if !o.End.After(o.Start) {
+		return errEndBeforeStart
+	}
+	if err := o.TemporalFunction.Validate(); err != nil {
+		return err
+	}
+	return o.Aggregation.Validate()
+}
+
+// Steps returns the number of steps.
+func (o Options) Steps() int {
+	return int(o.End.Sub(o.Start) / o.Step)
+}
+
+// Tag is a tag of an aggregated group.
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// Group is the result of an aggregation for a single group of series.
+type Group struct {
+	// Tags are the group by tags shared by all series of the group, sorted
+	// by name.
+	Tags []Tag
+	// Values are the aggregated values, one per step.
+	Values []float64
+}
@@ -160,6 +160,7 @@ type dbShard struct {
 	increasingIndex          increasingIndex
 	seriesPool               series.DatabaseSeriesPool
 	reverseIndex             namespaceIndex
+	seriesQuota              *namespaceSeriesQuota
 	insertQueue              *dbShardInsertQueue
 	lookup                   *shardMap
 	list                     *list.List
@@ -192,6 +193,8 @@ type dbShardRuntimeOptions struct {
 	writeNewSeriesAsync      bool
 	tickSleepSeriesBatchSize int
 	tickSleepPerSeries       time.Duration
+	maxActiveSeriesPerShard  int
+	maxActiveSeriesPerNode   int
 }
 
 type dbShardMetrics struct {
@@ -247,6 +250,7 @@ func newDatabaseShard(
 	namespaceReaderMgr databaseNamespaceReaderManager,
 	increasingIndex increasingIndex,
 	reverseIndex namespaceIndex,
+	seriesQuota *namespaceSeriesQuota,
 	needsBootstrap bool,
 	opts Options,
 	seriesOpts series.Options,
@@ -265,6 +269,7 @@ func newDatabaseShard(
 		increasingIndex:      increasingIndex,
 		seriesPool:           opts.DatabaseSeriesPool(),
 		reverseIndex:         reverseIndex,
+		seriesQuota:          seriesQuota,
 		lookup:               newShardMap(shardMapOptions{}),
 		list:                 list.New(),
 		newMergerFn:          fs.NewMerger,
@@ -323,6 +328,8 @@ func (s *dbShard) SetRuntimeOptions(value runtime.Options) {
 		writeNewSeriesAsync:      value.WriteNewSeriesAsync(),
 		tickSleepSeriesBatchSize: value.TickSeriesBatchSize(),
 		tickSleepPerSeries:       value.TickPerSeriesSleepDuration(),
+		maxActiveSeriesPerShard:  value.MaxActiveSeriesPerNamespacePerShard(),
+		maxActiveSeriesPerNode:   value.MaxActiveSeriesPerNamespacePerNode(),
 	}
 	s.Unlock()
 }
@@ -800,6 +807,7 @@ func (s *dbShard) purgeExpiredSeries(expiredEntries []*lookup.Entry) {
 		series.Close()
 		s.list.Remove(elem)
 		s.lookup.Delete(id)
+		s.seriesQuota.Removed(1)
 	}
 	s.Unlock()
 }
@@ -850,6 +858,14 @@ func (s *dbShard) writeAndIndex(
 
 	writable := entry != nil
 
+	// Reject new series if the namespace has reached its series quota,
+	// existing series continue to accept writes.
+	if !writable {
+		if err := s.checkSeriesQuota(id, opts); err != nil {
+			return ts.Series{}, false, err
+		}
+	}
+
 	// If no entry and we are not writing new series asynchronously.
 	if !writable && !opts.writeNewSeriesAsync {
 		// Avoid double lookup by enqueueing insert immediately.
@@ -1094,7 +1110,10 @@ func (s *dbShard) writableSeries(id ident.ID, tags ident.TagIterator) (*lookup.E
 }
 
 type writableSeriesOptions struct {
-	writeNewSeriesAsync bool
+	writeNewSeriesAsync     bool
+	maxActiveSeriesPerShard int
+	maxActiveSeriesPerNode  int
+	numSeries               int
 }
 
 func (s *dbShard) tryRetrieveWritableSeries(id ident.ID) (
@@ -1104,7 +1123,10 @@ func (s *dbShard) tryRetrieveWritableSeries(id ident.ID) (
 ) {
 	s.RLock()
 	opts := writableSeriesOptions{
-		writeNewSeriesAsync: s.currRuntimeOptions.writeNewSeriesAsync,
+		writeNewSeriesAsync:     s.currRuntimeOptions.writeNewSeriesAsync,
+		maxActiveSeriesPerShard: s.currRuntimeOptions.maxActiveSeriesPerShard,
+		maxActiveSeriesPerNode:  s.currRuntimeOptions.maxActiveSeriesPerNode,
+		numSeries:               s.list.Len(),
 	}
 	if entry, _, err := s.lookupEntryWithLock(id); err == nil {
 		entry.IncrementReaderWriterCount()
@@ -1118,6 +1140,21 @@ func (s *dbShard) tryRetrieveWritableSeries(id ident.ID) (
 	return nil, opts, nil
 }
 
+// checkSeriesQuota returns an error if inserting a new series would exceed
+// either the per shard or the per node series quota of the namespace. Since
+// new series are inserted in batches the quota is enforced on a best effort
+// basis and may be exceeded by the size of a pending insert batch.
+func (s *dbShard) checkSeriesQuota(id ident.ID, opts writableSeriesOptions) error {
+	if quota := opts.maxActiveSeriesPerShard; quota > 0 && opts.numSeries >= quota {
+		return s.seriesQuota.Reject(id, seriesQuotaTypeShard, quota)
+	}
+	if quota := opts.maxActiveSeriesPerNode; quota > 0 &&
+		s.seriesQuota.ActiveSeries() >= int64(quota) {
+		return s.seriesQuota.Reject(id, seriesQuotaTypeNode, quota)
+	}
+	return nil
+}
+
 func (s *dbShard) newShardEntry(
 	id ident.ID,
 	tagsArgOpts tagsArgOptions,
@@ -1324,6 +1361,7 @@ func (s *dbShard) insertNewShardEntryWithLock(entry *lookup.Entry) {
 		NoCopyKey:     true,
 		NoFinalizeKey: true,
 	})
+	s.seriesQuota.Inserted(1)
 }
 
 func (s *dbShard) insertSeriesBatch(inserts []dbShardInsert) error {
@@ -2055,6 +2093,139 @@ func (s *dbShard) WarmFlush(
 	return s.markWarmFlushStateSuccessOrError(blockStart, multiErr.FinalError())
 }
 
+func (s *dbShard) Rollup(
+	source namespace.Metadata,
+	blockStart time.Time,
+	flushPreparer persist.FlushPreparer,
+) error {
+	// We don't roll up data when the shard is still bootstrapping
+	s.RLock()
+	if s.bootstrapState != Bootstrapped {
+		s.RUnlock()
+		return errShardNotBootstrappedToFlush
+	}
+	s.RUnlock()
+
+	var (
+		fsOpts          = s.opts.CommitLogOptions().FilesystemOptions()
+		blockSize       = s.namespace.Options().RetentionOptions().BlockSize()
+		sourceBlockSize = source.Options().RetentionOptions().BlockSize()
+		accumulator     = newRollupAccumulator(s.namespace.Options().RollupOptions(),
+			s.opts.ReaderIteratorPool())
+	)
+	filesets, err := s.filesetsFn(fsOpts.FilePathPrefix(), source.ID(), s.ID())
+	if err != nil {
+		return s.markWarmFlushStateSuccessOrError(blockStart, err)
+	}
+	for t := blockStart; t.Before(blockStart.Add(blockSize)); t = t.Add(sourceBlockSize) {
+		fileset, ok := filesets.LatestVolumeForBlock(t)
+		if !ok {
+			// Nothing was flushed for this source block.
+			continue
+		}
+		if err := accumulator.AddFileSet(fsOpts, fileset.ID); err != nil {
+			return s.markWarmFlushStateSuccessOrError(blockStart, err)
+		}
+	}
+
+	prepareOpts := persist.DataPrepareOptions{
+		NamespaceMetadata: s.namespace,
+		Shard:             s.ID(),
+		BlockStart:        blockStart,
+		// Rolled up blocks are only ever written once, in place of a warm flush.
+		VolumeIndex:    0,
+		DeleteIfExists: false,
+		FileSetType:    persist.FileSetFlushType,
+	}
+	prepared, err := flushPreparer.PrepareData(prepareOpts)
+	if err != nil {
+		return s.markWarmFlushStateSuccessOrError(blockStart, err)
+	}
+
+	var (
+		multiErr     xerrors.MultiError
+		indexResults result.IndexResults
+		persistFn    = prepared.Persist
+	)
+	if s.reverseIndex != nil {
+		// NB: rolled up series are written directly to filesets rather than
+		// through the write path, so they must be indexed as they are persisted
+		// to be queryable before the index block is flushed.
+		var (
+			idxOpts    = s.namespace.Options().IndexOptions()
+			resultOpts = result.NewOptions().SetIndexMutableSegmentAllocator(
+				index.NewBootstrapResultMutableSegmentAllocator(s.opts.IndexOptions()))
+		)
+		indexResults = make(result.IndexResults)
+		persistFn = func(id ident.ID, tags ident.Tags, segment ts.Segment, checksum uint32) error {
+			if err := prepared.Persist(id, tags, segment, checksum); err != nil {
+				return err
+			}
+			return addRollupIndexResult(indexResults, id, tags, blockStart, idxOpts, resultOpts)
+		}
+	}
+
+	numSeries, err := accumulator.Persist(blockStart, s.opts.EncoderPool(), persistFn)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	if err := prepared.Close(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	if indexResults != nil && multiErr.NumErrors() == 0 {
+		// The rolled up series are added to the index the same way series
+		// bootstrapped from filesets are.
+		fulfilled := result.ShardTimeRanges{
+			s.ID(): xtime.NewRanges(xtime.Range{
+				Start: blockStart,
+				End:   blockStart.Add(blockSize),
+			}),
+		}
+		if err := indexResults.MarkFulfilled(blockStart, fulfilled,
+			s.namespace.Options().IndexOptions()); err != nil {
+			multiErr = multiErr.Add(err)
+		} else if err := s.reverseIndex.Bootstrap(indexResults); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	s.logger.Debug("shard rollup completed",
+		zap.Uint32("shard", s.ID()),
+		zap.Time("blockStart", blockStart),
+		zap.Int("numSeries", numSeries))
+
+	return s.markWarmFlushStateSuccessOrError(blockStart, multiErr.FinalError())
+}
+
+// addRollupIndexResult adds a rolled up series to the index block of the
+// block start it was persisted for.
+func addRollupIndexResult(
+	results result.IndexResults,
+	id ident.ID,
+	tags ident.Tags,
+	blockStart time.Time,
+	idxOpts namespace.IndexOptions,
+	resultOpts result.Options,
+) error {
+	segment, err := results.GetOrAddSegment(blockStart, idxOpts, resultOpts)
+	if err != nil {
+		return err
+	}
+
+	exists, err := segment.ContainsID(id.Bytes())
+	if err != nil || exists {
+		return err
+	}
+
+	d, err := convert.FromMetric(id, tags)
+	if err != nil {
+		return err
+	}
+
+	_, err = segment.Insert(d)
+	return err
+}
+
 func (s *dbShard) ColdFlush(
 	flushPreparer persist.FlushPreparer,
 	resources coldFlushReuseableResources,
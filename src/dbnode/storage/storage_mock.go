@@ -41,6 +41,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -486,6 +487,35 @@ func (mr *MockDatabaseMockRecorder) FlushState(namespace, shardID, blockStart in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushState", reflect.TypeOf((*MockDatabase)(nil).FlushState), namespace, shardID, blockStart)
 }
 
+// WaitForSnapshot mocks base method
+func (m *MockDatabase) WaitForSnapshot(timeout time.Duration) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForSnapshot", timeout)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForSnapshot indicates an expected call of WaitForSnapshot
+func (mr *MockDatabaseMockRecorder) WaitForSnapshot(timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForSnapshot", reflect.TypeOf((*MockDatabase)(nil).WaitForSnapshot), timeout)
+}
+
+// Rollup mocks base method
+func (m *MockDatabase) Rollup(namespace ident.ID, start time.Time, end time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", namespace, start, end)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockDatabaseMockRecorder) Rollup(namespace, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockDatabase)(nil).Rollup), namespace, start, end)
+}
+
 // Mockdatabase is a mock of database interface
 type Mockdatabase struct {
 	ctrl     *gomock.Controller
@@ -881,6 +911,35 @@ func (mr *MockdatabaseMockRecorder) FlushState(namespace, shardID, blockStart in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushState", reflect.TypeOf((*Mockdatabase)(nil).FlushState), namespace, shardID, blockStart)
 }
 
+// WaitForSnapshot mocks base method
+func (m *Mockdatabase) WaitForSnapshot(timeout time.Duration) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForSnapshot", timeout)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForSnapshot indicates an expected call of WaitForSnapshot
+func (mr *MockdatabaseMockRecorder) WaitForSnapshot(timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForSnapshot", reflect.TypeOf((*Mockdatabase)(nil).WaitForSnapshot), timeout)
+}
+
+// Rollup mocks base method
+func (m *Mockdatabase) Rollup(namespace ident.ID, start time.Time, end time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", namespace, start, end)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockdatabaseMockRecorder) Rollup(namespace, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*Mockdatabase)(nil).Rollup), namespace, start, end)
+}
+
 // GetOwnedNamespaces mocks base method
 func (m *Mockdatabase) GetOwnedNamespaces() ([]databaseNamespace, error) {
 	m.ctrl.T.Helper()
@@ -1329,6 +1388,20 @@ func (mr *MockdatabaseNamespaceMockRecorder) WarmFlush(blockStart, flush interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarmFlush", reflect.TypeOf((*MockdatabaseNamespace)(nil).WarmFlush), blockStart, flush)
 }
 
+// Rollup mocks base method
+func (m *MockdatabaseNamespace) Rollup(source databaseNamespace, blockStart time.Time, flush persist.FlushPreparer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", source, blockStart, flush)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockdatabaseNamespaceMockRecorder) Rollup(source, blockStart, flush interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockdatabaseNamespace)(nil).Rollup), source, blockStart, flush)
+}
+
 // FlushIndex mocks base method
 func (m *MockdatabaseNamespace) FlushIndex(flush persist.IndexFlush) error {
 	m.ctrl.T.Helper()
@@ -1793,6 +1866,20 @@ func (mr *MockdatabaseShardMockRecorder) WarmFlush(blockStart, flush, nsCtx inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarmFlush", reflect.TypeOf((*MockdatabaseShard)(nil).WarmFlush), blockStart, flush, nsCtx)
 }
 
+// Rollup mocks base method
+func (m *MockdatabaseShard) Rollup(source namespace.Metadata, blockStart time.Time, flush persist.FlushPreparer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", source, blockStart, flush)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockdatabaseShardMockRecorder) Rollup(source, blockStart, flush interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockdatabaseShard)(nil).Rollup), source, blockStart, flush)
+}
+
 // ColdFlush mocks base method
 func (m *MockdatabaseShard) ColdFlush(flush persist.FlushPreparer, resources coldFlushReuseableResources, nsCtx namespace.Context) error {
 	m.ctrl.T.Helper()
@@ -2285,6 +2372,48 @@ func (mr *MockdatabaseFlushManagerMockRecorder) LastSuccessfulSnapshotStartTime(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastSuccessfulSnapshotStartTime", reflect.TypeOf((*MockdatabaseFlushManager)(nil).LastSuccessfulSnapshotStartTime))
 }
 
+// RequestSnapshot mocks base method
+func (m *MockdatabaseFlushManager) RequestSnapshot() <-chan snapshotResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestSnapshot")
+	ret0, _ := ret[0].(<-chan snapshotResult)
+	return ret0
+}
+
+// RequestSnapshot indicates an expected call of RequestSnapshot
+func (mr *MockdatabaseFlushManagerMockRecorder) RequestSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSnapshot", reflect.TypeOf((*MockdatabaseFlushManager)(nil).RequestSnapshot))
+}
+
+// SnapshotRequested mocks base method
+func (m *MockdatabaseFlushManager) SnapshotRequested() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotRequested")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SnapshotRequested indicates an expected call of SnapshotRequested
+func (mr *MockdatabaseFlushManagerMockRecorder) SnapshotRequested() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotRequested", reflect.TypeOf((*MockdatabaseFlushManager)(nil).SnapshotRequested))
+}
+
+// Rollup mocks base method
+func (m *MockdatabaseFlushManager) Rollup(namespace ident.ID, start time.Time, end time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", namespace, start, end)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockdatabaseFlushManagerMockRecorder) Rollup(namespace, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockdatabaseFlushManager)(nil).Rollup), namespace, start, end)
+}
+
 // Report mocks base method
 func (m *MockdatabaseFlushManager) Report() {
 	m.ctrl.T.Helper()
@@ -2480,6 +2609,48 @@ func (mr *MockdatabaseFileSystemManagerMockRecorder) LastSuccessfulSnapshotStart
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastSuccessfulSnapshotStartTime", reflect.TypeOf((*MockdatabaseFileSystemManager)(nil).LastSuccessfulSnapshotStartTime))
 }
 
+// RequestSnapshot mocks base method
+func (m *MockdatabaseFileSystemManager) RequestSnapshot() <-chan snapshotResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestSnapshot")
+	ret0, _ := ret[0].(<-chan snapshotResult)
+	return ret0
+}
+
+// RequestSnapshot indicates an expected call of RequestSnapshot
+func (mr *MockdatabaseFileSystemManagerMockRecorder) RequestSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSnapshot", reflect.TypeOf((*MockdatabaseFileSystemManager)(nil).RequestSnapshot))
+}
+
+// SnapshotRequested mocks base method
+func (m *MockdatabaseFileSystemManager) SnapshotRequested() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotRequested")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SnapshotRequested indicates an expected call of SnapshotRequested
+func (mr *MockdatabaseFileSystemManagerMockRecorder) SnapshotRequested() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotRequested", reflect.TypeOf((*MockdatabaseFileSystemManager)(nil).SnapshotRequested))
+}
+
+// Rollup mocks base method
+func (m *MockdatabaseFileSystemManager) Rollup(namespace ident.ID, start time.Time, end time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", namespace, start, end)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockdatabaseFileSystemManagerMockRecorder) Rollup(namespace, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockdatabaseFileSystemManager)(nil).Rollup), namespace, start, end)
+}
+
 // MockdatabaseShardRepairer is a mock of databaseShardRepairer interface
 type MockdatabaseShardRepairer struct {
 	ctrl     *gomock.Controller
@@ -2816,6 +2987,34 @@ func (mr *MockdatabaseMediatorMockRecorder) LastSuccessfulSnapshotStartTime() *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastSuccessfulSnapshotStartTime", reflect.TypeOf((*MockdatabaseMediator)(nil).LastSuccessfulSnapshotStartTime))
 }
 
+// RequestSnapshot mocks base method
+func (m *MockdatabaseMediator) RequestSnapshot() <-chan snapshotResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestSnapshot")
+	ret0, _ := ret[0].(<-chan snapshotResult)
+	return ret0
+}
+
+// RequestSnapshot indicates an expected call of RequestSnapshot
+func (mr *MockdatabaseMediatorMockRecorder) RequestSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSnapshot", reflect.TypeOf((*MockdatabaseMediator)(nil).RequestSnapshot))
+}
+
+// Rollup mocks base method
+func (m *MockdatabaseMediator) Rollup(namespace ident.ID, start time.Time, end time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollup", namespace, start, end)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollup indicates an expected call of Rollup
+func (mr *MockdatabaseMediatorMockRecorder) Rollup(namespace, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollup", reflect.TypeOf((*MockdatabaseMediator)(nil).Rollup), namespace, start, end)
+}
+
 // MockOptions is a mock of Options interface
 type MockOptions struct {
 	ctrl     *gomock.Controller
@@ -3903,6 +4102,34 @@ func (mr *MockOptionsMockRecorder) MemoryTracker() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryTracker", reflect.TypeOf((*MockOptions)(nil).MemoryTracker))
 }
 
+// SetQueryLimits mocks base method
+func (m *MockOptions) SetQueryLimits(value limits.QueryLimits) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQueryLimits", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetQueryLimits indicates an expected call of SetQueryLimits
+func (mr *MockOptionsMockRecorder) SetQueryLimits(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueryLimits", reflect.TypeOf((*MockOptions)(nil).SetQueryLimits), value)
+}
+
+// QueryLimits mocks base method
+func (m *MockOptions) QueryLimits() limits.QueryLimits {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryLimits")
+	ret0, _ := ret[0].(limits.QueryLimits)
+	return ret0
+}
+
+// QueryLimits indicates an expected call of QueryLimits
+func (mr *MockOptionsMockRecorder) QueryLimits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLimits", reflect.TypeOf((*MockOptions)(nil).QueryLimits))
+}
+
 // MockMemoryTracker is a mock of MemoryTracker interface
 type MockMemoryTracker struct {
 	ctrl     *gomock.Controller
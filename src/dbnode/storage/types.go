@@ -37,6 +37,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/storage/series/lookup"
@@ -216,6 +217,18 @@ type Database interface {
 
 	// FlushState returns the flush state for the specified shard and block start.
 	FlushState(namespace ident.ID, shardID uint32, blockStart time.Time) (fileOpState, error)
+
+	// WaitForSnapshot requests a snapshot and waits for it to complete,
+	// returning its start time, or returns an error if it fails or does not
+	// complete within the timeout. The snapshot is taken by the filesystem
+	// processes once the current tick completes, so the wait may last as
+	// long as a tick plus a flush.
+	WaitForSnapshot(timeout time.Duration) (time.Time, error)
+
+	// Rollup rolls up the source namespace blocks in the time range
+	// [start, end) into the given rollup namespace, skipping blocks
+	// that have already been rolled up.
+	Rollup(namespace ident.ID, start, end time.Time) error
 }
 
 // database is the internal database interface.
@@ -344,6 +357,10 @@ type databaseNamespace interface {
 	// WarmFlush flushes in-memory WarmWrites.
 	WarmFlush(blockStart time.Time, flush persist.FlushPreparer) error
 
+	// Rollup downsamples the flushed source namespace blocks covering the
+	// block starting at blockStart and persists them as this namespace's block.
+	Rollup(source databaseNamespace, blockStart time.Time, flush persist.FlushPreparer) error
+
 	// FlushIndex flushes in-memory index data.
 	FlushIndex(
 		flush persist.IndexFlush,
@@ -504,6 +521,14 @@ type databaseShard interface {
 		nsCtx namespace.Context,
 	) error
 
+	// Rollup downsamples the flushed source namespace filesets of this shard
+	// covering the block starting at blockStart and persists them.
+	Rollup(
+		source namespace.Metadata,
+		blockStart time.Time,
+		flush persist.FlushPreparer,
+	) error
+
 	// ColdFlush flushes the unflushed ColdWrites in this shard.
 	ColdFlush(
 		flush persist.FlushPreparer,
@@ -674,6 +699,18 @@ type databaseFlushManager interface {
 	// successful snapshot, if any.
 	LastSuccessfulSnapshotStartTime() (time.Time, bool)
 
+	// RequestSnapshot requests a snapshot to be taken by the next flush,
+	// returning a channel that receives the result once it completes.
+	RequestSnapshot() <-chan snapshotResult
+
+	// SnapshotRequested returns whether a snapshot has been requested and
+	// not yet taken.
+	SnapshotRequested() bool
+
+	// Rollup rolls up the source namespace blocks in the time range
+	// [start, end) into the given rollup namespace.
+	Rollup(namespace ident.ID, start, end time.Time) error
+
 	// Report reports runtime information.
 	Report()
 }
@@ -719,6 +756,18 @@ type databaseFileSystemManager interface {
 	// LastSuccessfulSnapshotStartTime returns the start time of the last
 	// successful snapshot, if any.
 	LastSuccessfulSnapshotStartTime() (time.Time, bool)
+
+	// RequestSnapshot requests a snapshot to be taken by the next flush,
+	// returning a channel that receives the result once it completes.
+	RequestSnapshot() <-chan snapshotResult
+
+	// SnapshotRequested returns whether a snapshot has been requested and
+	// not yet taken.
+	SnapshotRequested() bool
+
+	// Rollup rolls up the source namespace blocks in the time range
+	// [start, end) into the given rollup namespace.
+	Rollup(namespace ident.ID, start, end time.Time) error
 }
 
 // databaseShardRepairer repairs in-memory data for a shard.
@@ -795,6 +844,14 @@ type databaseMediator interface {
 	// LastSuccessfulSnapshotStartTime returns the start time of the last
 	// successful snapshot, if any.
 	LastSuccessfulSnapshotStartTime() (time.Time, bool)
+
+	// RequestSnapshot requests a snapshot to be taken by the next flush,
+	// returning a channel that receives the result once it completes.
+	RequestSnapshot() <-chan snapshotResult
+
+	// Rollup rolls up the source namespace blocks in the time range
+	// [start, end) into the given rollup namespace.
+	Rollup(namespace ident.ID, start, end time.Time) error
 }
 
 // Options represents the options for storage.
@@ -1039,6 +1096,12 @@ type Options interface {
 
 	// MemoryTracker returns the MemoryTracker.
 	MemoryTracker() MemoryTracker
+
+	// SetQueryLimits sets the limits applied to queries.
+	SetQueryLimits(value limits.QueryLimits) Options
+
+	// QueryLimits returns the limits applied to queries.
+	QueryLimits() limits.QueryLimits
 }
 
 // MemoryTracker tracks memory.
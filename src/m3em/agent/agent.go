@@ -36,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/m3em/generated/proto/m3em"
 	"github.com/m3db/m3/src/m3em/os/exec"
 	"github.com/m3db/m3/src/m3em/os/fs"
+	"github.com/m3db/m3/src/m3em/x/proxy"
 	xerrors "github.com/m3db/m3/src/x/errors"
 
 	"github.com/uber-go/tally"
@@ -66,6 +67,9 @@ type opAgent struct {
 	newProcessMonitorFn newProcessMonitorFn
 	processMonitor      exec.ProcessMonitor
 	heartbeater         *heatbeater
+	proxies             map[string]proxy.Proxy
+	fillPaths           map[string]struct{}
+	diskThrottles       map[string]DiskThrottleResetFn
 
 	running            int32
 	stopping           int32
@@ -97,6 +101,9 @@ func New(
 		logger:              opts.InstrumentOptions().Logger(),
 		metrics:             newAgentMetrics(opts.InstrumentOptions().MetricsScope()),
 		newProcessMonitorFn: exec.NewProcessMonitor,
+		proxies:             make(map[string]proxy.Proxy),
+		fillPaths:           make(map[string]struct{}),
+		diskThrottles:       make(map[string]DiskThrottleResetFn),
 		doneCh:              make(chan struct{}, 1),
 		closeCh:             make(chan struct{}, 1),
 	}
@@ -300,6 +307,12 @@ func (o *opAgent) resetWithLock(reason string) error {
 		}
 	}
 
+	o.logger.Info("releasing injected faults")
+	if err := o.releaseFaultsWithLock(); err != nil {
+		o.logger.Warn("unable to release injected faults", zap.Error(err))
+		multiErr = multiErr.Add(err)
+	}
+
 	o.logger.Info("releasing host resources")
 	if err := o.opts.ReleaseHostResourcesFn()(); err != nil {
 		o.logger.Info("unable to release host resources", zap.Error(err))
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	defaultCgroupRoot   = "/sys/fs/cgroup"
+	defaultCgroupName   = "m3em"
+	defaultSysBlockRoot = "/sys/dev/block"
+)
+
+// defaultDiskThrottleFn throttles the process using the io controller of a
+// cgroup v2 hierarchy mounted at /sys/fs/cgroup.
+func defaultDiskThrottleFn(pid int, dir string, readBytesPerSec int64, writeBytesPerSec int64) (DiskThrottleResetFn, error) {
+	device, err := diskDevice(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// enabling the io controller fails if it's already enabled, or if the
+	// hierarchy does not support it; the latter is surfaced when io.max is
+	// written below
+	ioutil.WriteFile(path.Join(defaultCgroupRoot, "cgroup.subtree_control"), []byte("+io"), 0644)
+
+	cgroup := path.Join(defaultCgroupRoot, defaultCgroupName)
+	if err := os.MkdirAll(cgroup, 0755); err != nil {
+		return nil, fmt.Errorf("unable to create cgroup, [ err = %v ]", err)
+	}
+
+	if err := ioutil.WriteFile(
+		path.Join(cgroup, "cgroup.procs"), []byte(strconv.Itoa(pid)), 0644,
+	); err != nil {
+		return nil, fmt.Errorf("unable to add process to cgroup, [ err = %v ]", err)
+	}
+
+	if err := setIOLimit(cgroup, device, readBytesPerSec, writeBytesPerSec); err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		return setIOLimit(cgroup, device, 0, 0)
+	}, nil
+}
+
+// diskDevice returns the major:minor number of the disk backing the specified
+// directory, io limits can only be set on whole disks so the disk a partition
+// belongs to is returned for directories backed by a partition.
+func diskDevice(dir string) (string, error) {
+	var st syscall.Stat_t
+	if err := syscall.Stat(dir, &st); err != nil {
+		return "", fmt.Errorf("unable to stat directory, [ err = %v ]", err)
+	}
+
+	dev := uint64(st.Dev)
+	major := ((dev >> 8) & 0xfff) | ((dev >> 32) & ^uint64(0xfff))
+	minor := (dev & 0xff) | ((dev >> 12) & ^uint64(0xff))
+	device := fmt.Sprintf("%d:%d", major, minor)
+
+	sysPath := path.Join(defaultSysBlockRoot, device)
+	if _, err := os.Stat(path.Join(sysPath, "partition")); err != nil {
+		return device, nil
+	}
+
+	// the sysfs directory of a partition is nested in that of its disk
+	resolved, err := filepath.EvalSymlinks(sysPath)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve partition, [ err = %v ]", err)
+	}
+
+	parent, err := ioutil.ReadFile(path.Join(path.Dir(resolved), "dev"))
+	if err != nil {
+		return "", fmt.Errorf("unable to read disk of partition, [ err = %v ]", err)
+	}
+
+	return strings.TrimSpace(string(parent)), nil
+}
+
+func setIOLimit(cgroup string, device string, readBytesPerSec int64, writeBytesPerSec int64) error {
+	limit := fmt.Sprintf("%s rbps=%s wbps=%s",
+		device, ioLimit(readBytesPerSec), ioLimit(writeBytesPerSec))
+	if err := ioutil.WriteFile(path.Join(cgroup, "io.max"), []byte(limit), 0644); err != nil {
+		return fmt.Errorf("unable to set io limit, [ err = %v ]", err)
+	}
+
+	return nil
+}
+
+func ioLimit(bytesPerSec int64) string {
+	if bytesPerSec <= 0 {
+		return "max"
+	}
+	return strconv.FormatInt(bytesPerSec, 10)
+}
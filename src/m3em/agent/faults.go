@@ -0,0 +1,408 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package agent
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"syscall"
+	"time"
+
+	"github.com/m3db/m3/src/m3em/generated/proto/m3em"
+	"github.com/m3db/m3/src/m3em/x/proxy"
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"go.uber.org/zap"
+	context "golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultFillFileName  = ".m3em-fill"
+	defaultFillChunkSize = 1 << 20
+	defaultProxyHost     = "127.0.0.1"
+)
+
+func (o *opAgent) PauseProcess(ctx context.Context, request *m3em.PauseProcessRequest) (*m3em.PauseProcessResponse, error) {
+	o.logger.Info("received PauseProcess()")
+	if err := o.signalProcess(syscall.SIGSTOP); err != nil {
+		return nil, err
+	}
+	return &m3em.PauseProcessResponse{}, nil
+}
+
+func (o *opAgent) ResumeProcess(ctx context.Context, request *m3em.ResumeProcessRequest) (*m3em.ResumeProcessResponse, error) {
+	o.logger.Info("received ResumeProcess()")
+	if err := o.signalProcess(syscall.SIGCONT); err != nil {
+		return nil, err
+	}
+	return &m3em.ResumeProcessResponse{}, nil
+}
+
+// KillProcess terminates the test process without giving it a chance to
+// clean up, the termination is reported to the operator the same as a crash.
+func (o *opAgent) KillProcess(ctx context.Context, request *m3em.KillProcessRequest) (*m3em.KillProcessResponse, error) {
+	o.logger.Info("received KillProcess()")
+	if err := o.signalProcess(syscall.SIGKILL); err != nil {
+		return nil, err
+	}
+	return &m3em.KillProcessResponse{}, nil
+}
+
+func (o *opAgent) signalProcess(sig syscall.Signal) error {
+	o.RLock()
+	defer o.RUnlock()
+
+	if !o.Running() || o.processMonitor == nil {
+		return grpc.Errorf(codes.FailedPrecondition, "not running")
+	}
+
+	if err := o.processMonitor.Signal(sig); err != nil {
+		return grpc.Errorf(codes.Internal, "unable to send %v: %v", sig, err)
+	}
+
+	return nil
+}
+
+func (o *opAgent) pathRelativeToWorkingDir(target string) (string, error) {
+	paths, err := o.pathsRelativeToWorkingDir([]string{target})
+	if err != nil {
+		return "", grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return paths[0], nil
+}
+
+// FillDisk consumes disk space in the target directory by writing to a
+// filler file, a request for zero bytes removes the filler file.
+func (o *opAgent) FillDisk(ctx context.Context, request *m3em.FillDiskRequest) (*m3em.FillDiskResponse, error) {
+	if request == nil || request.NumBytes < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid request")
+	}
+	o.logger.Info("received FillDisk()",
+		zap.String("dir", request.TargetDir), zap.Int64("numBytes", request.NumBytes))
+
+	o.Lock()
+	defer o.Unlock()
+
+	if !o.isSetupWithLock() {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "agent has not been setup")
+	}
+
+	dir, err := o.pathRelativeToWorkingDir(request.TargetDir)
+	if err != nil {
+		return nil, err
+	}
+
+	fillPath := path.Join(dir, defaultFillFileName)
+	if request.NumBytes == 0 {
+		if err := removeIfExists(fillPath); err != nil {
+			return nil, grpc.Errorf(codes.Internal, "unable to remove fill file: %v", err)
+		}
+		delete(o.fillPaths, fillPath)
+		return &m3em.FillDiskResponse{}, nil
+	}
+
+	if err := os.MkdirAll(dir, o.opts.NewDirectoryMode()); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "unable to create directory: %v", err)
+	}
+
+	o.fillPaths[fillPath] = struct{}{}
+	numBytes, err := fillFile(fillPath, request.NumBytes, o.opts.NewFileMode())
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "unable to fill disk: %v", err)
+	}
+
+	return &m3em.FillDiskResponse{NumBytes: numBytes}, nil
+}
+
+// fillFile appends numBytes to the specified file, stopping early without
+// error if the disk is full. It returns the number of bytes written.
+func fillFile(filePath string, numBytes int64, mode os.FileMode) (int64, error) {
+	fd, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		buf     = make([]byte, defaultFillChunkSize)
+		written int64
+	)
+	for written < numBytes {
+		chunk := buf
+		if remaining := numBytes - written; remaining < int64(len(chunk)) {
+			chunk = chunk[:remaining]
+		}
+		n, err := fd.Write(chunk)
+		written += int64(n)
+		if err != nil {
+			if isNoSpaceErr(err) {
+				break
+			}
+			fd.Close()
+			return written, err
+		}
+	}
+
+	if err := fd.Sync(); err != nil && !isNoSpaceErr(err) {
+		fd.Close()
+		return written, err
+	}
+	return written, fd.Close()
+}
+
+func isNoSpaceErr(err error) bool {
+	if pe, ok := err.(*os.PathError); ok {
+		err = pe.Err
+	}
+	return err == syscall.ENOSPC
+}
+
+func removeIfExists(filePath string) error {
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (o *opAgent) ThrottleDisk(ctx context.Context, request *m3em.ThrottleDiskRequest) (*m3em.ThrottleDiskResponse, error) {
+	if request == nil || request.ReadBytesPerSec < 0 || request.WriteBytesPerSec < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid request")
+	}
+	o.logger.Info("received ThrottleDisk()",
+		zap.String("dir", request.TargetDir),
+		zap.Int64("readBytesPerSec", request.ReadBytesPerSec),
+		zap.Int64("writeBytesPerSec", request.WriteBytesPerSec))
+
+	o.Lock()
+	defer o.Unlock()
+
+	if !o.Running() || o.processMonitor == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "not running")
+	}
+
+	dir, err := o.pathRelativeToWorkingDir(request.TargetDir)
+	if err != nil {
+		return nil, err
+	}
+
+	pid := o.processMonitor.Pid()
+	if pid < 0 {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "not running")
+	}
+
+	resetFn, err := o.opts.DiskThrottleFn()(
+		pid, dir, request.ReadBytesPerSec, request.WriteBytesPerSec)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "unable to throttle disk: %v", err)
+	}
+	o.diskThrottles[dir] = resetFn
+
+	return &m3em.ThrottleDiskResponse{}, nil
+}
+
+// CorruptFile inverts the bits of the specified byte range of a file in the
+// working directory, e.g. to corrupt a fileset.
+func (o *opAgent) CorruptFile(ctx context.Context, request *m3em.CorruptFileRequest) (*m3em.CorruptFileResponse, error) {
+	if request == nil || request.TargetPath == "" || request.Offset < 0 || request.Length <= 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid request")
+	}
+	o.logger.Info("received CorruptFile()",
+		zap.String("path", request.TargetPath),
+		zap.Int64("offset", request.Offset),
+		zap.Int64("length", request.Length))
+
+	o.RLock()
+	defer o.RUnlock()
+
+	if !o.isSetupWithLock() {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "agent has not been setup")
+	}
+
+	filePath, err := o.pathRelativeToWorkingDir(request.TargetPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := corruptFile(filePath, request.Offset, request.Length); err != nil {
+		return nil, err
+	}
+
+	return &m3em.CorruptFileResponse{}, nil
+}
+
+func corruptFile(filePath string, offset int64, length int64) error {
+	fd, err := os.OpenFile(filePath, os.O_RDWR, 0)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "unable to open file: %v", err)
+	}
+	defer fd.Close()
+
+	fi, err := fd.Stat()
+	if err != nil {
+		return grpc.Errorf(codes.Internal, "unable to stat file: %v", err)
+	}
+
+	if offset+length > fi.Size() {
+		return grpc.Errorf(codes.OutOfRange,
+			"range [%d, %d) exceeds file size %d", offset, offset+length, fi.Size())
+	}
+
+	buf := make([]byte, length)
+	if _, err := fd.ReadAt(buf, offset); err != nil {
+		return grpc.Errorf(codes.Internal, "unable to read file: %v", err)
+	}
+
+	for i := range buf {
+		buf[i] ^= 0xff
+	}
+
+	if _, err := fd.WriteAt(buf, offset); err != nil {
+		return grpc.Errorf(codes.Internal, "unable to write file: %v", err)
+	}
+
+	if err := fd.Sync(); err != nil {
+		return grpc.Errorf(codes.Internal, "unable to sync file: %v", err)
+	}
+
+	return nil
+}
+
+// StartProxy starts a proxy on the agent host, forwarding to the target
+// address. Proxies may only listen on the loopback interface.
+func (o *opAgent) StartProxy(ctx context.Context, request *m3em.StartProxyRequest) (*m3em.StartProxyResponse, error) {
+	if request == nil || request.TargetAddress == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid request")
+	}
+	o.logger.Info("received StartProxy()",
+		zap.String("listenAddress", request.ListenAddress),
+		zap.String("targetAddress", request.TargetAddress))
+
+	listenAddress, err := loopbackAddress(request.ListenAddress)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	o.Lock()
+	defer o.Unlock()
+
+	if !o.isSetupWithLock() {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "agent has not been setup")
+	}
+
+	p, err := proxy.New(listenAddress, request.TargetAddress, o.logger)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "unable to start proxy: %v", err)
+	}
+	o.proxies[p.Addr()] = p
+
+	return &m3em.StartProxyResponse{ListenAddress: p.Addr()}, nil
+}
+
+func loopbackAddress(address string) (string, error) {
+	if address == "" {
+		return net.JoinHostPort(defaultProxyHost, "0"), nil
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", err
+	}
+
+	if host == "" {
+		host = defaultProxyHost
+	}
+
+	if host != "localhost" {
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			return "", fmt.Errorf("proxy must listen on a loopback address: %s", address)
+		}
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
+
+func (o *opAgent) UpdateProxy(ctx context.Context, request *m3em.UpdateProxyRequest) (*m3em.UpdateProxyResponse, error) {
+	if request == nil || request.LatencyNanos < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid request")
+	}
+	o.logger.Info("received UpdateProxy()",
+		zap.String("listenAddress", request.ListenAddress),
+		zap.Duration("latency", time.Duration(request.LatencyNanos)),
+		zap.Bool("dropConnections", request.DropConnections))
+
+	o.RLock()
+	defer o.RUnlock()
+
+	p, ok := o.proxies[request.ListenAddress]
+	if !ok {
+		return nil, grpc.Errorf(codes.NotFound, "no proxy listening on: %s", request.ListenAddress)
+	}
+
+	p.SetLatency(time.Duration(request.LatencyNanos))
+	if request.DropConnections {
+		p.DropConnections()
+	}
+
+	return &m3em.UpdateProxyResponse{}, nil
+}
+
+func (o *opAgent) StopProxy(ctx context.Context, request *m3em.StopProxyRequest) (*m3em.StopProxyResponse, error) {
+	if request == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "nil request")
+	}
+	o.logger.Info("received StopProxy()", zap.String("listenAddress", request.ListenAddress))
+
+	o.Lock()
+	defer o.Unlock()
+
+	p, ok := o.proxies[request.ListenAddress]
+	if !ok {
+		return nil, grpc.Errorf(codes.NotFound, "no proxy listening on: %s", request.ListenAddress)
+	}
+	delete(o.proxies, request.ListenAddress)
+
+	if err := p.Close(); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "unable to stop proxy: %v", err)
+	}
+
+	return &m3em.StopProxyResponse{}, nil
+}
+
+// releaseFaultsWithLock stops any running proxies, removes any fill files and
+// removes any disk throttles.
+func (o *opAgent) releaseFaultsWithLock() error {
+	var multiErr xerrors.MultiError
+	for addr, p := range o.proxies {
+		multiErr = multiErr.Add(p.Close())
+		delete(o.proxies, addr)
+	}
+	for fillPath := range o.fillPaths {
+		multiErr = multiErr.Add(removeIfExists(fillPath))
+		delete(o.fillPaths, fillPath)
+	}
+	for dir, resetFn := range o.diskThrottles {
+		multiErr = multiErr.Add(resetFn())
+		delete(o.diskThrottles, dir)
+	}
+	return multiErr.FinalError()
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package agent
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/m3em/generated/proto/m3em"
+	mockexec "github.com/m3db/m3/src/m3em/os/exec/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	context "golang.org/x/net/context"
+)
+
+func newTestSetupAgent(t *testing.T, opts Options) *opAgent {
+	testAgent, err := New(opts)
+	require.NoError(t, err)
+	rawAgent, ok := testAgent.(*opAgent)
+	require.True(t, ok)
+	rawAgent.token = "setup-token"
+	return rawAgent
+}
+
+func TestProcessFaultsNotRunning(t *testing.T) {
+	tempDir := newTempDir(t)
+	defer os.RemoveAll(tempDir)
+
+	rawAgent := newTestSetupAgent(t, newTestOptions(tempDir))
+	defer rawAgent.Close()
+
+	_, err := rawAgent.PauseProcess(context.Background(), &m3em.PauseProcessRequest{})
+	require.Error(t, err)
+	_, err = rawAgent.ResumeProcess(context.Background(), &m3em.ResumeProcessRequest{})
+	require.Error(t, err)
+	_, err = rawAgent.KillProcess(context.Background(), &m3em.KillProcessRequest{})
+	require.Error(t, err)
+}
+
+func TestProcessFaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tempDir := newTempDir(t)
+	defer os.RemoveAll(tempDir)
+
+	rawAgent := newTestSetupAgent(t, newTestOptions(tempDir))
+	defer rawAgent.Close()
+
+	pm := mockexec.NewMockProcessMonitor(ctrl)
+	rawAgent.processMonitor = pm
+	rawAgent.running = 1
+
+	gomock.InOrder(
+		pm.EXPECT().Signal(syscall.SIGSTOP).Return(nil),
+		pm.EXPECT().Signal(syscall.SIGCONT).Return(nil),
+		pm.EXPECT().Signal(syscall.SIGKILL).Return(nil),
+	)
+
+	_, err := rawAgent.PauseProcess(context.Background(), &m3em.PauseProcessRequest{})
+	require.NoError(t, err)
+	_, err = rawAgent.ResumeProcess(context.Background(), &m3em.ResumeProcessRequest{})
+	require.NoError(t, err)
+	_, err = rawAgent.KillProcess(context.Background(), &m3em.KillProcessRequest{})
+	require.NoError(t, err)
+}
+
+func TestThrottleDisk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tempDir := newTempDir(t)
+	defer os.RemoveAll(tempDir)
+
+	var (
+		throttledPid int
+		throttledDir string
+		readLimit    int64
+		writeLimit   int64
+		numResets    int
+	)
+	opts := newTestOptions(tempDir).
+		SetDiskThrottleFn(func(pid int, dir string, r int64, w int64) (DiskThrottleResetFn, error) {
+			throttledPid, throttledDir, readLimit, writeLimit = pid, dir, r, w
+			return func() error {
+				numResets++
+				return nil
+			}, nil
+		})
+	rawAgent := newTestSetupAgent(t, opts)
+	defer rawAgent.Close()
+
+	_, err := rawAgent.ThrottleDisk(context.Background(), &m3em.ThrottleDiskRequest{TargetDir: "data"})
+	require.Error(t, err)
+
+	pm := mockexec.NewMockProcessMonitor(ctrl)
+	pm.EXPECT().Pid().Return(42)
+	rawAgent.processMonitor = pm
+	rawAgent.running = 1
+
+	_, err = rawAgent.ThrottleDisk(context.Background(), &m3em.ThrottleDiskRequest{
+		TargetDir:        "data",
+		ReadBytesPerSec:  1024,
+		WriteBytesPerSec: 2048,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 42, throttledPid)
+	require.Equal(t, path.Join(tempDir, "data"), throttledDir)
+	require.Equal(t, int64(1024), readLimit)
+	require.Equal(t, int64(2048), writeLimit)
+
+	// Releasing faults removes the limits.
+	require.NoError(t, rawAgent.releaseFaultsWithLock())
+	require.Equal(t, 1, numResets)
+	require.Empty(t, rawAgent.diskThrottles)
+}
+
+func TestFillDisk(t *testing.T) {
+	tempDir := newTempDir(t)
+	defer os.RemoveAll(tempDir)
+
+	rawAgent := newTestSetupAgent(t, newTestOptions(tempDir))
+	defer rawAgent.Close()
+
+	_, err := rawAgent.FillDisk(context.Background(), &m3em.FillDiskRequest{
+		TargetDir: "../escape",
+		NumBytes:  1,
+	})
+	require.Error(t, err)
+
+	numBytes := int64(defaultFillChunkSize + 10)
+	resp, err := rawAgent.FillDisk(context.Background(), &m3em.FillDiskRequest{
+		TargetDir: "data",
+		NumBytes:  numBytes,
+	})
+	require.NoError(t, err)
+	require.Equal(t, numBytes, resp.NumBytes)
+
+	fillPath := path.Join(tempDir, "data", defaultFillFileName)
+	fi, err := os.Stat(fillPath)
+	require.NoError(t, err)
+	require.Equal(t, numBytes, fi.Size())
+
+	_, err = rawAgent.FillDisk(context.Background(), &m3em.FillDiskRequest{TargetDir: "data"})
+	require.NoError(t, err)
+	_, err = os.Stat(fillPath)
+	require.True(t, os.IsNotExist(err))
+
+	// fill files are removed on teardown
+	_, err = rawAgent.FillDisk(context.Background(), &m3em.FillDiskRequest{
+		TargetDir: "data",
+		NumBytes:  10,
+	})
+	require.NoError(t, err)
+	_, err = rawAgent.Teardown(context.Background(), &m3em.TeardownRequest{})
+	require.NoError(t, err)
+	_, err = os.Stat(fillPath)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestCorruptFile(t *testing.T) {
+	tempDir := newTempDir(t)
+	defer os.RemoveAll(tempDir)
+
+	rawAgent := newTestSetupAgent(t, newTestOptions(tempDir))
+	defer rawAgent.Close()
+
+	original := []byte("abcdefgh")
+	filePath := path.Join(tempDir, "fileset-data.db")
+	require.NoError(t, ioutil.WriteFile(filePath, original, os.FileMode(0666)))
+
+	_, err := rawAgent.CorruptFile(context.Background(), &m3em.CorruptFileRequest{
+		TargetPath: "fileset-data.db",
+		Offset:     6,
+		Length:     3,
+	})
+	require.Error(t, err)
+
+	_, err = rawAgent.CorruptFile(context.Background(), &m3em.CorruptFileRequest{
+		TargetPath: "fileset-data.db",
+		Offset:     2,
+		Length:     3,
+	})
+	require.NoError(t, err)
+
+	corrupted, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Equal(t, len(original), len(corrupted))
+	for i := range original {
+		if i >= 2 && i < 5 {
+			require.NotEqual(t, original[i], corrupted[i])
+		} else {
+			require.Equal(t, original[i], corrupted[i])
+		}
+	}
+}
+
+func TestLoopbackAddress(t *testing.T) {
+	addr, err := loopbackAddress("")
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:0", addr)
+
+	addr, err = loopbackAddress(":9000")
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:9000", addr)
+
+	addr, err = loopbackAddress("localhost:9000")
+	require.NoError(t, err)
+	require.Equal(t, "localhost:9000", addr)
+
+	_, err = loopbackAddress("0.0.0.0:9000")
+	require.Error(t, err)
+
+	_, err = loopbackAddress("10.0.0.1:9000")
+	require.Error(t, err)
+}
+
+func TestProxyFaults(t *testing.T) {
+	tempDir := newTempDir(t)
+	defer os.RemoveAll(tempDir)
+
+	rawAgent := newTestSetupAgent(t, newTestOptions(tempDir))
+	defer rawAgent.Close()
+
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer target.Close()
+	go func() {
+		for {
+			conn, err := target.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(conn, conn)
+		}
+	}()
+
+	startResp, err := rawAgent.StartProxy(context.Background(), &m3em.StartProxyRequest{
+		TargetAddress: target.Addr().String(),
+	})
+	require.NoError(t, err)
+	listenAddress := startResp.ListenAddress
+
+	latency := 50 * time.Millisecond
+	_, err = rawAgent.UpdateProxy(context.Background(), &m3em.UpdateProxyRequest{
+		ListenAddress: listenAddress,
+		LatencyNanos:  int64(latency),
+	})
+	require.NoError(t, err)
+
+	conn, err := net.Dial("tcp", listenAddress)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	start := time.Now()
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.Equal(t, "ping", string(buf))
+	require.True(t, time.Since(start) >= 2*latency)
+
+	_, err = rawAgent.StopProxy(context.Background(), &m3em.StopProxyRequest{
+		ListenAddress: listenAddress,
+	})
+	require.NoError(t, err)
+
+	_, err = rawAgent.UpdateProxy(context.Background(), &m3em.UpdateProxyRequest{
+		ListenAddress: listenAddress,
+	})
+	require.Error(t, err)
+
+	// proxies are stopped on teardown
+	_, err = rawAgent.StartProxy(context.Background(), &m3em.StartProxyRequest{
+		TargetAddress: target.Addr().String(),
+	})
+	require.NoError(t, err)
+	_, err = rawAgent.Teardown(context.Background(), &m3em.TeardownRequest{})
+	require.NoError(t, err)
+	require.Empty(t, rawAgent.proxies)
+}
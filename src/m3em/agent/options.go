@@ -58,6 +58,7 @@ type opts struct {
 	nowFn            xclock.NowFn
 	newFileMode      os.FileMode
 	newDirectoryMode os.FileMode
+	diskThrottleFn   DiskThrottleFn
 }
 
 // NewOptions constructs new options
@@ -70,6 +71,7 @@ func NewOptions(io instrument.Options) Options {
 		nowFn:            time.Now,
 		newFileMode:      defaultNewFileMode,
 		newDirectoryMode: defaultNewDirectoryMode,
+		diskThrottleFn:   defaultDiskThrottleFn,
 	}
 }
 
@@ -174,3 +176,12 @@ func (o *opts) SetNewDirectoryMode(value os.FileMode) Options {
 func (o *opts) NewDirectoryMode() os.FileMode {
 	return o.newDirectoryMode
 }
+
+func (o *opts) SetDiskThrottleFn(fn DiskThrottleFn) Options {
+	o.diskThrottleFn = fn
+	return o
+}
+
+func (o *opts) DiskThrottleFn() DiskThrottleFn {
+	return o.diskThrottleFn
+}
@@ -107,6 +107,12 @@ type Options interface {
 
 	// NewDirectoryMode returns the new directory mode
 	NewDirectoryMode() os.FileMode
+
+	// SetDiskThrottleFn sets the DiskThrottleFn
+	SetDiskThrottleFn(DiskThrottleFn) Options
+
+	// DiskThrottleFn returns the DiskThrottleFn
+	DiskThrottleFn() DiskThrottleFn
 }
 
 // HostResourcesFn is used by the Agent to capture/release any resources
@@ -120,3 +126,12 @@ type HostResourcesFn func() error
 // e.g. say the process binary expects the config with a cli flag "-f",
 // ExecGenFn("binary", "config") == "binary", ["-f", "config"]
 type ExecGenFn func(buildPath string, configPath string) (execPath string, args []string)
+
+// DiskThrottleFn limits the disk bandwidth available to the process with the
+// specified pid, for the device backing the specified directory. A limit of
+// zero indicates the corresponding direction is unthrottled. It returns a
+// DiskThrottleResetFn which removes the limits from the throttled device.
+type DiskThrottleFn func(pid int, dir string, readBytesPerSec int64, writeBytesPerSec int64) (DiskThrottleResetFn, error)
+
+// DiskThrottleResetFn removes the limits set by a DiskThrottleFn.
+type DiskThrottleResetFn func() error
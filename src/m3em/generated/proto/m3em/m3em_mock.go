@@ -56,6 +56,86 @@ func (m *MockOperatorClient) EXPECT() *MockOperatorClientMockRecorder {
 	return m.recorder
 }
 
+// CorruptFile mocks base method
+func (m *MockOperatorClient) CorruptFile(arg0 context.Context, arg1 *CorruptFileRequest, arg2 ...grpc.CallOption) (*CorruptFileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CorruptFile", varargs...)
+	ret0, _ := ret[0].(*CorruptFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CorruptFile indicates an expected call of CorruptFile
+func (mr *MockOperatorClientMockRecorder) CorruptFile(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorruptFile", reflect.TypeOf((*MockOperatorClient)(nil).CorruptFile), varargs...)
+}
+
+// FillDisk mocks base method
+func (m *MockOperatorClient) FillDisk(arg0 context.Context, arg1 *FillDiskRequest, arg2 ...grpc.CallOption) (*FillDiskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FillDisk", varargs...)
+	ret0, _ := ret[0].(*FillDiskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FillDisk indicates an expected call of FillDisk
+func (mr *MockOperatorClientMockRecorder) FillDisk(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillDisk", reflect.TypeOf((*MockOperatorClient)(nil).FillDisk), varargs...)
+}
+
+// KillProcess mocks base method
+func (m *MockOperatorClient) KillProcess(arg0 context.Context, arg1 *KillProcessRequest, arg2 ...grpc.CallOption) (*KillProcessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "KillProcess", varargs...)
+	ret0, _ := ret[0].(*KillProcessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KillProcess indicates an expected call of KillProcess
+func (mr *MockOperatorClientMockRecorder) KillProcess(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillProcess", reflect.TypeOf((*MockOperatorClient)(nil).KillProcess), varargs...)
+}
+
+// PauseProcess mocks base method
+func (m *MockOperatorClient) PauseProcess(arg0 context.Context, arg1 *PauseProcessRequest, arg2 ...grpc.CallOption) (*PauseProcessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseProcess", varargs...)
+	ret0, _ := ret[0].(*PauseProcessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseProcess indicates an expected call of PauseProcess
+func (mr *MockOperatorClientMockRecorder) PauseProcess(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseProcess", reflect.TypeOf((*MockOperatorClient)(nil).PauseProcess), varargs...)
+}
+
 // PullFile mocks base method
 func (m *MockOperatorClient) PullFile(arg0 context.Context, arg1 *PullFileRequest, arg2 ...grpc.CallOption) (Operator_PullFileClient, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +176,26 @@ func (mr *MockOperatorClientMockRecorder) PushFile(arg0 interface{}, arg1 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushFile", reflect.TypeOf((*MockOperatorClient)(nil).PushFile), varargs...)
 }
 
+// ResumeProcess mocks base method
+func (m *MockOperatorClient) ResumeProcess(arg0 context.Context, arg1 *ResumeProcessRequest, arg2 ...grpc.CallOption) (*ResumeProcessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeProcess", varargs...)
+	ret0, _ := ret[0].(*ResumeProcessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeProcess indicates an expected call of ResumeProcess
+func (mr *MockOperatorClientMockRecorder) ResumeProcess(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeProcess", reflect.TypeOf((*MockOperatorClient)(nil).ResumeProcess), varargs...)
+}
+
 // Setup mocks base method
 func (m *MockOperatorClient) Setup(arg0 context.Context, arg1 *SetupRequest, arg2 ...grpc.CallOption) (*SetupResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +236,26 @@ func (mr *MockOperatorClientMockRecorder) Start(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockOperatorClient)(nil).Start), varargs...)
 }
 
+// StartProxy mocks base method
+func (m *MockOperatorClient) StartProxy(arg0 context.Context, arg1 *StartProxyRequest, arg2 ...grpc.CallOption) (*StartProxyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartProxy", varargs...)
+	ret0, _ := ret[0].(*StartProxyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartProxy indicates an expected call of StartProxy
+func (mr *MockOperatorClientMockRecorder) StartProxy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartProxy", reflect.TypeOf((*MockOperatorClient)(nil).StartProxy), varargs...)
+}
+
 // Stop mocks base method
 func (m *MockOperatorClient) Stop(arg0 context.Context, arg1 *StopRequest, arg2 ...grpc.CallOption) (*StopResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +276,26 @@ func (mr *MockOperatorClientMockRecorder) Stop(arg0, arg1 interface{}, arg2 ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockOperatorClient)(nil).Stop), varargs...)
 }
 
+// StopProxy mocks base method
+func (m *MockOperatorClient) StopProxy(arg0 context.Context, arg1 *StopProxyRequest, arg2 ...grpc.CallOption) (*StopProxyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopProxy", varargs...)
+	ret0, _ := ret[0].(*StopProxyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopProxy indicates an expected call of StopProxy
+func (mr *MockOperatorClientMockRecorder) StopProxy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopProxy", reflect.TypeOf((*MockOperatorClient)(nil).StopProxy), varargs...)
+}
+
 // Teardown mocks base method
 func (m *MockOperatorClient) Teardown(arg0 context.Context, arg1 *TeardownRequest, arg2 ...grpc.CallOption) (*TeardownResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +316,46 @@ func (mr *MockOperatorClientMockRecorder) Teardown(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Teardown", reflect.TypeOf((*MockOperatorClient)(nil).Teardown), varargs...)
 }
 
+// ThrottleDisk mocks base method
+func (m *MockOperatorClient) ThrottleDisk(arg0 context.Context, arg1 *ThrottleDiskRequest, arg2 ...grpc.CallOption) (*ThrottleDiskResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ThrottleDisk", varargs...)
+	ret0, _ := ret[0].(*ThrottleDiskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ThrottleDisk indicates an expected call of ThrottleDisk
+func (mr *MockOperatorClientMockRecorder) ThrottleDisk(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ThrottleDisk", reflect.TypeOf((*MockOperatorClient)(nil).ThrottleDisk), varargs...)
+}
+
+// UpdateProxy mocks base method
+func (m *MockOperatorClient) UpdateProxy(arg0 context.Context, arg1 *UpdateProxyRequest, arg2 ...grpc.CallOption) (*UpdateProxyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProxy", varargs...)
+	ret0, _ := ret[0].(*UpdateProxyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProxy indicates an expected call of UpdateProxy
+func (mr *MockOperatorClientMockRecorder) UpdateProxy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProxy", reflect.TypeOf((*MockOperatorClient)(nil).UpdateProxy), varargs...)
+}
+
 // MockOperator_PushFileClient is a mock of Operator_PushFileClient interface
 type MockOperator_PushFileClient struct {
 	ctrl     *gomock.Controller
@@ -41,6 +41,24 @@
 		PushFileRequest
 		PushFileResponse
 		DataChunk
+		PauseProcessRequest
+		PauseProcessResponse
+		ResumeProcessRequest
+		ResumeProcessResponse
+		KillProcessRequest
+		KillProcessResponse
+		FillDiskRequest
+		FillDiskResponse
+		ThrottleDiskRequest
+		ThrottleDiskResponse
+		StartProxyRequest
+		StartProxyResponse
+		UpdateProxyRequest
+		UpdateProxyResponse
+		StopProxyRequest
+		StopProxyResponse
+		CorruptFileRequest
+		CorruptFileResponse
 */
 package m3em
 
@@ -397,6 +415,278 @@ func (m *DataChunk) GetBytes() []byte {
 	return nil
 }
 
+type PauseProcessRequest struct {
+}
+
+func (m *PauseProcessRequest) Reset()                    { *m = PauseProcessRequest{} }
+func (m *PauseProcessRequest) String() string            { return proto.CompactTextString(m) }
+func (*PauseProcessRequest) ProtoMessage()               {}
+func (*PauseProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{13} }
+
+type PauseProcessResponse struct {
+}
+
+func (m *PauseProcessResponse) Reset()                    { *m = PauseProcessResponse{} }
+func (m *PauseProcessResponse) String() string            { return proto.CompactTextString(m) }
+func (*PauseProcessResponse) ProtoMessage()               {}
+func (*PauseProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{14} }
+
+type ResumeProcessRequest struct {
+}
+
+func (m *ResumeProcessRequest) Reset()                    { *m = ResumeProcessRequest{} }
+func (m *ResumeProcessRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResumeProcessRequest) ProtoMessage()               {}
+func (*ResumeProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{15} }
+
+type ResumeProcessResponse struct {
+}
+
+func (m *ResumeProcessResponse) Reset()                    { *m = ResumeProcessResponse{} }
+func (m *ResumeProcessResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResumeProcessResponse) ProtoMessage()               {}
+func (*ResumeProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{16} }
+
+type KillProcessRequest struct {
+}
+
+func (m *KillProcessRequest) Reset()                    { *m = KillProcessRequest{} }
+func (m *KillProcessRequest) String() string            { return proto.CompactTextString(m) }
+func (*KillProcessRequest) ProtoMessage()               {}
+func (*KillProcessRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{17} }
+
+type KillProcessResponse struct {
+}
+
+func (m *KillProcessResponse) Reset()                    { *m = KillProcessResponse{} }
+func (m *KillProcessResponse) String() string            { return proto.CompactTextString(m) }
+func (*KillProcessResponse) ProtoMessage()               {}
+func (*KillProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{18} }
+
+type FillDiskRequest struct {
+	TargetDir string `protobuf:"bytes,1,opt,name=target_dir,json=targetDir,proto3" json:"target_dir,omitempty"`
+	NumBytes  int64  `protobuf:"varint,2,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`
+}
+
+func (m *FillDiskRequest) Reset()                    { *m = FillDiskRequest{} }
+func (m *FillDiskRequest) String() string            { return proto.CompactTextString(m) }
+func (*FillDiskRequest) ProtoMessage()               {}
+func (*FillDiskRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{19} }
+
+func (m *FillDiskRequest) GetTargetDir() string {
+	if m != nil {
+		return m.TargetDir
+	}
+	return ""
+}
+
+func (m *FillDiskRequest) GetNumBytes() int64 {
+	if m != nil {
+		return m.NumBytes
+	}
+	return 0
+}
+
+type FillDiskResponse struct {
+	NumBytes int64 `protobuf:"varint,1,opt,name=num_bytes,json=numBytes,proto3" json:"num_bytes,omitempty"`
+}
+
+func (m *FillDiskResponse) Reset()                    { *m = FillDiskResponse{} }
+func (m *FillDiskResponse) String() string            { return proto.CompactTextString(m) }
+func (*FillDiskResponse) ProtoMessage()               {}
+func (*FillDiskResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{20} }
+
+func (m *FillDiskResponse) GetNumBytes() int64 {
+	if m != nil {
+		return m.NumBytes
+	}
+	return 0
+}
+
+type ThrottleDiskRequest struct {
+	TargetDir        string `protobuf:"bytes,1,opt,name=target_dir,json=targetDir,proto3" json:"target_dir,omitempty"`
+	ReadBytesPerSec  int64  `protobuf:"varint,2,opt,name=read_bytes_per_sec,json=readBytesPerSec,proto3" json:"read_bytes_per_sec,omitempty"`
+	WriteBytesPerSec int64  `protobuf:"varint,3,opt,name=write_bytes_per_sec,json=writeBytesPerSec,proto3" json:"write_bytes_per_sec,omitempty"`
+}
+
+func (m *ThrottleDiskRequest) Reset()                    { *m = ThrottleDiskRequest{} }
+func (m *ThrottleDiskRequest) String() string            { return proto.CompactTextString(m) }
+func (*ThrottleDiskRequest) ProtoMessage()               {}
+func (*ThrottleDiskRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{21} }
+
+func (m *ThrottleDiskRequest) GetTargetDir() string {
+	if m != nil {
+		return m.TargetDir
+	}
+	return ""
+}
+
+func (m *ThrottleDiskRequest) GetReadBytesPerSec() int64 {
+	if m != nil {
+		return m.ReadBytesPerSec
+	}
+	return 0
+}
+
+func (m *ThrottleDiskRequest) GetWriteBytesPerSec() int64 {
+	if m != nil {
+		return m.WriteBytesPerSec
+	}
+	return 0
+}
+
+type ThrottleDiskResponse struct {
+}
+
+func (m *ThrottleDiskResponse) Reset()                    { *m = ThrottleDiskResponse{} }
+func (m *ThrottleDiskResponse) String() string            { return proto.CompactTextString(m) }
+func (*ThrottleDiskResponse) ProtoMessage()               {}
+func (*ThrottleDiskResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{22} }
+
+type StartProxyRequest struct {
+	ListenAddress string `protobuf:"bytes,1,opt,name=listen_address,json=listenAddress,proto3" json:"listen_address,omitempty"`
+	TargetAddress string `protobuf:"bytes,2,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
+}
+
+func (m *StartProxyRequest) Reset()                    { *m = StartProxyRequest{} }
+func (m *StartProxyRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartProxyRequest) ProtoMessage()               {}
+func (*StartProxyRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{23} }
+
+func (m *StartProxyRequest) GetListenAddress() string {
+	if m != nil {
+		return m.ListenAddress
+	}
+	return ""
+}
+
+func (m *StartProxyRequest) GetTargetAddress() string {
+	if m != nil {
+		return m.TargetAddress
+	}
+	return ""
+}
+
+type StartProxyResponse struct {
+	ListenAddress string `protobuf:"bytes,1,opt,name=listen_address,json=listenAddress,proto3" json:"listen_address,omitempty"`
+}
+
+func (m *StartProxyResponse) Reset()                    { *m = StartProxyResponse{} }
+func (m *StartProxyResponse) String() string            { return proto.CompactTextString(m) }
+func (*StartProxyResponse) ProtoMessage()               {}
+func (*StartProxyResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{24} }
+
+func (m *StartProxyResponse) GetListenAddress() string {
+	if m != nil {
+		return m.ListenAddress
+	}
+	return ""
+}
+
+type UpdateProxyRequest struct {
+	ListenAddress   string `protobuf:"bytes,1,opt,name=listen_address,json=listenAddress,proto3" json:"listen_address,omitempty"`
+	LatencyNanos    int64  `protobuf:"varint,2,opt,name=latency_nanos,json=latencyNanos,proto3" json:"latency_nanos,omitempty"`
+	DropConnections bool   `protobuf:"varint,3,opt,name=drop_connections,json=dropConnections,proto3" json:"drop_connections,omitempty"`
+}
+
+func (m *UpdateProxyRequest) Reset()                    { *m = UpdateProxyRequest{} }
+func (m *UpdateProxyRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateProxyRequest) ProtoMessage()               {}
+func (*UpdateProxyRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{25} }
+
+func (m *UpdateProxyRequest) GetListenAddress() string {
+	if m != nil {
+		return m.ListenAddress
+	}
+	return ""
+}
+
+func (m *UpdateProxyRequest) GetLatencyNanos() int64 {
+	if m != nil {
+		return m.LatencyNanos
+	}
+	return 0
+}
+
+func (m *UpdateProxyRequest) GetDropConnections() bool {
+	if m != nil {
+		return m.DropConnections
+	}
+	return false
+}
+
+type UpdateProxyResponse struct {
+}
+
+func (m *UpdateProxyResponse) Reset()                    { *m = UpdateProxyResponse{} }
+func (m *UpdateProxyResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateProxyResponse) ProtoMessage()               {}
+func (*UpdateProxyResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{26} }
+
+type StopProxyRequest struct {
+	ListenAddress string `protobuf:"bytes,1,opt,name=listen_address,json=listenAddress,proto3" json:"listen_address,omitempty"`
+}
+
+func (m *StopProxyRequest) Reset()                    { *m = StopProxyRequest{} }
+func (m *StopProxyRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopProxyRequest) ProtoMessage()               {}
+func (*StopProxyRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{27} }
+
+func (m *StopProxyRequest) GetListenAddress() string {
+	if m != nil {
+		return m.ListenAddress
+	}
+	return ""
+}
+
+type StopProxyResponse struct {
+}
+
+func (m *StopProxyResponse) Reset()                    { *m = StopProxyResponse{} }
+func (m *StopProxyResponse) String() string            { return proto.CompactTextString(m) }
+func (*StopProxyResponse) ProtoMessage()               {}
+func (*StopProxyResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{28} }
+
+type CorruptFileRequest struct {
+	TargetPath string `protobuf:"bytes,1,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	Offset     int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length     int64  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *CorruptFileRequest) Reset()                    { *m = CorruptFileRequest{} }
+func (m *CorruptFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*CorruptFileRequest) ProtoMessage()               {}
+func (*CorruptFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{29} }
+
+func (m *CorruptFileRequest) GetTargetPath() string {
+	if m != nil {
+		return m.TargetPath
+	}
+	return ""
+}
+
+func (m *CorruptFileRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *CorruptFileRequest) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type CorruptFileResponse struct {
+}
+
+func (m *CorruptFileResponse) Reset()                    { *m = CorruptFileResponse{} }
+func (m *CorruptFileResponse) String() string            { return proto.CompactTextString(m) }
+func (*CorruptFileResponse) ProtoMessage()               {}
+func (*CorruptFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorOperator, []int{30} }
+
 func init() {
 	proto.RegisterType((*SetupRequest)(nil), "m3em.SetupRequest")
 	proto.RegisterType((*SetupResponse)(nil), "m3em.SetupResponse")
@@ -411,6 +701,24 @@ func init() {
 	proto.RegisterType((*PushFileRequest)(nil), "m3em.PushFileRequest")
 	proto.RegisterType((*PushFileResponse)(nil), "m3em.PushFileResponse")
 	proto.RegisterType((*DataChunk)(nil), "m3em.DataChunk")
+	proto.RegisterType((*PauseProcessRequest)(nil), "m3em.PauseProcessRequest")
+	proto.RegisterType((*PauseProcessResponse)(nil), "m3em.PauseProcessResponse")
+	proto.RegisterType((*ResumeProcessRequest)(nil), "m3em.ResumeProcessRequest")
+	proto.RegisterType((*ResumeProcessResponse)(nil), "m3em.ResumeProcessResponse")
+	proto.RegisterType((*KillProcessRequest)(nil), "m3em.KillProcessRequest")
+	proto.RegisterType((*KillProcessResponse)(nil), "m3em.KillProcessResponse")
+	proto.RegisterType((*FillDiskRequest)(nil), "m3em.FillDiskRequest")
+	proto.RegisterType((*FillDiskResponse)(nil), "m3em.FillDiskResponse")
+	proto.RegisterType((*ThrottleDiskRequest)(nil), "m3em.ThrottleDiskRequest")
+	proto.RegisterType((*ThrottleDiskResponse)(nil), "m3em.ThrottleDiskResponse")
+	proto.RegisterType((*StartProxyRequest)(nil), "m3em.StartProxyRequest")
+	proto.RegisterType((*StartProxyResponse)(nil), "m3em.StartProxyResponse")
+	proto.RegisterType((*UpdateProxyRequest)(nil), "m3em.UpdateProxyRequest")
+	proto.RegisterType((*UpdateProxyResponse)(nil), "m3em.UpdateProxyResponse")
+	proto.RegisterType((*StopProxyRequest)(nil), "m3em.StopProxyRequest")
+	proto.RegisterType((*StopProxyResponse)(nil), "m3em.StopProxyResponse")
+	proto.RegisterType((*CorruptFileRequest)(nil), "m3em.CorruptFileRequest")
+	proto.RegisterType((*CorruptFileResponse)(nil), "m3em.CorruptFileResponse")
 	proto.RegisterEnum("m3em.PullFileType", PullFileType_name, PullFileType_value)
 	proto.RegisterEnum("m3em.PullFileContentType", PullFileContentType_name, PullFileContentType_value)
 	proto.RegisterEnum("m3em.PushFileType", PushFileType_name, PushFileType_value)
@@ -433,6 +741,15 @@ type OperatorClient interface {
 	Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownResponse, error)
 	PullFile(ctx context.Context, in *PullFileRequest, opts ...grpc.CallOption) (Operator_PullFileClient, error)
 	PushFile(ctx context.Context, opts ...grpc.CallOption) (Operator_PushFileClient, error)
+	PauseProcess(ctx context.Context, in *PauseProcessRequest, opts ...grpc.CallOption) (*PauseProcessResponse, error)
+	ResumeProcess(ctx context.Context, in *ResumeProcessRequest, opts ...grpc.CallOption) (*ResumeProcessResponse, error)
+	KillProcess(ctx context.Context, in *KillProcessRequest, opts ...grpc.CallOption) (*KillProcessResponse, error)
+	FillDisk(ctx context.Context, in *FillDiskRequest, opts ...grpc.CallOption) (*FillDiskResponse, error)
+	ThrottleDisk(ctx context.Context, in *ThrottleDiskRequest, opts ...grpc.CallOption) (*ThrottleDiskResponse, error)
+	StartProxy(ctx context.Context, in *StartProxyRequest, opts ...grpc.CallOption) (*StartProxyResponse, error)
+	UpdateProxy(ctx context.Context, in *UpdateProxyRequest, opts ...grpc.CallOption) (*UpdateProxyResponse, error)
+	StopProxy(ctx context.Context, in *StopProxyRequest, opts ...grpc.CallOption) (*StopProxyResponse, error)
+	CorruptFile(ctx context.Context, in *CorruptFileRequest, opts ...grpc.CallOption) (*CorruptFileResponse, error)
 }
 
 type operatorClient struct {
@@ -545,6 +862,87 @@ func (x *operatorPushFileClient) CloseAndRecv() (*PushFileResponse, error) {
 	return m, nil
 }
 
+func (c *operatorClient) PauseProcess(ctx context.Context, in *PauseProcessRequest, opts ...grpc.CallOption) (*PauseProcessResponse, error) {
+	out := new(PauseProcessResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/PauseProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ResumeProcess(ctx context.Context, in *ResumeProcessRequest, opts ...grpc.CallOption) (*ResumeProcessResponse, error) {
+	out := new(ResumeProcessResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/ResumeProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) KillProcess(ctx context.Context, in *KillProcessRequest, opts ...grpc.CallOption) (*KillProcessResponse, error) {
+	out := new(KillProcessResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/KillProcess", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) FillDisk(ctx context.Context, in *FillDiskRequest, opts ...grpc.CallOption) (*FillDiskResponse, error) {
+	out := new(FillDiskResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/FillDisk", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ThrottleDisk(ctx context.Context, in *ThrottleDiskRequest, opts ...grpc.CallOption) (*ThrottleDiskResponse, error) {
+	out := new(ThrottleDiskResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/ThrottleDisk", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) StartProxy(ctx context.Context, in *StartProxyRequest, opts ...grpc.CallOption) (*StartProxyResponse, error) {
+	out := new(StartProxyResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/StartProxy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) UpdateProxy(ctx context.Context, in *UpdateProxyRequest, opts ...grpc.CallOption) (*UpdateProxyResponse, error) {
+	out := new(UpdateProxyResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/UpdateProxy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) StopProxy(ctx context.Context, in *StopProxyRequest, opts ...grpc.CallOption) (*StopProxyResponse, error) {
+	out := new(StopProxyResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/StopProxy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) CorruptFile(ctx context.Context, in *CorruptFileRequest, opts ...grpc.CallOption) (*CorruptFileResponse, error) {
+	out := new(CorruptFileResponse)
+	err := grpc.Invoke(ctx, "/m3em.Operator/CorruptFile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Operator service
 
 type OperatorServer interface {
@@ -554,6 +952,15 @@ type OperatorServer interface {
 	Teardown(context.Context, *TeardownRequest) (*TeardownResponse, error)
 	PullFile(*PullFileRequest, Operator_PullFileServer) error
 	PushFile(Operator_PushFileServer) error
+	PauseProcess(context.Context, *PauseProcessRequest) (*PauseProcessResponse, error)
+	ResumeProcess(context.Context, *ResumeProcessRequest) (*ResumeProcessResponse, error)
+	KillProcess(context.Context, *KillProcessRequest) (*KillProcessResponse, error)
+	FillDisk(context.Context, *FillDiskRequest) (*FillDiskResponse, error)
+	ThrottleDisk(context.Context, *ThrottleDiskRequest) (*ThrottleDiskResponse, error)
+	StartProxy(context.Context, *StartProxyRequest) (*StartProxyResponse, error)
+	UpdateProxy(context.Context, *UpdateProxyRequest) (*UpdateProxyResponse, error)
+	StopProxy(context.Context, *StopProxyRequest) (*StopProxyResponse, error)
+	CorruptFile(context.Context, *CorruptFileRequest) (*CorruptFileResponse, error)
 }
 
 func RegisterOperatorServer(s *grpc.Server, srv OperatorServer) {
@@ -679,60 +1086,258 @@ func (x *operatorPushFileServer) Recv() (*PushFileRequest, error) {
 	return m, nil
 }
 
-var _Operator_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "m3em.Operator",
-	HandlerType: (*OperatorServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Setup",
-			Handler:    _Operator_Setup_Handler,
-		},
-		{
-			MethodName: "Start",
-			Handler:    _Operator_Start_Handler,
-		},
-		{
-			MethodName: "Stop",
-			Handler:    _Operator_Stop_Handler,
-		},
-		{
-			MethodName: "Teardown",
-			Handler:    _Operator_Teardown_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "PullFile",
-			Handler:       _Operator_PullFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "PushFile",
-			Handler:       _Operator_PushFile_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "github.com/m3db/m3/src/m3em/generated/proto/m3em/operator.proto",
+func _Operator_PauseProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).PauseProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/PauseProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).PauseProcess(ctx, req.(*PauseProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _Operator_ResumeProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeProcessRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(OperatorServer).ResumeProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/ResumeProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ResumeProcess(ctx, req.(*ResumeProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SetupRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.SessionToken) > 0 {
-		dAtA[i] = 0xa
-		i++
+func _Operator_KillProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).KillProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/KillProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).KillProcess(ctx, req.(*KillProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_FillDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FillDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).FillDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/FillDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).FillDisk(ctx, req.(*FillDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ThrottleDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThrottleDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ThrottleDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/ThrottleDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ThrottleDisk(ctx, req.(*ThrottleDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_StartProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).StartProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/StartProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).StartProxy(ctx, req.(*StartProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/UpdateProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateProxy(ctx, req.(*UpdateProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_StopProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).StopProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/StopProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).StopProxy(ctx, req.(*StopProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_CorruptFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorruptFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).CorruptFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3em.Operator/CorruptFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).CorruptFile(ctx, req.(*CorruptFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Operator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "m3em.Operator",
+	HandlerType: (*OperatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Setup",
+			Handler:    _Operator_Setup_Handler,
+		},
+		{
+			MethodName: "Start",
+			Handler:    _Operator_Start_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _Operator_Stop_Handler,
+		},
+		{
+			MethodName: "Teardown",
+			Handler:    _Operator_Teardown_Handler,
+		},
+		{
+			MethodName: "PauseProcess",
+			Handler:    _Operator_PauseProcess_Handler,
+		},
+		{
+			MethodName: "ResumeProcess",
+			Handler:    _Operator_ResumeProcess_Handler,
+		},
+		{
+			MethodName: "KillProcess",
+			Handler:    _Operator_KillProcess_Handler,
+		},
+		{
+			MethodName: "FillDisk",
+			Handler:    _Operator_FillDisk_Handler,
+		},
+		{
+			MethodName: "ThrottleDisk",
+			Handler:    _Operator_ThrottleDisk_Handler,
+		},
+		{
+			MethodName: "StartProxy",
+			Handler:    _Operator_StartProxy_Handler,
+		},
+		{
+			MethodName: "UpdateProxy",
+			Handler:    _Operator_UpdateProxy_Handler,
+		},
+		{
+			MethodName: "StopProxy",
+			Handler:    _Operator_StopProxy_Handler,
+		},
+		{
+			MethodName: "CorruptFile",
+			Handler:    _Operator_CorruptFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PullFile",
+			Handler:       _Operator_PullFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PushFile",
+			Handler:       _Operator_PushFile_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "github.com/m3db/m3/src/m3em/generated/proto/m3em/operator.proto",
+}
+
+func (m *SetupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetupRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionToken) > 0 {
+		dAtA[i] = 0xa
+		i++
 		i = encodeVarintOperator(dAtA, i, uint64(len(m.SessionToken)))
 		i += copy(dAtA[i:], m.SessionToken)
 	}
@@ -1088,173 +1693,1949 @@ func (m *DataChunk) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintOperator(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *SetupRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.SessionToken)
-	if l > 0 {
-		n += 1 + l + sovOperator(uint64(l))
-	}
-	l = len(m.OperatorUuid)
-	if l > 0 {
-		n += 1 + l + sovOperator(uint64(l))
-	}
-	if m.Force {
-		n += 2
-	}
-	if m.HeartbeatEnabled {
-		n += 2
-	}
-	l = len(m.HeartbeatEndpoint)
-	if l > 0 {
-		n += 1 + l + sovOperator(uint64(l))
-	}
-	if m.HeartbeatFrequencySecs != 0 {
-		n += 1 + sovOperator(uint64(m.HeartbeatFrequencySecs))
+func (m *PauseProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetupResponse) Size() (n int) {
+func (m *PauseProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	return n
+	return i, nil
 }
 
-func (m *StartRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *PauseProcessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *StartResponse) Size() (n int) {
+func (m *PauseProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	return n
+	return i, nil
 }
 
-func (m *StopRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *ResumeProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *StopResponse) Size() (n int) {
+func (m *ResumeProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	return n
+	return i, nil
 }
 
-func (m *TeardownRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *ResumeProcessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *TeardownResponse) Size() (n int) {
+func (m *ResumeProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	return n
+	return i, nil
 }
 
-func (m *PullFileRequest) Size() (n int) {
+func (m *KillProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KillProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.FileType != 0 {
-		n += 1 + sovOperator(uint64(m.FileType))
+	return i, nil
+}
+
+func (m *KillProcessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.ChunkSize != 0 {
-		n += 1 + sovOperator(uint64(m.ChunkSize))
+	return dAtA[:n], nil
+}
+
+func (m *KillProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *FillDiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxSize != 0 {
-		n += 1 + sovOperator(uint64(m.MaxSize))
+	return dAtA[:n], nil
+}
+
+func (m *FillDiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetDir) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(len(m.TargetDir)))
+		i += copy(dAtA[i:], m.TargetDir)
 	}
-	return n
+	if m.NumBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(m.NumBytes))
+	}
+	return i, nil
 }
 
-func (m *PullFileResponse) Size() (n int) {
+func (m *FillDiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FillDiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovOperator(uint64(l))
+	if m.NumBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(m.NumBytes))
 	}
-	if m.Truncated {
-		n += 2
+	return i, nil
+}
+
+func (m *ThrottleDiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PushFileRequest) Size() (n int) {
+func (m *ThrottleDiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovOperator(uint64(m.Type))
+	if len(m.TargetDir) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(len(m.TargetDir)))
+		i += copy(dAtA[i:], m.TargetDir)
 	}
-	if m.Overwrite {
-		n += 2
+	if m.ReadBytesPerSec != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(m.ReadBytesPerSec))
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovOperator(uint64(l))
+	if m.WriteBytesPerSec != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(m.WriteBytesPerSec))
 	}
-	if len(m.TargetPaths) > 0 {
-		for _, s := range m.TargetPaths {
-			l = len(s)
-			n += 1 + l + sovOperator(uint64(l))
-		}
+	return i, nil
+}
+
+func (m *ThrottleDiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PushFileResponse) Size() (n int) {
+func (m *ThrottleDiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.FileChecksum != 0 {
-		n += 1 + sovOperator(uint64(m.FileChecksum))
+	return i, nil
+}
+
+func (m *StartProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.NumChunksRecvd != 0 {
-		n += 1 + sovOperator(uint64(m.NumChunksRecvd))
+	return dAtA[:n], nil
+}
+
+func (m *StartProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ListenAddress) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(len(m.ListenAddress)))
+		i += copy(dAtA[i:], m.ListenAddress)
 	}
-	return n
+	if len(m.TargetAddress) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(len(m.TargetAddress)))
+		i += copy(dAtA[i:], m.TargetAddress)
+	}
+	return i, nil
 }
 
-func (m *DataChunk) Size() (n int) {
+func (m *StartProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Idx != 0 {
-		n += 1 + sovOperator(uint64(m.Idx))
+	if len(m.ListenAddress) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(len(m.ListenAddress)))
+		i += copy(dAtA[i:], m.ListenAddress)
 	}
-	l = len(m.Bytes)
-	if l > 0 {
-		n += 1 + l + sovOperator(uint64(l))
+	return i, nil
+}
+
+func (m *UpdateProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovOperator(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *UpdateProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ListenAddress) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(len(m.ListenAddress)))
+		i += copy(dAtA[i:], m.ListenAddress)
+	}
+	if m.LatencyNanos != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(m.LatencyNanos))
+	}
+	if m.DropConnections {
+		dAtA[i] = 0x18
+		i++
+		if m.DropConnections {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
+	return i, nil
 }
-func sozOperator(x uint64) (n int) {
-	return sovOperator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *UpdateProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *StopProxyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopProxyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ListenAddress) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(len(m.ListenAddress)))
+		i += copy(dAtA[i:], m.ListenAddress)
+	}
+	return i, nil
+}
+
+func (m *StopProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *CorruptFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorruptFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetPath) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(len(m.TargetPath)))
+		i += copy(dAtA[i:], m.TargetPath)
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOperator(dAtA, i, uint64(m.Length))
+	}
+	return i, nil
+}
+
+func (m *CorruptFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorruptFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeVarintOperator(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *SetupRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	l = len(m.OperatorUuid)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.HeartbeatEnabled {
+		n += 2
+	}
+	l = len(m.HeartbeatEndpoint)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	if m.HeartbeatFrequencySecs != 0 {
+		n += 1 + sovOperator(uint64(m.HeartbeatFrequencySecs))
+	}
+	return n
+}
+
+func (m *SetupResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StartRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StartResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StopRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StopResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TeardownRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TeardownResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PullFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.FileType != 0 {
+		n += 1 + sovOperator(uint64(m.FileType))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovOperator(uint64(m.ChunkSize))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovOperator(uint64(m.MaxSize))
+	}
+	return n
+}
+
+func (m *PullFileResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *PushFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovOperator(uint64(m.Type))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	if len(m.TargetPaths) > 0 {
+		for _, s := range m.TargetPaths {
+			l = len(s)
+			n += 1 + l + sovOperator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PushFileResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.FileChecksum != 0 {
+		n += 1 + sovOperator(uint64(m.FileChecksum))
+	}
+	if m.NumChunksRecvd != 0 {
+		n += 1 + sovOperator(uint64(m.NumChunksRecvd))
+	}
+	return n
+}
+
+func (m *DataChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.Idx != 0 {
+		n += 1 + sovOperator(uint64(m.Idx))
+	}
+	l = len(m.Bytes)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseProcessResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResumeProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResumeProcessResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *KillProcessRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *KillProcessResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FillDiskRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TargetDir)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	if m.NumBytes != 0 {
+		n += 1 + sovOperator(uint64(m.NumBytes))
+	}
+	return n
+}
+
+func (m *FillDiskResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.NumBytes != 0 {
+		n += 1 + sovOperator(uint64(m.NumBytes))
+	}
+	return n
+}
+
+func (m *ThrottleDiskRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TargetDir)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	if m.ReadBytesPerSec != 0 {
+		n += 1 + sovOperator(uint64(m.ReadBytesPerSec))
+	}
+	if m.WriteBytesPerSec != 0 {
+		n += 1 + sovOperator(uint64(m.WriteBytesPerSec))
+	}
+	return n
+}
+
+func (m *ThrottleDiskResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StartProxyRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ListenAddress)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	return n
+}
+
+func (m *StartProxyResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ListenAddress)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateProxyRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ListenAddress)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	if m.LatencyNanos != 0 {
+		n += 1 + sovOperator(uint64(m.LatencyNanos))
+	}
+	if m.DropConnections {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateProxyResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StopProxyRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ListenAddress)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	return n
+}
+
+func (m *StopProxyResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CorruptFileRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TargetPath)
+	if l > 0 {
+		n += 1 + l + sovOperator(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovOperator(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovOperator(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *CorruptFileResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func sovOperator(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozOperator(x uint64) (n int) {
+	return sovOperator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorUuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HeartbeatEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeartbeatEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatFrequencySecs", wireType)
+			}
+			m.HeartbeatFrequencySecs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatFrequencySecs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeardownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeardownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeardownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeardownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeardownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			}
+			m.FileType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileType |= (PullFileType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &DataChunk{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (PushFileType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &DataChunk{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPaths = append(m.TargetPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileChecksum", wireType)
+			}
+			m.FileChecksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileChecksum |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumChunksRecvd", wireType)
+			}
+			m.NumChunksRecvd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumChunksRecvd |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
+			}
+			m.Idx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Idx |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseProcessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseProcessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *SetupRequest) Unmarshal(dAtA []byte) error {
+func (m *ResumeProcessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1277,158 +3658,112 @@ func (m *SetupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeProcessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResumeProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthOperator
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorUuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOperator
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeProcessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HeartbeatEnabled = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatEndpoint", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeProcessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthOperator
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HeartbeatEndpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatFrequencySecs", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KillProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
 			}
-			m.HeartbeatFrequencySecs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HeartbeatFrequencySecs |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KillProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KillProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -1450,7 +3785,7 @@ func (m *SetupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetupResponse) Unmarshal(dAtA []byte) error {
+func (m *KillProcessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1473,10 +3808,10 @@ func (m *SetupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: KillProcessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KillProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1500,7 +3835,7 @@ func (m *SetupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartRequest) Unmarshal(dAtA []byte) error {
+func (m *FillDiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1513,22 +3848,70 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FillDiskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FillDiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBytes", wireType)
+			}
+			m.NumBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -1550,7 +3933,7 @@ func (m *StartRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartResponse) Unmarshal(dAtA []byte) error {
+func (m *FillDiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1573,12 +3956,31 @@ func (m *StartResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FillDiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FillDiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBytes", wireType)
+			}
+			m.NumBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -1600,7 +4002,7 @@ func (m *StartResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopRequest) Unmarshal(dAtA []byte) error {
+func (m *ThrottleDiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1623,12 +4025,79 @@ func (m *StopRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ThrottleDiskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ThrottleDiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytesPerSec", wireType)
+			}
+			m.ReadBytesPerSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytesPerSec |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytesPerSec", wireType)
+			}
+			m.WriteBytesPerSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteBytesPerSec |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -1650,7 +4119,7 @@ func (m *StopRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopResponse) Unmarshal(dAtA []byte) error {
+func (m *ThrottleDiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,10 +4142,10 @@ func (m *StopResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ThrottleDiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ThrottleDiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1700,7 +4169,7 @@ func (m *StopResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
+func (m *StartProxyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1723,12 +4192,70 @@ func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TeardownRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartProxyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TeardownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -1750,7 +4277,7 @@ func (m *TeardownRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TeardownResponse) Unmarshal(dAtA []byte) error {
+func (m *StartProxyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1773,12 +4300,41 @@ func (m *TeardownResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TeardownResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartProxyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TeardownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -1800,7 +4356,7 @@ func (m *TeardownResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullFileRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateProxyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1823,17 +4379,17 @@ func (m *PullFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateProxyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListenAddress", wireType)
 			}
-			m.FileType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperator
@@ -1843,16 +4399,26 @@ func (m *PullFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileType |= (PullFileType(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyNanos", wireType)
 			}
-			m.ChunkSize = 0
+			m.LatencyNanos = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperator
@@ -1862,16 +4428,16 @@ func (m *PullFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChunkSize |= (int64(b) & 0x7F) << shift
+				m.LatencyNanos |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropConnections", wireType)
 			}
-			m.MaxSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperator
@@ -1881,11 +4447,62 @@ func (m *PullFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSize |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DropConnections = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOperator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -1907,7 +4524,7 @@ func (m *PullFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullFileResponse) Unmarshal(dAtA []byte) error {
+func (m *StopProxyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1930,17 +4547,17 @@ func (m *PullFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopProxyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopProxyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListenAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperator
@@ -1950,45 +4567,21 @@ func (m *PullFileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOperator
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &DataChunk{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ListenAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -2010,7 +4603,7 @@ func (m *PullFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushFileRequest) Unmarshal(dAtA []byte) error {
+func (m *StopProxyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2033,113 +4626,12 @@ func (m *PushFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopProxyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= (PushFileType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Overwrite = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOperator
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data == nil {
-				m.Data = &DataChunk{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPaths", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOperator
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetPaths = append(m.TargetPaths, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -2161,7 +4653,7 @@ func (m *PushFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushFileResponse) Unmarshal(dAtA []byte) error {
+func (m *CorruptFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2184,17 +4676,17 @@ func (m *PushFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CorruptFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CorruptFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileChecksum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPath", wireType)
 			}
-			m.FileChecksum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperator
@@ -2204,16 +4696,26 @@ func (m *PushFileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileChecksum |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumChunksRecvd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.NumChunksRecvd = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOperator
@@ -2223,7 +4725,26 @@ func (m *PushFileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumChunksRecvd |= (int32(b) & 0x7F) << shift
+				m.Offset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2249,7 +4770,7 @@ func (m *PushFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataChunk) Unmarshal(dAtA []byte) error {
+func (m *CorruptFileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2272,62 +4793,12 @@ func (m *DataChunk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataChunk: wiretype end group for non-group")
+			return fmt.Errorf("proto: CorruptFileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CorruptFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
-			}
-			m.Idx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Idx |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOperator
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOperator
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytes == nil {
-				m.Bytes = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOperator(dAtA[iNdEx:])
@@ -2459,58 +4930,86 @@ func init() {
 }
 
 var fileDescriptorOperator = []byte{
-	// 841 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
-	0x18, 0x5d, 0xe7, 0x67, 0x49, 0xbe, 0x26, 0xad, 0x3b, 0x0b, 0x95, 0x29, 0xbb, 0xa1, 0xeb, 0x95,
-	0x50, 0x54, 0xb4, 0xcd, 0xaa, 0xb9, 0x01, 0x71, 0x81, 0xba, 0x69, 0x02, 0x15, 0x51, 0x52, 0x8d,
-	0x1d, 0xd0, 0x5e, 0x59, 0x8e, 0x3d, 0x6d, 0xac, 0xc6, 0x3f, 0x78, 0xc6, 0xbb, 0xed, 0xc2, 0x25,
-	0xd7, 0x88, 0x37, 0xe0, 0x75, 0xb8, 0x44, 0x3c, 0x01, 0x2a, 0x2f, 0x82, 0x66, 0xc6, 0x63, 0xbb,
-	0xd1, 0xb6, 0x77, 0x99, 0x73, 0xce, 0x37, 0xdf, 0x77, 0x66, 0xc6, 0x27, 0xf0, 0xed, 0x65, 0xc0,
-	0x56, 0xd9, 0xf2, 0xc8, 0x8b, 0xc3, 0x41, 0x38, 0xf4, 0x97, 0x83, 0x70, 0x38, 0xa0, 0xa9, 0x37,
-	0x08, 0x87, 0x24, 0x1c, 0x5c, 0x92, 0x88, 0xa4, 0x2e, 0x23, 0xfe, 0x20, 0x49, 0x63, 0x16, 0x4b,
-	0x30, 0x4e, 0x38, 0x16, 0xa7, 0x47, 0x02, 0x43, 0x0d, 0x0e, 0x9a, 0xbf, 0xd5, 0xa0, 0x63, 0x11,
-	0x96, 0x25, 0x98, 0xfc, 0x9c, 0x11, 0xca, 0xd0, 0x0b, 0xe8, 0x52, 0x42, 0x69, 0x10, 0x47, 0x0e,
-	0x8b, 0xaf, 0x48, 0x64, 0x68, 0x07, 0x5a, 0xbf, 0x8d, 0x3b, 0x39, 0x68, 0x73, 0x8c, 0x8b, 0xd4,
-	0x6e, 0x4e, 0x96, 0x05, 0xbe, 0x51, 0x93, 0x22, 0x05, 0x2e, 0xb2, 0xc0, 0x47, 0x1f, 0x43, 0xf3,
-	0x22, 0x4e, 0x3d, 0x62, 0xd4, 0x0f, 0xb4, 0x7e, 0x0b, 0xcb, 0x05, 0xfa, 0x12, 0x76, 0x57, 0xc4,
-	0x4d, 0xd9, 0x92, 0xb8, 0xcc, 0x21, 0x91, 0xbb, 0x5c, 0x13, 0xdf, 0x68, 0x08, 0x85, 0x5e, 0x10,
-	0x63, 0x89, 0xa3, 0x97, 0x80, 0xaa, 0x62, 0x3f, 0x89, 0x83, 0x88, 0x19, 0x4d, 0xd1, 0x6c, 0xb7,
-	0xa2, 0x96, 0x04, 0xfa, 0x0a, 0x8c, 0x52, 0x7e, 0x91, 0x72, 0x47, 0x91, 0x77, 0xe3, 0x50, 0xe2,
-	0x51, 0xe3, 0xf1, 0x81, 0xd6, 0xef, 0xe2, 0xbd, 0x82, 0x9f, 0x28, 0xda, 0x22, 0x1e, 0x35, 0x77,
-	0xa0, 0x9b, 0x9f, 0x02, 0x4d, 0xe2, 0x88, 0x12, 0x73, 0x1b, 0x3a, 0x16, 0x73, 0x53, 0x96, 0x1f,
-	0x8b, 0x10, 0xc8, 0x75, 0x2e, 0xe8, 0xc2, 0x96, 0xc5, 0x62, 0x75, 0x6c, 0x52, 0x1f, 0x97, 0xf5,
-	0xbb, 0xb0, 0x63, 0x13, 0x37, 0xf5, 0xe3, 0x77, 0x91, 0x92, 0x20, 0xd0, 0x4b, 0x28, 0x97, 0xfd,
-	0x0a, 0x3b, 0xe7, 0xd9, 0x7a, 0x3d, 0x09, 0xd6, 0x44, 0x5d, 0xc0, 0x00, 0xda, 0x17, 0xc1, 0x9a,
-	0x38, 0xec, 0x26, 0x21, 0xe2, 0xf0, 0xb7, 0x8f, 0xd1, 0x11, 0xbf, 0xab, 0x23, 0xa5, 0xb4, 0x6f,
-	0x12, 0x82, 0x5b, 0x17, 0xf9, 0x2f, 0xf4, 0x0c, 0xc0, 0x5b, 0x65, 0xd1, 0x95, 0x43, 0x83, 0xf7,
-	0x44, 0xdc, 0x44, 0x1d, 0xb7, 0x05, 0x62, 0x05, 0xef, 0x09, 0xfa, 0x14, 0x5a, 0xa1, 0x7b, 0x2d,
-	0xc9, 0xba, 0x20, 0x3f, 0x0a, 0xdd, 0x6b, 0x4e, 0x99, 0x0b, 0xd0, 0xcb, 0xee, 0x72, 0x22, 0xf4,
-	0x02, 0x1a, 0xbe, 0xcb, 0x5c, 0xd1, 0x79, 0xeb, 0x78, 0x47, 0x76, 0x3e, 0x75, 0x99, 0x3b, 0xe2,
-	0x3b, 0x62, 0x41, 0xa2, 0xa7, 0xd0, 0x66, 0x69, 0x16, 0x79, 0xfc, 0x89, 0x89, 0x8e, 0x2d, 0x5c,
-	0x02, 0xe6, 0x9f, 0x1a, 0x77, 0x45, 0x57, 0x55, 0x57, 0x5f, 0x40, 0xe3, 0x43, 0x86, 0xa4, 0x48,
-	0x18, 0x12, 0x3c, 0xdf, 0x39, 0x7e, 0x4b, 0xd2, 0x77, 0x69, 0xc0, 0x88, 0xda, 0xb9, 0x00, 0x8a,
-	0xe1, 0xea, 0x0f, 0x0d, 0xf7, 0x1c, 0x3a, 0xcc, 0x4d, 0x2f, 0x09, 0x73, 0x12, 0x97, 0xad, 0xa8,
-	0xd1, 0x38, 0xa8, 0xf7, 0xdb, 0x78, 0x4b, 0x62, 0xe7, 0x1c, 0x32, 0x5d, 0x6e, 0x5c, 0x0d, 0x58,
-	0x18, 0xef, 0x8a, 0x73, 0xf7, 0x56, 0xc4, 0xbb, 0xa2, 0x59, 0x28, 0x46, 0xed, 0xe2, 0x0e, 0x07,
-	0x47, 0x39, 0x86, 0xfa, 0xa0, 0x47, 0x59, 0xe8, 0x88, 0xd3, 0xa5, 0x4e, 0x4a, 0xbc, 0xb7, 0xd2,
-	0x7f, 0x13, 0x6f, 0x47, 0x59, 0x28, 0xa6, 0xa0, 0x98, 0xa3, 0xe6, 0x10, 0xda, 0xc5, 0x60, 0x48,
-	0x87, 0x7a, 0xe0, 0x5f, 0x8b, 0x1d, 0x9b, 0x98, 0xff, 0xe4, 0x1f, 0xc7, 0xf2, 0x86, 0x11, 0x2a,
-	0xaa, 0x3b, 0x58, 0x2e, 0x0e, 0x13, 0xe8, 0x54, 0x2f, 0x19, 0xed, 0xc3, 0xde, 0xf9, 0x62, 0x3a,
-	0x75, 0x26, 0x67, 0xd3, 0xb1, 0x63, 0xbf, 0x39, 0x1f, 0x3b, 0x8b, 0xd9, 0x0f, 0xb3, 0xf9, 0x4f,
-	0x33, 0xfd, 0x11, 0x7a, 0x0e, 0xcf, 0x36, 0x38, 0x6b, 0x8c, 0x7f, 0x3c, 0x1b, 0x8d, 0x1d, 0xcb,
-	0x3e, 0x9d, 0x2f, 0x6c, 0x5d, 0x7b, 0x58, 0x32, 0xc6, 0x58, 0xaf, 0x1d, 0xfe, 0x02, 0x4f, 0x54,
-	0xc7, 0x51, 0x1c, 0x31, 0x12, 0x31, 0xd1, 0xd8, 0x84, 0x5e, 0x59, 0x39, 0x9a, 0xcf, 0xec, 0xf1,
-	0xcc, 0xde, 0x1c, 0xe0, 0x73, 0xf8, 0xec, 0x1e, 0xcd, 0xf4, 0xc4, 0xe2, 0xed, 0xef, 0x17, 0x4c,
-	0x16, 0xd3, 0xa9, 0x5e, 0x3b, 0xfc, 0x5d, 0xe3, 0x7e, 0xcb, 0x37, 0x20, 0xfd, 0x5a, 0xdf, 0xdf,
-	0xef, 0xf7, 0x0e, 0xa7, 0xcc, 0xbc, 0x3e, 0x9b, 0x9d, 0xe0, 0x37, 0xca, 0xef, 0x07, 0x25, 0xa3,
-	0xf9, 0x6c, 0x72, 0xf6, 0x9d, 0x5e, 0x43, 0x4f, 0xc1, 0xd8, 0x90, 0x9c, 0x9e, 0xd8, 0x27, 0x62,
-	0xa9, 0xd7, 0x8f, 0xff, 0xa9, 0x41, 0x6b, 0x9e, 0x67, 0x18, 0x7a, 0x05, 0x4d, 0x91, 0x09, 0x28,
-	0x7f, 0xad, 0xd5, 0x98, 0xdc, 0x7f, 0x72, 0x07, 0xcb, 0x9f, 0x10, 0xaf, 0xe0, 0x21, 0x51, 0x54,
-	0x54, 0x12, 0xa4, 0xa8, 0xa8, 0xa6, 0x08, 0x7a, 0x09, 0x0d, 0x1e, 0x1b, 0x68, 0x57, 0x91, 0x45,
-	0xa2, 0xec, 0xa3, 0x2a, 0x94, 0xcb, 0xbf, 0x86, 0x96, 0x8a, 0x10, 0xf4, 0x89, 0xe4, 0x37, 0x52,
-	0x66, 0x7f, 0x6f, 0x13, 0xce, 0x4b, 0xbf, 0x81, 0x96, 0xba, 0x68, 0x55, 0xba, 0x91, 0x3c, 0xaa,
-	0x74, 0x33, 0x12, 0x5e, 0x69, 0xb2, 0x58, 0xde, 0x53, 0x59, 0x7c, 0xe7, 0x03, 0x2f, 0x8b, 0xef,
-	0x7e, 0x56, 0x7d, 0xed, 0xb5, 0xfe, 0xd7, 0x6d, 0x4f, 0xfb, 0xfb, 0xb6, 0xa7, 0xfd, 0x7b, 0xdb,
-	0xd3, 0xfe, 0xf8, 0xaf, 0xf7, 0x68, 0xf9, 0x58, 0xfc, 0x03, 0x0d, 0xff, 0x0f, 0x00, 0x00, 0xff,
-	0xff, 0x5e, 0x54, 0x4b, 0x61, 0xc4, 0x06, 0x00, 0x00,
+	// 1286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x57, 0xdd, 0x6e, 0x1a, 0x47,
+	0x14, 0x0e, 0xc6, 0x76, 0xe0, 0x18, 0xcc, 0x7a, 0x6c, 0x63, 0x8c, 0x1b, 0xa7, 0xa5, 0x6a, 0xe5,
+	0x26, 0xaa, 0x89, 0xec, 0x9b, 0x46, 0xa9, 0x14, 0xf9, 0x07, 0x37, 0x56, 0x5c, 0x8c, 0x16, 0x68,
+	0x95, 0xab, 0xd5, 0xb2, 0x3b, 0x98, 0x95, 0x61, 0x97, 0xee, 0x4f, 0x62, 0xb7, 0xbd, 0xec, 0x5d,
+	0xa5, 0x2a, 0x6f, 0xd0, 0x57, 0xe8, 0x63, 0xf4, 0xb2, 0x8f, 0x50, 0xb5, 0x2f, 0xd2, 0x33, 0x7f,
+	0xec, 0xb2, 0xe0, 0x28, 0xcd, 0x05, 0x86, 0xf9, 0xce, 0x77, 0xe6, 0xfc, 0xcc, 0x99, 0x73, 0xc6,
+	0xf0, 0xfc, 0xca, 0x09, 0x07, 0x51, 0x6f, 0xdf, 0xf2, 0x46, 0xf5, 0xd1, 0xa1, 0xdd, 0xc3, 0x3f,
+	0xf5, 0xc0, 0xb7, 0xf0, 0x8b, 0x8e, 0xea, 0x57, 0xd4, 0xa5, 0xbe, 0x19, 0x52, 0xbb, 0x3e, 0xf6,
+	0xbd, 0xd0, 0x13, 0xa0, 0x37, 0x66, 0x98, 0xe7, 0xef, 0x73, 0x8c, 0x2c, 0x32, 0xb0, 0xf6, 0xcb,
+	0x02, 0x14, 0xda, 0x34, 0x8c, 0xc6, 0x3a, 0xfd, 0x21, 0xa2, 0x41, 0x48, 0x3e, 0x85, 0x62, 0x40,
+	0x83, 0xc0, 0xf1, 0x5c, 0x23, 0xf4, 0xae, 0xa9, 0x5b, 0xc9, 0x7c, 0x9c, 0xd9, 0xcb, 0xeb, 0x05,
+	0x09, 0x76, 0x18, 0xc6, 0x48, 0x6a, 0x37, 0x23, 0x8a, 0x1c, 0xbb, 0xb2, 0x20, 0x48, 0x0a, 0xec,
+	0x22, 0x46, 0x36, 0x60, 0xa9, 0xef, 0xf9, 0x16, 0xad, 0x64, 0x51, 0x98, 0xd3, 0xc5, 0x82, 0x3c,
+	0x86, 0xb5, 0x01, 0x35, 0xfd, 0xb0, 0x47, 0xcd, 0xd0, 0xa0, 0xae, 0xd9, 0x1b, 0x52, 0xbb, 0xb2,
+	0xc8, 0x19, 0xda, 0x44, 0xd0, 0x10, 0x38, 0xf9, 0x12, 0x48, 0x92, 0x6c, 0x8f, 0x3d, 0xc7, 0x0d,
+	0x2b, 0x4b, 0xdc, 0xd8, 0x5a, 0x82, 0x2d, 0x04, 0xe4, 0x2b, 0xa8, 0xc4, 0xf4, 0xbe, 0xcf, 0x22,
+	0x72, 0xad, 0x5b, 0x23, 0xa0, 0x56, 0x50, 0x59, 0x46, 0xa5, 0xa2, 0x5e, 0x9e, 0xc8, 0xcf, 0x94,
+	0xb8, 0x8d, 0xd2, 0x5a, 0x09, 0x8a, 0x32, 0x0b, 0xc1, 0xd8, 0x73, 0x03, 0x5a, 0x5b, 0xc5, 0xb4,
+	0x84, 0x48, 0x95, 0x69, 0xe1, 0x04, 0xb1, 0x96, 0x84, 0x22, 0xac, 0xb4, 0x43, 0x4f, 0xa5, 0x4d,
+	0xf0, 0xbd, 0x58, 0x7f, 0x0d, 0x4a, 0x1d, 0x34, 0x65, 0x7b, 0x6f, 0x5c, 0x45, 0x21, 0xa0, 0xc5,
+	0x90, 0xa4, 0xfd, 0x0c, 0xa5, 0x56, 0x34, 0x1c, 0x9e, 0x39, 0x43, 0xaa, 0x0e, 0xa0, 0x0e, 0xf9,
+	0x3e, 0x2e, 0x8d, 0xf0, 0x76, 0x4c, 0x79, 0xf2, 0x57, 0x0f, 0xc8, 0x3e, 0x3b, 0xab, 0x7d, 0xc5,
+	0xec, 0xa0, 0x44, 0xcf, 0xf5, 0xe5, 0x2f, 0xf2, 0x00, 0xc0, 0x1a, 0x44, 0xee, 0xb5, 0x11, 0x38,
+	0x3f, 0x52, 0x7e, 0x12, 0x59, 0x3d, 0xcf, 0x91, 0x36, 0x02, 0x64, 0x1b, 0x72, 0x23, 0xf3, 0x46,
+	0x08, 0xb3, 0x5c, 0x78, 0x1f, 0xd7, 0x4c, 0x54, 0xeb, 0x82, 0x16, 0x5b, 0x17, 0x1e, 0xe1, 0xd1,
+	0x2e, 0xda, 0x66, 0x68, 0x72, 0xcb, 0x2b, 0x07, 0x25, 0x61, 0xf9, 0x14, 0x91, 0x13, 0xb6, 0xa3,
+	0xce, 0x85, 0xe4, 0x23, 0xc8, 0x87, 0x7e, 0xe4, 0x5a, 0xac, 0xc4, 0xb8, 0xc5, 0x9c, 0x1e, 0x03,
+	0xb5, 0xdf, 0x33, 0x2c, 0xaa, 0x60, 0x90, 0x8c, 0xea, 0x73, 0x58, 0x9c, 0x17, 0x90, 0x20, 0xf1,
+	0x80, 0xb8, 0x9c, 0xed, 0xec, 0xbd, 0xa6, 0xfe, 0x1b, 0xdf, 0x09, 0xa9, 0xda, 0x79, 0x02, 0x4c,
+	0x9c, 0xcb, 0xbe, 0xcb, 0xb9, 0x4f, 0xa0, 0x80, 0x27, 0x75, 0x45, 0x43, 0x63, 0x6c, 0x86, 0x83,
+	0x00, 0x8b, 0x2b, 0x8b, 0xe5, 0xb2, 0x22, 0xb0, 0x16, 0x83, 0x6a, 0x26, 0x0b, 0x5c, 0x39, 0x38,
+	0x09, 0xbc, 0xc8, 0xf3, 0x6e, 0x0d, 0xa8, 0x75, 0x1d, 0x44, 0x23, 0xee, 0x6a, 0x51, 0x2f, 0x30,
+	0xf0, 0x44, 0x62, 0x64, 0x0f, 0x34, 0x37, 0x1a, 0x19, 0x3c, 0xbb, 0x81, 0xe1, 0x53, 0xeb, 0xb5,
+	0x88, 0x7f, 0x49, 0x5f, 0x45, 0x9c, 0x7b, 0x11, 0xe8, 0x0c, 0xad, 0x1d, 0x42, 0x7e, 0xe2, 0x18,
+	0xd1, 0x20, 0xeb, 0xd8, 0x37, 0x7c, 0xc7, 0x25, 0x9d, 0xfd, 0x64, 0x97, 0xa3, 0x77, 0x1b, 0xd2,
+	0x80, 0x6b, 0x17, 0x74, 0xb1, 0xa8, 0x6d, 0xc2, 0x7a, 0xcb, 0x8c, 0x02, 0xda, 0xf2, 0x3d, 0x0b,
+	0xef, 0x9b, 0xaa, 0x9c, 0x32, 0x6c, 0x4c, 0xc3, 0xb2, 0x7a, 0x10, 0xc7, 0xdf, 0xd1, 0x28, 0xcd,
+	0xdf, 0x82, 0xcd, 0x14, 0x2e, 0x15, 0x36, 0x80, 0xbc, 0x74, 0x86, 0xc3, 0x14, 0x1d, 0xad, 0x4e,
+	0xa1, 0x92, 0xfc, 0x2d, 0x94, 0x30, 0x41, 0xc3, 0x53, 0x27, 0xb8, 0x56, 0xa7, 0x88, 0xa5, 0x26,
+	0x53, 0x6b, 0x3b, 0xbe, 0xec, 0x0c, 0x79, 0x81, 0x9c, 0x3a, 0x3e, 0xd9, 0x81, 0x3c, 0xcb, 0x4e,
+	0x1c, 0x58, 0x56, 0xcf, 0x21, 0x70, 0xcc, 0x63, 0xab, 0x83, 0x16, 0x6f, 0x27, 0x73, 0x3e, 0xa5,
+	0x90, 0x49, 0x29, 0xbc, 0xcd, 0xc0, 0x7a, 0x67, 0x80, 0xbd, 0x2a, 0x1c, 0xd2, 0xff, 0xe1, 0xc4,
+	0x63, 0x20, 0x3e, 0x35, 0x6d, 0xb1, 0xa9, 0x81, 0x0d, 0x89, 0xdd, 0x7f, 0xe9, 0x4d, 0x89, 0x49,
+	0xf8, 0xee, 0x2d, 0xea, 0xe3, 0xc5, 0xc7, 0x06, 0xb3, 0xce, 0x2b, 0x2b, 0xc5, 0x16, 0xf7, 0x44,
+	0xe3, 0xa2, 0x04, 0x9d, 0x25, 0x7c, 0xda, 0x23, 0x99, 0x2a, 0x13, 0xd6, 0x78, 0x77, 0xc0, 0x14,
+	0xde, 0xdc, 0x2a, 0x3f, 0x3f, 0x83, 0xd5, 0xa1, 0x13, 0x84, 0xd4, 0x35, 0x4c, 0xdb, 0xf6, 0x31,
+	0xb3, 0xd2, 0xd7, 0xa2, 0x40, 0x8f, 0x04, 0xc8, 0x68, 0x32, 0x1c, 0x45, 0x13, 0xcd, 0xb4, 0x28,
+	0x50, 0x49, 0xab, 0x3d, 0x03, 0x92, 0x34, 0x21, 0x13, 0xf8, 0x7e, 0x36, 0x6a, 0xbf, 0x66, 0x80,
+	0x74, 0xc7, 0x78, 0x3b, 0xe8, 0x87, 0x78, 0x88, 0x37, 0x63, 0x88, 0xaa, 0xac, 0x95, 0xba, 0xa6,
+	0xeb, 0xa9, 0xa3, 0x2d, 0x48, 0xb0, 0xc9, 0x30, 0xf2, 0x05, 0x68, 0xb6, 0xef, 0x8d, 0x0d, 0xcb,
+	0x73, 0x5d, 0x6a, 0x85, 0x38, 0x2a, 0x02, 0xd9, 0xf8, 0x4b, 0x0c, 0x3f, 0x89, 0x61, 0x56, 0x6f,
+	0x53, 0xce, 0xc8, 0x24, 0x3e, 0x05, 0x8d, 0xb5, 0xd0, 0x0f, 0xf0, 0xb0, 0xb6, 0xce, 0xf2, 0x3f,
+	0x51, 0x95, 0xfb, 0x51, 0x20, 0x27, 0x9e, 0xef, 0x47, 0xe3, 0x30, 0xd9, 0x88, 0x1e, 0xc2, 0x4a,
+	0xa2, 0x3b, 0xc8, 0xed, 0x20, 0x6e, 0x0e, 0xa4, 0x0c, 0xcb, 0x5e, 0xbf, 0x1f, 0xd0, 0x50, 0x86,
+	0x29, 0x57, 0x0c, 0x1f, 0x52, 0xf7, 0x0a, 0x75, 0x44, 0x75, 0xc8, 0x15, 0x8b, 0x66, 0xca, 0x8c,
+	0xb0, 0xfe, 0x68, 0x0c, 0x85, 0x64, 0xbf, 0x26, 0x55, 0x28, 0xb7, 0xba, 0x17, 0x17, 0xc6, 0xd9,
+	0xf9, 0x45, 0xc3, 0xe8, 0xbc, 0x6a, 0x35, 0x8c, 0x6e, 0xf3, 0x65, 0xf3, 0xf2, 0xfb, 0xa6, 0x76,
+	0x0f, 0x3b, 0xd6, 0x83, 0x94, 0xac, 0xdd, 0xd0, 0xbf, 0x3b, 0x3f, 0xc1, 0xef, 0xce, 0xe9, 0x65,
+	0xb7, 0xa3, 0x65, 0xde, 0x4d, 0x69, 0xe8, 0xba, 0xb6, 0xf0, 0xe8, 0x27, 0x6c, 0x1e, 0xd2, 0x22,
+	0x66, 0x1b, 0xb3, 0x13, 0x72, 0xc3, 0x35, 0xd8, 0x8d, 0x35, 0x4f, 0x2e, 0x9b, 0x9d, 0x46, 0xb3,
+	0x93, 0x76, 0xe0, 0x21, 0xec, 0xdc, 0xc1, 0xb9, 0x38, 0x6a, 0x33, 0xf3, 0x77, 0x13, 0xce, 0x10,
+	0x46, 0xe3, 0xbf, 0x65, 0x58, 0xbc, 0x71, 0x3b, 0x17, 0xf1, 0xb6, 0x5f, 0xdc, 0x1d, 0xef, 0x94,
+	0x4c, 0x05, 0x73, 0x7c, 0xde, 0x3c, 0xd2, 0x5f, 0xa9, 0x78, 0xe7, 0x52, 0xd0, 0xfa, 0xd9, 0xf9,
+	0x37, 0xda, 0x02, 0x8e, 0x8a, 0x4a, 0x8a, 0x72, 0x7a, 0xd4, 0x39, 0xe2, 0x4b, 0x2d, 0x7b, 0xf0,
+	0xc7, 0x7d, 0xc8, 0x5d, 0xca, 0xe7, 0x08, 0x79, 0x02, 0x4b, 0x7c, 0xbc, 0x13, 0x39, 0x78, 0x92,
+	0x2f, 0x9e, 0xea, 0xfa, 0x14, 0x26, 0x2f, 0x16, 0xd3, 0x60, 0xd7, 0x6d, 0xa2, 0x91, 0x78, 0x0c,
+	0x4c, 0x34, 0x92, 0x0f, 0x02, 0x6c, 0x25, 0x8b, 0xac, 0x06, 0xc9, 0x9a, 0x12, 0x4e, 0x1e, 0x07,
+	0x55, 0x92, 0x84, 0x24, 0xfd, 0x29, 0xe4, 0xd4, 0x6b, 0x80, 0x6c, 0x0a, 0x79, 0xea, 0xc1, 0x50,
+	0x2d, 0xa7, 0x61, 0xa9, 0xfa, 0x0c, 0x72, 0xea, 0xa0, 0x95, 0x6a, 0xea, 0x11, 0xa1, 0x54, 0xd3,
+	0xd3, 0xfd, 0x49, 0x46, 0x28, 0x8b, 0x73, 0x8a, 0x95, 0xa7, 0x66, 0x75, 0xac, 0x3c, 0x3d, 0x21,
+	0xf7, 0x32, 0xa4, 0x81, 0x87, 0x9c, 0x18, 0x44, 0x64, 0x5b, 0x32, 0x67, 0x67, 0x56, 0xb5, 0x3a,
+	0x4f, 0x24, 0x03, 0x78, 0x01, 0xc5, 0xa9, 0xf9, 0x44, 0x24, 0x79, 0xde, 0x30, 0xab, 0xee, 0xcc,
+	0x95, 0xc9, 0x9d, 0x8e, 0x61, 0x25, 0x31, 0xba, 0x48, 0x45, 0x70, 0x67, 0x67, 0x5c, 0x75, 0x7b,
+	0x8e, 0x24, 0x3e, 0x09, 0x35, 0x98, 0x54, 0x46, 0x52, 0x73, 0x4f, 0x65, 0x64, 0x66, 0x7e, 0x61,
+	0x3e, 0x92, 0xf3, 0x40, 0xe5, 0x63, 0xce, 0xd4, 0x52, 0xf9, 0x98, 0x37, 0x3e, 0xc8, 0x73, 0x80,
+	0xb8, 0xb7, 0x93, 0xad, 0x44, 0x75, 0x25, 0x9b, 0x61, 0xb5, 0x32, 0x2b, 0x88, 0xd3, 0x90, 0xe8,
+	0xa8, 0x2a, 0x0d, 0xb3, 0x1d, 0x5f, 0xa5, 0x61, 0x4e, 0xfb, 0x25, 0x5f, 0x43, 0x7e, 0xd2, 0x43,
+	0x49, 0x39, 0xae, 0xd8, 0x29, 0xfd, 0xad, 0x19, 0x3c, 0xf6, 0x20, 0xd1, 0x05, 0x95, 0x07, 0xb3,
+	0xfd, 0x57, 0x79, 0x30, 0xa7, 0x65, 0x1e, 0x6b, 0x7f, 0xfe, 0xb3, 0x9b, 0xf9, 0x0b, 0x3f, 0x7f,
+	0xe3, 0xe7, 0xed, 0xbf, 0xbb, 0xf7, 0x7a, 0xcb, 0xfc, 0x5f, 0x95, 0xc3, 0xff, 0x00, 0x18, 0x9a,
+	0x60, 0x07, 0xed, 0x0c, 0x00, 0x00,
 }
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package node
+
+import (
+	"fmt"
+	"time"
+)
+
+// The functions below return ServiceNodeFn(s) injecting faults, for use with
+// a ConcurrentExecutor, e.g. to pause the service process on all nodes:
+//
+//	executor := NewConcurrentExecutor(nodes, len(nodes), timeout, PauseFn(10*time.Second))
+//	err := executor.Run()
+
+// PauseFn returns a ServiceNodeFn which pauses the service process for the
+// specified duration before resuming it, e.g. to simulate a long GC pause.
+func PauseFn(d time.Duration) ServiceNodeFn {
+	return func(node ServiceNode) error {
+		if err := node.PauseProcess(); err != nil {
+			return err
+		}
+		time.Sleep(d)
+		return node.ResumeProcess()
+	}
+}
+
+// KillFn returns a ServiceNodeFn which kills the service process without
+// allowing it to clean up.
+func KillFn() ServiceNodeFn {
+	return func(node ServiceNode) error {
+		return node.KillProcess()
+	}
+}
+
+// FillDiskFn returns a ServiceNodeFn which consumes the specified number of
+// bytes in a directory relative to the agent working directory. It fails if
+// less than numBytes were consumed, unless allowPartial is set, e.g. when
+// filling the disk completely.
+func FillDiskFn(dir string, numBytes int64, allowPartial bool) ServiceNodeFn {
+	return func(node ServiceNode) error {
+		filled, err := node.FillDisk(dir, numBytes)
+		if err != nil {
+			return err
+		}
+		if filled < numBytes && !allowPartial {
+			return fmt.Errorf("node %s: filled %d of %d bytes", node.ID(), filled, numBytes)
+		}
+		return nil
+	}
+}
+
+// ThrottleDiskFn returns a ServiceNodeFn which limits the disk bandwidth
+// available to the service process.
+func ThrottleDiskFn(dir string, readBytesPerSec int64, writeBytesPerSec int64) ServiceNodeFn {
+	return func(node ServiceNode) error {
+		return node.ThrottleDisk(dir, readBytesPerSec, writeBytesPerSec)
+	}
+}
+
+// StartProxyFn returns a ServiceNodeFn which starts a proxy on each node's
+// agent, listening on the specified loopback address.
+func StartProxyFn(listenAddress string, targetAddress string) ServiceNodeFn {
+	return func(node ServiceNode) error {
+		_, err := node.StartProxy(listenAddress, targetAddress)
+		return err
+	}
+}
+
+// ProxyLatencyFn returns a ServiceNodeFn which sets the latency injected by
+// the proxy listening on the specified address.
+func ProxyLatencyFn(listenAddress string, latency time.Duration) ServiceNodeFn {
+	return func(node ServiceNode) error {
+		return node.UpdateProxy(listenAddress, latency, false)
+	}
+}
+
+// DropConnectionsFn returns a ServiceNodeFn which drops all connections open
+// through the proxy listening on the specified address, and sets the latency
+// injected for subsequent connections.
+func DropConnectionsFn(listenAddress string, latency time.Duration) ServiceNodeFn {
+	return func(node ServiceNode) error {
+		return node.UpdateProxy(listenAddress, latency, true)
+	}
+}
+
+// StopProxyFn returns a ServiceNodeFn which stops the proxy listening on the
+// specified address.
+func StopProxyFn(listenAddress string) ServiceNodeFn {
+	return func(node ServiceNode) error {
+		return node.StopProxy(listenAddress)
+	}
+}
+
+// CorruptFileFn returns a ServiceNodeFn which corrupts the specified byte
+// range of a file relative to the agent working directory.
+func CorruptFileFn(path string, offset int64, length int64) ServiceNodeFn {
+	return func(node ServiceNode) error {
+		return node.CorruptFile(path, offset, length)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/m3db/m3/src/cluster/placement"
 	"github.com/m3db/m3/src/m3em/build"
@@ -46,6 +47,8 @@ var (
 	errUnableToStartNode            = fmt.Errorf("unable to start node, it must be setup")
 	errUnableToStopNode             = fmt.Errorf("unable to stop node, it must be running")
 	errUnableToTransferFile         = fmt.Errorf("unable to transfer file. node must be setup/running")
+	errUnableToInjectProcessFault   = fmt.Errorf("unable to inject process fault, node must be running")
+	errUnableToInjectFault          = fmt.Errorf("unable to inject fault. node must be setup/running")
 )
 
 type svcNode struct {
@@ -466,6 +469,137 @@ func (i *svcNode) Stop() error {
 	return nil
 }
 
+func (i *svcNode) PauseProcess() error {
+	return i.attemptRunning(func(ctx context.Context) error {
+		_, err := i.client.PauseProcess(ctx, &m3em.PauseProcessRequest{})
+		return err
+	})
+}
+
+func (i *svcNode) ResumeProcess() error {
+	return i.attemptRunning(func(ctx context.Context) error {
+		_, err := i.client.ResumeProcess(ctx, &m3em.ResumeProcessRequest{})
+		return err
+	})
+}
+
+func (i *svcNode) KillProcess() error {
+	i.Lock()
+	defer i.Unlock()
+	if i.status != StatusRunning {
+		return errUnableToInjectProcessFault
+	}
+
+	if err := i.opts.Retrier().Attempt(func() error {
+		ctx := context.Background()
+		_, err := i.client.KillProcess(ctx, &m3em.KillProcessRequest{})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	i.status = StatusSetup
+	return nil
+}
+
+func (i *svcNode) attemptRunning(fn func(context.Context) error) error {
+	i.Lock()
+	defer i.Unlock()
+	if i.status != StatusRunning {
+		return errUnableToInjectProcessFault
+	}
+
+	return i.opts.Retrier().Attempt(func() error {
+		return fn(context.Background())
+	})
+}
+
+func (i *svcNode) attemptSetup(fn func(context.Context) error) error {
+	i.Lock()
+	defer i.Unlock()
+	if i.status != StatusSetup && i.status != StatusRunning {
+		return errUnableToInjectFault
+	}
+
+	return i.opts.Retrier().Attempt(func() error {
+		return fn(context.Background())
+	})
+}
+
+func (i *svcNode) FillDisk(dir string, numBytes int64) (int64, error) {
+	var filled int64
+	err := i.attemptSetup(func(ctx context.Context) error {
+		resp, err := i.client.FillDisk(ctx, &m3em.FillDiskRequest{
+			TargetDir: dir,
+			NumBytes:  numBytes,
+		})
+		if err != nil {
+			return err
+		}
+		filled = resp.NumBytes
+		return nil
+	})
+	return filled, err
+}
+
+func (i *svcNode) ThrottleDisk(dir string, readBytesPerSec int64, writeBytesPerSec int64) error {
+	return i.attemptRunning(func(ctx context.Context) error {
+		_, err := i.client.ThrottleDisk(ctx, &m3em.ThrottleDiskRequest{
+			TargetDir:        dir,
+			ReadBytesPerSec:  readBytesPerSec,
+			WriteBytesPerSec: writeBytesPerSec,
+		})
+		return err
+	})
+}
+
+func (i *svcNode) StartProxy(listenAddress string, targetAddress string) (string, error) {
+	var addr string
+	err := i.attemptSetup(func(ctx context.Context) error {
+		resp, err := i.client.StartProxy(ctx, &m3em.StartProxyRequest{
+			ListenAddress: listenAddress,
+			TargetAddress: targetAddress,
+		})
+		if err != nil {
+			return err
+		}
+		addr = resp.ListenAddress
+		return nil
+	})
+	return addr, err
+}
+
+func (i *svcNode) UpdateProxy(listenAddress string, latency time.Duration, dropConnections bool) error {
+	return i.attemptSetup(func(ctx context.Context) error {
+		_, err := i.client.UpdateProxy(ctx, &m3em.UpdateProxyRequest{
+			ListenAddress:   listenAddress,
+			LatencyNanos:    int64(latency),
+			DropConnections: dropConnections,
+		})
+		return err
+	})
+}
+
+func (i *svcNode) StopProxy(listenAddress string) error {
+	return i.attemptSetup(func(ctx context.Context) error {
+		_, err := i.client.StopProxy(ctx, &m3em.StopProxyRequest{
+			ListenAddress: listenAddress,
+		})
+		return err
+	})
+}
+
+func (i *svcNode) CorruptFile(path string, offset int64, length int64) error {
+	return i.attemptSetup(func(ctx context.Context) error {
+		_, err := i.client.CorruptFile(ctx, &m3em.CorruptFileRequest{
+			TargetPath: path,
+			Offset:     offset,
+			Length:     length,
+		})
+		return err
+	})
+}
+
 func (i *svcNode) Status() Status {
 	i.Lock()
 	defer i.Unlock()
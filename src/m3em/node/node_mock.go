@@ -89,6 +89,20 @@ func (mr *MockServiceNodeMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockServiceNode)(nil).Close))
 }
 
+// CorruptFile mocks base method
+func (m *MockServiceNode) CorruptFile(arg0 string, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CorruptFile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CorruptFile indicates an expected call of CorruptFile
+func (mr *MockServiceNodeMockRecorder) CorruptFile(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorruptFile", reflect.TypeOf((*MockServiceNode)(nil).CorruptFile), arg0, arg1, arg2)
+}
+
 // DeregisterListener mocks base method
 func (m *MockServiceNode) DeregisterListener(arg0 ListenerID) {
 	m.ctrl.T.Helper()
@@ -115,6 +129,21 @@ func (mr *MockServiceNodeMockRecorder) Endpoint() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Endpoint", reflect.TypeOf((*MockServiceNode)(nil).Endpoint))
 }
 
+// FillDisk mocks base method
+func (m *MockServiceNode) FillDisk(arg0 string, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FillDisk", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FillDisk indicates an expected call of FillDisk
+func (mr *MockServiceNodeMockRecorder) FillDisk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillDisk", reflect.TypeOf((*MockServiceNode)(nil).FillDisk), arg0, arg1)
+}
+
 // GetRemoteOutput mocks base method
 func (m *MockServiceNode) GetRemoteOutput(arg0 RemoteOutputType, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +243,34 @@ func (mr *MockServiceNodeMockRecorder) IsolationGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsolationGroup", reflect.TypeOf((*MockServiceNode)(nil).IsolationGroup))
 }
 
+// KillProcess mocks base method
+func (m *MockServiceNode) KillProcess() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KillProcess")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KillProcess indicates an expected call of KillProcess
+func (mr *MockServiceNodeMockRecorder) KillProcess() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillProcess", reflect.TypeOf((*MockServiceNode)(nil).KillProcess))
+}
+
+// PauseProcess mocks base method
+func (m *MockServiceNode) PauseProcess() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseProcess")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseProcess indicates an expected call of PauseProcess
+func (mr *MockServiceNodeMockRecorder) PauseProcess() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseProcess", reflect.TypeOf((*MockServiceNode)(nil).PauseProcess))
+}
+
 // Port mocks base method
 func (m *MockServiceNode) Port() uint32 {
 	m.ctrl.T.Helper()
@@ -257,6 +314,20 @@ func (mr *MockServiceNodeMockRecorder) RegisterListener(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterListener", reflect.TypeOf((*MockServiceNode)(nil).RegisterListener), arg0)
 }
 
+// ResumeProcess mocks base method
+func (m *MockServiceNode) ResumeProcess() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeProcess")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeProcess indicates an expected call of ResumeProcess
+func (mr *MockServiceNodeMockRecorder) ResumeProcess() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeProcess", reflect.TypeOf((*MockServiceNode)(nil).ResumeProcess))
+}
+
 // SetEndpoint mocks base method
 func (m *MockServiceNode) SetEndpoint(arg0 string) placement.Instance {
 	m.ctrl.T.Helper()
@@ -439,6 +510,21 @@ func (mr *MockServiceNodeMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockServiceNode)(nil).Start))
 }
 
+// StartProxy mocks base method
+func (m *MockServiceNode) StartProxy(arg0, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartProxy", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartProxy indicates an expected call of StartProxy
+func (mr *MockServiceNodeMockRecorder) StartProxy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartProxy", reflect.TypeOf((*MockServiceNode)(nil).StartProxy), arg0, arg1)
+}
+
 // Status mocks base method
 func (m *MockServiceNode) Status() Status {
 	m.ctrl.T.Helper()
@@ -467,6 +553,20 @@ func (mr *MockServiceNodeMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockServiceNode)(nil).Stop))
 }
 
+// StopProxy mocks base method
+func (m *MockServiceNode) StopProxy(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopProxy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopProxy indicates an expected call of StopProxy
+func (mr *MockServiceNodeMockRecorder) StopProxy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopProxy", reflect.TypeOf((*MockServiceNode)(nil).StopProxy), arg0)
+}
+
 // String mocks base method
 func (m *MockServiceNode) String() string {
 	m.ctrl.T.Helper()
@@ -495,6 +595,20 @@ func (mr *MockServiceNodeMockRecorder) Teardown() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Teardown", reflect.TypeOf((*MockServiceNode)(nil).Teardown))
 }
 
+// ThrottleDisk mocks base method
+func (m *MockServiceNode) ThrottleDisk(arg0 string, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ThrottleDisk", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ThrottleDisk indicates an expected call of ThrottleDisk
+func (mr *MockServiceNodeMockRecorder) ThrottleDisk(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ThrottleDisk", reflect.TypeOf((*MockServiceNode)(nil).ThrottleDisk), arg0, arg1, arg2)
+}
+
 // TransferLocalFile mocks base method
 func (m *MockServiceNode) TransferLocalFile(arg0 string, arg1 []string, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -509,6 +623,20 @@ func (mr *MockServiceNodeMockRecorder) TransferLocalFile(arg0, arg1, arg2 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLocalFile", reflect.TypeOf((*MockServiceNode)(nil).TransferLocalFile), arg0, arg1, arg2)
 }
 
+// UpdateProxy mocks base method
+func (m *MockServiceNode) UpdateProxy(arg0 string, arg1 time.Duration, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProxy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProxy indicates an expected call of UpdateProxy
+func (mr *MockServiceNodeMockRecorder) UpdateProxy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProxy", reflect.TypeOf((*MockServiceNode)(nil).UpdateProxy), arg0, arg1, arg2)
+}
+
 // Weight mocks base method
 func (m *MockServiceNode) Weight() uint32 {
 	m.ctrl.T.Helper()
@@ -27,6 +27,7 @@ import (
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/m3db/m3/src/cluster/placement"
 	"github.com/m3db/m3/src/m3em/build"
@@ -369,3 +370,100 @@ func TestNodeGetRemoteOutput(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedBytes, transferredBytes)
 }
+
+func TestNodeProcessFaultsIllegalStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := m3em.NewMockOperatorClient(ctrl)
+	opts := newTestOptions(mockClient)
+	mockInstance := newMockPlacementInstance(ctrl)
+	node, err := New(mockInstance, opts)
+	require.NoError(t, err)
+	serviceNode := node.(*svcNode)
+	serviceNode.status = StatusSetup
+	require.Error(t, serviceNode.PauseProcess())
+	require.Error(t, serviceNode.ResumeProcess())
+	require.Error(t, serviceNode.KillProcess())
+	require.Error(t, serviceNode.ThrottleDisk("data", 1, 1))
+	serviceNode.status = StatusUninitialized
+	_, err = serviceNode.FillDisk("data", 1)
+	require.Error(t, err)
+	_, err = serviceNode.StartProxy("", "127.0.0.1:9000")
+	require.Error(t, err)
+	require.Error(t, serviceNode.CorruptFile("data", 0, 1))
+}
+
+func TestNodeRunningStatusToKillTransition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := m3em.NewMockOperatorClient(ctrl)
+	opts := newTestOptions(mockClient)
+	mockInstance := newMockPlacementInstance(ctrl)
+	node, err := New(mockInstance, opts)
+	require.NoError(t, err)
+	serviceNode := node.(*svcNode)
+	serviceNode.status = StatusRunning
+	gomock.InOrder(
+		mockClient.EXPECT().PauseProcess(gomock.Any(), gomock.Any()),
+		mockClient.EXPECT().ResumeProcess(gomock.Any(), gomock.Any()),
+		mockClient.EXPECT().KillProcess(gomock.Any(), gomock.Any()),
+	)
+	require.NoError(t, PauseFn(time.Millisecond)(serviceNode))
+	require.Equal(t, StatusRunning, serviceNode.Status())
+	require.NoError(t, serviceNode.KillProcess())
+	require.Equal(t, StatusSetup, serviceNode.Status())
+}
+
+func TestNodeFaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := m3em.NewMockOperatorClient(ctrl)
+	opts := newTestOptions(mockClient)
+	mockInstance := newMockPlacementInstance(ctrl)
+	node, err := New(mockInstance, opts)
+	require.NoError(t, err)
+	serviceNode := node.(*svcNode)
+	serviceNode.status = StatusRunning
+
+	mockClient.EXPECT().FillDisk(gomock.Any(), &m3em.FillDiskRequest{
+		TargetDir: "data",
+		NumBytes:  100,
+	}).Return(&m3em.FillDiskResponse{NumBytes: 50}, nil).Times(2)
+	filled, err := serviceNode.FillDisk("data", 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), filled)
+	require.Error(t, FillDiskFn("data", 100, false)(serviceNode))
+
+	mockClient.EXPECT().ThrottleDisk(gomock.Any(), &m3em.ThrottleDiskRequest{
+		TargetDir:        "data",
+		ReadBytesPerSec:  10,
+		WriteBytesPerSec: 20,
+	}).Return(&m3em.ThrottleDiskResponse{}, nil)
+	require.NoError(t, ThrottleDiskFn("data", 10, 20)(serviceNode))
+
+	mockClient.EXPECT().StartProxy(gomock.Any(), &m3em.StartProxyRequest{
+		TargetAddress: "127.0.0.1:9000",
+	}).Return(&m3em.StartProxyResponse{ListenAddress: "127.0.0.1:19000"}, nil)
+	listenAddress, err := serviceNode.StartProxy("", "127.0.0.1:9000")
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:19000", listenAddress)
+
+	mockClient.EXPECT().UpdateProxy(gomock.Any(), &m3em.UpdateProxyRequest{
+		ListenAddress:   listenAddress,
+		LatencyNanos:    int64(time.Second),
+		DropConnections: true,
+	}).Return(&m3em.UpdateProxyResponse{}, nil)
+	require.NoError(t, DropConnectionsFn(listenAddress, time.Second)(serviceNode))
+
+	mockClient.EXPECT().StopProxy(gomock.Any(), &m3em.StopProxyRequest{
+		ListenAddress: listenAddress,
+	}).Return(&m3em.StopProxyResponse{}, nil)
+	require.NoError(t, StopProxyFn(listenAddress)(serviceNode))
+
+	mockClient.EXPECT().CorruptFile(gomock.Any(), &m3em.CorruptFileRequest{
+		TargetPath: "data/fileset-data.db",
+		Offset:     10,
+		Length:     4,
+	}).Return(&m3em.CorruptFileResponse{}, nil)
+	require.NoError(t, CorruptFileFn("data/fileset-data.db", 10, 4)(serviceNode))
+}
@@ -142,6 +142,42 @@ type ServiceNode interface {
 
 	// GetRemoteOutput transfers the specified remote file to the specified path
 	GetRemoteOutput(t RemoteOutputType, localDest string) (truncated bool, err error)
+
+	// PauseProcess suspends the service process (SIGSTOP), e.g. to simulate a long GC pause.
+	PauseProcess() error
+
+	// ResumeProcess resumes a paused service process (SIGCONT).
+	ResumeProcess() error
+
+	// KillProcess kills the service process (SIGKILL) without allowing it to clean up.
+	// The ServiceNode is left Setup, and may be Start()-ed again.
+	KillProcess() error
+
+	// FillDisk consumes numBytes of disk space in the specified directory, relative to
+	// the working directory of the remote agent. It returns the number of bytes consumed,
+	// which is less than requested if the disk is full. Calling it with numBytes of zero
+	// releases the consumed space.
+	FillDisk(dir string, numBytes int64) (int64, error)
+
+	// ThrottleDisk limits the disk bandwidth available to the service process for the
+	// device backing the specified directory. A limit of zero is unthrottled.
+	ThrottleDisk(dir string, readBytesPerSec int64, writeBytesPerSec int64) error
+
+	// StartProxy starts a TCP proxy on the remote agent's loopback interface, forwarding
+	// connections to the target address. An empty listen address picks a free port. It
+	// returns the address the proxy is listening on.
+	StartProxy(listenAddress string, targetAddress string) (string, error)
+
+	// UpdateProxy sets the latency injected by the proxy in each direction, and
+	// optionally drops all open connections.
+	UpdateProxy(listenAddress string, latency time.Duration, dropConnections bool) error
+
+	// StopProxy stops the proxy listening on the specified address.
+	StopProxy(listenAddress string) error
+
+	// CorruptFile inverts the bits in the specified byte range of a file, relative to
+	// the working directory of the remote agent, e.g. to corrupt a fileset.
+	CorruptFile(path string, offset int64, length int64) error
 }
 
 // ServiceNodeFn performs an operation on a given ServiceNode
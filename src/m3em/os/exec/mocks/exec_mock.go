@@ -25,6 +25,7 @@
 package exec
 
 import (
+	"os"
 	"reflect"
 
 	"github.com/golang/mock/gomock"
@@ -197,3 +198,31 @@ func (mr *MockProcessMonitorMockRecorder) StderrPath() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StderrPath", reflect.TypeOf((*MockProcessMonitor)(nil).StderrPath))
 }
+
+// Signal mocks base method
+func (m *MockProcessMonitor) Signal(sig os.Signal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signal", sig)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Signal indicates an expected call of Signal
+func (mr *MockProcessMonitorMockRecorder) Signal(sig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signal", reflect.TypeOf((*MockProcessMonitor)(nil).Signal), sig)
+}
+
+// Pid mocks base method
+func (m *MockProcessMonitor) Pid() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pid")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Pid indicates an expected call of Pid
+func (mr *MockProcessMonitorMockRecorder) Pid() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pid", reflect.TypeOf((*MockProcessMonitor)(nil).Pid))
+}
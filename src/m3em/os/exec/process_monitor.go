@@ -41,6 +41,7 @@ var (
 	errUnableToStartClosed  = fmt.Errorf("unable to start: process monitor Closed()")
 	errUnableToStartRunning = fmt.Errorf("unable to start: process already running")
 	errUnableToStopStoped   = fmt.Errorf("unable to stop: process not running")
+	errUnableToSignal       = fmt.Errorf("unable to signal: process not running")
 )
 
 func (m EnvMap) toSlice() []string {
@@ -188,11 +189,16 @@ func (pm *processMonitor) startAsync() {
 }
 
 func (pm *processMonitor) startSync() {
+	// NB: the process is started with the lock held to ensure cmd.Process
+	// is set before it's visible to Signal()/Pid().
 	pm.Lock()
 	pm.running = true
+	err := pm.cmd.Start()
 	pm.Unlock()
 
-	err := pm.cmd.Run()
+	if err == nil {
+		err = pm.cmd.Wait()
+	}
 	pm.Lock()
 	defer pm.Unlock()
 
@@ -260,6 +266,24 @@ func (pm *processMonitor) Close() error {
 	return multiErr.FinalError()
 }
 
+func (pm *processMonitor) Signal(sig os.Signal) error {
+	pm.Lock()
+	defer pm.Unlock()
+	if !pm.running || pm.cmd.Process == nil {
+		return errUnableToSignal
+	}
+	return pm.cmd.Process.Signal(sig)
+}
+
+func (pm *processMonitor) Pid() int {
+	pm.Lock()
+	defer pm.Unlock()
+	if !pm.running || pm.cmd.Process == nil {
+		return -1
+	}
+	return pm.cmd.Process.Pid
+}
+
 func (pm *processMonitor) StdoutPath() string {
 	return pm.stdoutPath
 }
@@ -26,6 +26,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"syscall"
 	"testing"
 	"time"
 
@@ -327,3 +328,52 @@ func TestStop(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte{}, stderrContents)
 }
+
+func TestSignal(t *testing.T) {
+	tempDir := newTempDir(t)
+	defer os.RemoveAll(tempDir)
+
+	scriptNum := 0
+	scriptContents := []byte(`#!/usr/bin/env bash
+	while true; do sleep 1; done`)
+	testScript := newTestScript(t, tempDir, scriptNum, scriptContents)
+	cmd := Cmd{
+		Path:      testScript,
+		Args:      []string{},
+		OutputDir: tempDir,
+	}
+	errCh := make(chan error, 1)
+	tl := NewProcessListener(
+		func() { require.FailNow(t, "unexpected OnComplete notification") },
+		func(err error) { errCh <- err },
+	)
+
+	pm, err := NewProcessMonitor(cmd, tl)
+	require.NoError(t, err)
+	require.Equal(t, -1, pm.Pid())
+	require.Error(t, pm.Signal(syscall.SIGKILL))
+	require.NoError(t, pm.Start())
+
+	// wait until execution has started
+	for pm.Pid() == -1 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// pausing and resuming the process must not terminate it
+	require.NoError(t, pm.Signal(syscall.SIGSTOP))
+	require.NoError(t, pm.Signal(syscall.SIGCONT))
+	time.Sleep(100 * time.Millisecond)
+	require.True(t, pm.Running())
+
+	// killing the process is reported as an error, unlike Stop()
+	require.NoError(t, pm.Signal(syscall.SIGKILL))
+	select {
+	case err := <-errCh:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "process was not killed")
+	}
+	require.Error(t, pm.Err())
+	require.Equal(t, -1, pm.Pid())
+	require.NoError(t, pm.Close())
+}
@@ -22,6 +22,7 @@ package exec
 
 import (
 	"fmt"
+	"os"
 )
 
 var (
@@ -96,4 +97,11 @@ type ProcessMonitor interface {
 
 	// StderrPath returns the path to the process stderr file
 	StderrPath() string
+
+	// Signal sends the specified signal to the running process
+	Signal(sig os.Signal) error
+
+	// Pid returns the pid of the running process, or -1 if the process
+	// is not running
+	Pid() int
 }
@@ -0,0 +1,236 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package proxy
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDialTimeout   = 5 * time.Second
+	defaultBufferSize    = 32 * 1024
+	defaultPendingChunks = 64
+)
+
+var (
+	errProxyClosed = errors.New("proxy is closed")
+)
+
+type proxy struct {
+	sync.Mutex
+	listener net.Listener
+	target   string
+	logger   *zap.Logger
+	latency  int64
+	conns    map[*proxyConn]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+// New returns a new Proxy listening on the specified address, and forwarding
+// connections to the target address.
+func New(
+	listenAddress string,
+	targetAddress string,
+	logger *zap.Logger,
+) (Proxy, error) {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &proxy{
+		listener: listener,
+		target:   targetAddress,
+		logger:   logger,
+		conns:    make(map[*proxyConn]struct{}),
+	}
+	p.wg.Add(1)
+	go p.acceptLoop()
+	return p, nil
+}
+
+func (p *proxy) Addr() string {
+	return p.listener.Addr().String()
+}
+
+func (p *proxy) TargetAddr() string {
+	return p.target
+}
+
+func (p *proxy) SetLatency(d time.Duration) {
+	atomic.StoreInt64(&p.latency, int64(d))
+}
+
+func (p *proxy) Latency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&p.latency))
+}
+
+func (p *proxy) DropConnections() {
+	p.Lock()
+	conns := make([]*proxyConn, 0, len(p.conns))
+	for c := range p.conns {
+		conns = append(conns, c)
+	}
+	p.Unlock()
+
+	for _, c := range conns {
+		c.close()
+	}
+}
+
+func (p *proxy) Close() error {
+	p.Lock()
+	if p.closed {
+		p.Unlock()
+		return errProxyClosed
+	}
+	p.closed = true
+	p.Unlock()
+
+	err := p.listener.Close()
+	p.DropConnections()
+	p.wg.Wait()
+	return err
+}
+
+func (p *proxy) isClosed() bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.closed
+}
+
+func (p *proxy) acceptLoop() {
+	defer p.wg.Done()
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			if p.isClosed() {
+				return
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				p.logger.Warn("temporary error accepting connection", zap.Error(err))
+				continue
+			}
+			p.logger.Error("unable to accept connection", zap.Error(err))
+			return
+		}
+
+		target, err := net.DialTimeout("tcp", p.target, defaultDialTimeout)
+		if err != nil {
+			p.logger.Warn("unable to dial target",
+				zap.String("target", p.target), zap.Error(err))
+			conn.Close()
+			continue
+		}
+
+		pc := &proxyConn{proxy: p, client: conn, target: target}
+		if !p.add(pc) {
+			pc.close()
+			return
+		}
+		p.wg.Add(2)
+		go p.forward(pc, conn, target)
+		go p.forward(pc, target, conn)
+	}
+}
+
+func (p *proxy) add(pc *proxyConn) bool {
+	p.Lock()
+	defer p.Unlock()
+	if p.closed {
+		return false
+	}
+	p.conns[pc] = struct{}{}
+	return true
+}
+
+func (p *proxy) remove(pc *proxyConn) {
+	p.Lock()
+	delete(p.conns, pc)
+	p.Unlock()
+}
+
+type chunk struct {
+	data []byte
+	due  time.Time
+}
+
+// forward copies data from src to dst, delaying each chunk read by the
+// latency configured at the time it was read.
+func (p *proxy) forward(pc *proxyConn, src net.Conn, dst net.Conn) {
+	defer p.wg.Done()
+	var (
+		chunks = make(chan chunk, defaultPendingChunks)
+		done   = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+		for c := range chunks {
+			if d := time.Until(c.due); d > 0 {
+				time.Sleep(d)
+			}
+			if _, err := dst.Write(c.data); err != nil {
+				break
+			}
+		}
+		// unblock the reader if the writer failed
+		pc.close()
+		for range chunks {
+		}
+	}()
+
+	buf := make([]byte, defaultBufferSize)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			chunks <- chunk{data: data, due: time.Now().Add(p.Latency())}
+		}
+		if err != nil {
+			break
+		}
+	}
+	close(chunks)
+	<-done
+}
+
+type proxyConn struct {
+	proxy  *proxy
+	client net.Conn
+	target net.Conn
+	once   sync.Once
+}
+
+func (c *proxyConn) close() {
+	c.once.Do(func() {
+		c.client.Close()
+		c.target.Close()
+		c.proxy.remove(c)
+	})
+}
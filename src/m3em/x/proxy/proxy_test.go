@@ -0,0 +1,139 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package proxy
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newEchoServer(t *testing.T) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return l
+}
+
+func roundTrip(t *testing.T, conn net.Conn, msg string) {
+	_, err := conn.Write([]byte(msg))
+	require.NoError(t, err)
+	buf := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.Equal(t, msg, string(buf))
+}
+
+func TestProxyForwards(t *testing.T) {
+	echo := newEchoServer(t)
+	defer echo.Close()
+
+	p, err := New("127.0.0.1:0", echo.Addr().String(), zap.NewNop())
+	require.NoError(t, err)
+	defer p.Close()
+
+	conn, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	roundTrip(t, conn, "hello")
+	roundTrip(t, conn, "world")
+}
+
+func TestProxyLatency(t *testing.T) {
+	echo := newEchoServer(t)
+	defer echo.Close()
+
+	p, err := New("127.0.0.1:0", echo.Addr().String(), zap.NewNop())
+	require.NoError(t, err)
+	defer p.Close()
+
+	latency := 50 * time.Millisecond
+	p.SetLatency(latency)
+	require.Equal(t, latency, p.Latency())
+
+	conn, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	start := time.Now()
+	roundTrip(t, conn, "hello")
+	// latency is added in each direction
+	require.True(t, time.Since(start) >= 2*latency)
+}
+
+func TestProxyDropConnections(t *testing.T) {
+	echo := newEchoServer(t)
+	defer echo.Close()
+
+	p, err := New("127.0.0.1:0", echo.Addr().String(), zap.NewNop())
+	require.NoError(t, err)
+	defer p.Close()
+
+	conn, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	roundTrip(t, conn, "hello")
+
+	p.DropConnections()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	require.Equal(t, io.EOF, err)
+
+	// new connections are still accepted
+	conn2, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn2.Close()
+	roundTrip(t, conn2, "world")
+}
+
+func TestProxyClose(t *testing.T) {
+	echo := newEchoServer(t)
+	defer echo.Close()
+
+	p, err := New("127.0.0.1:0", echo.Addr().String(), zap.NewNop())
+	require.NoError(t, err)
+
+	conn, err := net.Dial("tcp", p.Addr())
+	require.NoError(t, err)
+	defer conn.Close()
+	roundTrip(t, conn, "hello")
+
+	require.NoError(t, p.Close())
+	require.Equal(t, errProxyClosed, p.Close())
+
+	_, err = net.Dial("tcp", p.Addr())
+	require.Error(t, err)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package proxy
+
+import (
+	"time"
+)
+
+// Proxy is a TCP proxy used to inject network faults between a test process
+// and its clients.
+type Proxy interface {
+	// Addr returns the address the proxy is listening on.
+	Addr() string
+
+	// TargetAddr returns the address the proxy forwards connections to.
+	TargetAddr() string
+
+	// SetLatency sets the latency added to data forwarded in each direction.
+	SetLatency(time.Duration)
+
+	// Latency returns the latency added to data forwarded in each direction.
+	Latency() time.Duration
+
+	// DropConnections closes all open connections, the proxy continues to
+	// accept new connections.
+	DropConnections()
+
+	// Close stops the proxy and closes all open connections.
+	Close() error
+}
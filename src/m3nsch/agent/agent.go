@@ -58,11 +58,16 @@ type m3nschAgent struct {
 	workerWg      sync.WaitGroup      // used to track when workers are finished
 	params        workerParams        // worker params
 	lastStartTime int64               // last time a workload was started as unix epoch
+	latencies     *latencyHistograms  // operation -> latency histogram
+	readersDone   chan struct{}       // closed to stop readers
+	readersWg     sync.WaitGroup      // used to track when readers are finished
 }
 
 type workerParams struct {
 	sync.RWMutex
 	fn         workerFn          // workerFn (read|write)
+	readFn     readerFn          // readerFn used by read workloads
+	tags       []m3nsch.Tag      // tags of the metrics in the working set
 	workingSet []generatedMetric // metrics corresponding to workload
 	ranges     []workerRange     // worker-idx -> workingSet idx range
 }
@@ -77,11 +82,15 @@ func New(
 		opts:     opts,
 		logger:   opts.InstrumentOptions().Logger(),
 		params: workerParams{
-			fn: workerWriteFn,
+			fn:     workerWriteFn,
+			readFn: readerFetchTaggedFn,
 		},
+		latencies: newLatencyHistograms(defaultLatencyBuckets),
 	}
 	ms.metrics = agentMetrics{
-		writeMethodMetrics: ms.newMethodMetrics("write"),
+		writeMethodMetrics:       ms.newMethodMetrics("write"),
+		writeTaggedMethodMetrics: ms.newMethodMetrics("writeTagged"),
+		fetchTaggedMethodMetrics: ms.newMethodMetrics("fetchTagged"),
 	}
 	return ms
 
@@ -115,6 +124,7 @@ func (ms *m3nschAgent) resetWithLock() {
 	ms.workload = m3nsch.Workload{}
 	ms.agentStatus = m3nsch.StatusUninitialized
 	ms.params.workingSet = nil
+	ms.params.tags = nil
 	ms.params.ranges = nil
 }
 
@@ -130,10 +140,17 @@ func (ms *m3nschAgent) setWorkerParams(workload m3nsch.Workload) {
 	if len(current) > cardinality {
 		current = current[:cardinality]
 	}
+	if !tagsEqual(ms.params.tags, workload.Tags) {
+		for i := range current {
+			current[i].tags = metricTags(workload.MetricStartIdx+i, workload.Tags)
+		}
+		ms.params.tags = append([]m3nsch.Tag(nil), workload.Tags...)
+	}
 	for i := len(current); i < cardinality; i++ {
 		idx := workload.MetricStartIdx + i
 		current = append(current, generatedMetric{
 			name:       fmt.Sprintf("%v.m%d", workload.MetricPrefix, idx),
+			tags:       metricTags(idx, workload.Tags),
 			timeseries: ms.registry.Get(i),
 		})
 	}
@@ -156,8 +173,9 @@ func (ms *m3nschAgent) Status() m3nsch.AgentStatus {
 	ms.RLock()
 	defer ms.RUnlock()
 	return m3nsch.AgentStatus{
-		Status: ms.agentStatus,
-		Token:  ms.token,
+		Status:    ms.agentStatus,
+		Token:     ms.token,
+		Latencies: ms.latencies.snapshot(),
 	}
 }
 
@@ -167,7 +185,11 @@ func (ms *m3nschAgent) Workload() m3nsch.Workload {
 	return ms.workload
 }
 
-func (ms *m3nschAgent) SetWorkload(w m3nsch.Workload) {
+func (ms *m3nschAgent) SetWorkload(w m3nsch.Workload) error {
+	if err := validateWorkload(w); err != nil {
+		return err
+	}
+
 	ms.Lock()
 	defer ms.Unlock()
 	ms.workload = w
@@ -175,7 +197,10 @@ func (ms *m3nschAgent) SetWorkload(w m3nsch.Workload) {
 
 	if ms.agentStatus == m3nsch.StatusRunning {
 		ms.notifyWorkersWithLock(workerNotification{update: true})
+		ms.stopReadersWithLock()
+		ms.startReadersWithLock()
 	}
+	return nil
 }
 
 func (ms *m3nschAgent) Init(
@@ -192,6 +217,10 @@ func (ms *m3nschAgent) Init(
 		return errAlreadyInitialized
 	}
 
+	if err := validateWorkload(w); err != nil {
+		return err
+	}
+
 	if status == m3nsch.StatusRunning {
 		if err := ms.stopWithLock(); err != nil {
 			return err
@@ -220,10 +249,12 @@ func (ms *m3nschAgent) Start() error {
 	ms.workerChans = newWorkerChannels(concurrency)
 	ms.agentStatus = m3nsch.StatusRunning
 	atomic.StoreInt64(&ms.lastStartTime, time.Now().Unix())
+	ms.latencies.reset()
 	ms.workerWg.Add(concurrency)
 	for i := 0; i < concurrency; i++ {
 		go ms.runWorker(i, ms.workerChans[i])
 	}
+	ms.startReadersWithLock()
 	return nil
 }
 
@@ -236,6 +267,7 @@ func (ms *m3nschAgent) stopWithLock() error {
 	if status == m3nsch.StatusRunning {
 		ms.notifyWorkersWithLock(workerNotification{stop: true})
 		ms.workerWg.Wait()
+		ms.stopReadersWithLock()
 	}
 
 	ms.resetWithLock()
@@ -285,6 +317,8 @@ func (ms *m3nschAgent) runWorker(workerIdx int, workerCh chan workerNotification
 	defer ms.workerWg.Done()
 	var (
 		methodMetrics                            = ms.metrics.writeMethodMetrics
+		writeLatencies                           = ms.latencies.histogram("write")
+		writeTaggedLatencies                     = ms.latencies.histogram("writeTagged")
 		timeUnit, namespace, fakeNow, tickPeriod = ms.workerParams()
 		tickLoop                                 = time.NewTicker(tickPeriod)
 	)
@@ -316,7 +350,13 @@ func (ms *m3nschAgent) runWorker(workerIdx int, workerCh chan workerNotification
 
 			err := ms.params.fn(workerIdx, ms.session, namespace, metric, fakeNow, timeUnit)
 			elapsed := time.Since(start)
-			methodMetrics.ReportSuccessOrError(err, elapsed)
+			if len(metric.tags) > 0 {
+				ms.metrics.writeTaggedMethodMetrics.ReportSuccessOrError(err, elapsed)
+				writeTaggedLatencies.record(err, elapsed)
+			} else {
+				methodMetrics.ReportSuccessOrError(err, elapsed)
+				writeLatencies.record(err, elapsed)
+			}
 		}
 	}
 }
@@ -328,9 +368,33 @@ func metricUniqueSuffix(startTime, now time.Time, uniqueAmplifier float64) strin
 
 type generatedMetric struct {
 	name       string
+	tags       []ident.Tag
 	timeseries datums.SyntheticTimeSeries
 }
 
+func metricTags(metricIdx int, tags []m3nsch.Tag) []ident.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	metricTags := make([]ident.Tag, 0, len(tags))
+	for _, tag := range tags {
+		metricTags = append(metricTags, ident.StringTag(tag.Name, tagValue(metricIdx, tag)))
+	}
+	return metricTags
+}
+
+func tagsEqual(a, b []m3nsch.Tag) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 type workerRange struct {
 	startIdx int // inclusive
 	endIdx   int // exclusive
@@ -364,11 +428,17 @@ func (c workerChannels) notify(msg workerNotification) {
 }
 
 type agentMetrics struct {
-	writeMethodMetrics instrument.MethodMetrics
+	writeMethodMetrics       instrument.MethodMetrics
+	writeTaggedMethodMetrics instrument.MethodMetrics
+	fetchTaggedMethodMetrics instrument.MethodMetrics
 }
 
 type workerFn func(workerIdx int, session client.Session, namespace string, metric generatedMetric, t time.Time, u xtime.Unit) error
 
 func workerWriteFn(_ int, session client.Session, namespace string, metric generatedMetric, t time.Time, u xtime.Unit) error {
+	if len(metric.tags) > 0 {
+		tags := ident.NewTagsIterator(ident.NewTags(metric.tags...))
+		return session.WriteTagged(ident.StringID(namespace), ident.StringID(metric.name), tags, t, metric.timeseries.Next(), u, nil)
+	}
 	return session.Write(ident.StringID(namespace), ident.StringID(metric.name), t, metric.timeseries.Next(), u, nil)
 }
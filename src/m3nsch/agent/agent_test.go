@@ -27,8 +27,11 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/idx"
 	"github.com/m3db/m3/src/m3nsch"
 	"github.com/m3db/m3/src/m3nsch/datums"
+	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	xtime "github.com/m3db/m3/src/x/time"
 
@@ -193,7 +196,7 @@ func TestWorkerParams(t *testing.T) {
 			Namespace:   testNs,
 		}
 	)
-	agent.SetWorkload(workload)
+	require.NoError(t, agent.SetWorkload(workload))
 
 	expectedWritesPerWorkerPerSec := workload.IngressQPS / opts.Concurrency()
 	expectedTickPeriodPerWorker := time.Duration(1000.0/float64(expectedWritesPerWorkerPerSec)) * time.Millisecond
@@ -338,3 +341,189 @@ func TestMetricUniqueSuffix(t *testing.T) {
 		assert.Equal(t, exp, l, "expected to see %d unique metrics", l)
 	}
 }
+
+func TestTaggedWriterAgent(t *testing.T) {
+	var (
+		reg  = datums.NewDefaultRegistry(testNumPointsPerDatum)
+		opts = newTestOptions().
+			SetConcurrency(1)
+		workload = m3nsch.Workload{
+			Cardinality:    10,
+			IngressQPS:     100,
+			MetricStartIdx: 5,
+			Tags: []m3nsch.Tag{
+				{Name: "host", Cardinality: 3},
+				{Name: "dc", Cardinality: 2},
+			},
+		}
+		agent = New(reg, opts).(*m3nschAgent)
+
+		writesLock sync.Mutex
+		tagsByName = make(map[string][]ident.Tag)
+	)
+
+	agent.params.fn = func(_ int, _ client.Session, _ string, metric generatedMetric, _ time.Time, _ xtime.Unit) error {
+		writesLock.Lock()
+		tagsByName[metric.name] = metric.tags
+		writesLock.Unlock()
+		return nil
+	}
+
+	require.NoError(t, agent.Init("", workload, false, "", ""))
+	require.NoError(t, agent.Start())
+	time.Sleep(200 * time.Millisecond)
+	require.NoError(t, agent.Stop())
+
+	writesLock.Lock()
+	defer writesLock.Unlock()
+	require.NotEmpty(t, tagsByName)
+	for i := 0; i < workload.Cardinality; i++ {
+		metricIdx := workload.MetricStartIdx + i
+		tags, ok := tagsByName[fmt.Sprintf(".m%d", metricIdx)]
+		if !ok {
+			continue
+		}
+		require.Equal(t, []ident.Tag{
+			ident.StringTag("host", fmt.Sprintf("v%d", metricIdx%3)),
+			ident.StringTag("dc", fmt.Sprintf("v%d", metricIdx%2)),
+		}, tags)
+	}
+
+	status := agent.Status()
+	histogram, ok := status.Latencies["writeTagged"]
+	require.True(t, ok)
+	require.Equal(t, int64(len(tagsByName)), histogram.Count())
+}
+
+func TestInitInvalidReadWorkload(t *testing.T) {
+	var (
+		reg      = datums.NewDefaultRegistry(testNumPointsPerDatum)
+		agent    = New(reg, newTestOptions().SetConcurrency(1))
+		workload = m3nsch.Workload{
+			Cardinality: 10,
+			IngressQPS:  10,
+			Reads: []m3nsch.ReadWorkload{
+				{TagName: "unknown", QPS: 1},
+			},
+		}
+	)
+	require.Error(t, agent.Init("", workload, false, "", ""))
+}
+
+func TestReaderAgent(t *testing.T) {
+	var (
+		reg  = datums.NewDefaultRegistry(testNumPointsPerDatum)
+		opts = newTestOptions().
+			SetConcurrency(1)
+		workload = m3nsch.Workload{
+			Namespace:   "testNs",
+			Cardinality: 10,
+			IngressQPS:  10,
+			Tags:        []m3nsch.Tag{{Name: "host", Cardinality: 4}},
+			Reads: []m3nsch.ReadWorkload{
+				{
+					QueryType:   m3nsch.NegationQuery,
+					TagName:     "host",
+					Range:       time.Hour,
+					QPS:         100,
+					Concurrency: 2,
+					Limit:       10,
+				},
+			},
+		}
+		agent = New(reg, opts).(*m3nschAgent)
+
+		readsLock  sync.Mutex
+		reads      []index.QueryOptions
+		namespaces = make(map[string]struct{})
+	)
+
+	agent.params.fn = func(_ int, _ client.Session, _ string, _ generatedMetric, _ time.Time, _ xtime.Unit) error {
+		return nil
+	}
+	agent.params.readFn = func(_ client.Session, ns string, _ index.Query, opts index.QueryOptions) error {
+		readsLock.Lock()
+		namespaces[ns] = struct{}{}
+		reads = append(reads, opts)
+		readsLock.Unlock()
+		return nil
+	}
+
+	require.NoError(t, agent.Init("", workload, false, "", ""))
+	require.NoError(t, agent.Start())
+	time.Sleep(1 * time.Second)
+	require.NoError(t, agent.Stop())
+
+	readsLock.Lock()
+	defer readsLock.Unlock()
+
+	require.Equal(t, map[string]struct{}{workload.Namespace: {}}, namespaces)
+
+	// ensure we've seen 80% of the reads we're expecting
+	require.InEpsilon(t, workload.Reads[0].QPS, len(reads), 0.2)
+	for _, opts := range reads {
+		require.Equal(t, workload.Reads[0].Range, opts.EndExclusive.Sub(opts.StartInclusive))
+		require.Equal(t, workload.Reads[0].Limit, opts.Limit)
+	}
+
+	status := agent.Status()
+	histogram, ok := status.Latencies["fetchTagged.negation"]
+	require.True(t, ok)
+	require.Equal(t, int64(len(reads)), histogram.Count())
+	require.Equal(t, len(histogram.Buckets)+1, len(histogram.Counts))
+}
+
+func TestNewReadQuery(t *testing.T) {
+	q, err := newReadQuery(m3nsch.TermQuery, "host", 3)
+	require.NoError(t, err)
+	require.True(t, q.Equal(idx.NewTermQuery([]byte("host"), []byte("v3"))))
+
+	q, err = newReadQuery(m3nsch.RegexpQuery, "host", 3)
+	require.NoError(t, err)
+	expected, err := idx.NewRegexpQuery([]byte("host"), []byte("v3.*"))
+	require.NoError(t, err)
+	require.True(t, q.Equal(expected))
+
+	q, err = newReadQuery(m3nsch.NegationQuery, "host", 3)
+	require.NoError(t, err)
+	require.True(t, q.Equal(idx.NewConjunctionQuery(
+		idx.NewFieldQuery([]byte("host")),
+		idx.NewNegationQuery(idx.NewTermQuery([]byte("host"), []byte("v3"))),
+	)))
+
+	_, err = newReadQuery(m3nsch.QueryType(-1), "host", 3)
+	require.Error(t, err)
+}
+
+func TestLatencyHistogram(t *testing.T) {
+	h := newLatencyHistogram([]time.Duration{time.Millisecond, 10 * time.Millisecond})
+	h.record(nil, time.Microsecond)
+	h.record(nil, time.Millisecond)
+	h.record(nil, 5*time.Millisecond)
+	h.record(nil, time.Second)
+	h.record(fmt.Errorf("err"), time.Microsecond)
+
+	snapshot := h.snapshot()
+	require.Equal(t, []int64{2, 1, 1}, snapshot.Counts)
+	require.Equal(t, int64(1), snapshot.Errors)
+	require.Equal(t, int64(4), snapshot.Count())
+	require.Equal(t, time.Millisecond, snapshot.Quantile(0.5))
+	require.Equal(t, 10*time.Millisecond, snapshot.Quantile(0.75))
+	require.Equal(t, 10*time.Millisecond, snapshot.Quantile(0.99))
+}
+
+func TestSetWorkloadInvalid(t *testing.T) {
+	var (
+		reg      = datums.NewDefaultRegistry(testNumPointsPerDatum)
+		agent    = New(reg, newTestOptions()).(*m3nschAgent)
+		workload = m3nsch.Workload{
+			Cardinality: 1000,
+			IngressQPS:  100,
+			BaseTime:    time.Now(),
+			Namespace:   "testNs",
+			Tags:        []m3nsch.Tag{{Name: "host", Cardinality: 0}},
+		}
+	)
+	require.Error(t, agent.SetWorkload(workload))
+	require.Equal(t, m3nsch.Workload{}, agent.Workload())
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package agent
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3/src/m3nsch"
+)
+
+var (
+	// defaultLatencyBuckets are the upper bounds of the buckets used to
+	// track operation latencies.
+	defaultLatencyBuckets = []time.Duration{
+		250 * time.Microsecond,
+		500 * time.Microsecond,
+		time.Millisecond,
+		2500 * time.Microsecond,
+		5 * time.Millisecond,
+		10 * time.Millisecond,
+		25 * time.Millisecond,
+		50 * time.Millisecond,
+		100 * time.Millisecond,
+		250 * time.Millisecond,
+		500 * time.Millisecond,
+		time.Second,
+		2500 * time.Millisecond,
+		5 * time.Second,
+	}
+)
+
+// latencyHistogram is a fixed bucket histogram safe for concurrent updates.
+type latencyHistogram struct {
+	buckets []time.Duration
+	counts  []int64
+	errors  int64
+}
+
+func newLatencyHistogram(buckets []time.Duration) *latencyHistogram {
+	return &latencyHistogram{
+		buckets: buckets,
+		counts:  make([]int64, len(buckets)+1),
+	}
+}
+
+func (h *latencyHistogram) record(err error, d time.Duration) {
+	if err != nil {
+		atomic.AddInt64(&h.errors, 1)
+		return
+	}
+	idx := sort.Search(len(h.buckets), func(i int) bool {
+		return d <= h.buckets[i]
+	})
+	atomic.AddInt64(&h.counts[idx], 1)
+}
+
+func (h *latencyHistogram) snapshot() m3nsch.LatencyHistogram {
+	counts := make([]int64, len(h.counts))
+	for i := range h.counts {
+		counts[i] = atomic.LoadInt64(&h.counts[i])
+	}
+	return m3nsch.LatencyHistogram{
+		Buckets: append([]time.Duration(nil), h.buckets...),
+		Counts:  counts,
+		Errors:  atomic.LoadInt64(&h.errors),
+	}
+}
+
+// latencyHistograms tracks a latency histogram per operation.
+type latencyHistograms struct {
+	sync.RWMutex
+	buckets    []time.Duration
+	histograms map[string]*latencyHistogram
+}
+
+func newLatencyHistograms(buckets []time.Duration) *latencyHistograms {
+	return &latencyHistograms{
+		buckets:    buckets,
+		histograms: make(map[string]*latencyHistogram),
+	}
+}
+
+func (l *latencyHistograms) histogram(op string) *latencyHistogram {
+	l.RLock()
+	h, ok := l.histograms[op]
+	l.RUnlock()
+	if ok {
+		return h
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if h, ok = l.histograms[op]; ok {
+		return h
+	}
+	h = newLatencyHistogram(l.buckets)
+	l.histograms[op] = h
+	return h
+}
+
+func (l *latencyHistograms) reset() {
+	l.Lock()
+	l.histograms = make(map[string]*latencyHistogram)
+	l.Unlock()
+}
+
+func (l *latencyHistograms) snapshot() map[string]m3nsch.LatencyHistogram {
+	l.RLock()
+	defer l.RUnlock()
+	if len(l.histograms) == 0 {
+		return nil
+	}
+	snapshots := make(map[string]m3nsch.LatencyHistogram, len(l.histograms))
+	for op, h := range l.histograms {
+		snapshots[op] = h.snapshot()
+	}
+	return snapshots
+}
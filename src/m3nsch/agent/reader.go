@@ -0,0 +1,198 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package agent
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/m3nsch"
+	"github.com/m3db/m3/src/x/ident"
+
+	"go.uber.org/zap"
+)
+
+const (
+	fetchTaggedOpPrefix = "fetchTagged."
+)
+
+// tagValue returns the value of a workload tag for the metric at the
+// specified index.
+func tagValue(metricIdx int, tag m3nsch.Tag) string {
+	return formatTagValue(metricIdx % tag.Cardinality)
+}
+
+func formatTagValue(k int) string {
+	return "v" + strconv.Itoa(k)
+}
+
+// workloadTagCardinality returns the cardinality of the named tag within the
+// workload, or false if the tag is not part of the workload.
+func workloadTagCardinality(w m3nsch.Workload, name string) (int, bool) {
+	for _, tag := range w.Tags {
+		if tag.Name == name {
+			return tag.Cardinality, true
+		}
+	}
+	return 0, false
+}
+
+func validateWorkload(w m3nsch.Workload) error {
+	for _, tag := range w.Tags {
+		if tag.Name == "" {
+			return fmt.Errorf("invalid tag: empty name")
+		}
+		if tag.Cardinality <= 0 {
+			return fmt.Errorf("invalid tag %s: cardinality must be positive", tag.Name)
+		}
+	}
+	for _, r := range w.Reads {
+		if _, ok := workloadTagCardinality(w, r.TagName); !ok {
+			return fmt.Errorf("invalid read workload: unknown tag %s", r.TagName)
+		}
+		if r.QPS < 0 || r.Concurrency < 0 || r.Limit < 0 {
+			return fmt.Errorf("invalid read workload for tag %s: negative value", r.TagName)
+		}
+	}
+	return nil
+}
+
+// newReadQuery returns the query of the specified type matching the k-th
+// value of a tag.
+func newReadQuery(queryType m3nsch.QueryType, tagName string, k int) (index.Query, error) {
+	var (
+		field = []byte(tagName)
+		value = formatTagValue(k)
+	)
+	switch queryType {
+	case m3nsch.TermQuery:
+		return index.Query{Query: idx.NewTermQuery(field, []byte(value))}, nil
+	case m3nsch.RegexpQuery:
+		q, err := idx.NewRegexpQuery(field, []byte(value+".*"))
+		if err != nil {
+			return index.Query{}, err
+		}
+		return index.Query{Query: q}, nil
+	case m3nsch.NegationQuery:
+		q := idx.NewConjunctionQuery(
+			idx.NewFieldQuery(field),
+			idx.NewNegationQuery(idx.NewTermQuery(field, []byte(value))),
+		)
+		return index.Query{Query: q}, nil
+	}
+	return index.Query{}, fmt.Errorf("unknown query type: %d", queryType)
+}
+
+type readerFn func(session client.Session, namespace string, q index.Query, opts index.QueryOptions) error
+
+func readerFetchTaggedFn(session client.Session, namespace string, q index.Query, opts index.QueryOptions) error {
+	iters, _, err := session.FetchTagged(ident.StringID(namespace), q, opts)
+	if err != nil {
+		return err
+	}
+	iters.Close()
+	return nil
+}
+
+func (ms *m3nschAgent) startReadersWithLock() {
+	var (
+		workload = ms.workload
+		session  = ms.session
+		done     = make(chan struct{})
+	)
+	ms.readersDone = done
+	for _, r := range workload.Reads {
+		cardinality, ok := workloadTagCardinality(workload, r.TagName)
+		if !ok || r.QPS <= 0 {
+			ms.logger.Warn("skipping read workload",
+				zap.String("tagName", r.TagName),
+				zap.Int("qps", r.QPS))
+			continue
+		}
+
+		concurrency := r.Concurrency
+		if concurrency <= 0 {
+			concurrency = 1
+		}
+		tickPeriod := time.Duration(float64(time.Second) * float64(concurrency) / float64(r.QPS))
+		ms.readersWg.Add(concurrency)
+		for i := 0; i < concurrency; i++ {
+			go ms.runReader(i, r, cardinality, workload.Namespace, session, tickPeriod, done)
+		}
+	}
+}
+
+func (ms *m3nschAgent) stopReadersWithLock() {
+	if ms.readersDone == nil {
+		return
+	}
+	close(ms.readersDone)
+	ms.readersWg.Wait()
+	ms.readersDone = nil
+}
+
+func (ms *m3nschAgent) runReader(
+	readerIdx int,
+	r m3nsch.ReadWorkload,
+	cardinality int,
+	namespace string,
+	session client.Session,
+	tickPeriod time.Duration,
+	done chan struct{},
+) {
+	defer ms.readersWg.Done()
+	var (
+		histogram = ms.latencies.histogram(fetchTaggedOpPrefix + r.QueryType.String())
+		tickLoop  = time.NewTicker(tickPeriod)
+		next      = readerIdx
+	)
+	defer tickLoop.Stop()
+	for {
+		select {
+		case <-done:
+			return
+
+		case <-tickLoop.C:
+			q, err := newReadQuery(r.QueryType, r.TagName, next%cardinality)
+			next++
+			if err != nil {
+				histogram.record(err, 0)
+				continue
+			}
+
+			var (
+				start = time.Now()
+				opts  = index.QueryOptions{
+					StartInclusive: start.Add(-r.Range),
+					EndExclusive:   start,
+					Limit:          r.Limit,
+				}
+			)
+			err = ms.params.readFn(session, namespace, q, opts)
+			elapsed := time.Since(start)
+			ms.metrics.fetchTaggedMethodMetrics.ReportSuccessOrError(err, elapsed)
+			histogram.record(err, elapsed)
+		}
+	}
+}
@@ -127,12 +127,19 @@ func (m *m3nschCoordinator) Status() (map[string]m3nsch.AgentStatus, error) {
 			return
 		}
 
+		latencies, err := convert.ToM3nschLatencies(response.GetLatencies())
+		if err != nil {
+			multiErr.Add(c.endpoint, err)
+			return
+		}
+
 		lock.Lock()
 		statuses[c.endpoint] = m3nsch.AgentStatus{
-			Status:   status,
-			Token:    response.Token,
-			MaxQPS:   response.MaxQPS,
-			Workload: workload,
+			Status:    status,
+			Token:     response.Token,
+			MaxQPS:    response.MaxQPS,
+			Workload:  workload,
+			Latencies: latencies,
 		}
 		lock.Unlock()
 	})
@@ -168,7 +175,15 @@ func (m *m3nschCoordinator) Workload() (m3nsch.Workload, error) {
 			workload.BaseTime = status.Workload.BaseTime
 			workload.Namespace = status.Workload.Namespace
 			workload.MetricPrefix = status.Workload.MetricPrefix
+			workload.Tags = status.Workload.Tags
+			workload.Reads = append([]m3nsch.ReadWorkload(nil), status.Workload.Reads...)
 			first = false
+		} else {
+			for i := range workload.Reads {
+				if i < len(status.Workload.Reads) {
+					workload.Reads[i].QPS += status.Workload.Reads[i].QPS
+				}
+			}
 		}
 		workload.Cardinality += status.Workload.Cardinality
 		workload.IngressQPS += status.Workload.IngressQPS
@@ -343,6 +358,7 @@ func (m *m3nschCoordinator) splitWorkload(
 		workerWorkload.MetricStartIdx = metricStart
 		workerWorkload.Cardinality = numMetrics
 		workerWorkload.IngressQPS = qps
+		workerWorkload.Reads = splitReadWorkloads(aggWorkload.Reads, workerFrac)
 		splitWorkload[endpoint] = workerWorkload
 
 		metricStart += numMetrics
@@ -350,6 +366,21 @@ func (m *m3nschCoordinator) splitWorkload(
 	return splitWorkload, nil
 }
 
+// splitReadWorkloads returns the read workloads scaled down to the specified
+// fraction of the aggregate query rate, concurrency is per agent and is
+// left as is.
+func splitReadWorkloads(reads []m3nsch.ReadWorkload, frac float64) []m3nsch.ReadWorkload {
+	if len(reads) == 0 {
+		return nil
+	}
+	split := make([]m3nsch.ReadWorkload, 0, len(reads))
+	for _, r := range reads {
+		r.QPS = int(float64(r.QPS) * frac)
+		split = append(split, r)
+	}
+	return split
+}
+
 type syncClientMultiErr struct {
 	sync.Mutex
 	multiErr xerrors.MultiError
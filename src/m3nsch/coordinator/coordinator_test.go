@@ -148,3 +148,50 @@ func TestSplitWorkload(t *testing.T) {
 	require.Equal(t, 2000, workload2.Cardinality)
 	require.Equal(t, 200, workload2.IngressQPS)
 }
+
+func TestSplitWorkloadReads(t *testing.T) {
+	coordinator := newTestCoordinator()
+	aggregateWorkload := m3nsch.Workload{
+		Cardinality: 3000,
+		IngressQPS:  300,
+		Tags:        []m3nsch.Tag{{Name: "host", Cardinality: 100}},
+		Reads: []m3nsch.ReadWorkload{
+			{
+				QueryType:   m3nsch.RegexpQuery,
+				TagName:     "host",
+				Range:       time.Hour,
+				QPS:         30,
+				Concurrency: 4,
+				Limit:       10,
+			},
+		},
+	}
+	statuses := map[string]m3nsch.AgentStatus{
+		testEndpoints[0]: {
+			MaxQPS: 200,
+		},
+		testEndpoints[1]: {
+			MaxQPS: 400,
+		},
+	}
+	splitWorkloads, err := coordinator.splitWorkload(aggregateWorkload, statuses)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(splitWorkloads))
+
+	for endpoint, expectedQPS := range map[string]int{
+		testEndpoints[0]: 10,
+		testEndpoints[1]: 20,
+	} {
+		workload, ok := splitWorkloads[endpoint]
+		require.True(t, ok)
+		require.Equal(t, aggregateWorkload.Tags, workload.Tags)
+		require.Equal(t, 1, len(workload.Reads))
+
+		expected := aggregateWorkload.Reads[0]
+		expected.QPS = expectedQPS
+		require.Equal(t, expected, workload.Reads[0])
+	}
+
+	// ensure the aggregate workload is not modified
+	require.Equal(t, 30, aggregateWorkload.Reads[0].QPS)
+}
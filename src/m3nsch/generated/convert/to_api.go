@@ -40,14 +40,78 @@ func ToM3nschWorkload(workload *proto.Workload) (m3nsch.Workload, error) {
 		return m3nsch.Workload{}, fmt.Errorf("invalid workload")
 	}
 
-	return m3nsch.Workload{
+	w := m3nsch.Workload{
 		BaseTime:        toTimeFromProtoTimestamp(workload.BaseTime),
 		MetricPrefix:    workload.MetricPrefix,
 		Namespace:       workload.Namespace,
 		Cardinality:     int(workload.Cardinality),
 		IngressQPS:      int(workload.IngressQPS),
 		UniqueAmplifier: workload.UniqueAmplifier,
-	}, nil
+	}
+	for _, tag := range workload.Tags {
+		if tag == nil {
+			return m3nsch.Workload{}, fmt.Errorf("invalid tag")
+		}
+		w.Tags = append(w.Tags, m3nsch.Tag{
+			Name:        tag.Name,
+			Cardinality: int(tag.Cardinality),
+		})
+	}
+	for _, r := range workload.Reads {
+		if r == nil {
+			return m3nsch.Workload{}, fmt.Errorf("invalid read workload")
+		}
+		queryType, err := ToM3nschQueryType(r.QueryType)
+		if err != nil {
+			return m3nsch.Workload{}, err
+		}
+		w.Reads = append(w.Reads, m3nsch.ReadWorkload{
+			QueryType:   queryType,
+			TagName:     r.TagName,
+			Range:       time.Duration(r.RangeNanos),
+			QPS:         int(r.QueryQPS),
+			Concurrency: int(r.Concurrency),
+			Limit:       int(r.Limit),
+		})
+	}
+	return w, nil
+}
+
+// ToM3nschQueryType converts a rpc QueryType into an equivalent API QueryType.
+func ToM3nschQueryType(queryType proto.QueryType) (m3nsch.QueryType, error) {
+	switch queryType {
+	case proto.QueryType_TERM:
+		return m3nsch.TermQuery, nil
+	case proto.QueryType_REGEXP:
+		return m3nsch.RegexpQuery, nil
+	case proto.QueryType_NEGATION:
+		return m3nsch.NegationQuery, nil
+	}
+	return m3nsch.TermQuery, fmt.Errorf("invalid query type: %s", queryType.String())
+}
+
+// ToM3nschLatencies converts rpc operation latencies into equivalent API
+// latency histograms keyed by operation.
+func ToM3nschLatencies(latencies []*proto.OperationLatency) (map[string]m3nsch.LatencyHistogram, error) {
+	if len(latencies) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]m3nsch.LatencyHistogram, len(latencies))
+	for _, l := range latencies {
+		if l == nil || len(l.Counts) != len(l.BucketsNanos)+1 {
+			return nil, fmt.Errorf("invalid latencies")
+		}
+		buckets := make([]time.Duration, 0, len(l.BucketsNanos))
+		for _, b := range l.BucketsNanos {
+			buckets = append(buckets, time.Duration(b))
+		}
+		result[l.Operation] = m3nsch.LatencyHistogram{
+			Buckets: buckets,
+			Counts:  l.Counts,
+			Errors:  l.Errors,
+		}
+	}
+	return result, nil
 }
 
 // ToM3nschStatus converts a rpc Status into an equivalent API Status.
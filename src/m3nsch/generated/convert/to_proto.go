@@ -21,6 +21,8 @@
 package convert
 
 import (
+	"sort"
+
 	"github.com/m3db/m3/src/m3nsch"
 	proto "github.com/m3db/m3/src/m3nsch/generated/proto/m3nsch"
 
@@ -52,5 +54,58 @@ func ToProtoWorkload(mw m3nsch.Workload) proto.Workload {
 	w.MetricPrefix = mw.MetricPrefix
 	w.Namespace = mw.Namespace
 	w.UniqueAmplifier = mw.UniqueAmplifier
+	for _, tag := range mw.Tags {
+		w.Tags = append(w.Tags, &proto.Tag{
+			Name:        tag.Name,
+			Cardinality: int32(tag.Cardinality),
+		})
+	}
+	for _, r := range mw.Reads {
+		w.Reads = append(w.Reads, &proto.ReadWorkload{
+			QueryType:   ToProtoQueryType(r.QueryType),
+			TagName:     r.TagName,
+			RangeNanos:  int64(r.Range),
+			QueryQPS:    int32(r.QPS),
+			Concurrency: int32(r.Concurrency),
+			Limit:       int32(r.Limit),
+		})
+	}
 	return w
 }
+
+// ToProtoQueryType converts an API QueryType into a RPC QueryType.
+func ToProtoQueryType(queryType m3nsch.QueryType) proto.QueryType {
+	switch queryType {
+	case m3nsch.RegexpQuery:
+		return proto.QueryType_REGEXP
+	case m3nsch.NegationQuery:
+		return proto.QueryType_NEGATION
+	}
+	return proto.QueryType_TERM
+}
+
+// ToProtoLatencies converts API latency histograms into RPC operation latencies,
+// ordered by operation name.
+func ToProtoLatencies(latencies map[string]m3nsch.LatencyHistogram) []*proto.OperationLatency {
+	ops := make([]string, 0, len(latencies))
+	for op := range latencies {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+
+	result := make([]*proto.OperationLatency, 0, len(ops))
+	for _, op := range ops {
+		h := latencies[op]
+		buckets := make([]int64, 0, len(h.Buckets))
+		for _, b := range h.Buckets {
+			buckets = append(buckets, int64(b))
+		}
+		result = append(result, &proto.OperationLatency{
+			Operation:    op,
+			BucketsNanos: buckets,
+			Counts:       h.Counts,
+			Errors:       h.Errors,
+		})
+	}
+	return result
+}
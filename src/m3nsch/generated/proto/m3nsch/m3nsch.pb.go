@@ -39,6 +39,9 @@
 		StopRequest
 		StopResponse
 		Workload
+		Tag
+		ReadWorkload
+		OperationLatency
 */
 package m3nsch
 
@@ -92,6 +95,30 @@ func (x Status) String() string {
 }
 func (Status) EnumDescriptor() ([]byte, []int) { return fileDescriptorM3Nsch, []int{0} }
 
+type QueryType int32
+
+const (
+	QueryType_TERM     QueryType = 0
+	QueryType_REGEXP   QueryType = 1
+	QueryType_NEGATION QueryType = 2
+)
+
+var QueryType_name = map[int32]string{
+	0: "TERM",
+	1: "REGEXP",
+	2: "NEGATION",
+}
+var QueryType_value = map[string]int32{
+	"TERM":     0,
+	"REGEXP":   1,
+	"NEGATION": 2,
+}
+
+func (x QueryType) String() string {
+	return proto.EnumName(QueryType_name, int32(x))
+}
+func (QueryType) EnumDescriptor() ([]byte, []int) { return fileDescriptorM3Nsch, []int{1} }
+
 type StatusRequest struct {
 }
 
@@ -101,10 +128,11 @@ func (*StatusRequest) ProtoMessage()               {}
 func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorM3Nsch, []int{0} }
 
 type StatusResponse struct {
-	Status   Status    `protobuf:"varint,1,opt,name=status,proto3,enum=m3nsch.Status" json:"status,omitempty"`
-	Token    string    `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	MaxQPS   int64     `protobuf:"varint,3,opt,name=maxQPS,proto3" json:"maxQPS,omitempty"`
-	Workload *Workload `protobuf:"bytes,4,opt,name=workload" json:"workload,omitempty"`
+	Status    Status              `protobuf:"varint,1,opt,name=status,proto3,enum=m3nsch.Status" json:"status,omitempty"`
+	Token     string              `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	MaxQPS    int64               `protobuf:"varint,3,opt,name=maxQPS,proto3" json:"maxQPS,omitempty"`
+	Workload  *Workload           `protobuf:"bytes,4,opt,name=workload" json:"workload,omitempty"`
+	Latencies []*OperationLatency `protobuf:"bytes,5,rep,name=latencies" json:"latencies,omitempty"`
 }
 
 func (m *StatusResponse) Reset()                    { *m = StatusResponse{} }
@@ -140,6 +168,13 @@ func (m *StatusResponse) GetWorkload() *Workload {
 	return nil
 }
 
+func (m *StatusResponse) GetLatencies() []*OperationLatency {
+	if m != nil {
+		return m.Latencies
+	}
+	return nil
+}
+
 type InitRequest struct {
 	Token      string    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Workload   *Workload `protobuf:"bytes,2,opt,name=workload" json:"workload,omitempty"`
@@ -259,6 +294,8 @@ type Workload struct {
 	Cardinality     int32                      `protobuf:"varint,4,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
 	IngressQPS      int32                      `protobuf:"varint,5,opt,name=ingressQPS,proto3" json:"ingressQPS,omitempty"`
 	UniqueAmplifier float64                    `protobuf:"fixed64,6,opt,name=uniqueAmplifier,proto3" json:"uniqueAmplifier,omitempty"`
+	Tags            []*Tag                     `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
+	Reads           []*ReadWorkload            `protobuf:"bytes,8,rep,name=reads" json:"reads,omitempty"`
 }
 
 func (m *Workload) Reset()                    { *m = Workload{} }
@@ -308,6 +345,140 @@ func (m *Workload) GetUniqueAmplifier() float64 {
 	return 0
 }
 
+func (m *Workload) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Workload) GetReads() []*ReadWorkload {
+	if m != nil {
+		return m.Reads
+	}
+	return nil
+}
+
+type Tag struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cardinality int32  `protobuf:"varint,2,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+}
+
+func (m *Tag) Reset()                    { *m = Tag{} }
+func (m *Tag) String() string            { return proto.CompactTextString(m) }
+func (*Tag) ProtoMessage()               {}
+func (*Tag) Descriptor() ([]byte, []int) { return fileDescriptorM3Nsch, []int{11} }
+
+func (m *Tag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tag) GetCardinality() int32 {
+	if m != nil {
+		return m.Cardinality
+	}
+	return 0
+}
+
+type ReadWorkload struct {
+	QueryType   QueryType `protobuf:"varint,1,opt,name=queryType,proto3,enum=m3nsch.QueryType" json:"queryType,omitempty"`
+	TagName     string    `protobuf:"bytes,2,opt,name=tagName,proto3" json:"tagName,omitempty"`
+	RangeNanos  int64     `protobuf:"varint,3,opt,name=rangeNanos,proto3" json:"rangeNanos,omitempty"`
+	QueryQPS    int32     `protobuf:"varint,4,opt,name=queryQPS,proto3" json:"queryQPS,omitempty"`
+	Concurrency int32     `protobuf:"varint,5,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Limit       int32     `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *ReadWorkload) Reset()                    { *m = ReadWorkload{} }
+func (m *ReadWorkload) String() string            { return proto.CompactTextString(m) }
+func (*ReadWorkload) ProtoMessage()               {}
+func (*ReadWorkload) Descriptor() ([]byte, []int) { return fileDescriptorM3Nsch, []int{12} }
+
+func (m *ReadWorkload) GetQueryType() QueryType {
+	if m != nil {
+		return m.QueryType
+	}
+	return QueryType_TERM
+}
+
+func (m *ReadWorkload) GetTagName() string {
+	if m != nil {
+		return m.TagName
+	}
+	return ""
+}
+
+func (m *ReadWorkload) GetRangeNanos() int64 {
+	if m != nil {
+		return m.RangeNanos
+	}
+	return 0
+}
+
+func (m *ReadWorkload) GetQueryQPS() int32 {
+	if m != nil {
+		return m.QueryQPS
+	}
+	return 0
+}
+
+func (m *ReadWorkload) GetConcurrency() int32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *ReadWorkload) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type OperationLatency struct {
+	Operation    string  `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	BucketsNanos []int64 `protobuf:"varint,2,rep,packed,name=bucketsNanos" json:"bucketsNanos,omitempty"`
+	Counts       []int64 `protobuf:"varint,3,rep,packed,name=counts" json:"counts,omitempty"`
+	Errors       int64   `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *OperationLatency) Reset()                    { *m = OperationLatency{} }
+func (m *OperationLatency) String() string            { return proto.CompactTextString(m) }
+func (*OperationLatency) ProtoMessage()               {}
+func (*OperationLatency) Descriptor() ([]byte, []int) { return fileDescriptorM3Nsch, []int{13} }
+
+func (m *OperationLatency) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *OperationLatency) GetBucketsNanos() []int64 {
+	if m != nil {
+		return m.BucketsNanos
+	}
+	return nil
+}
+
+func (m *OperationLatency) GetCounts() []int64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *OperationLatency) GetErrors() int64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StatusRequest)(nil), "m3nsch.StatusRequest")
 	proto.RegisterType((*StatusResponse)(nil), "m3nsch.StatusResponse")
@@ -320,7 +491,11 @@ func init() {
 	proto.RegisterType((*StopRequest)(nil), "m3nsch.StopRequest")
 	proto.RegisterType((*StopResponse)(nil), "m3nsch.StopResponse")
 	proto.RegisterType((*Workload)(nil), "m3nsch.Workload")
+	proto.RegisterType((*Tag)(nil), "m3nsch.Tag")
+	proto.RegisterType((*ReadWorkload)(nil), "m3nsch.ReadWorkload")
+	proto.RegisterType((*OperationLatency)(nil), "m3nsch.OperationLatency")
 	proto.RegisterEnum("m3nsch.Status", Status_name, Status_value)
+	proto.RegisterEnum("m3nsch.QueryType", QueryType_name, QueryType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -586,6 +761,18 @@ func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.Latencies) > 0 {
+		for _, msg := range m.Latencies {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintM3Nsch(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -834,6 +1021,173 @@ func (m *Workload) MarshalTo(dAtA []byte) (int, error) {
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UniqueAmplifier))))
 		i += 8
 	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintM3Nsch(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Reads) > 0 {
+		for _, msg := range m.Reads {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintM3Nsch(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Tag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Cardinality != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(m.Cardinality))
+	}
+	return i, nil
+}
+
+func (m *ReadWorkload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadWorkload) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.QueryType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(m.QueryType))
+	}
+	if len(m.TagName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(len(m.TagName)))
+		i += copy(dAtA[i:], m.TagName)
+	}
+	if m.RangeNanos != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(m.RangeNanos))
+	}
+	if m.QueryQPS != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(m.QueryQPS))
+	}
+	if m.Concurrency != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(m.Concurrency))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *OperationLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationLatency) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operation) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(len(m.Operation)))
+		i += copy(dAtA[i:], m.Operation)
+	}
+	if len(m.BucketsNanos) > 0 {
+		dAtA6 := make([]byte, len(m.BucketsNanos)*10)
+		var j5 int
+		for _, num1 := range m.BucketsNanos {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	if len(m.Counts) > 0 {
+		dAtA8 := make([]byte, len(m.Counts)*10)
+		var j7 int
+		for _, num1 := range m.Counts {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
+	}
+	if m.Errors != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintM3Nsch(dAtA, i, uint64(m.Errors))
+	}
 	return i, nil
 }
 
@@ -869,6 +1223,12 @@ func (m *StatusResponse) Size() (n int) {
 		l = m.Workload.Size()
 		n += 1 + l + sovM3Nsch(uint64(l))
 	}
+	if len(m.Latencies) > 0 {
+		for _, e := range m.Latencies {
+			l = e.Size()
+			n += 1 + l + sovM3Nsch(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -967,6 +1327,83 @@ func (m *Workload) Size() (n int) {
 	if m.UniqueAmplifier != 0 {
 		n += 9
 	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovM3Nsch(uint64(l))
+		}
+	}
+	if len(m.Reads) > 0 {
+		for _, e := range m.Reads {
+			l = e.Size()
+			n += 1 + l + sovM3Nsch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovM3Nsch(uint64(l))
+	}
+	if m.Cardinality != 0 {
+		n += 1 + sovM3Nsch(uint64(m.Cardinality))
+	}
+	return n
+}
+
+func (m *ReadWorkload) Size() (n int) {
+	var l int
+	_ = l
+	if m.QueryType != 0 {
+		n += 1 + sovM3Nsch(uint64(m.QueryType))
+	}
+	l = len(m.TagName)
+	if l > 0 {
+		n += 1 + l + sovM3Nsch(uint64(l))
+	}
+	if m.RangeNanos != 0 {
+		n += 1 + sovM3Nsch(uint64(m.RangeNanos))
+	}
+	if m.QueryQPS != 0 {
+		n += 1 + sovM3Nsch(uint64(m.QueryQPS))
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovM3Nsch(uint64(m.Concurrency))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovM3Nsch(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *OperationLatency) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovM3Nsch(uint64(l))
+	}
+	if len(m.BucketsNanos) > 0 {
+		l = 0
+		for _, e := range m.BucketsNanos {
+			l += sovM3Nsch(uint64(e))
+		}
+		n += 1 + sovM3Nsch(uint64(l)) + l
+	}
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovM3Nsch(uint64(e))
+		}
+		n += 1 + sovM3Nsch(uint64(l)) + l
+	}
+	if m.Errors != 0 {
+		n += 1 + sovM3Nsch(uint64(m.Errors))
+	}
 	return n
 }
 
@@ -1162,13 +1599,44 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipM3Nsch(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latencies", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Latencies = append(m.Latencies, &OperationLatency{})
+			if err := m.Latencies[len(m.Latencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipM3Nsch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthM3Nsch
 			}
 			if (iNdEx + skippy) > l {
@@ -1925,6 +2393,562 @@ func (m *Workload) Unmarshal(dAtA []byte) error {
 			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.UniqueAmplifier = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reads = append(m.Reads, &ReadWorkload{})
+			if err := m.Reads[len(m.Reads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipM3Nsch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowM3Nsch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cardinality", wireType)
+			}
+			m.Cardinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cardinality |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipM3Nsch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadWorkload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowM3Nsch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadWorkload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadWorkload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			m.QueryType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryType |= (QueryType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeNanos", wireType)
+			}
+			m.RangeNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryQPS", wireType)
+			}
+			m.QueryQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryQPS |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipM3Nsch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowM3Nsch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthM3Nsch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowM3Nsch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BucketsNanos = append(m.BucketsNanos, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowM3Nsch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthM3Nsch
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowM3Nsch
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BucketsNanos = append(m.BucketsNanos, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketsNanos", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowM3Nsch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowM3Nsch
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthM3Nsch
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowM3Nsch
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			m.Errors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowM3Nsch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errors |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipM3Nsch(dAtA[iNdEx:])
@@ -2056,44 +3080,60 @@ func init() {
 }
 
 var fileDescriptorM3Nsch = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4d, 0x6e, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0x69, 0x63, 0x92, 0x97, 0x26, 0x0d, 0x43, 0x5a, 0x45, 0x11, 0x0a, 0x51, 0x16,
-	0x28, 0x42, 0x28, 0x16, 0x0d, 0xa2, 0x2b, 0x16, 0x45, 0x14, 0x14, 0x41, 0x4d, 0x71, 0x5a, 0x55,
-	0xea, 0x6e, 0xe2, 0x4c, 0xdc, 0x51, 0x63, 0x8f, 0x3b, 0x33, 0x86, 0xf6, 0x16, 0x2c, 0x10, 0x37,
-	0xe0, 0x2e, 0x2c, 0x39, 0x02, 0x2a, 0x17, 0xe0, 0x08, 0xc8, 0x9e, 0x71, 0xec, 0x64, 0xd3, 0x95,
-	0xf5, 0x7e, 0xef, 0x73, 0xde, 0xfb, 0x1b, 0x0e, 0x7d, 0xa6, 0x2e, 0xe3, 0xe9, 0xd0, 0xe3, 0x81,
-	0x1d, 0x8c, 0x66, 0x53, 0x3b, 0x18, 0xd9, 0x52, 0x78, 0x76, 0x30, 0x0a, 0xa5, 0x77, 0x69, 0xfb,
-	0x34, 0xa4, 0x82, 0x28, 0x3a, 0xb3, 0x23, 0xc1, 0x15, 0xcf, 0xb0, 0xfe, 0x0c, 0x53, 0x86, 0x2d,
-	0x6d, 0x75, 0x9e, 0xf8, 0x9c, 0xfb, 0x0b, 0xaa, 0x23, 0xa7, 0xf1, 0xdc, 0x56, 0x2c, 0xa0, 0x52,
-	0x91, 0x20, 0xd2, 0x81, 0xfd, 0x1d, 0xa8, 0x4f, 0x14, 0x51, 0xb1, 0x74, 0xe9, 0x75, 0x4c, 0xa5,
-	0xea, 0x7f, 0x47, 0xd0, 0xc8, 0x88, 0x8c, 0x78, 0x28, 0x29, 0x7e, 0x0a, 0x96, 0x4c, 0x49, 0x1b,
-	0xf5, 0xd0, 0xa0, 0xb1, 0xdf, 0x18, 0x9a, 0x5e, 0x26, 0xce, 0x78, 0x71, 0x0b, 0xca, 0x8a, 0x5f,
-	0xd1, 0xb0, 0x5d, 0xea, 0xa1, 0x41, 0xd5, 0xd5, 0x06, 0xde, 0x03, 0x2b, 0x20, 0x37, 0x9f, 0x4f,
-	0x26, 0xed, 0xcd, 0x1e, 0x1a, 0x6c, 0xba, 0xc6, 0xc2, 0xcf, 0xa1, 0xf2, 0x95, 0x8b, 0xab, 0x05,
-	0x27, 0xb3, 0xf6, 0x56, 0x0f, 0x0d, 0x6a, 0xfb, 0xcd, 0xac, 0xee, 0xb9, 0xe1, 0xee, 0x32, 0xa2,
-	0xff, 0x13, 0x41, 0x6d, 0x1c, 0x32, 0x65, 0xc6, 0xcc, 0x7b, 0xa1, 0x62, 0xaf, 0x62, 0xcd, 0xd2,
-	0x7d, 0x35, 0x93, 0x1a, 0x73, 0x2e, 0x3c, 0x9a, 0x0e, 0x56, 0x71, 0xb5, 0x81, 0xbb, 0x00, 0x8a,
-	0x08, 0x9f, 0xaa, 0x0b, 0x1e, 0xd2, 0x74, 0xb2, 0xaa, 0x5b, 0x20, 0xf8, 0x31, 0x54, 0xb5, 0x75,
-	0x14, 0x7e, 0x69, 0x97, 0x53, 0x77, 0x0e, 0xfa, 0x0d, 0xd8, 0xd6, 0x63, 0xea, 0xdd, 0xf5, 0x5f,
-	0x43, 0xfd, 0x98, 0xcf, 0xd8, 0xfc, 0x36, 0x1b, 0xbc, 0x38, 0x22, 0xba, 0xf7, 0xd9, 0x4d, 0x68,
-	0x64, 0xe9, 0xa6, 0x60, 0x03, 0xb6, 0x27, 0x8a, 0x88, 0x6c, 0x11, 0xe6, 0x80, 0x22, 0xef, 0x58,
-	0x87, 0xda, 0x44, 0xf1, 0x28, 0xf3, 0xa7, 0xf1, 0x89, 0x69, 0xdc, 0xff, 0x10, 0x54, 0xb2, 0x46,
-	0xf8, 0x15, 0x54, 0xa6, 0x44, 0xd2, 0x53, 0x16, 0x50, 0x33, 0x4c, 0x67, 0xa8, 0x15, 0x33, 0xcc,
-	0x14, 0x33, 0x3c, 0xcd, 0x14, 0xe3, 0x2e, 0x63, 0x71, 0x1f, 0xb6, 0x03, 0xaa, 0x04, 0xf3, 0x4e,
-	0x04, 0x9d, 0xb3, 0x1b, 0x73, 0xf0, 0x15, 0x96, 0xec, 0x29, 0x24, 0x01, 0x95, 0x11, 0x31, 0x1b,
-	0xae, 0xba, 0x39, 0xc0, 0x3d, 0xa8, 0x79, 0x44, 0xcc, 0x58, 0x48, 0x16, 0x4c, 0xdd, 0xa6, 0x6b,
-	0x2e, 0xbb, 0x45, 0x94, 0xdc, 0x81, 0x85, 0xbe, 0xa0, 0x52, 0x26, 0xda, 0x29, 0xa7, 0x01, 0x05,
-	0x82, 0x07, 0xb0, 0x13, 0x87, 0xec, 0x3a, 0xa6, 0x87, 0x41, 0xb4, 0x60, 0x73, 0x46, 0x45, 0xdb,
-	0xea, 0xa1, 0x01, 0x72, 0xd7, 0xf1, 0xb3, 0x77, 0x60, 0x69, 0xa5, 0xe2, 0x1a, 0x3c, 0x38, 0x73,
-	0x3e, 0x38, 0x9f, 0xce, 0x9d, 0xe6, 0x06, 0x7e, 0x08, 0xf5, 0x33, 0x67, 0xec, 0x8c, 0x4f, 0xc7,
-	0x87, 0x1f, 0xc7, 0x17, 0x47, 0x6f, 0x9b, 0x08, 0xef, 0x40, 0xad, 0x08, 0x4a, 0x49, 0x82, 0x7b,
-	0xe6, 0x38, 0x63, 0xe7, 0x7d, 0x73, 0x73, 0xff, 0x47, 0x09, 0xac, 0x63, 0x9a, 0x9c, 0x0a, 0x1f,
-	0x2c, 0x4b, 0xee, 0xae, 0xfd, 0x0c, 0x7a, 0xed, 0x9d, 0xbd, 0x75, 0x6c, 0xfe, 0xa5, 0x17, 0xb0,
-	0x95, 0xe8, 0x03, 0x3f, 0xca, 0xfc, 0x05, 0x51, 0x77, 0x5a, 0xab, 0xd0, 0xa4, 0xbc, 0x84, 0x72,
-	0x7a, 0x61, 0xdc, 0x2a, 0xd4, 0x5c, 0x0a, 0xa0, 0xb3, 0xbb, 0x46, 0xf3, 0x46, 0xc9, 0xdd, 0xf3,
-	0x46, 0x05, 0x51, 0x74, 0x5a, 0xab, 0xd0, 0xa4, 0x1c, 0x80, 0xa5, 0xc5, 0x96, 0x3f, 0x6a, 0x45,
-	0xbb, 0xf9, 0xa3, 0x56, 0x35, 0xf9, 0xa6, 0xf9, 0xeb, 0xae, 0x8b, 0x7e, 0xdf, 0x75, 0xd1, 0x9f,
-	0xbb, 0x2e, 0xfa, 0xf6, 0xb7, 0xbb, 0x31, 0xb5, 0x52, 0xf9, 0x8c, 0xfe, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x6a, 0x4c, 0xfe, 0xd7, 0xcb, 0x04, 0x00, 0x00,
+	// 868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x85, 0x54, 0xcd, 0x6e, 0x13, 0x49,
+	0x10, 0x66, 0xfc, 0x17, 0xbb, 0x9c, 0x38, 0xa6, 0xd7, 0xa0, 0x91, 0x85, 0x42, 0x34, 0x07, 0x84,
+	0xa2, 0x95, 0x2d, 0x02, 0x62, 0x0f, 0x88, 0x43, 0x56, 0x6b, 0x22, 0x0b, 0x32, 0x09, 0x1d, 0x47,
+	0x59, 0x71, 0x1b, 0x8f, 0xdb, 0xc3, 0x28, 0x76, 0xb7, 0xe9, 0x69, 0x03, 0xbe, 0xf3, 0x00, 0x9c,
+	0xf6, 0x0d, 0xf6, 0x31, 0xf6, 0xbe, 0x87, 0x5d, 0x89, 0x47, 0x40, 0xec, 0x8b, 0x6c, 0xff, 0x7a,
+	0xc6, 0xe6, 0xc0, 0xc1, 0x3f, 0xf5, 0xd5, 0xd7, 0xd5, 0x55, 0x5f, 0x55, 0x17, 0x9c, 0x24, 0xa9,
+	0x78, 0xbb, 0x1c, 0xf7, 0x62, 0x36, 0xef, 0xcf, 0x1f, 0x4f, 0xc6, 0xf2, 0xab, 0x9f, 0xf1, 0x58,
+	0xfe, 0xd0, 0x2c, 0x7e, 0xdb, 0x4f, 0x08, 0x25, 0x3c, 0x12, 0x64, 0xd2, 0x5f, 0x70, 0x26, 0x98,
+	0x83, 0xcd, 0x4f, 0x4f, 0x63, 0xa8, 0x66, 0xac, 0xee, 0xfd, 0x84, 0xb1, 0x64, 0x46, 0x0c, 0x73,
+	0xbc, 0x9c, 0xf6, 0x45, 0x3a, 0x27, 0x99, 0x88, 0xe6, 0x0b, 0x43, 0x0c, 0xf6, 0x61, 0xef, 0x52,
+	0x44, 0x62, 0x99, 0x61, 0xf2, 0x6e, 0x29, 0x3d, 0xc1, 0x3f, 0x1e, 0xb4, 0x1c, 0x92, 0x2d, 0x18,
+	0xcd, 0x08, 0x7a, 0x00, 0xb5, 0x4c, 0x23, 0xbe, 0x77, 0xe8, 0x3d, 0x6c, 0x1d, 0xb7, 0x7a, 0xf6,
+	0x2e, 0xcb, 0xb3, 0x5e, 0xd4, 0x81, 0xaa, 0x60, 0x37, 0x84, 0xfa, 0x25, 0x49, 0x6b, 0x60, 0x63,
+	0xa0, 0xbb, 0x50, 0x9b, 0x47, 0x1f, 0x5f, 0x5f, 0x5c, 0xfa, 0x65, 0x09, 0x97, 0xb1, 0xb5, 0xd0,
+	0xcf, 0x50, 0xff, 0xc0, 0xf8, 0xcd, 0x8c, 0x45, 0x13, 0xbf, 0x22, 0x3d, 0xcd, 0xe3, 0xb6, 0x8b,
+	0x7b, 0x6d, 0x71, 0xbc, 0x66, 0xa0, 0xa7, 0xd0, 0x98, 0xc9, 0x9a, 0x69, 0x9c, 0x92, 0xcc, 0xaf,
+	0x1e, 0x96, 0x25, 0xdd, 0x77, 0xf4, 0xf3, 0x85, 0xd2, 0x23, 0x65, 0xf4, 0x95, 0x66, 0xac, 0x70,
+	0x4e, 0x0d, 0xfe, 0xf4, 0xa0, 0x39, 0xa4, 0xa9, 0xb0, 0xe5, 0xe5, 0x39, 0x7a, 0xc5, 0x1c, 0x8b,
+	0xb9, 0x94, 0x7e, 0x98, 0x8b, 0x8c, 0x31, 0x65, 0x3c, 0x26, 0xba, 0xa0, 0x3a, 0x36, 0x06, 0x3a,
+	0x00, 0x10, 0x11, 0x4f, 0x88, 0x78, 0xc3, 0x28, 0xd1, 0x15, 0x35, 0x70, 0x01, 0x41, 0xf7, 0xa0,
+	0x61, 0xac, 0x01, 0x7d, 0x2f, 0x2b, 0x50, 0xee, 0x1c, 0x08, 0x5a, 0xb0, 0x6b, 0xd2, 0x34, 0x9a,
+	0x07, 0xcf, 0x61, 0xef, 0x8c, 0x4d, 0xd2, 0xe9, 0xca, 0x25, 0x5e, 0x4c, 0xd1, 0xfb, 0x51, 0x8a,
+	0x41, 0x1b, 0x5a, 0xee, 0xb8, 0x0d, 0x28, 0x2f, 0x90, 0xed, 0xe2, 0x4e, 0x08, 0xdb, 0x78, 0x9e,
+	0xdf, 0xb8, 0x07, 0xcd, 0x4b, 0xc1, 0x16, 0xce, 0xaf, 0xf9, 0xca, 0xb4, 0xee, 0xbf, 0x4a, 0x50,
+	0xbf, 0xce, 0xbb, 0x51, 0x1f, 0x47, 0x19, 0x19, 0xc9, 0x61, 0xb2, 0xc9, 0x74, 0x7b, 0x66, 0xd2,
+	0x7a, 0x6e, 0xd2, 0x7a, 0x23, 0x37, 0x69, 0x78, 0xcd, 0x45, 0x01, 0xec, 0xce, 0x89, 0xe0, 0x69,
+	0x7c, 0xc1, 0xc9, 0x34, 0xfd, 0x68, 0x07, 0x65, 0x03, 0x53, 0x3a, 0xd1, 0x48, 0x1e, 0x5d, 0x44,
+	0x56, 0x61, 0xa9, 0xd3, 0x1a, 0x40, 0x87, 0xd0, 0x8c, 0x23, 0x3e, 0x49, 0x69, 0x34, 0x4b, 0xc5,
+	0x4a, 0xcb, 0x5c, 0xc5, 0x45, 0x48, 0xf5, 0x21, 0xa5, 0x09, 0x27, 0x59, 0xa6, 0x66, 0xae, 0xaa,
+	0x09, 0x05, 0x04, 0x3d, 0x84, 0xfd, 0x25, 0x4d, 0x65, 0x91, 0x27, 0xf3, 0xc5, 0x2c, 0x9d, 0xa6,
+	0x84, 0xfb, 0x35, 0x49, 0xf2, 0xf0, 0x36, 0x8c, 0xee, 0x43, 0x45, 0x44, 0x49, 0xe6, 0xef, 0xe8,
+	0x71, 0x6b, 0x3a, 0xb9, 0x47, 0x51, 0x82, 0xb5, 0x03, 0x1d, 0x41, 0x95, 0x93, 0x68, 0x92, 0xf9,
+	0x75, 0xcd, 0xe8, 0x38, 0x06, 0x96, 0xe0, 0xba, 0x29, 0x86, 0x12, 0x3c, 0x83, 0xb2, 0x3c, 0x88,
+	0x10, 0x54, 0x54, 0x31, 0x76, 0xfc, 0xf4, 0xff, 0xed, 0x9a, 0x4a, 0xdf, 0xd5, 0x14, 0xfc, 0xeb,
+	0xc1, 0x6e, 0x31, 0x28, 0xea, 0x43, 0x43, 0xa6, 0xca, 0x57, 0xa3, 0xd5, 0x82, 0xd8, 0x57, 0x79,
+	0xdb, 0xdd, 0xfe, 0xda, 0x39, 0x70, 0xce, 0x41, 0x3e, 0xec, 0xc8, 0x94, 0x43, 0x75, 0xb5, 0x11,
+	0xdd, 0x99, 0x4a, 0x2f, 0x1e, 0xd1, 0x84, 0x84, 0x11, 0x65, 0x99, 0x7d, 0xa3, 0x05, 0x04, 0x75,
+	0xa1, 0xae, 0xc3, 0x28, 0x35, 0x8d, 0xdc, 0x6b, 0x5b, 0x67, 0xce, 0x68, 0xbc, 0xe4, 0x5c, 0xbd,
+	0x3b, 0x2b, 0x76, 0x11, 0x52, 0x6f, 0x65, 0x96, 0xce, 0x53, 0xa1, 0x35, 0xae, 0x62, 0x63, 0x04,
+	0x9f, 0x3c, 0x68, 0x6f, 0xbf, 0x5a, 0xd5, 0x78, 0xe6, 0x30, 0xab, 0x4f, 0x0e, 0xa8, 0xd1, 0x19,
+	0x2f, 0xe3, 0x1b, 0x22, 0x32, 0x93, 0x68, 0x49, 0x4a, 0x5e, 0xc6, 0x1b, 0x98, 0x5a, 0x35, 0x31,
+	0x5b, 0x52, 0xa1, 0xca, 0x50, 0x5e, 0x6b, 0x29, 0x9c, 0x70, 0xce, 0x78, 0xa6, 0x0b, 0x90, 0xb8,
+	0xb1, 0x8e, 0x5e, 0x40, 0xcd, 0xac, 0x30, 0xd4, 0x84, 0x9d, 0xab, 0xf0, 0x65, 0x78, 0x7e, 0x1d,
+	0xb6, 0x6f, 0xa1, 0xdb, 0xb0, 0x77, 0x15, 0x0e, 0xc3, 0xe1, 0x68, 0x78, 0xf2, 0x6a, 0xf8, 0x66,
+	0xf0, 0x5b, 0xdb, 0x43, 0xfb, 0x72, 0x8b, 0x14, 0x80, 0x92, 0x3a, 0x80, 0xaf, 0x42, 0xc9, 0x3a,
+	0x6d, 0x97, 0x8f, 0x64, 0x37, 0xd6, 0xa2, 0xa3, 0x3a, 0x54, 0x46, 0x03, 0x7c, 0x26, 0xe3, 0x00,
+	0xd4, 0xf0, 0xe0, 0x74, 0xf0, 0xfb, 0x85, 0x0c, 0xb0, 0x0b, 0xf5, 0x70, 0x70, 0x7a, 0x32, 0x1a,
+	0x9e, 0x87, 0xed, 0xd2, 0xf1, 0x1f, 0x25, 0xa8, 0x9d, 0x11, 0xd5, 0x2d, 0xf4, 0xcb, 0x3a, 0x87,
+	0x3b, 0x5b, 0x6b, 0xd5, 0x3c, 0xc4, 0xee, 0xdd, 0x6d, 0xd8, 0x6e, 0xe5, 0x47, 0x50, 0x51, 0x1b,
+	0x03, 0xfd, 0xe4, 0xfc, 0x85, 0x35, 0xd7, 0xed, 0x6c, 0x82, 0xf6, 0xc8, 0x13, 0xa8, 0xea, 0x37,
+	0x8f, 0x3a, 0x85, 0x98, 0xeb, 0x95, 0xd0, 0xbd, 0xb3, 0x85, 0xe6, 0x17, 0xa9, 0x4d, 0x90, 0x5f,
+	0x54, 0x58, 0x13, 0xdd, 0xce, 0x26, 0x68, 0x8f, 0xc8, 0xa2, 0xcc, 0xfa, 0xc9, 0x8b, 0xda, 0xd8,
+	0x66, 0x79, 0x51, 0x9b, 0x5b, 0xea, 0xd7, 0xf6, 0xdf, 0xdf, 0x0e, 0xbc, 0x2f, 0xf2, 0xf3, 0x55,
+	0x7e, 0x3e, 0xff, 0x77, 0x70, 0x6b, 0x5c, 0xd3, 0x0b, 0xe5, 0xf1, 0xff, 0x3a, 0x0a, 0xbf, 0xff,
+	0x15, 0x07, 0x00, 0x00,
 }
@@ -21,6 +21,8 @@
 package m3nsch
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/client"
@@ -69,6 +71,123 @@ type Workload struct {
 	// between 0.0 and 1.0 that will be unique. This allows for generating metrics
 	// with steady cardinality rate over time.
 	UniqueAmplifier float64
+
+	// Tags is the set of tags attached to each metric. When non-empty, metrics
+	// are written using tagged writes and are queryable via the index.
+	Tags []Tag
+
+	// Reads is the set of index read workloads run alongside the writes.
+	Reads []ReadWorkload
+}
+
+// Tag describes a tag attached to each generated metric.
+type Tag struct {
+	// Name is the tag name.
+	Name string
+
+	// Cardinality is the number of unique values used for the tag, values
+	// are assigned to metrics in a round-robin fashion. This uniform
+	// distribution is the only one supported.
+	Cardinality int
+}
+
+// QueryType is the shape of index query issued by a read workload.
+type QueryType int
+
+const (
+	// TermQuery matches a single tag value exactly.
+	TermQuery QueryType = iota
+
+	// RegexpQuery matches tag values using a prefix regular expression.
+	RegexpQuery
+
+	// NegationQuery matches all series with the tag, excluding a single value.
+	NegationQuery
+)
+
+var queryTypeNames = []string{"term", "regexp", "negation"}
+
+func (t QueryType) String() string {
+	if t < 0 || int(t) >= len(queryTypeNames) {
+		return "unknown"
+	}
+	return queryTypeNames[t]
+}
+
+// ParseQueryType parses a QueryType from its string representation.
+func ParseQueryType(str string) (QueryType, error) {
+	for i, name := range queryTypeNames {
+		if name == str {
+			return QueryType(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid query type: %s, valid types are: %v", str, queryTypeNames)
+}
+
+// ReadWorkload is a collection of attributes required to define a FetchTagged
+// read workload.
+type ReadWorkload struct {
+	// QueryType is the shape of query issued.
+	QueryType QueryType
+
+	// TagName is the tag the query matches against, it must be one of the
+	// workload's tags.
+	TagName string
+
+	// Range is the time range queried, ending at the current time.
+	Range time.Duration
+
+	// QPS is the number of queries issued per second.
+	QPS int
+
+	// Concurrency is the number of go-routines issuing queries.
+	Concurrency int
+
+	// Limit is the maximum number of series returned per query, zero
+	// means no limit.
+	Limit int
+}
+
+// LatencyHistogram is a histogram of operation latencies.
+type LatencyHistogram struct {
+	// Buckets are the upper bounds of each histogram bucket in increasing order.
+	Buckets []time.Duration
+
+	// Counts are the number of operations per bucket, it has one more element
+	// than Buckets, the last of which counts operations exceeding all bounds.
+	Counts []int64
+
+	// Errors is the number of operations which returned an error.
+	Errors int64
+}
+
+// Count returns the total number of operations recorded in the histogram.
+func (h LatencyHistogram) Count() int64 {
+	var total int64
+	for _, c := range h.Counts {
+		total += c
+	}
+	return total
+}
+
+// Quantile returns the upper bound of the bucket containing the specified
+// quantile, or the largest bound if the quantile lies in the overflow bucket.
+func (h LatencyHistogram) Quantile(q float64) time.Duration {
+	total := h.Count()
+	if total == 0 || len(h.Buckets) == 0 {
+		return 0
+	}
+	var (
+		target = int64(math.Ceil(q * float64(total)))
+		seen   int64
+	)
+	for i, c := range h.Counts {
+		seen += c
+		if seen >= target && i < len(h.Buckets) {
+			return h.Buckets[i]
+		}
+	}
+	return h.Buckets[len(h.Buckets)-1]
 }
 
 // Coordinator refers to the process responsible for synchronizing load generation.
@@ -138,6 +257,10 @@ type AgentStatus struct {
 
 	// Workload is the currently configured workload on the agent process
 	Workload Workload
+
+	// Latencies are the operation latencies observed by the agent process
+	// since it was last started, keyed by operation name
+	Latencies map[string]LatencyHistogram
 }
 
 // Agent refers to the process responsible for executing load generation.
@@ -148,8 +271,9 @@ type Agent interface {
 	// Workload returns Workload currently configured on the agent process.
 	Workload() Workload
 
-	// SetWorkload sets the Workload on the agent process.
-	SetWorkload(Workload)
+	// SetWorkload sets the Workload on the agent process, or errors if the
+	// Workload is invalid.
+	SetWorkload(Workload) error
 
 	// Init initializes resources required by the agent process.
 	Init(token string, w Workload, force bool, targetZone string, targetEnv string) error
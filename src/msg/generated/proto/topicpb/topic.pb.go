@@ -76,9 +76,11 @@ func (x ConsumptionType) String() string {
 func (ConsumptionType) EnumDescriptor() ([]byte, []int) { return fileDescriptorTopic, []int{0} }
 
 type Topic struct {
-	Name             string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	NumberOfShards   uint32             `protobuf:"varint,2,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
-	ConsumerServices []*ConsumerService `protobuf:"bytes,3,rep,name=consumer_services,json=consumerServices" json:"consumer_services,omitempty"`
+	Name                  string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NumberOfShards        uint32             `protobuf:"varint,2,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
+	ConsumerServices      []*ConsumerService `protobuf:"bytes,3,rep,name=consumer_services,json=consumerServices" json:"consumer_services,omitempty"`
+	PendingNumberOfShards uint32             `protobuf:"varint,4,opt,name=pending_number_of_shards,json=pendingNumberOfShards,proto3" json:"pending_number_of_shards,omitempty"`
+	ReshardStartNanos     int64              `protobuf:"varint,5,opt,name=reshard_start_nanos,json=reshardStartNanos,proto3" json:"reshard_start_nanos,omitempty"`
 }
 
 func (m *Topic) Reset()                    { *m = Topic{} }
@@ -107,6 +109,20 @@ func (m *Topic) GetConsumerServices() []*ConsumerService {
 	return nil
 }
 
+func (m *Topic) GetPendingNumberOfShards() uint32 {
+	if m != nil {
+		return m.PendingNumberOfShards
+	}
+	return 0
+}
+
+func (m *Topic) GetReshardStartNanos() int64 {
+	if m != nil {
+		return m.ReshardStartNanos
+	}
+	return 0
+}
+
 type ConsumerService struct {
 	ServiceId       *ServiceID      `protobuf:"bytes,1,opt,name=service_id,json=serviceId" json:"service_id,omitempty"`
 	ConsumptionType ConsumptionType `protobuf:"varint,2,opt,name=consumption_type,json=consumptionType,proto3,enum=topicpb.ConsumptionType" json:"consumption_type,omitempty"`
@@ -215,6 +231,16 @@ func (m *Topic) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.PendingNumberOfShards != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTopic(dAtA, i, uint64(m.PendingNumberOfShards))
+	}
+	if m.ReshardStartNanos != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTopic(dAtA, i, uint64(m.ReshardStartNanos))
+	}
 	return i, nil
 }
 
@@ -317,6 +343,12 @@ func (m *Topic) Size() (n int) {
 			n += 1 + l + sovTopic(uint64(l))
 		}
 	}
+	if m.PendingNumberOfShards != 0 {
+		n += 1 + sovTopic(uint64(m.PendingNumberOfShards))
+	}
+	if m.ReshardStartNanos != 0 {
+		n += 1 + sovTopic(uint64(m.ReshardStartNanos))
+	}
 	return n
 }
 
@@ -475,6 +507,44 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNumberOfShards", wireType)
+			}
+			m.PendingNumberOfShards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingNumberOfShards |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReshardStartNanos", wireType)
+			}
+			m.ReshardStartNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReshardStartNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopic(dAtA[iNdEx:])
@@ -864,30 +934,32 @@ func init() {
 }
 
 var fileDescriptorTopic = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4f, 0x8e, 0xd3, 0x30,
-	0x14, 0x87, 0xc7, 0x53, 0x98, 0x51, 0x5e, 0x44, 0x9b, 0xf1, 0x2a, 0xab, 0x28, 0xea, 0x2a, 0x9a,
-	0x45, 0x22, 0xa6, 0x3b, 0x16, 0x48, 0x43, 0x1b, 0x89, 0x0a, 0x94, 0x41, 0x6e, 0x46, 0x2c, 0xa3,
-	0xfc, 0xf1, 0x64, 0x22, 0xd5, 0x76, 0x64, 0xbb, 0x95, 0xca, 0x19, 0x58, 0x70, 0x19, 0xee, 0xc0,
-	0x92, 0x23, 0xa0, 0x72, 0x11, 0x14, 0xd7, 0x14, 0x0a, 0xb3, 0xca, 0xd3, 0x97, 0x9f, 0xdf, 0xfb,
-	0xfc, 0x0c, 0xaf, 0xdb, 0x4e, 0x3f, 0x6e, 0xaa, 0xb8, 0x16, 0x2c, 0x61, 0xb3, 0xa6, 0x4a, 0xd8,
-	0x2c, 0x51, 0xb2, 0x4e, 0x98, 0x6a, 0x93, 0x96, 0x72, 0x2a, 0x4b, 0x4d, 0x9b, 0xa4, 0x97, 0x42,
-	0x8b, 0x44, 0x8b, 0xbe, 0xab, 0xfb, 0xea, 0xf0, 0x8d, 0x0d, 0xc3, 0x97, 0x16, 0x4e, 0x3f, 0x23,
-	0x78, 0x9e, 0x0f, 0x35, 0xc6, 0xf0, 0x8c, 0x97, 0x8c, 0xfa, 0x28, 0x44, 0x91, 0x43, 0x4c, 0x8d,
-	0x23, 0xf0, 0xf8, 0x86, 0x55, 0x54, 0x16, 0xe2, 0xa1, 0x50, 0x8f, 0xa5, 0x6c, 0x94, 0x7f, 0x1e,
-	0xa2, 0xe8, 0x05, 0x19, 0x1f, 0xf8, 0xdd, 0xc3, 0xca, 0x50, 0x9c, 0xc2, 0x55, 0x2d, 0xb8, 0xda,
-	0x30, 0x2a, 0x0b, 0x45, 0xe5, 0xb6, 0xab, 0xa9, 0xf2, 0x47, 0xe1, 0x28, 0x72, 0x6f, 0xfc, 0xd8,
-	0x0e, 0x8b, 0xe7, 0x36, 0xb1, 0x3a, 0x04, 0x88, 0x57, 0x9f, 0x02, 0x35, 0xfd, 0x8a, 0x60, 0xf2,
-	0x4f, 0x0a, 0xbf, 0x04, 0xb0, 0x1d, 0x8b, 0xae, 0x31, 0x7a, 0xee, 0x0d, 0x3e, 0xf6, 0xb4, 0xa9,
-	0xe5, 0x82, 0x38, 0x36, 0xb5, 0x6c, 0xf0, 0x1c, 0x6c, 0xeb, 0x5e, 0x77, 0x82, 0x17, 0x7a, 0xd7,
-	0x53, 0xe3, 0x3d, 0xfe, 0x4f, 0xc6, 0x04, 0xf2, 0x5d, 0x4f, 0xc9, 0xa4, 0x3e, 0x05, 0xf8, 0x1a,
-	0xae, 0x18, 0x55, 0xaa, 0x6c, 0x69, 0xa1, 0xf5, 0xba, 0xe0, 0x25, 0x17, 0xc3, 0x95, 0x50, 0x34,
-	0x22, 0x13, 0xfb, 0x23, 0xd7, 0xeb, 0x6c, 0xc0, 0xd3, 0x7b, 0x70, 0x8e, 0x22, 0x4f, 0x6e, 0x32,
-	0x04, 0x97, 0xf2, 0x6d, 0x27, 0x05, 0x67, 0x94, 0x6b, 0x23, 0xe3, 0x90, 0xbf, 0xd1, 0x70, 0xea,
-	0x93, 0xe0, 0xd4, 0x4c, 0x70, 0x88, 0xa9, 0xaf, 0x5f, 0xfd, 0xde, 0xc6, 0x1f, 0x2b, 0x17, 0x2e,
-	0xef, 0xb3, 0x77, 0xd9, 0xdd, 0xc7, 0xcc, 0x3b, 0xc3, 0x00, 0x17, 0xab, 0xb7, 0xb7, 0x24, 0x5d,
-	0x78, 0x08, 0x8f, 0x01, 0x48, 0xfa, 0xe1, 0xfd, 0x72, 0x7e, 0x9b, 0xa7, 0x0b, 0xef, 0xfc, 0x8d,
-	0xf7, 0x6d, 0x1f, 0xa0, 0xef, 0xfb, 0x00, 0xfd, 0xd8, 0x07, 0xe8, 0xcb, 0xcf, 0xe0, 0xac, 0xba,
-	0x30, 0x6f, 0x3f, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xd7, 0xf0, 0x71, 0x3d, 0x02, 0x00,
-	0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6a, 0xdb, 0x4e,
+	0x14, 0xc5, 0x33, 0x71, 0x3e, 0xd0, 0x35, 0x7f, 0x5b, 0x9e, 0x3f, 0x05, 0xad, 0x8c, 0xc8, 0x4a,
+	0x64, 0x21, 0xd1, 0x78, 0x51, 0xe8, 0xa2, 0x90, 0xda, 0x86, 0x9a, 0x16, 0xa5, 0x8c, 0x1d, 0xba,
+	0x1c, 0xf4, 0x71, 0xa3, 0x08, 0x32, 0x33, 0x62, 0x66, 0x1c, 0x48, 0x9f, 0xa2, 0x2f, 0xd3, 0x77,
+	0xe8, 0xb2, 0x8f, 0x50, 0xdc, 0x67, 0xe8, 0xbe, 0x78, 0x3c, 0x4d, 0xeb, 0xa6, 0x2b, 0x5d, 0xce,
+	0x39, 0xe2, 0xfc, 0xee, 0x95, 0xe0, 0x55, 0xd3, 0xda, 0xdb, 0x75, 0x99, 0x56, 0x4a, 0x64, 0x62,
+	0x52, 0x97, 0x99, 0x98, 0x64, 0x46, 0x57, 0x99, 0x30, 0x4d, 0xd6, 0xa0, 0x44, 0x5d, 0x58, 0xac,
+	0xb3, 0x4e, 0x2b, 0xab, 0x32, 0xab, 0xba, 0xb6, 0xea, 0xca, 0xdd, 0x33, 0x75, 0x1a, 0x3d, 0xf5,
+	0xe2, 0xd9, 0x0f, 0x02, 0xc7, 0xab, 0xed, 0x4c, 0x29, 0x1c, 0xc9, 0x42, 0x60, 0x44, 0x62, 0x92,
+	0x04, 0xcc, 0xcd, 0x34, 0x81, 0x50, 0xae, 0x45, 0x89, 0x9a, 0xab, 0x1b, 0x6e, 0x6e, 0x0b, 0x5d,
+	0x9b, 0xe8, 0x30, 0x26, 0xc9, 0x7f, 0x6c, 0xb0, 0xd3, 0xaf, 0x6e, 0x96, 0x4e, 0xa5, 0x73, 0x18,
+	0x55, 0x4a, 0x9a, 0xb5, 0x40, 0xcd, 0x0d, 0xea, 0xfb, 0xb6, 0x42, 0x13, 0xf5, 0xe2, 0x5e, 0xd2,
+	0xbf, 0x88, 0x52, 0x5f, 0x96, 0x4e, 0x7d, 0x62, 0xb9, 0x0b, 0xb0, 0xb0, 0xda, 0x17, 0x0c, 0x7d,
+	0x01, 0x51, 0x87, 0xb2, 0x6e, 0x65, 0xc3, 0x9f, 0x14, 0x1f, 0xb9, 0xe2, 0x67, 0xde, 0xcf, 0xf7,
+	0xfb, 0x53, 0xf8, 0x5f, 0xa3, 0x0b, 0x72, 0x63, 0x0b, 0x6d, 0xb9, 0x2c, 0xa4, 0x32, 0xd1, 0x71,
+	0x4c, 0x92, 0x1e, 0x1b, 0x79, 0x6b, 0xb9, 0x75, 0xf2, 0xad, 0x71, 0xf6, 0x99, 0xc0, 0xf0, 0x2f,
+	0x1c, 0xfa, 0x1c, 0xc0, 0xa3, 0xf3, 0xb6, 0x76, 0x77, 0xe8, 0x5f, 0xd0, 0x47, 0x78, 0x9f, 0x5a,
+	0xcc, 0x58, 0xe0, 0x53, 0x8b, 0x9a, 0x4e, 0xc1, 0xef, 0xd0, 0xd9, 0x56, 0x49, 0x6e, 0x1f, 0x3a,
+	0x74, 0x07, 0x1a, 0x3c, 0xd9, 0xda, 0x05, 0x56, 0x0f, 0x1d, 0xb2, 0x61, 0xb5, 0x2f, 0xd0, 0x73,
+	0x18, 0x09, 0x34, 0xa6, 0x68, 0x90, 0x5b, 0x7b, 0xe7, 0xc9, 0x7b, 0x8e, 0x7c, 0xe8, 0x8d, 0x95,
+	0xbd, 0xdb, 0x71, 0x5f, 0x43, 0xf0, 0x08, 0xf2, 0xcf, 0x4f, 0x16, 0x43, 0x1f, 0xe5, 0x7d, 0xab,
+	0x95, 0x14, 0x28, 0xad, 0x83, 0x09, 0xd8, 0x9f, 0xd2, 0xf6, 0xad, 0x8f, 0x4a, 0xa2, 0x6b, 0x08,
+	0x98, 0x9b, 0xcf, 0x5f, 0xfe, 0xba, 0xc6, 0x6f, 0xaa, 0x3e, 0x9c, 0x5e, 0xe7, 0x6f, 0xf3, 0xab,
+	0x0f, 0x79, 0x78, 0x40, 0x01, 0x4e, 0x96, 0x6f, 0x2e, 0xd9, 0x7c, 0x16, 0x12, 0x3a, 0x00, 0x60,
+	0xf3, 0xf7, 0xef, 0x16, 0xd3, 0xcb, 0xd5, 0x7c, 0x16, 0x1e, 0xbe, 0x0e, 0xbf, 0x6c, 0xc6, 0xe4,
+	0xeb, 0x66, 0x4c, 0xbe, 0x6d, 0xc6, 0xe4, 0xd3, 0xf7, 0xf1, 0x41, 0x79, 0xe2, 0x7e, 0xb2, 0xc9,
+	0xcf, 0x01, 0x00, 0x97, 0x65, 0x98, 0x0b, 0xa6, 0x02, 0x00, 0x00,
 }
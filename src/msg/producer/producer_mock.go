@@ -172,6 +172,20 @@ func (mr *MockProducerMockRecorder) NumShards() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumShards", reflect.TypeOf((*MockProducer)(nil).NumShards))
 }
 
+// PendingNumShards mocks base method
+func (m *MockProducer) PendingNumShards() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingNumShards")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// PendingNumShards indicates an expected call of PendingNumShards
+func (mr *MockProducerMockRecorder) PendingNumShards() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingNumShards", reflect.TypeOf((*MockProducer)(nil).PendingNumShards))
+}
+
 // Produce mocks base method
 func (m *MockProducer) Produce(arg0 Message) error {
 	m.ctrl.T.Helper()
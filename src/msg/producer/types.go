@@ -51,6 +51,19 @@ type Message interface {
 	Finalize(FinalizeReason)
 }
 
+// ReshardableMessage is a Message which can be assigned a shard for a different
+// number of shards. While a topic is being resharded, producers write messages
+// to their shard for both the current and the pending number of shards, only
+// messages implementing ReshardableMessage are written to the pending shards.
+// Once resharding completes, producers write ReshardableMessages created before
+// the cutover to their shard for the new number of shards.
+type ReshardableMessage interface {
+	Message
+
+	// ShardFor returns the shard of the message for the given number of shards.
+	ShardFor(numShards uint32) uint32
+}
+
 // CloseType decides how the producer should be closed.
 type CloseType int
 
@@ -76,6 +89,10 @@ type Producer interface {
 	// producing to.
 	NumShards() uint32
 
+	// PendingNumShards returns the number of shards the topic the producer is
+	// producing to is being resharded to, zero if it is not being resharded.
+	PendingNumShards() uint32
+
 	// Init initializes a producer.
 	Init() error
 
@@ -132,6 +149,10 @@ type Writer interface {
 	// writing to.
 	NumShards() uint32
 
+	// PendingNumShards returns the number of shards the topic the writer is
+	// writing to is being resharded to, zero if it is not being resharded.
+	PendingNumShards() uint32
+
 	// Init initializes a writer.
 	Init() error
 
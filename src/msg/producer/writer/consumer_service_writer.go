@@ -63,6 +63,11 @@ type consumerServiceWriter interface {
 	// Write writes a message.
 	Write(rm *producer.RefCountedMessage)
 
+	// WriteShard writes a message to the given shard rather than the shard
+	// of the message, it is used to dual write messages while the topic is
+	// being resharded.
+	WriteShard(shard uint32, rm *producer.RefCountedMessage)
+
 	// Init will initialize the consumer service writer.
 	Init(initType) error
 
@@ -181,8 +186,12 @@ func initShardWriters(
 }
 
 func (w *consumerServiceWriterImpl) Write(rm *producer.RefCountedMessage) {
+	w.WriteShard(rm.Shard(), rm)
+}
+
+func (w *consumerServiceWriterImpl) WriteShard(shard uint32, rm *producer.RefCountedMessage) {
 	if rm.Accept(w.dataFilter) {
-		w.shardWriters[rm.Shard()].Write(rm)
+		w.shardWriters[shard].Write(rm)
 		w.m.filterAccepted.Inc(1)
 		return
 	}
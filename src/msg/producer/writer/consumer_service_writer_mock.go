@@ -67,6 +67,18 @@ func (mr *MockconsumerServiceWriterMockRecorder) Write(rm interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockconsumerServiceWriter)(nil).Write), rm)
 }
 
+// WriteShard mocks base method
+func (m *MockconsumerServiceWriter) WriteShard(shard uint32, rm *producer.RefCountedMessage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WriteShard", shard, rm)
+}
+
+// WriteShard indicates an expected call of WriteShard
+func (mr *MockconsumerServiceWriterMockRecorder) WriteShard(shard, rm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteShard", reflect.TypeOf((*MockconsumerServiceWriter)(nil).WriteShard), shard, rm)
+}
+
 // Init mocks base method
 func (m *MockconsumerServiceWriter) Init(arg0 initType) error {
 	m.ctrl.T.Helper()
@@ -67,29 +67,40 @@ type writer struct {
 	opts   Options
 	logger *zap.Logger
 
-	value                  watch.Value
-	initType               initType
-	numShards              uint32
-	consumerServiceWriters map[string]consumerServiceWriter
-	filterRegistry         map[string]producer.FilterFunc
-	isClosed               bool
-	m                      writerMetrics
+	value                       watch.Value
+	initType                    initType
+	numShards                   uint32
+	pendingNumShards            uint32
+	consumerServiceWriters      map[string]consumerServiceWriter
+	consumerServiceWriterStates map[string]consumerServiceWriterState
+	filterRegistry              map[string]producer.FilterFunc
+	isClosed                    bool
+	m                           writerMetrics
 
 	processFn watch.ProcessFn
 }
 
+// consumerServiceWriterState is the state a consumer service writer was
+// created with, the consumer service writer needs to be recreated when it
+// changes.
+type consumerServiceWriterState struct {
+	consumptionType topic.ConsumptionType
+	numShards       uint32
+}
+
 // NewWriter creates a new writer.
 func NewWriter(opts Options) producer.Writer {
 	w := &writer{
-		topic:                  opts.TopicName(),
-		ts:                     opts.TopicService(),
-		opts:                   opts,
-		logger:                 opts.InstrumentOptions().Logger(),
-		initType:               failOnError,
-		consumerServiceWriters: make(map[string]consumerServiceWriter),
-		filterRegistry:         make(map[string]producer.FilterFunc),
-		isClosed:               false,
-		m:                      newWriterMetrics(opts.InstrumentOptions().MetricsScope()),
+		topic:                       opts.TopicName(),
+		ts:                          opts.TopicService(),
+		opts:                        opts,
+		logger:                      opts.InstrumentOptions().Logger(),
+		initType:                    failOnError,
+		consumerServiceWriters:      make(map[string]consumerServiceWriter),
+		consumerServiceWriterStates: make(map[string]consumerServiceWriterState),
+		filterRegistry:              make(map[string]producer.FilterFunc),
+		isClosed:                    false,
+		m:                           newWriterMetrics(opts.InstrumentOptions().MetricsScope()),
 	}
 	w.processFn = w.process
 	return w
@@ -103,18 +114,39 @@ func (w *writer) Write(rm *producer.RefCountedMessage) error {
 		return errWriterClosed
 	}
 	shard := rm.Shard()
-	if shard >= w.numShards {
+	msg, reshardable := rm.Message.(producer.ReshardableMessage)
+	if reshardable {
+		// NB: The shard of the message may have been computed for the number
+		// of shards before resharding completed, always write the message to
+		// its shard for the current number of shards.
+		shard = msg.ShardFor(w.numShards)
+	}
+	if maxShards := maxNumShards(w.numShards, w.pendingNumShards); shard >= maxShards {
 		w.m.invalidShard.Inc(1)
 		rm.Drop()
 		w.RUnlock()
-		return fmt.Errorf("could not write message for shard %d which is larger than max shard id %d", shard, w.numShards-1)
+		return fmt.Errorf("could not write message for shard %d which is larger than max shard id %d", shard, maxShards-1)
 	}
 	// NB(cw): Need to inc ref here in case a consumer service
 	// writes the message too fast and close the message.
 	rm.IncRef()
 	for _, csw := range w.consumerServiceWriters {
+		if reshardable {
+			csw.WriteShard(shard, rm)
+			continue
+		}
 		csw.Write(rm)
 	}
+	// While the topic is being resharded, also write the message to its shard
+	// for the pending number of shards, the consumers are expected to only
+	// accept messages for the shards they own.
+	if reshardable && w.pendingNumShards > 0 {
+		if pendingShard := msg.ShardFor(w.pendingNumShards); pendingShard != shard {
+			for _, csw := range w.consumerServiceWriters {
+				csw.WriteShard(pendingShard, rm)
+			}
+		}
+	}
 	rm.DecRef()
 	w.RUnlock()
 	return nil
@@ -152,59 +184,69 @@ func (w *writer) NumShards() uint32 {
 	return n
 }
 
+func (w *writer) PendingNumShards() uint32 {
+	w.RLock()
+	n := w.pendingNumShards
+	w.RUnlock()
+	return n
+}
+
 func (w *writer) process(update interface{}) error {
 	t := update.(topic.Topic)
 	if err := t.Validate(); err != nil {
 		return err
 	}
-	// We don't allow changing number of shards for topics, it will be
-	// prevented on topic service side, but also being defensive here as well.
-	numShards := w.NumShards()
-	if numShards != 0 && numShards != t.NumberOfShards() {
+	// The number of shards of a topic can only be changed by resharding it,
+	// i.e. by cutting over to the pending number of shards.
+	w.RLock()
+	numShards, pendingNumShards := w.numShards, w.pendingNumShards
+	w.RUnlock()
+	if numShards != 0 && numShards != t.NumberOfShards() &&
+		(pendingNumShards == 0 || pendingNumShards != t.NumberOfShards()) {
 		w.m.topicUpdateError.Inc(1)
 		return fmt.Errorf("invalid topic update with %d shards, expecting %d", t.NumberOfShards(), numShards)
 	}
 	var (
-		iOpts                     = w.opts.InstrumentOptions()
-		newConsumerServiceWriters = make(map[string]consumerServiceWriter, len(t.ConsumerServices()))
-		toBeClosed                []consumerServiceWriter
-		multiErr                  xerrors.MultiError
+		writerNumShards                = maxNumShards(t.NumberOfShards(), t.PendingNumberOfShards())
+		newConsumerServiceWriters      = make(map[string]consumerServiceWriter, len(t.ConsumerServices()))
+		newConsumerServiceWriterStates = make(map[string]consumerServiceWriterState, len(t.ConsumerServices()))
+		toBeClosed                     []consumerServiceWriter
+		multiErr                       xerrors.MultiError
 	)
 	for _, cs := range t.ConsumerServices() {
-		key := cs.ServiceID().String()
+		var (
+			key   = cs.ServiceID().String()
+			state = consumerServiceWriterState{
+				consumptionType: cs.ConsumptionType(),
+				numShards:       writerNumShards,
+			}
+		)
 		csw, ok := w.consumerServiceWriters[key]
-		if ok {
+		if ok && !w.consumerServiceWriterStateChanged(key, state) {
 			csw.SetMessageTTLNanos(cs.MessageTTLNanos())
 			newConsumerServiceWriters[key] = csw
+			newConsumerServiceWriterStates[key] = state
 			continue
 		}
-		scope := iOpts.MetricsScope().Tagged(map[string]string{
-			"consumer-service-name": cs.ServiceID().Name(),
-			"consumer-service-zone": cs.ServiceID().Zone(),
-			"consumer-service-env":  cs.ServiceID().Environment(),
-			"consumption-type":      cs.ConsumptionType().String(),
-		})
-		csw, err := newConsumerServiceWriter(cs, t.NumberOfShards(), w.opts.SetInstrumentOptions(iOpts.SetMetricsScope(scope)))
+		// The consumption type or the number of shards of the consumer service
+		// changed, the existing consumer service writer is replaced and closed
+		// once the messages buffered for it are consumed.
+		csw, err := w.newConsumerServiceWriter(cs, writerNumShards)
 		if err != nil {
-			w.logger.Error("could not create consumer service writer",
-				zap.String("writer", cs.String()), zap.Error(err))
 			multiErr = multiErr.Add(err)
+			if existing, ok := w.consumerServiceWriters[key]; ok {
+				// Keep writing with the existing consumer service writer.
+				existing.SetMessageTTLNanos(cs.MessageTTLNanos())
+				newConsumerServiceWriters[key] = existing
+				newConsumerServiceWriterStates[key] = w.consumerServiceWriterStates[key]
+			}
 			continue
 		}
-		if err = csw.Init(w.initType); err != nil {
-			w.logger.Error("could not init consumer service writer",
-				zap.String("writer", cs.String()), zap.Error(err))
-			multiErr = multiErr.Add(err)
-			// Could not initialize the consumer service, simply close it.
-			csw.Close()
-			continue
-		}
-		csw.SetMessageTTLNanos(cs.MessageTTLNanos())
 		newConsumerServiceWriters[key] = csw
-		w.logger.Info("initialized consumer service writer", zap.String("writer", cs.String()))
+		newConsumerServiceWriterStates[key] = state
 	}
 	for key, csw := range w.consumerServiceWriters {
-		if _, ok := newConsumerServiceWriters[key]; !ok {
+		if newCsw, ok := newConsumerServiceWriters[key]; !ok || newCsw != csw {
 			toBeClosed = append(toBeClosed, csw)
 		}
 	}
@@ -225,10 +267,13 @@ func (w *writer) process(update interface{}) error {
 		}
 	}
 	w.consumerServiceWriters = newConsumerServiceWriters
+	w.consumerServiceWriterStates = newConsumerServiceWriterStates
 	w.numShards = t.NumberOfShards()
+	w.pendingNumShards = t.PendingNumberOfShards()
 	w.Unlock()
 
-	// Close removed consumer service.
+	// Close removed and replaced consumer service writers, closing blocks
+	// until the messages buffered for them are consumed.
 	go func() {
 		for _, csw := range toBeClosed {
 			csw.Close()
@@ -243,6 +288,44 @@ func (w *writer) process(update interface{}) error {
 	return nil
 }
 
+func (w *writer) consumerServiceWriterStateChanged(
+	key string,
+	state consumerServiceWriterState,
+) bool {
+	prevState, ok := w.consumerServiceWriterStates[key]
+	return ok && prevState != state
+}
+
+func (w *writer) newConsumerServiceWriter(
+	cs topic.ConsumerService,
+	numShards uint32,
+) (consumerServiceWriter, error) {
+	iOpts := w.opts.InstrumentOptions()
+	scope := iOpts.MetricsScope().Tagged(map[string]string{
+		"consumer-service-name": cs.ServiceID().Name(),
+		"consumer-service-zone": cs.ServiceID().Zone(),
+		"consumer-service-env":  cs.ServiceID().Environment(),
+		"consumption-type":      cs.ConsumptionType().String(),
+	})
+	csw, err := newConsumerServiceWriter(cs, numShards, w.opts.SetInstrumentOptions(iOpts.SetMetricsScope(scope)))
+	if err != nil {
+		w.logger.Error("could not create consumer service writer",
+			zap.String("writer", cs.String()), zap.Error(err))
+		return nil, err
+	}
+	if err = csw.Init(w.initType); err != nil {
+		w.logger.Error("could not init consumer service writer",
+			zap.String("writer", cs.String()), zap.Error(err))
+		// Could not initialize the consumer service, simply close it.
+		csw.Close()
+		return nil, err
+	}
+	csw.SetMessageTTLNanos(cs.MessageTTLNanos())
+	w.logger.Info("initialized consumer service writer",
+		zap.String("writer", cs.String()), zap.Uint32("numShards", numShards))
+	return csw, nil
+}
+
 func (w *writer) Close() {
 	w.Lock()
 	if w.isClosed {
@@ -281,3 +364,10 @@ func (w *writer) UnregisterFilter(sid services.ServiceID) {
 		csw.UnregisterFilter()
 	}
 }
+
+func maxNumShards(numShards, pendingNumShards uint32) uint32 {
+	if pendingNumShards > numShards {
+		return pendingNumShards
+	}
+	return numShards
+}
@@ -838,3 +838,203 @@ func TestWriterNumShards(t *testing.T) {
 	require.NoError(t, w.Init())
 	require.Equal(t, 2, int(w.NumShards()))
 }
+
+func TestWriterWriteDuringReshard(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := testOptions()
+	w := NewWriter(opts).(*writer)
+	w.numShards = 2
+	w.pendingNumShards = 4
+
+	csw1 := NewMockconsumerServiceWriter(ctrl)
+	w.consumerServiceWriters["s1"] = csw1
+
+	// The message is written to both its current and its pending shard.
+	mm := producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(3)
+	mm.EXPECT().Shard().Return(uint32(1)).AnyTimes()
+	mm.EXPECT().Finalize(producer.Consumed)
+	rm := producer.NewRefCountedMessage(newTestReshardableMessage(mm, map[uint32]uint32{2: 1, 4: 3}), nil)
+	csw1.EXPECT().WriteShard(uint32(1), rm)
+	csw1.EXPECT().WriteShard(uint32(3), rm)
+	require.NoError(t, w.Write(rm))
+
+	// The message is written once when the shards are the same.
+	mm = producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(3)
+	mm.EXPECT().Shard().Return(uint32(1)).AnyTimes()
+	mm.EXPECT().Finalize(producer.Consumed)
+	rm = producer.NewRefCountedMessage(newTestReshardableMessage(mm, map[uint32]uint32{2: 1, 4: 1}), nil)
+	csw1.EXPECT().WriteShard(uint32(1), rm)
+	require.NoError(t, w.Write(rm))
+
+	// Messages that can not be resharded are only written to their current shard.
+	mm = producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(3)
+	mm.EXPECT().Shard().Return(uint32(3)).AnyTimes()
+	mm.EXPECT().Finalize(producer.Consumed)
+	rm = producer.NewRefCountedMessage(mm, nil)
+	csw1.EXPECT().Write(rm)
+	require.NoError(t, w.Write(rm))
+
+	mm = producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(3)
+	mm.EXPECT().Shard().Return(uint32(4)).AnyTimes()
+	mm.EXPECT().Finalize(producer.Dropped)
+	rm = producer.NewRefCountedMessage(mm, nil)
+	require.Error(t, w.Write(rm))
+}
+
+func TestWriterWriteAfterReshardCompleted(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := testOptions()
+	w := NewWriter(opts).(*writer)
+	w.numShards = 2
+
+	csw1 := NewMockconsumerServiceWriter(ctrl)
+	w.consumerServiceWriters["s1"] = csw1
+
+	// A message sharded for the number of shards before the topic was
+	// resharded down is written to its shard for the new number of shards
+	// instead of being dropped.
+	mm := producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(3)
+	mm.EXPECT().Shard().Return(uint32(3)).AnyTimes()
+	mm.EXPECT().Finalize(producer.Consumed)
+	rm := producer.NewRefCountedMessage(newTestReshardableMessage(mm, map[uint32]uint32{4: 3, 2: 1}), nil)
+	csw1.EXPECT().WriteShard(uint32(1), rm)
+	require.NoError(t, w.Write(rm))
+}
+
+func TestWriterReshardTopicUpdate(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mem.NewStore()
+	cs := client.NewMockClient(ctrl)
+	cs.EXPECT().Store(gomock.Any()).Return(store, nil)
+
+	ts, err := topic.NewService(topic.NewServiceOptions().SetConfigService(cs))
+	require.NoError(t, err)
+
+	opts := testOptions().SetTopicService(ts)
+	sid1 := services.NewServiceID().SetName("s1")
+	cs1 := topic.NewConsumerService().SetConsumptionType(topic.Replicated).SetServiceID(sid1)
+	testTopic := topic.NewTopic().
+		SetName(opts.TopicName()).
+		SetNumberOfShards(2).
+		SetConsumerServices([]topic.ConsumerService{cs1})
+	_, err = ts.CheckAndSet(testTopic, kv.UninitializedVersion)
+	require.NoError(t, err)
+
+	sd := services.NewMockServices(ctrl)
+	opts = opts.SetServiceDiscovery(sd)
+	ps1 := testPlacementService(store, sid1)
+	sd.EXPECT().PlacementService(sid1, gomock.Any()).Return(ps1, nil).AnyTimes()
+
+	p1 := placement.NewPlacement().
+		SetInstances([]placement.Instance{
+			placement.NewInstance().
+				SetID("i1").
+				SetEndpoint("addr1").
+				SetShards(shard.NewShards([]shard.Shard{
+					shard.NewShard(0).SetState(shard.Available),
+					shard.NewShard(1).SetState(shard.Available),
+				})),
+		}).
+		SetShards([]uint32{0, 1}).
+		SetReplicaFactor(1).
+		SetIsSharded(true)
+	_, err = ps1.Set(p1)
+	require.NoError(t, err)
+
+	w := NewWriter(opts).(*writer)
+	var wg sync.WaitGroup
+	w.processFn = func(i interface{}) error {
+		defer wg.Done()
+		return w.process(i)
+	}
+	wg.Add(1)
+	require.NoError(t, w.Init())
+	wg.Wait()
+	defer w.Close()
+
+	currentCsw := func() consumerServiceWriter {
+		w.RLock()
+		defer w.RUnlock()
+		return w.consumerServiceWriters[sid1.String()]
+	}
+	csw := currentCsw()
+	require.NotNil(t, csw)
+
+	// Starting a reshard recreates the consumer service writer with the
+	// pending number of shards.
+	startNanos := time.Now().UnixNano()
+	testTopic, err = testTopic.StartReshard(4, startNanos)
+	require.NoError(t, err)
+	wg.Add(1)
+	_, err = ts.CheckAndSet(testTopic.SetVersion(1), 1)
+	require.NoError(t, err)
+	wg.Wait()
+	require.Equal(t, 2, int(w.NumShards()))
+	require.Equal(t, 4, int(w.pendingNumShards))
+	require.NotEqual(t, csw, currentCsw())
+	csw = currentCsw()
+
+	// Changing the number of shards to anything but the pending number of
+	// shards is rejected.
+	wg.Add(1)
+	_, err = ts.CheckAndSet(testTopic.SetNumberOfShards(3).SetVersion(2), 2)
+	require.NoError(t, err)
+	wg.Wait()
+	require.Equal(t, 2, int(w.NumShards()))
+	require.Equal(t, 4, int(w.pendingNumShards))
+
+	// Completing the reshard cuts over to the pending number of shards, the
+	// consumer service writer already has enough shards.
+	testTopic, err = testTopic.CompleteReshard(startNanos+int64(time.Minute), int64(time.Minute))
+	require.NoError(t, err)
+	wg.Add(1)
+	_, err = ts.CheckAndSet(testTopic.SetVersion(3), 3)
+	require.NoError(t, err)
+	wg.Wait()
+	require.Equal(t, 4, int(w.NumShards()))
+	require.Equal(t, 0, int(w.pendingNumShards))
+	require.Equal(t, csw, currentCsw())
+
+	// Changing the consumption type recreates the consumer service writer.
+	cs1 = cs1.SetConsumptionType(topic.Shared)
+	wg.Add(1)
+	_, err = ts.CheckAndSet(testTopic.SetConsumerServices([]topic.ConsumerService{cs1}).SetVersion(4), 4)
+	require.NoError(t, err)
+	wg.Wait()
+	require.NotEqual(t, csw, currentCsw())
+	require.Equal(t, topic.Shared, w.consumerServiceWriterStates[sid1.String()].consumptionType)
+}
+
+type testReshardableMessage struct {
+	producer.Message
+
+	shards map[uint32]uint32
+}
+
+func newTestReshardableMessage(
+	m producer.Message,
+	shards map[uint32]uint32,
+) *testReshardableMessage {
+	return &testReshardableMessage{Message: m, shards: shards}
+}
+
+func (m *testReshardableMessage) ShardFor(numShards uint32) uint32 {
+	return m.shards[numShards]
+}
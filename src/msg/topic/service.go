@@ -87,6 +87,22 @@ func (s *service) Watch(name string) (Watch, error) {
 	return NewWatch(w), nil
 }
 
+func (s *service) History(name string, from, to int) ([]Topic, error) {
+	values, err := s.store.History(key(name), from, to)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]Topic, 0, len(values))
+	for _, value := range values {
+		t, err := NewTopicFromValue(value)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, t)
+	}
+	return topics, nil
+}
+
 func key(name string) string {
 	return name
 }
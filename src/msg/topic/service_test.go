@@ -113,3 +113,38 @@ func TestTopicService(t *testing.T) {
 
 	w.Close()
 }
+
+func TestTopicServiceHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kvOpts := kv.NewOverrideOptions().SetNamespace("foo")
+	cs := client.NewMockClient(ctrl)
+	cs.EXPECT().Store(kvOpts).Return(mem.NewStore(), nil)
+
+	s, err := NewService(NewServiceOptions().SetConfigService(cs).SetKVOverrideOptions(kvOpts))
+	require.NoError(t, err)
+
+	topicName := "topic1"
+	_, err = s.History(topicName, 1, 2)
+	require.Error(t, err)
+
+	topic1 := NewTopic().
+		SetName(topicName).
+		SetNumberOfShards(100).
+		SetConsumerServices([]ConsumerService{
+			NewConsumerService().SetConsumptionType(Shared).SetServiceID(services.NewServiceID().SetName("s1")),
+		})
+	topic1, err = s.CheckAndSet(topic1, kv.UninitializedVersion)
+	require.NoError(t, err)
+	_, err = s.CheckAndSet(topic1.SetNumberOfShards(200), 1)
+	require.NoError(t, err)
+
+	topics, err := s.History(topicName, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(topics))
+	require.Equal(t, 1, topics[0].Version())
+	require.Equal(t, uint32(100), topics[0].NumberOfShards())
+	require.Equal(t, 2, topics[1].Version())
+	require.Equal(t, uint32(200), topics[1].NumberOfShards())
+}
@@ -32,15 +32,20 @@ import (
 )
 
 var (
-	errEmptyName  = errors.New("invalid topic: empty name")
-	errZeroShards = errors.New("invalid topic: zero shards")
+	errEmptyName           = errors.New("invalid topic: empty name")
+	errZeroShards          = errors.New("invalid topic: zero shards")
+	errSamePendingShards   = errors.New("invalid topic: pending number of shards equals number of shards")
+	errReshardInProgress   = errors.New("topic is already being resharded")
+	errNoReshardInProgress = errors.New("topic is not being resharded")
 )
 
 type topic struct {
-	name             string
-	numOfShards      uint32
-	consumerServices []ConsumerService
-	version          int
+	name              string
+	numOfShards       uint32
+	pendingNumShards  uint32
+	reshardStartNanos int64
+	consumerServices  []ConsumerService
+	version           int
 }
 
 // NewTopic creates a new topic.
@@ -72,6 +77,8 @@ func NewTopicFromProto(t *topicpb.Topic) (Topic, error) {
 	return NewTopic().
 		SetName(t.Name).
 		SetNumberOfShards(t.NumberOfShards).
+		SetPendingNumberOfShards(t.PendingNumberOfShards).
+		SetReshardStartNanos(t.ReshardStartNanos).
 		SetConsumerServices(css), nil
 }
 
@@ -95,6 +102,26 @@ func (t *topic) SetNumberOfShards(value uint32) Topic {
 	return &newt
 }
 
+func (t *topic) PendingNumberOfShards() uint32 {
+	return t.pendingNumShards
+}
+
+func (t *topic) SetPendingNumberOfShards(value uint32) Topic {
+	newt := *t
+	newt.pendingNumShards = value
+	return &newt
+}
+
+func (t *topic) ReshardStartNanos() int64 {
+	return t.reshardStartNanos
+}
+
+func (t *topic) SetReshardStartNanos(value int64) Topic {
+	newt := *t
+	newt.reshardStartNanos = value
+	return &newt
+}
+
 func (t *topic) ConsumerServices() []ConsumerService {
 	return t.consumerServices
 }
@@ -144,21 +171,78 @@ func (t *topic) UpdateConsumerService(value ConsumerService) (Topic, error) {
 		if !cs.ServiceID().Equal(value.ServiceID()) {
 			continue
 		}
-		if value.ConsumptionType() != cs.ConsumptionType() {
-			return nil, fmt.Errorf("could not change consumption type for consumer service %s", value.ServiceID().String())
+		if value.ConsumptionType() == Unknown {
+			return nil, fmt.Errorf("could not change consumption type for consumer service %s to %s", value.ServiceID().String(), Unknown)
 		}
-		css[i] = value
-		return t.SetConsumerServices(css), nil
+		// NB: Producers recreate the writers of a consumer service when its
+		// consumption type changes, after the messages buffered for it drain.
+		newCss := make([]ConsumerService, len(css))
+		copy(newCss, css)
+		newCss[i] = value
+		return t.SetConsumerServices(newCss), nil
 	}
 	return nil, fmt.Errorf("could not find consumer service %s in the topic", value.String())
 }
 
+func (t *topic) StartReshard(numberOfShards uint32, nowNanos int64) (Topic, error) {
+	if t.pendingNumShards != 0 {
+		return nil, errReshardInProgress
+	}
+	if numberOfShards == 0 {
+		return nil, errZeroShards
+	}
+	if numberOfShards == t.numOfShards {
+		return nil, fmt.Errorf("topic already has %d shards", numberOfShards)
+	}
+	return t.
+		SetPendingNumberOfShards(numberOfShards).
+		SetReshardStartNanos(nowNanos), nil
+}
+
+func (t *topic) CompleteReshard(nowNanos int64, minDrainNanos int64) (Topic, error) {
+	if t.pendingNumShards == 0 {
+		return nil, errNoReshardInProgress
+	}
+	drainNanos := minDrainNanos
+	for _, cs := range t.consumerServices {
+		ttlNanos := cs.MessageTTLNanos()
+		if ttlNanos == 0 && minDrainNanos == 0 {
+			return nil, fmt.Errorf("could not verify consumer service %s drained the old shards: "+
+				"messages are retried without a ttl, a minimum drain duration is required",
+				cs.ServiceID().String())
+		}
+		if ttlNanos > drainNanos {
+			drainNanos = ttlNanos
+		}
+	}
+	if drainedNanos := t.reshardStartNanos + drainNanos; nowNanos < drainedNanos {
+		return nil, fmt.Errorf("consumers have not drained the old shards, resharding can be completed in %v",
+			time.Duration(drainedNanos-nowNanos))
+	}
+	return t.
+		SetNumberOfShards(t.pendingNumShards).
+		SetPendingNumberOfShards(0).
+		SetReshardStartNanos(0), nil
+}
+
+func (t *topic) AbortReshard() (Topic, error) {
+	if t.pendingNumShards == 0 {
+		return nil, errNoReshardInProgress
+	}
+	return t.
+		SetPendingNumberOfShards(0).
+		SetReshardStartNanos(0), nil
+}
+
 func (t *topic) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("\n{\n")
 	buf.WriteString(fmt.Sprintf("\tversion: %d\n", t.version))
 	buf.WriteString(fmt.Sprintf("\tname: %s\n", t.name))
 	buf.WriteString(fmt.Sprintf("\tnumOfShards: %d\n", t.numOfShards))
+	if t.pendingNumShards != 0 {
+		buf.WriteString(fmt.Sprintf("\tpendingNumOfShards: %d\n", t.pendingNumShards))
+	}
 	if len(t.consumerServices) > 0 {
 		buf.WriteString("\tconsumerServices: {\n")
 	}
@@ -179,6 +263,9 @@ func (t *topic) Validate() error {
 	if t.NumberOfShards() == 0 {
 		return errZeroShards
 	}
+	if t.PendingNumberOfShards() == t.NumberOfShards() {
+		return errSamePendingShards
+	}
 	uniqConsumers := make(map[string]struct{}, len(t.ConsumerServices()))
 	for _, cs := range t.ConsumerServices() {
 		_, ok := uniqConsumers[cs.ServiceID().String()]
@@ -202,9 +289,11 @@ func ToProto(t Topic) (*topicpb.Topic, error) {
 		csspb[i] = cspb
 	}
 	return &topicpb.Topic{
-		Name:             t.Name(),
-		NumberOfShards:   t.NumberOfShards(),
-		ConsumerServices: csspb,
+		Name:                  t.Name(),
+		NumberOfShards:        t.NumberOfShards(),
+		PendingNumberOfShards: t.PendingNumberOfShards(),
+		ReshardStartNanos:     t.ReshardStartNanos(),
+		ConsumerServices:      csspb,
 	}, nil
 }
 
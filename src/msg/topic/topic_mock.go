@@ -97,6 +97,21 @@ func (mr *MockServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), arg0)
 }
 
+// History mocks base method
+func (m *MockService) History(arg0 string, arg1, arg2 int) ([]Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History
+func (mr *MockServiceMockRecorder) History(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockService)(nil).History), arg0, arg1, arg2)
+}
+
 // Watch mocks base method
 func (m *MockService) Watch(arg0 string) (Watch, error) {
 	m.ctrl.T.Helper()
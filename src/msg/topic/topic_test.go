@@ -121,10 +121,15 @@ func TestTopicUpdateConsumer(t *testing.T) {
 			[]ConsumerService{cs1},
 		)
 
-	_, err := tpc.UpdateConsumerService(cs1.SetConsumptionType(Replicated))
+	_, err := tpc.UpdateConsumerService(cs1.SetConsumptionType(Unknown))
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "could not change consumption type")
 
+	replicated, err := tpc.UpdateConsumerService(cs1.SetConsumptionType(Replicated))
+	require.NoError(t, err)
+	require.Equal(t, Replicated, replicated.ConsumerServices()[0].ConsumptionType())
+	require.Equal(t, Shared, tpc.ConsumerServices()[0].ConsumptionType())
+
 	_, err = tpc.UpdateConsumerService(cs1.SetServiceID(services.NewServiceID().SetName("foo")))
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "could not find consumer service")
@@ -144,6 +149,91 @@ func TestTopicUpdateConsumer(t *testing.T) {
 	require.Equal(t, int64(500), cs2.MessageTTLNanos())
 }
 
+func TestTopicReshard(t *testing.T) {
+	cs1 := NewConsumerService().
+		SetConsumptionType(Shared).
+		SetServiceID(services.NewServiceID().SetName("s1")).
+		SetMessageTTLNanos(int64(time.Minute))
+	tpc := NewTopic().
+		SetName("testName").
+		SetNumberOfShards(64).
+		SetConsumerServices([]ConsumerService{cs1})
+
+	startNanos := time.Now().UnixNano()
+	_, err := tpc.CompleteReshard(startNanos, 0)
+	require.Equal(t, errNoReshardInProgress, err)
+	_, err = tpc.AbortReshard()
+	require.Equal(t, errNoReshardInProgress, err)
+	_, err = tpc.StartReshard(0, startNanos)
+	require.Equal(t, errZeroShards, err)
+	_, err = tpc.StartReshard(64, startNanos)
+	require.Error(t, err)
+
+	resharding, err := tpc.StartReshard(128, startNanos)
+	require.NoError(t, err)
+	require.NoError(t, resharding.Validate())
+	require.Equal(t, uint32(64), resharding.NumberOfShards())
+	require.Equal(t, uint32(128), resharding.PendingNumberOfShards())
+	require.Equal(t, startNanos, resharding.ReshardStartNanos())
+	require.Equal(t, uint32(0), tpc.PendingNumberOfShards())
+
+	_, err = resharding.StartReshard(256, startNanos)
+	require.Equal(t, errReshardInProgress, err)
+
+	aborted, err := resharding.AbortReshard()
+	require.NoError(t, err)
+	require.Equal(t, uint32(64), aborted.NumberOfShards())
+	require.Equal(t, uint32(0), aborted.PendingNumberOfShards())
+	require.Equal(t, int64(0), aborted.ReshardStartNanos())
+
+	// The consumers have not drained the old shards before the message ttl
+	// or the minimum drain duration elapsed.
+	_, err = resharding.CompleteReshard(startNanos+int64(time.Second), 0)
+	require.Error(t, err)
+	_, err = resharding.CompleteReshard(startNanos+int64(time.Minute), int64(time.Hour))
+	require.Error(t, err)
+
+	completed, err := resharding.CompleteReshard(startNanos+int64(time.Minute), 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(128), completed.NumberOfShards())
+	require.Equal(t, uint32(0), completed.PendingNumberOfShards())
+	require.Equal(t, int64(0), completed.ReshardStartNanos())
+
+	pb, err := ToProto(resharding)
+	require.NoError(t, err)
+	require.Equal(t, uint32(128), pb.PendingNumberOfShards)
+	require.Equal(t, startNanos, pb.ReshardStartNanos)
+	fromProto, err := NewTopicFromProto(pb)
+	require.NoError(t, err)
+	require.Equal(t, uint32(64), fromProto.NumberOfShards())
+	require.Equal(t, uint32(128), fromProto.PendingNumberOfShards())
+	require.Equal(t, startNanos, fromProto.ReshardStartNanos())
+}
+
+func TestTopicCompleteReshardWithoutMessageTTL(t *testing.T) {
+	cs1 := NewConsumerService().
+		SetConsumptionType(Shared).
+		SetServiceID(services.NewServiceID().SetName("s1"))
+	tpc := NewTopic().
+		SetName("testName").
+		SetNumberOfShards(64).
+		SetConsumerServices([]ConsumerService{cs1})
+
+	startNanos := time.Now().UnixNano()
+	resharding, err := tpc.StartReshard(128, startNanos)
+	require.NoError(t, err)
+
+	// Messages are retried without a ttl, the consumers can only be assumed
+	// to have drained the old shards after the minimum drain duration.
+	_, err = resharding.CompleteReshard(startNanos+int64(time.Hour), 0)
+	require.Error(t, err)
+	_, err = resharding.CompleteReshard(startNanos+int64(time.Second), int64(time.Minute))
+	require.Error(t, err)
+	completed, err := resharding.CompleteReshard(startNanos+int64(time.Minute), int64(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, uint32(128), completed.NumberOfShards())
+}
+
 func TestTopicString(t *testing.T) {
 	cs1 := NewConsumerService().
 		SetConsumptionType(Shared).
@@ -196,6 +286,12 @@ func TestTopicValidation(t *testing.T) {
 	err = topic.Validate()
 	require.NoError(t, err)
 
+	topic = topic.SetPendingNumberOfShards(1024)
+	err = topic.Validate()
+	require.Equal(t, errSamePendingShards, err)
+
+	topic = topic.SetPendingNumberOfShards(0)
+
 	cs1 := NewConsumerService().
 		SetConsumptionType(Shared).
 		SetServiceID(services.NewServiceID().
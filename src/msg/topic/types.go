@@ -40,6 +40,21 @@ type Topic interface {
 	// SetNumberOfShards sets the total number of shards of the topic.
 	SetNumberOfShards(value uint32) Topic
 
+	// PendingNumberOfShards returns the number of shards the topic is being
+	// resharded to, zero if the topic is not being resharded.
+	PendingNumberOfShards() uint32
+
+	// SetPendingNumberOfShards sets the number of shards the topic is being
+	// resharded to.
+	SetPendingNumberOfShards(value uint32) Topic
+
+	// ReshardStartNanos returns the time the topic started being resharded,
+	// zero if the topic is not being resharded.
+	ReshardStartNanos() int64
+
+	// SetReshardStartNanos sets the time the topic started being resharded.
+	SetReshardStartNanos(value int64) Topic
+
 	// ConsumerServices returns the consumers of the topic.
 	ConsumerServices() []ConsumerService
 
@@ -61,6 +76,24 @@ type Topic interface {
 	// UpdateConsumerService updates a consumer in the topic.
 	UpdateConsumerService(value ConsumerService) (Topic, error)
 
+	// StartReshard starts resharding the topic to the given number of shards,
+	// producers write to both the current and the pending shards until the
+	// reshard is completed or aborted.
+	StartReshard(numberOfShards uint32, nowNanos int64) (Topic, error)
+
+	// CompleteReshard cuts the topic over to the pending number of shards once
+	// the consumers have drained the old shards. Producers retry the messages
+	// written only to the old shards until they are acked or their ttl expires,
+	// so the old shards are drained once the longest message ttl of the
+	// consumer services and the minimum drain duration have elapsed since
+	// resharding started. The minimum drain duration is required when a
+	// consumer service retries messages without a ttl.
+	CompleteReshard(nowNanos int64, minDrainNanos int64) (Topic, error)
+
+	// AbortReshard stops resharding the topic, keeping the current number
+	// of shards.
+	AbortReshard() (Topic, error)
+
 	// String returns the string representation of the topic.
 	String() string
 
@@ -117,6 +150,10 @@ type Service interface {
 
 	// Watch returns a topic watch.
 	Watch(name string) (Watch, error)
+
+	// History returns the versions of the topic with the name in the
+	// range [from, to).
+	History(name string, from, to int) ([]Topic, error)
 }
 
 // ServiceOptions configures the topic service.
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	activeQueryIDVar = "id"
+
+	// ActiveQueriesURL is the url for listing active queries.
+	ActiveQueriesURL = RoutePrefixV1 + "/queries/active"
+
+	// ActiveQueriesHTTPMethod is the HTTP method used to list active queries.
+	ActiveQueriesHTTPMethod = http.MethodGet
+
+	// CancelQueryHTTPMethod is the HTTP method used to cancel a query.
+	CancelQueryHTTPMethod = http.MethodDelete
+)
+
+var (
+	// CancelQueryURL is the url for cancelling an active query.
+	CancelQueryURL = fmt.Sprintf("%s/queries/{%s}", RoutePrefixV1, activeQueryIDVar)
+
+	errEmptyQueryID       = errors.New("must specify query ID to cancel")
+	errActiveQueryMissing = errors.New("unable to find an active query with specified ID")
+)
+
+// ActiveQueriesHandler lists the queries currently executing.
+type ActiveQueriesHandler struct {
+	engine         executor.Engine
+	instrumentOpts instrument.Options
+}
+
+// NewActiveQueriesHandler returns a new instance of ActiveQueriesHandler.
+func NewActiveQueriesHandler(
+	engine executor.Engine,
+	instrumentOpts instrument.Options,
+) http.Handler {
+	return &ActiveQueriesHandler{
+		engine:         engine,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+type activeQueriesResponse struct {
+	Queries []executor.ActiveQuery `json:"queries"`
+}
+
+func (h *ActiveQueriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+	xhttp.WriteJSONResponse(w, activeQueriesResponse{
+		Queries: h.engine.ActiveQueries().List(),
+	}, logger)
+}
+
+// CancelQueryHandler cancels a query that is currently executing.
+type CancelQueryHandler struct {
+	engine         executor.Engine
+	instrumentOpts instrument.Options
+}
+
+// NewCancelQueryHandler returns a new instance of CancelQueryHandler.
+func NewCancelQueryHandler(
+	engine executor.Engine,
+	instrumentOpts instrument.Options,
+) http.Handler {
+	return &CancelQueryHandler{
+		engine:         engine,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+type cancelQueryResponse struct {
+	Cancelled bool `json:"cancelled"`
+}
+
+func (h *CancelQueryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+	id := strings.TrimSpace(mux.Vars(r)[activeQueryIDVar])
+	if id == "" {
+		logger.Error("no query ID to cancel", zap.Error(errEmptyQueryID))
+		xhttp.Error(w, errEmptyQueryID, http.StatusBadRequest)
+		return
+	}
+
+	if !h.engine.ActiveQueries().Cancel(id) {
+		xhttp.Error(w, errActiveQueryMissing, http.StatusNotFound)
+		return
+	}
+
+	logger.Info("cancelled active query", zap.String("id", id))
+	xhttp.WriteJSONResponse(w, cancelQueryResponse{Cancelled: true}, logger)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testActiveQueries struct {
+	queries   []executor.ActiveQuery
+	cancelled []string
+}
+
+func (q *testActiveQueries) List() []executor.ActiveQuery {
+	return q.queries
+}
+
+func (q *testActiveQueries) Cancel(id string) bool {
+	for _, query := range q.queries {
+		if query.ID == id {
+			q.cancelled = append(q.cancelled, id)
+			return true
+		}
+	}
+
+	return false
+}
+
+func newActiveQueriesTestRouter(
+	ctrl *gomock.Controller,
+	active executor.ActiveQueries,
+) *mux.Router {
+	engine := executor.NewMockEngine(ctrl)
+	engine.EXPECT().ActiveQueries().Return(active).AnyTimes()
+
+	router := mux.NewRouter()
+	router.Handle(ActiveQueriesURL,
+		NewActiveQueriesHandler(engine, instrument.NewOptions())).
+		Methods(ActiveQueriesHTTPMethod)
+	router.Handle(CancelQueryURL,
+		NewCancelQueryHandler(engine, instrument.NewOptions())).
+		Methods(CancelQueryHTTPMethod)
+	return router
+}
+
+func TestActiveQueriesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	start := time.Unix(1500000000, 0).UTC()
+	active := &testActiveQueries{
+		queries: []executor.ActiveQuery{{
+			ID:                "1",
+			Query:             "sum(foo)",
+			Source:            "tenant-a",
+			Start:             start,
+			FetchedDatapoints: 42,
+			Stage:             "executing",
+		}},
+	}
+	router := newActiveQueriesTestRouter(ctrl, active)
+
+	req := httptest.NewRequest(ActiveQueriesHTTPMethod, ActiveQueriesURL, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp activeQueriesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp.Queries, 1)
+	assert.Equal(t, active.queries[0], resp.Queries[0])
+}
+
+func TestCancelQueryHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	active := &testActiveQueries{
+		queries: []executor.ActiveQuery{{ID: "1"}},
+	}
+	router := newActiveQueriesTestRouter(ctrl, active)
+
+	req := httptest.NewRequest(CancelQueryHTTPMethod, "/api/v1/queries/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"1"}, active.cancelled)
+
+	req = httptest.NewRequest(CancelQueryHTTPMethod, "/api/v1/queries/2", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, []string{"1"}, active.cancelled)
+}
@@ -174,6 +174,10 @@ func (b fetchOptionsBuilder) NewFetchOptions(
 		fetchOpts.LookbackDuration = &lookback
 	}
 
+	if str := req.Header.Get(SourceHeader); str != "" {
+		fetchOpts.Source = []byte(str)
+	}
+
 	return fetchOpts, nil
 }
 
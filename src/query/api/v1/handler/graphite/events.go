@@ -0,0 +1,294 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/graphite/events"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// EventsURL is the url for posting and fetching graphite events.
+	EventsURL = handler.RoutePrefixV1 + "/graphite/events"
+
+	// EventsGetDataURL is the graphite-web compatible url for fetching
+	// graphite events, as used by Grafana's Graphite datasource.
+	EventsGetDataURL = EventsURL + "/get_data"
+
+	defaultEventsFrom  = "-1d"
+	defaultEventsUntil = "now"
+	eventsSetUnion     = "union"
+)
+
+var (
+	// EventsHTTPMethods are the HTTP methods for the events handler.
+	EventsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+	// EventsGetDataHTTPMethods are the HTTP methods for the graphite-web
+	// compatible events handler.
+	EventsGetDataHTTPMethods = []string{http.MethodGet}
+
+	errNoEventWhat = errors.New("event requires 'what'")
+)
+
+type graphiteEventsHandler struct {
+	store          events.Store
+	nowFn          func() time.Time
+	instrumentOpts instrument.Options
+}
+
+// NewEventsHandler returns a new instance of the events handler, which posts
+// events with POST and fetches them with GET.
+func NewEventsHandler(
+	store events.Store,
+	instrumentOpts instrument.Options,
+) http.Handler {
+	return &graphiteEventsHandler{
+		store:          store,
+		nowFn:          time.Now,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+// eventTags are the tags of an event, graphite-web accepts either a list or
+// a string of space separated tags.
+type eventTags []string
+
+func (t *eventTags) UnmarshalJSON(data []byte) error {
+	var tags []string
+	if err := json.Unmarshal(data, &tags); err == nil {
+		*t = normalizeEventTags(tags)
+		return nil
+	}
+
+	var tagsString string
+	if err := json.Unmarshal(data, &tagsString); err != nil {
+		return errors.New("event 'tags' must be a list or a string")
+	}
+
+	*t = normalizeEventTags([]string{tagsString})
+	return nil
+}
+
+type eventRequest struct {
+	What string    `json:"what"`
+	Tags eventTags `json:"tags"`
+	Data string    `json:"data"`
+	// When is the time of the event in seconds, defaulting to now.
+	When *float64 `json:"when"`
+}
+
+type eventResponse struct {
+	ID   string   `json:"id"`
+	When float64  `json:"when"`
+	What string   `json:"what"`
+	Tags []string `json:"tags"`
+	Data string   `json:"data"`
+}
+
+type postEventResponse struct {
+	ID string `json:"id"`
+}
+
+func (h *graphiteEventsHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	if r.Method == http.MethodPost {
+		h.post(w, r, logger)
+		return
+	}
+
+	h.get(w, r, logger)
+}
+
+func (h *graphiteEventsHandler) post(
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *zap.Logger,
+) {
+	event, err := parseEventRequest(r, h.nowFn())
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	id, err := h.store.Write(event)
+	if err != nil {
+		logger.Error("unable to write event", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	xhttp.WriteJSONResponse(w, postEventResponse{ID: id}, logger)
+}
+
+func (h *graphiteEventsHandler) get(
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *zap.Logger,
+) {
+	query, err := parseEventsQuery(r, h.nowFn())
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	fetched, err := h.store.Fetch(query)
+	if err != nil {
+		logger.Error("unable to fetch events", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	results := make([]eventResponse, 0, len(fetched))
+	for _, event := range fetched {
+		tags := event.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		// NB: graphite-web returns the time of events in seconds.
+		when := float64(event.When.Unix()) +
+			float64(event.When.Nanosecond())/float64(time.Second)
+		results = append(results, eventResponse{
+			ID:   event.ID,
+			When: when,
+			What: event.What,
+			Tags: tags,
+			Data: event.Data,
+		})
+	}
+
+	xhttp.WriteJSONResponse(w, results, logger)
+}
+
+func parseEventRequest(r *http.Request, now time.Time) (events.Event, error) {
+	if r.Body == nil {
+		return events.Event{}, errNoEventWhat
+	}
+
+	defer r.Body.Close()
+	var req eventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return events.Event{}, fmt.Errorf("invalid event: %v", err)
+	}
+
+	if req.What == "" {
+		return events.Event{}, errNoEventWhat
+	}
+
+	when := now
+	if req.When != nil {
+		if math.IsNaN(*req.When) || math.IsInf(*req.When, 0) {
+			return events.Event{}, fmt.Errorf("invalid event 'when': %v", *req.When)
+		}
+
+		secs, frac := math.Modf(*req.When)
+		when = time.Unix(int64(secs), int64(frac*float64(time.Second)))
+	}
+
+	return events.Event{
+		When: when,
+		What: req.What,
+		Tags: req.Tags,
+		Data: req.Data,
+	}, nil
+}
+
+func parseEventsQuery(r *http.Request, now time.Time) (events.Query, error) {
+	if err := r.ParseForm(); err != nil {
+		return events.Query{}, err
+	}
+
+	fromString, untilString := r.FormValue("from"), r.FormValue("until")
+	if len(fromString) == 0 {
+		fromString = defaultEventsFrom
+	}
+
+	if len(untilString) == 0 {
+		untilString = defaultEventsUntil
+	}
+
+	from, err := graphite.ParseTime(fromString, now, tzOffsetForAbsoluteTime)
+	if err != nil {
+		return events.Query{}, fmt.Errorf("invalid 'from': %s", fromString)
+	}
+
+	until, err := graphite.ParseTime(untilString, now, tzOffsetForAbsoluteTime)
+	if err != nil {
+		return events.Query{}, fmt.Errorf("invalid 'until': %s", untilString)
+	}
+
+	if until.Before(from) {
+		return events.Query{}, errFromNotBeforeUntil
+	}
+
+	return events.Query{
+		Start: from,
+		End:   until,
+		Tags:  normalizeEventTags(r.Form["tags"]),
+		Union: r.FormValue("set") == eventsSetUnion,
+	}, nil
+}
+
+// normalizeEventTags splits space or comma separated tags, dropping any
+// duplicates.
+func normalizeEventTags(values []string) []string {
+	var (
+		tags []string
+		seen = make(map[string]struct{})
+	)
+	for _, value := range values {
+		split := strings.FieldsFunc(value, func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})
+		for _, tag := range split {
+			if _, ok := seen[tag]; ok {
+				continue
+			}
+
+			seen[tag] = struct{}{}
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
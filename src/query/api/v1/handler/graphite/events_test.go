@@ -0,0 +1,205 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/graphite/events"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEventsStore struct {
+	written []events.Event
+	queries []events.Query
+	fetched []events.Event
+}
+
+func (s *testEventsStore) Write(event events.Event) (string, error) {
+	s.written = append(s.written, event)
+	return "id", nil
+}
+
+func (s *testEventsStore) Fetch(query events.Query) ([]events.Event, error) {
+	s.queries = append(s.queries, query)
+	return s.fetched, nil
+}
+
+func newTestEventsHandler(store events.Store, now time.Time) http.Handler {
+	h := NewEventsHandler(store, instrument.NewOptions())
+	h.(*graphiteEventsHandler).nowFn = func() time.Time { return now }
+	return h
+}
+
+func TestEventsHandlerPost(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	tests := []struct {
+		name     string
+		body     string
+		expected events.Event
+	}{
+		{
+			name: "list of tags",
+			body: `{"what":"deploy","tags":["api","prod","api"],"data":"v1","when":1400000000.5}`,
+			expected: events.Event{
+				When: time.Unix(1400000000, int64(500*time.Millisecond)),
+				What: "deploy",
+				Tags: []string{"api", "prod"},
+				Data: "v1",
+			},
+		},
+		{
+			name: "string of tags",
+			body: `{"what":"deploy","tags":"api prod"}`,
+			expected: events.Event{
+				When: now,
+				What: "deploy",
+				Tags: []string{"api", "prod"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &testEventsStore{}
+			h := newTestEventsHandler(store, now)
+
+			req := httptest.NewRequest(http.MethodPost, EventsURL, strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+			h.ServeHTTP(recorder, req)
+
+			require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+			assert.JSONEq(t, `{"id":"id"}`, recorder.Body.String())
+			require.Len(t, store.written, 1)
+			assert.True(t, tt.expected.When.Equal(store.written[0].When))
+			store.written[0].When = tt.expected.When
+			assert.Equal(t, tt.expected, store.written[0])
+		})
+	}
+}
+
+func TestEventsHandlerPostInvalid(t *testing.T) {
+	for _, body := range []string{
+		``,
+		`{"tags":"api"}`,
+		`{"what":"deploy","tags":1}`,
+	} {
+		store := &testEventsStore{}
+		h := newTestEventsHandler(store, time.Now())
+
+		req := httptest.NewRequest(http.MethodPost, EventsURL, strings.NewReader(body))
+		recorder := httptest.NewRecorder()
+		h.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, body)
+		assert.Empty(t, store.written)
+	}
+}
+
+func TestEventsHandlerGet(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	store := &testEventsStore{
+		fetched: []events.Event{
+			{
+				ID:   "a",
+				When: time.Unix(1499999000, int64(250*time.Millisecond)),
+				What: "deploy",
+				Tags: []string{"api", "prod"},
+				Data: "v1",
+			},
+			{
+				ID:   "b",
+				When: time.Unix(1499999500, 0),
+				What: "rollback",
+			},
+		},
+	}
+	h := newTestEventsHandler(store, now)
+
+	req := httptest.NewRequest(http.MethodGet,
+		EventsGetDataURL+"?from=-1h&until=now&tags=api+prod&tags=deploy&set=union", nil)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	require.Len(t, store.queries, 1)
+	assert.True(t, now.Add(-time.Hour).Equal(store.queries[0].Start))
+	assert.True(t, now.Equal(store.queries[0].End))
+	assert.Equal(t, []string{"api", "prod", "deploy"}, store.queries[0].Tags)
+	assert.True(t, store.queries[0].Union)
+
+	var results []map[string]interface{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &results))
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"id":   "a",
+			"when": 1499999000.25,
+			"what": "deploy",
+			"tags": []interface{}{"api", "prod"},
+			"data": "v1",
+		},
+		{
+			"id":   "b",
+			"when": float64(1499999500),
+			"what": "rollback",
+			"tags": []interface{}{},
+			"data": "",
+		},
+	}, results)
+}
+
+func TestEventsHandlerGetDefaults(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	store := &testEventsStore{}
+	h := newTestEventsHandler(store, now)
+
+	req := httptest.NewRequest(http.MethodGet, EventsURL, nil)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.JSONEq(t, `[]`, recorder.Body.String())
+	require.Len(t, store.queries, 1)
+	assert.True(t, now.Add(-24*time.Hour).Equal(store.queries[0].Start))
+	assert.True(t, now.Equal(store.queries[0].End))
+	assert.Empty(t, store.queries[0].Tags)
+	assert.False(t, store.queries[0].Union)
+}
+
+func TestEventsHandlerGetInvalidRange(t *testing.T) {
+	store := &testEventsStore{}
+	h := newTestEventsHandler(store, time.Now())
+
+	req := httptest.NewRequest(http.MethodGet, EventsURL+"?from=now&until=-1h", nil)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Empty(t, store.queries)
+}
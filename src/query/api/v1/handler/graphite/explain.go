@@ -0,0 +1,99 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/m3db/m3/src/query/explain"
+	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/native"
+)
+
+// explainExpression adds the calls of a compiled target to the logical plan
+// of the explanation, with the steps ordered from the fetches upwards.
+func explainExpression(
+	explanation *explain.Explanation,
+	targetIdx int,
+	expr native.CallASTNode,
+) {
+	var nextID int
+	explainCall(explanation, targetIdx, expr, "", &nextID)
+}
+
+func explainCall(
+	explanation *explain.Explanation,
+	targetIdx int,
+	call native.CallASTNode,
+	childID string,
+	nextID *int,
+) string {
+	id := fmt.Sprintf("%d.%d", targetIdx, *nextID)
+	*nextID++
+
+	var (
+		parents []string
+		params  []string
+	)
+
+	for _, arg := range call.Arguments() {
+		if argCall, ok := arg.(native.CallASTNode); ok {
+			parents = append(parents,
+				explainCall(explanation, targetIdx, argCall, id, nextID))
+			continue
+		}
+
+		params = append(params, arg.String())
+	}
+
+	step := explain.Step{
+		ID:      id,
+		Op:      call.Name(),
+		Params:  strings.Join(params, ","),
+		Parents: parents,
+	}
+
+	if childID != "" {
+		step.Children = []string{childID}
+	}
+
+	explanation.AddLogicalStep(step)
+	return id
+}
+
+// newExplainTracer returns a tracer which records each traced function call
+// and fetch as a node of the explanation.
+func newExplainTracer(explanation *explain.Explanation) common.Tracer {
+	var nextID int64
+	return func(t common.Trace) {
+		id := strconv.FormatInt(atomic.AddInt64(&nextID, 1), 10)
+		node := explanation.Node(id, t.ActivityName)
+		for _, in := range t.Inputs {
+			node.RecordInput(in.NumSeries, in.NumDatapoints)
+		}
+
+		node.RecordOutput(t.Outputs.NumSeries, t.Outputs.NumDatapoints)
+		node.RecordDuration(t.Duration)
+	}
+}
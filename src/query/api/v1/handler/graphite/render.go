@@ -22,15 +22,18 @@ package graphite
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/http"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/explain"
 	"github.com/m3db/m3/src/query/graphite/common"
 	"github.com/m3db/m3/src/query/graphite/errors"
 	"github.com/m3db/m3/src/query/graphite/native"
@@ -110,11 +113,17 @@ func (h *renderHandler) serveHTTP(
 	}
 
 	var (
-		results = make([]ts.SeriesList, len(p.Targets))
-		errorCh = make(chan error, 1)
-		mu      sync.Mutex
+		results     = make([]ts.SeriesList, len(p.Targets))
+		errorCh     = make(chan error, 1)
+		mu          sync.Mutex
+		explanation *explain.Explanation
 	)
 
+	if p.Explain {
+		explanation = explain.New()
+		reqCtx = explain.NewContext(reqCtx, explanation)
+	}
+
 	ctx := common.NewContext(common.ContextOptions{
 		Engine:  h.engine,
 		Start:   p.From,
@@ -127,6 +136,10 @@ func (h *renderHandler) serveHTTP(
 	ctx.SetRequestContext(reqCtx)
 	defer ctx.Close()
 
+	if explanation != nil {
+		ctx.Trace = newExplainTracer(explanation)
+	}
+
 	var wg sync.WaitGroup
 	meta := block.NewResultMetadata()
 	wg.Add(len(p.Targets))
@@ -146,6 +159,7 @@ func (h *renderHandler) serveHTTP(
 				wg.Done()
 			}()
 
+			start := time.Now()
 			exp, err := h.engine.Compile(target)
 			if err != nil {
 				sendError(errorCh, errors.NewRenamedError(err,
@@ -153,6 +167,12 @@ func (h *renderHandler) serveHTTP(
 				return
 			}
 
+			if explanation != nil {
+				explanation.RecordPhase("compiling "+target, time.Since(start))
+				explainExpression(explanation, i, exp)
+				start = time.Now()
+			}
+
 			targetSeries, err := exp.Execute(childCtx)
 			if err != nil {
 				sendError(errorCh, errors.NewRenamedError(err,
@@ -160,16 +180,19 @@ func (h *renderHandler) serveHTTP(
 				return
 			}
 
+			if explanation != nil {
+				explanation.RecordPhase("executing "+target, time.Since(start))
+			}
+
 			for i, s := range targetSeries.Values {
-				if s.Len() <= int(p.MaxDataPoints) {
-					continue
+				consolidated, err := consolidateToMaxDataPoints(s, p.MaxDataPoints)
+				if err != nil {
+					sendError(errorCh, errors.NewRenamedError(err,
+						fmt.Errorf("error: target %s could not be consolidated: %s", target, err)))
+					return
 				}
 
-				var (
-					samplingMultiplier = math.Ceil(float64(s.Len()) / float64(p.MaxDataPoints))
-					newMillisPerStep   = int(samplingMultiplier * float64(s.MillisPerStep()))
-				)
-				targetSeries.Values[i] = ts.LTTB(s, s.StartTime(), s.EndTime(), newMillisPerStep)
+				targetSeries.Values[i] = consolidated
 			}
 
 			mu.Lock()
@@ -183,7 +206,10 @@ func (h *renderHandler) serveHTTP(
 	close(errorCh)
 	err = <-errorCh
 	if err != nil {
-		return respError{err: err, code: http.StatusInternalServerError}
+		return respError{
+			err:  err,
+			code: handler.ErrorStatusCode(err, http.StatusInternalServerError),
+		}
 	}
 
 	// Count and sort the groups if not sorted already.
@@ -211,6 +237,35 @@ func (h *renderHandler) serveHTTP(
 	}
 
 	handler.AddWarningHeaders(w, meta)
+	if explanation != nil {
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(explanation.Report())
+		return respError{err: err, code: http.StatusOK}
+	}
+
 	err = WriteRenderResponse(w, response, p.Format)
 	return respError{err: err, code: http.StatusOK}
 }
+
+// consolidateToMaxDataPoints resizes a series with more than maxDataPoints
+// values to a coarser step, consolidating each bucket using the series'
+// consolidation function (as set by consolidateBy, defaulting to average).
+func consolidateToMaxDataPoints(s *ts.Series, maxDataPoints int64) (*ts.Series, error) {
+	if int64(s.Len()) <= maxDataPoints {
+		return s, nil
+	}
+
+	var (
+		samplingMultiplier = math.Ceil(float64(s.Len()) / float64(maxDataPoints))
+		newMillisPerStep   = int(samplingMultiplier * float64(s.MillisPerStep()))
+	)
+
+	consolidated, err := s.IntersectAndResize(s.StartTime(), s.EndTime(),
+		newMillisPerStep, s.ConsolidationFunc())
+	if err != nil {
+		return nil, err
+	}
+
+	consolidated.SetConsolidationFunc(s.ConsolidationFunc())
+	return consolidated, nil
+}
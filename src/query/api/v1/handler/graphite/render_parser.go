@@ -40,6 +40,9 @@ const (
 	queryRangeShiftThreshold = 55 * time.Minute
 	queryRangeShift          = 15 * time.Second
 	pickleFormat             = "pickle"
+	csvFormat                = "csv"
+	rawFormat                = "raw"
+	msgpackFormat            = "msgpack"
 )
 
 var (
@@ -53,12 +56,22 @@ func WriteRenderResponse(
 	series ts.SeriesList,
 	format string,
 ) error {
-	if format == pickleFormat {
+	switch format {
+	case pickleFormat:
 		w.Header().Set("Content-Type", "application/octet-stream")
 		return renderResultsPickle(w, series.Values)
+	case csvFormat:
+		w.Header().Set("Content-Type", "text/csv")
+		return renderResultsCSV(w, series.Values)
+	case rawFormat:
+		w.Header().Set("Content-Type", "text/plain")
+		return renderResultsRaw(w, series.Values)
+	case msgpackFormat:
+		w.Header().Set("Content-Type", "application/x-msgpack")
+		return renderResultsMsgpack(w, series.Values)
 	}
 
-	// NB: return json unless requesting specifically `pickleFormat`
+	// NB: return json unless requesting specifically one of the other formats.
 	w.Header().Set("Content-Type", "application/json")
 	return renderResultsJSON(w, series.Values)
 }
@@ -78,6 +91,7 @@ type RenderRequest struct {
 	MaxDataPoints int64
 	Compare       time.Duration
 	Timeout       time.Duration
+	Explain       bool
 }
 
 // ParseRenderRequest parses the arguments to a render call from an incoming request.
@@ -93,6 +107,7 @@ func ParseRenderRequest(r *http.Request) (RenderRequest, error) {
 	}
 
 	p.Targets = r.Form["target"]
+	p.Format = r.FormValue("format")
 
 	if len(p.Targets) == 0 {
 		return p, errNoTarget
@@ -177,6 +192,14 @@ func ParseRenderRequest(r *http.Request) (RenderRequest, error) {
 		p.Compare = compareFrom.Sub(p.From)
 	}
 
+	explainString := r.FormValue("explain")
+	if len(explainString) != 0 {
+		p.Explain, err = strconv.ParseBool(explainString)
+		if err != nil {
+			return p, errors.NewInvalidParamsError(fmt.Errorf("invalid 'explain': %s", explainString))
+		}
+	}
+
 	timeout := r.FormValue("timeout")
 	if timeout != "" {
 		duration, err := time.ParseDuration(timeout)
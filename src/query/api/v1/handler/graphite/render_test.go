@@ -21,6 +21,7 @@
 package graphite
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/explain"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
@@ -114,6 +116,63 @@ func TestParseQueryResults(t *testing.T) {
 	require.Equal(t, expected, string(buf))
 }
 
+func TestParseQueryResultsExplain(t *testing.T) {
+	mockStorage := mock.NewMockStorage()
+	resolution := 10 * time.Second
+	truncateStart := time.Now().Add(-30 * time.Minute).Truncate(resolution)
+	start := truncateStart.Add(time.Second)
+	vals := ts.NewFixedStepValues(resolution, 3, 3, start)
+	tags := models.NewTags(0, nil)
+	tags = tags.AddTag(models.Tag{Name: graphite.TagName(0), Value: []byte("foo")})
+	tags = tags.AddTag(models.Tag{Name: graphite.TagName(1), Value: []byte("bar")})
+	seriesList := ts.SeriesList{
+		ts.NewSeries([]byte("series_name"), vals, tags),
+	}
+
+	meta := block.NewResultMetadata()
+	meta.Resolutions = []int64{int64(resolution)}
+	mockStorage.SetFetchResult(&storage.FetchResult{
+		SeriesList: seriesList,
+		Metadata:   meta,
+	}, nil)
+	handler := NewRenderHandler(mockStorage,
+		models.QueryContextOptions{}, nil, instrument.NewOptions())
+
+	req := newGraphiteReadHTTPRequest(t)
+	req.URL.RawQuery = fmt.Sprintf("target=sumSeries(foo.bar)&from=%d&until=%d&explain=true",
+		start.Unix(), start.Unix()+30)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	res := recorder.Result()
+	require.Equal(t, 200, res.StatusCode)
+
+	var report explain.Report
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&report))
+	assert.Equal(t, []explain.Step{
+		{ID: "0.1", Op: "fetch", Params: "foo.bar", Children: []string{"0.0"}},
+		{ID: "0.0", Op: "sumSeries", Parents: []string{"0.1"}},
+	}, report.LogicalPlan)
+
+	require.Equal(t, 2, len(report.Nodes))
+	fetch := report.Nodes[0]
+	assert.Equal(t, "fetch foo.bar", fetch.Op)
+	assert.Equal(t, 1, fetch.SeriesOut)
+	assert.Equal(t, 3, fetch.DatapointsOut)
+
+	sum := report.Nodes[1]
+	assert.Equal(t, "sumSeries", sum.Op)
+	assert.Equal(t, 1, sum.SeriesIn)
+	assert.Equal(t, 3, sum.DatapointsIn)
+	assert.Equal(t, 1, sum.SeriesOut)
+
+	req = newGraphiteReadHTTPRequest(t)
+	req.URL.RawQuery = "target=foo.bar&explain=not_a_bool"
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 400, recorder.Result().StatusCode)
+}
+
 func TestParseQueryResultsMaxDatapoints(t *testing.T) {
 	mockStorage := mock.NewMockStorage()
 
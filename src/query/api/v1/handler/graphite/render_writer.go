@@ -0,0 +1,163 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/graphite/ts"
+
+	msgpack "gopkg.in/vmihailenco/msgpack.v2"
+)
+
+const (
+	csvTimeFormat = "2006-01-02 15:04:05"
+	rawNoneValue  = "None"
+)
+
+// renderResultsCSV writes one "name,timestamp,value" line per datapoint,
+// matching the graphite-web csv format; missing values are left empty.
+func renderResultsCSV(w io.Writer, series []*ts.Series) error {
+	bw := bufio.NewWriter(w)
+	for _, s := range series {
+		name := s.Name()
+		for i := 0; i < s.Len(); i++ {
+			timestamp, val := s.StartTimeForStep(i), s.ValueAt(i)
+			bw.WriteString(name)
+			bw.WriteByte(',')
+			bw.WriteString(timestamp.UTC().Format(csvTimeFormat))
+			bw.WriteByte(',')
+			if !math.IsNaN(val) {
+				bw.WriteString(strconv.FormatFloat(val, 'f', -1, 64))
+			}
+			bw.WriteByte('\n')
+		}
+	}
+
+	return bw.Flush()
+}
+
+// renderResultsRaw writes one "name,start,end,step|v1,v2,..." line per series,
+// matching the graphite-web raw format; missing values are written as None.
+func renderResultsRaw(w io.Writer, series []*ts.Series) error {
+	bw := bufio.NewWriter(w)
+	for _, s := range series {
+		bw.WriteString(s.Name())
+		bw.WriteByte(',')
+		bw.WriteString(strconv.FormatInt(s.StartTime().UTC().Unix(), 10))
+		bw.WriteByte(',')
+		bw.WriteString(strconv.FormatInt(s.EndTime().UTC().Unix(), 10))
+		bw.WriteByte(',')
+		bw.WriteString(strconv.Itoa(s.MillisPerStep() / 1000))
+		bw.WriteByte('|')
+		for i := 0; i < s.Len(); i++ {
+			if i > 0 {
+				bw.WriteByte(',')
+			}
+
+			val := s.ValueAt(i)
+			if math.IsNaN(val) {
+				bw.WriteString(rawNoneValue)
+				continue
+			}
+
+			bw.WriteString(strconv.FormatFloat(val, 'f', -1, 64))
+		}
+		bw.WriteByte('\n')
+	}
+
+	return bw.Flush()
+}
+
+// renderResultsMsgpack writes a list of series maps with the same keys as
+// the pickle format; missing values are encoded as nil.
+func renderResultsMsgpack(w io.Writer, series []*ts.Series) error {
+	bw := bufio.NewWriter(w)
+	enc := msgpack.NewEncoder(bw)
+	if err := enc.EncodeArrayLen(len(series)); err != nil {
+		return err
+	}
+
+	for _, s := range series {
+		if err := encodeMsgpackSeries(enc, s); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+func encodeMsgpackSeries(enc *msgpack.Encoder, s *ts.Series) error {
+	if err := enc.EncodeMapLen(5); err != nil {
+		return err
+	}
+
+	fields := []struct {
+		key   string
+		value int64
+	}{
+		{key: "start", value: s.StartTime().UTC().Unix()},
+		{key: "end", value: s.EndTime().UTC().Unix()},
+		{key: "step", value: int64(s.MillisPerStep() / 1000)},
+	}
+
+	if err := enc.EncodeString("name"); err != nil {
+		return err
+	}
+	if err := enc.EncodeString(s.Name()); err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		if err := enc.EncodeString(f.key); err != nil {
+			return err
+		}
+		if err := enc.EncodeInt64(f.value); err != nil {
+			return err
+		}
+	}
+
+	if err := enc.EncodeString("values"); err != nil {
+		return err
+	}
+	if err := enc.EncodeArrayLen(s.Len()); err != nil {
+		return err
+	}
+
+	for i := 0; i < s.Len(); i++ {
+		val := s.ValueAt(i)
+		if math.IsNaN(val) {
+			if err := enc.EncodeNil(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := enc.EncodeFloat64(val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
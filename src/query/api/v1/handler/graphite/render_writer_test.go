@@ -0,0 +1,137 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/graphite/context"
+	"github.com/m3db/m3/src/query/graphite/ts"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	msgpack "gopkg.in/vmihailenco/msgpack.v2"
+)
+
+func newTestRenderSeries(ctx context.Context) []*ts.Series {
+	start := time.Date(2019, 6, 8, 13, 20, 0, 0, time.UTC)
+	vals := ts.NewValues(ctx, 10000, 3)
+	vals.SetValueAt(0, 1)
+	vals.SetValueAt(1, math.NaN())
+	vals.SetValueAt(2, 2.5)
+	return []*ts.Series{ts.NewSeries(ctx, "foo.bar", start, vals)}
+}
+
+func TestRenderResultsCSV(t *testing.T) {
+	ctx := context.New()
+	defer ctx.Close()
+
+	var buf bytes.Buffer
+	require.NoError(t, renderResultsCSV(&buf, newTestRenderSeries(ctx)))
+
+	expected := "foo.bar,2019-06-08 13:20:00,1\n" +
+		"foo.bar,2019-06-08 13:20:10,\n" +
+		"foo.bar,2019-06-08 13:20:20,2.5\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestRenderResultsRaw(t *testing.T) {
+	ctx := context.New()
+	defer ctx.Close()
+
+	var buf bytes.Buffer
+	require.NoError(t, renderResultsRaw(&buf, newTestRenderSeries(ctx)))
+
+	expected := "foo.bar,1560000000,1560000030,10|1,None,2.5\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestRenderResultsMsgpack(t *testing.T) {
+	ctx := context.New()
+	defer ctx.Close()
+
+	var buf bytes.Buffer
+	require.NoError(t, renderResultsMsgpack(&buf, newTestRenderSeries(ctx)))
+
+	type msgpackSeries struct {
+		Name   string     `msgpack:"name"`
+		Start  int64      `msgpack:"start"`
+		End    int64      `msgpack:"end"`
+		Step   int64      `msgpack:"step"`
+		Values []*float64 `msgpack:"values"`
+	}
+
+	var decoded []msgpackSeries
+	require.NoError(t, msgpack.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, 1, len(decoded))
+
+	s := decoded[0]
+	assert.Equal(t, "foo.bar", s.Name)
+	assert.Equal(t, int64(1560000000), s.Start)
+	assert.Equal(t, int64(1560000030), s.End)
+	assert.Equal(t, int64(10), s.Step)
+	require.Equal(t, 3, len(s.Values))
+	require.NotNil(t, s.Values[0])
+	assert.Equal(t, 1.0, *s.Values[0])
+	assert.Nil(t, s.Values[1])
+	require.NotNil(t, s.Values[2])
+	assert.Equal(t, 2.5, *s.Values[2])
+}
+
+func TestConsolidateToMaxDataPoints(t *testing.T) {
+	ctx := context.New()
+	defer ctx.Close()
+
+	start := time.Date(2019, 6, 8, 13, 20, 0, 0, time.UTC)
+	vals := ts.NewValues(ctx, 10000, 6)
+	for i := 0; i < vals.Len(); i++ {
+		vals.SetValueAt(i, float64(i+1))
+	}
+
+	series := ts.NewSeries(ctx, "foo.bar", start, vals)
+	unchanged, err := consolidateToMaxDataPoints(series, 6)
+	require.NoError(t, err)
+	assert.Equal(t, series, unchanged)
+
+	tests := []struct {
+		cf       ts.ConsolidationFunc
+		expected []float64
+	}{
+		{cf: nil, expected: []float64{2, 5}},
+		{cf: ts.Sum, expected: []float64{6, 15}},
+		{cf: ts.Max, expected: []float64{3, 6}},
+		{cf: ts.Min, expected: []float64{1, 4}},
+	}
+
+	for _, tt := range tests {
+		series.SetConsolidationFunc(tt.cf)
+		consolidated, err := consolidateToMaxDataPoints(series, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 30000, consolidated.MillisPerStep())
+		require.Equal(t, len(tt.expected), consolidated.Len())
+		for i, v := range tt.expected {
+			assert.Equal(t, v, consolidated.ValueAt(i))
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/m3db/m3/src/query/block"
 
@@ -57,3 +58,30 @@ func TestAddWarningHeaders(t *testing.T) {
 	assert.Equal(t, 1, len(recorder.Header()))
 	assert.Equal(t, ex, recorder.Header().Get(LimitHeader))
 }
+
+func TestAddNamespacesHeader(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	meta := block.NewResultMetadata()
+	AddNamespacesHeader(recorder, meta)
+	assert.Equal(t, 0, len(recorder.Header()))
+
+	now := time.Unix(7200, 0)
+	meta.Namespaces = []block.NamespaceRange{
+		{
+			Namespace:  "long",
+			Resolution: time.Hour,
+			Start:      now.Add(-2 * time.Hour),
+			End:        now.Add(-time.Hour),
+		},
+		{
+			Namespace: "unagg",
+			Start:     now.Add(-time.Hour),
+			End:       now,
+		},
+	}
+
+	AddNamespacesHeader(recorder, meta)
+	assert.Equal(t, 1, len(recorder.Header()))
+	assert.Equal(t, "long_1h0m0s_0_3600,unagg_0s_3600_7200",
+		recorder.Header().Get(NamespacesHeader))
+}
@@ -60,6 +60,10 @@ const (
 	// in JSON format. See `handler.stringTagOptions` for definitions.`
 	RestrictByTagsJSONHeader = "M3-Restrict-By-Tags-JSON"
 
+	// SourceHeader identifies the user or tenant issuing a query, used when
+	// listing active queries.
+	SourceHeader = "M3-Source"
+
 	// UnaggregatedStoragePolicy specifies the unaggregated storage policy.
 	UnaggregatedStoragePolicy = "unaggregated"
 
@@ -85,6 +89,10 @@ const (
 	// LimitHeaderSeriesLimitApplied is the header applied when fetch results are
 	// maxed.
 	LimitHeaderSeriesLimitApplied = "max_fetch_series_limit_applied"
+
+	// NamespacesHeader is the header added to describe the namespaces selected
+	// to serve a query, and the portion of the query range each served.
+	NamespacesHeader = "M3-Namespaces"
 )
 
 // AddWarningHeaders adds any warning headers present in the result's metadata.
@@ -111,3 +119,18 @@ func AddWarningHeaders(w http.ResponseWriter, meta block.ResultMetadata) {
 
 	w.Header().Set(LimitHeader, strings.Join(warnings, ","))
 }
+
+// AddNamespacesHeader adds the namespaces selected to serve the query, as
+// present in the result's metadata. No-op if no namespaces are present.
+func AddNamespacesHeader(w http.ResponseWriter, meta block.ResultMetadata) {
+	if len(meta.Namespaces) == 0 {
+		return
+	}
+
+	namespaces := make([]string, 0, len(meta.Namespaces))
+	for _, ns := range meta.Namespaces {
+		namespaces = append(namespaces, ns.Header())
+	}
+
+	w.Header().Set(NamespacesHeader, strings.Join(namespaces, ","))
+}
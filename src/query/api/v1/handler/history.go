@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// HistoryFromVersionParam is the query parameter for the first version to
+	// return from a history endpoint.
+	HistoryFromVersionParam = "from"
+	// HistoryToVersionParam is the query parameter for the last version to
+	// return from a history endpoint.
+	HistoryToVersionParam = "to"
+
+	// DefaultHistoryVersions is the number of versions returned by a history
+	// endpoint when no range is specified.
+	DefaultHistoryVersions = 10
+	// MaxHistoryVersions is the maximum number of versions a history endpoint
+	// will return for a single request.
+	MaxHistoryVersions = 100
+)
+
+var errNoVersions = errors.New("no versions exist")
+
+// VersionRange is an inclusive range of versions of a KV key.
+type VersionRange struct {
+	From int
+	To   int
+}
+
+// ParseVersionRange parses the version range requested from a history
+// endpoint given the current version of the key. By default the range ends
+// at the current version and covers the last DefaultHistoryVersions versions.
+func ParseVersionRange(r *http.Request, current int) (VersionRange, error) {
+	if current < 1 {
+		return VersionRange{}, errNoVersions
+	}
+
+	var (
+		values = r.URL.Query()
+		rng    = VersionRange{To: current}
+		err    error
+	)
+	if v := values.Get(HistoryToVersionParam); v != "" {
+		if rng.To, err = strconv.Atoi(v); err != nil {
+			return VersionRange{}, fmt.Errorf("invalid to version: %v", err)
+		}
+	}
+
+	rng.From = rng.To - DefaultHistoryVersions + 1
+	if rng.From < 1 {
+		rng.From = 1
+	}
+	if v := values.Get(HistoryFromVersionParam); v != "" {
+		if rng.From, err = strconv.Atoi(v); err != nil {
+			return VersionRange{}, fmt.Errorf("invalid from version: %v", err)
+		}
+	}
+
+	if rng.From < 1 || rng.From > rng.To || rng.To > current {
+		return VersionRange{}, fmt.Errorf(
+			"invalid version range [%d, %d], current version is %d",
+			rng.From, rng.To, current)
+	}
+	if n := rng.To - rng.From + 1; n > MaxHistoryVersions {
+		return VersionRange{}, fmt.Errorf(
+			"version range covers %d versions, at most %d may be requested",
+			n, MaxHistoryVersions)
+	}
+
+	return rng, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersionRange(t *testing.T) {
+	tests := []struct {
+		query   string
+		current int
+		result  VersionRange
+		err     bool
+	}{
+		{query: "", current: 3, result: VersionRange{From: 1, To: 3}},
+		{query: "", current: 25, result: VersionRange{From: 16, To: 25}},
+		{query: "?to=12", current: 25, result: VersionRange{From: 3, To: 12}},
+		{query: "?from=5", current: 25, result: VersionRange{From: 5, To: 25}},
+		{query: "?from=5&to=7", current: 25, result: VersionRange{From: 5, To: 7}},
+		{query: "", current: 0, err: true},
+		{query: "?from=0", current: 25, err: true},
+		{query: "?from=8&to=7", current: 25, err: true},
+		{query: "?to=26", current: 25, err: true},
+		{query: "?from=abc", current: 25, err: true},
+		{query: "?from=1&to=200", current: 200, err: true},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest("GET", "/history"+test.query, nil)
+		result, err := ParseVersionRange(req, test.current)
+		if test.err {
+			assert.Error(t, err, test.query)
+			continue
+		}
+		require.NoError(t, err, test.query)
+		assert.Equal(t, test.result, result, test.query)
+	}
+}
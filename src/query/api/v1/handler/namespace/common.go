@@ -134,6 +134,14 @@ func RegisterRoutes(
 	r.HandleFunc(DeprecatedM3DBDeleteURL, deleteHandler.ServeHTTP).Methods(DeleteHTTPMethod)
 	r.HandleFunc(M3DBDeleteURL, deleteHandler.ServeHTTP).Methods(DeleteHTTPMethod)
 
+	// M3DB namespace history.
+	historyHandler := wrapped(NewHistoryHandler(client, instrumentOpts))
+	r.HandleFunc(M3DBHistoryURL, historyHandler.ServeHTTP).Methods(HistoryHTTPMethod)
+
+	// Roll back M3DB namespaces.
+	rollbackHandler := wrapped(NewRollbackHandler(client, instrumentOpts))
+	r.HandleFunc(M3DBRollbackURL, rollbackHandler.ServeHTTP).Methods(RollbackHTTPMethod)
+
 	// Deploy M3DB schemas.
 	schemaHandler := wrapped(
 		applyMiddleware(NewSchemaHandler(client, instrumentOpts).ServeHTTP, defaults))
@@ -0,0 +1,277 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"sort"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/kv"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+var (
+	// M3DBHistoryURL is the url for the namespace history handler (with the
+	// GET method).
+	M3DBHistoryURL = path.Join(handler.RoutePrefixV1, M3DBServiceNamespacePathName, "history")
+
+	// HistoryHTTPMethod is the HTTP method used with this resource.
+	HistoryHTTPMethod = http.MethodGet
+)
+
+// HistoryResponse is the response returned by the namespace history handler.
+type HistoryResponse struct {
+	CurrentVersion int                     `json:"currentVersion"`
+	Versions       []NamespaceHistoryEntry `json:"versions"`
+}
+
+// NamespaceHistoryEntry describes a single historical version of the
+// namespace registry, the diff is relative to the preceding version and is
+// omitted for the first version. KV stores do not record when a version was
+// written so no timestamp is available for namespace versions.
+type NamespaceHistoryEntry struct {
+	Version    int                   `json:"version"`
+	Namespaces []string              `json:"namespaces"`
+	Diff       *NamespaceVersionDiff `json:"diff,omitempty"`
+}
+
+// NamespaceVersionDiff is the semantic difference between two versions of
+// the namespace registry.
+type NamespaceVersionDiff struct {
+	Added    []string          `json:"added,omitempty"`
+	Removed  []string          `json:"removed,omitempty"`
+	Modified []NamespaceChange `json:"modified,omitempty"`
+}
+
+// NamespaceChange lists the options of a namespace that changed between two
+// versions of the namespace registry.
+type NamespaceChange struct {
+	Name           string   `json:"name"`
+	ChangedOptions []string `json:"changedOptions"`
+}
+
+// HistoryHandler is the handler for namespace history.
+type HistoryHandler Handler
+
+// NewHistoryHandler returns a new instance of HistoryHandler.
+func NewHistoryHandler(
+	client clusterclient.Client,
+	instrumentOpts instrument.Options,
+) *HistoryHandler {
+	return &HistoryHandler{
+		client:         client,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+func (h *HistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	store, err := h.client.KV()
+	if err != nil {
+		logger.Error("unable to get kv store", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	current, err := store.Get(M3DBNodeNamespacesKey)
+	if err == kv.ErrNotFound {
+		xhttp.Error(w, fmt.Errorf("no namespaces have been set"), http.StatusNotFound)
+		return
+	} else if err != nil {
+		logger.Error("unable to get namespaces", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	rng, err := handler.ParseVersionRange(r, current.Version())
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	// Fetch the version preceding the range so the first version returned
+	// can be diffed as well.
+	from := rng.From
+	if from > 1 {
+		from--
+	}
+	values, err := store.History(M3DBNodeNamespacesKey, from, rng.To+1)
+	if err != nil {
+		logger.Error("unable to get namespace history", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := HistoryResponse{
+		CurrentVersion: current.Version(),
+		Versions:       make([]NamespaceHistoryEntry, 0, len(values)),
+	}
+
+	var prev *nsproto.Registry
+	for _, value := range values {
+		var registry nsproto.Registry
+		if err := value.Unmarshal(&registry); err != nil {
+			err = fmt.Errorf("failed to parse namespace version %v: %v", value.Version(), err)
+			logger.Error("unable to get namespace history", zap.Error(err))
+			xhttp.Error(w, err, http.StatusInternalServerError)
+			return
+		}
+
+		if value.Version() >= rng.From {
+			entry := NamespaceHistoryEntry{
+				Version:    value.Version(),
+				Namespaces: namespaceNames(&registry),
+			}
+			if prev != nil {
+				diff, err := DiffRegistries(prev, &registry)
+				if err != nil {
+					logger.Error("unable to diff namespace versions", zap.Error(err))
+					xhttp.Error(w, err, http.StatusInternalServerError)
+					return
+				}
+				entry.Diff = &diff
+			}
+			resp.Versions = append(resp.Versions, entry)
+		}
+
+		prev = &registry
+	}
+
+	xhttp.WriteJSONResponse(w, resp, logger)
+}
+
+// DiffRegistries returns the semantic difference between two versions of the
+// namespace registry.
+func DiffRegistries(prev, next *nsproto.Registry) (NamespaceVersionDiff, error) {
+	var diff NamespaceVersionDiff
+	for _, name := range namespaceNames(next) {
+		prevOpts, ok := prev.Namespaces[name]
+		if !ok {
+			diff.Added = append(diff.Added, name)
+			continue
+		}
+
+		changed, err := changedOptions(prevOpts, next.Namespaces[name])
+		if err != nil {
+			return NamespaceVersionDiff{}, err
+		}
+		if len(changed) > 0 {
+			diff.Modified = append(diff.Modified, NamespaceChange{
+				Name:           name,
+				ChangedOptions: changed,
+			})
+		}
+	}
+	for _, name := range namespaceNames(prev) {
+		if _, ok := next.Namespaces[name]; !ok {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+	return diff, nil
+}
+
+func namespaceNames(registry *nsproto.Registry) []string {
+	names := make([]string, 0, len(registry.Namespaces))
+	for name := range registry.Namespaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// changedOptions returns the JSON names of the namespace options that differ,
+// nested options are reported by their dotted path.
+func changedOptions(prev, next *nsproto.NamespaceOptions) ([]string, error) {
+	prevFields, err := optionFields(prev)
+	if err != nil {
+		return nil, err
+	}
+	nextFields, err := optionFields(next)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	diffFields("", prevFields, nextFields, &changed)
+	sort.Strings(changed)
+	return changed, nil
+}
+
+func optionFields(opts *nsproto.NamespaceOptions) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if opts == nil {
+		return fields, nil
+	}
+
+	var (
+		buf       bytes.Buffer
+		marshaler = jsonpb.Marshaler{EmitDefaults: true}
+	)
+	if err := marshaler.Marshal(&buf, opts); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func diffFields(prefix string, prev, next map[string]interface{}, changed *[]string) {
+	keys := make(map[string]struct{}, len(prev)+len(next))
+	for k := range prev {
+		keys[k] = struct{}{}
+	}
+	for k := range next {
+		keys[k] = struct{}{}
+	}
+
+	for k := range keys {
+		var (
+			prevValue = prev[k]
+			nextValue = next[k]
+			name      = prefix + k
+		)
+		prevMap, prevIsMap := prevValue.(map[string]interface{})
+		nextMap, nextIsMap := nextValue.(map[string]interface{})
+		if prevIsMap && nextIsMap {
+			diffFields(name+".", prevMap, nextMap, changed)
+			continue
+		}
+		if !reflect.DeepEqual(prevValue, nextValue) {
+			*changed = append(*changed, name)
+		}
+	}
+}
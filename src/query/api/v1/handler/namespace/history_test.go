@@ -0,0 +1,172 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHistoryTestNamespaceOptions(retentionNanos int64) *nsproto.NamespaceOptions {
+	return &nsproto.NamespaceOptions{
+		BootstrapEnabled:  true,
+		FlushEnabled:      true,
+		WritesToCommitLog: true,
+		RetentionOptions: &nsproto.RetentionOptions{
+			RetentionPeriodNanos:                     retentionNanos,
+			BlockSizeNanos:                           7200000000000,
+			BufferFutureNanos:                        600000000000,
+			BufferPastNanos:                          600000000000,
+			BlockDataExpiry:                          true,
+			BlockDataExpiryAfterNotAccessPeriodNanos: 3600000000000,
+		},
+	}
+}
+
+// setupNamespaceHistoryTest returns a client backed by an in memory store
+// holding three versions of the namespace registry.
+func setupNamespaceHistoryTest(t *testing.T, ctrl *gomock.Controller) (*client.MockClient, kv.Store) {
+	store := mem.NewStore()
+	for _, registry := range []*nsproto.Registry{
+		{Namespaces: map[string]*nsproto.NamespaceOptions{
+			"a": newHistoryTestNamespaceOptions(172800000000000),
+		}},
+		{Namespaces: map[string]*nsproto.NamespaceOptions{
+			"a": newHistoryTestNamespaceOptions(172800000000000),
+			"b": newHistoryTestNamespaceOptions(172800000000000),
+		}},
+		{Namespaces: map[string]*nsproto.NamespaceOptions{
+			"b": newHistoryTestNamespaceOptions(345600000000000),
+		}},
+	} {
+		_, err := store.Set(M3DBNodeNamespacesKey, registry)
+		require.NoError(t, err)
+	}
+
+	mockClient := client.NewMockClient(ctrl)
+	mockClient.EXPECT().KV().Return(store, nil).AnyTimes()
+	return mockClient, store
+}
+
+func TestNamespaceHistoryHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, _ := setupNamespaceHistoryTest(t, ctrl)
+	historyHandler := NewHistoryHandler(mockClient, instrument.NewOptions())
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(HistoryHTTPMethod, M3DBHistoryURL, nil)
+	historyHandler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var history HistoryResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&history))
+	assert.Equal(t, HistoryResponse{
+		CurrentVersion: 3,
+		Versions: []NamespaceHistoryEntry{
+			{Version: 1, Namespaces: []string{"a"}},
+			{
+				Version:    2,
+				Namespaces: []string{"a", "b"},
+				Diff:       &NamespaceVersionDiff{Added: []string{"b"}},
+			},
+			{
+				Version:    3,
+				Namespaces: []string{"b"},
+				Diff: &NamespaceVersionDiff{
+					Removed: []string{"a"},
+					Modified: []NamespaceChange{{
+						Name:           "b",
+						ChangedOptions: []string{"retentionOptions.retentionPeriodNanos"},
+					}},
+				},
+			},
+		},
+	}, history)
+
+	// The version preceding the range is used to diff the first version.
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(HistoryHTTPMethod, M3DBHistoryURL+"?from=2&to=2", nil)
+	historyHandler.ServeHTTP(w, req)
+
+	resp = w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	history = HistoryResponse{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&history))
+	require.Equal(t, 1, len(history.Versions))
+	assert.Equal(t, &NamespaceVersionDiff{Added: []string{"b"}}, history.Versions[0].Diff)
+}
+
+func TestNamespaceRollbackHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, store := setupNamespaceHistoryTest(t, ctrl)
+	rollbackHandler := NewRollbackHandler(mockClient, instrument.NewOptions())
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(RollbackHTTPMethod, M3DBRollbackURL,
+		strings.NewReader(`{"version": 2}`))
+	rollbackHandler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	value, err := store.Get(M3DBNodeNamespacesKey)
+	require.NoError(t, err)
+	assert.Equal(t, 4, value.Version())
+
+	var registry nsproto.Registry
+	require.NoError(t, value.Unmarshal(&registry))
+	assert.Equal(t, []string{"a", "b"}, namespaceNames(&registry))
+	assert.Equal(t, int64(172800000000000),
+		registry.Namespaces["b"].RetentionOptions.RetentionPeriodNanos)
+}
+
+func TestNamespaceRollbackHandlerInvalidVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, _ := setupNamespaceHistoryTest(t, ctrl)
+	rollbackHandler := NewRollbackHandler(mockClient, instrument.NewOptions())
+
+	for _, body := range []string{`{}`, `{"version": 3}`, `{"version": 5}`} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(RollbackHTTPMethod, M3DBRollbackURL,
+			strings.NewReader(body))
+		rollbackHandler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode, body)
+	}
+}
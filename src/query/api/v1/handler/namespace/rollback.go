@@ -0,0 +1,169 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// M3DBRollbackURL is the url for the namespace rollback handler (with the
+	// POST method).
+	M3DBRollbackURL = path.Join(handler.RoutePrefixV1, M3DBServiceNamespacePathName, "rollback")
+
+	// RollbackHTTPMethod is the HTTP method used with this resource.
+	RollbackHTTPMethod = http.MethodPost
+
+	errNoRollbackVersion = errors.New("version to roll back to must be specified")
+)
+
+// RollbackRequest is the request to restore a previous version of the
+// namespace registry.
+type RollbackRequest struct {
+	Version int `json:"version"`
+}
+
+// RollbackHandler is the handler for namespace rollbacks.
+type RollbackHandler Handler
+
+// NewRollbackHandler returns a new instance of RollbackHandler.
+func NewRollbackHandler(
+	client clusterclient.Client,
+	instrumentOpts instrument.Options,
+) *RollbackHandler {
+	return &RollbackHandler{
+		client:         client,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+func (h *RollbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	req, rErr := h.parseRequest(r)
+	if rErr != nil {
+		logger.Error("unable to parse request", zap.Error(rErr))
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	registry, err := h.Rollback(req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(invalidRollbackError); ok {
+			status = http.StatusBadRequest
+		}
+		logger.Error("unable to roll back namespaces", zap.Error(err))
+		xhttp.Error(w, err, status)
+		return
+	}
+
+	resp := &admin.NamespaceGetResponse{
+		Registry: &registry,
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func (h *RollbackHandler) parseRequest(r *http.Request) (*RollbackRequest, *xhttp.ParseError) {
+	defer r.Body.Close()
+
+	req := &RollbackRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	if req.Version <= 0 {
+		return nil, xhttp.NewParseError(errNoRollbackVersion, http.StatusBadRequest)
+	}
+
+	return req, nil
+}
+
+// Rollback restores a previous version of the namespace registry, the
+// restored registry is persisted as a new version.
+func (h *RollbackHandler) Rollback(req *RollbackRequest) (nsproto.Registry, error) {
+	var emptyReg = nsproto.Registry{}
+
+	store, err := h.client.KV()
+	if err != nil {
+		return emptyReg, err
+	}
+
+	current, err := store.Get(M3DBNodeNamespacesKey)
+	if err != nil {
+		return emptyReg, err
+	}
+
+	if req.Version >= current.Version() {
+		return emptyReg, invalidRollbackError{fmt.Errorf(
+			"cannot roll back to version %d, current version is %d",
+			req.Version, current.Version())}
+	}
+
+	values, err := store.History(M3DBNodeNamespacesKey, req.Version, req.Version+1)
+	if err != nil {
+		return emptyReg, err
+	}
+	if len(values) != 1 {
+		return emptyReg, invalidRollbackError{fmt.Errorf(
+			"namespace version %d is not available", req.Version)}
+	}
+
+	var registry nsproto.Registry
+	if err := values[0].Unmarshal(&registry); err != nil {
+		return emptyReg, fmt.Errorf("failed to parse namespace version %v: %v", req.Version, err)
+	}
+
+	// Ensure the restored registry is still valid, options may have since
+	// become stricter.
+	if _, err := namespace.FromProto(registry); err != nil {
+		return emptyReg, invalidRollbackError{fmt.Errorf(
+			"namespace version %d is invalid: %v", req.Version, err)}
+	}
+
+	// Ensure the registry being replaced is still the current one.
+	if _, err := store.CheckAndSet(M3DBNodeNamespacesKey, current.Version(), &registry); err != nil {
+		return emptyReg, err
+	}
+
+	return registry, nil
+}
+
+type invalidRollbackError struct {
+	error
+}
@@ -91,6 +91,11 @@ func (h *AddHandler) ServeHTTP(
 		return
 	}
 
+	if isDryRun(r) {
+		writeDryRunResponse(w, r, svc, h.HandlerOptions, h.nowFn(), placement, logger)
+		return
+	}
+
 	placementProto, err := placement.Proto()
 	if err != nil {
 		logger.Error("unable to get placement protobuf", zap.Error(err))
@@ -127,8 +132,7 @@ func (h *AddHandler) Add(
 		return nil, err
 	}
 
-	serviceOpts := handler.NewServiceOptions(svc, httpReq.Header,
-		h.m3AggServiceOptions)
+	serviceOpts := newServiceOptions(svc, httpReq, h.m3AggServiceOptions)
 	var validateFn placement.ValidateFn
 	if !req.Force {
 		validateFn = validateAllAvailable
@@ -294,6 +294,51 @@ func RegisterRoutes(
 	r.HandleFunc(M3DBReplaceURL, replaceFn).Methods(ReplaceHTTPMethod)
 	r.HandleFunc(M3AggReplaceURL, replaceFn).Methods(ReplaceHTTPMethod)
 	r.HandleFunc(M3CoordinatorReplaceURL, replaceFn).Methods(ReplaceHTTPMethod)
+
+	// Update weights
+	var (
+		updateWeightsHandler = NewUpdateWeightsHandler(opts)
+		updateWeightsFn      = applyMiddleware(updateWeightsHandler.ServeHTTP, defaults, opts.instrumentOptions)
+	)
+	r.HandleFunc(M3DBUpdateWeightsURL, updateWeightsFn).Methods(UpdateWeightsHTTPMethod)
+	r.HandleFunc(M3AggUpdateWeightsURL, updateWeightsFn).Methods(UpdateWeightsHTTPMethod)
+	r.HandleFunc(M3CoordinatorUpdateWeightsURL, updateWeightsFn).Methods(UpdateWeightsHTTPMethod)
+
+	// Rebalance
+	var (
+		rebalanceHandler = NewRebalanceHandler(opts)
+		rebalanceFn      = applyMiddleware(rebalanceHandler.ServeHTTP, defaults, opts.instrumentOptions)
+	)
+	r.HandleFunc(M3DBRebalanceURL, rebalanceFn).Methods(RebalanceHTTPMethod)
+	r.HandleFunc(M3AggRebalanceURL, rebalanceFn).Methods(RebalanceHTTPMethod)
+	r.HandleFunc(M3CoordinatorRebalanceURL, rebalanceFn).Methods(RebalanceHTTPMethod)
+
+	// Deploy plan
+	var (
+		deployPlanHandler = NewDeployPlanHandler(opts)
+		deployPlanFn      = applyMiddleware(deployPlanHandler.ServeHTTP, defaults, opts.instrumentOptions)
+	)
+	r.HandleFunc(M3DBDeployPlanURL, deployPlanFn).Methods(DeployPlanHTTPMethod)
+	r.HandleFunc(M3AggDeployPlanURL, deployPlanFn).Methods(DeployPlanHTTPMethod)
+	r.HandleFunc(M3CoordinatorDeployPlanURL, deployPlanFn).Methods(DeployPlanHTTPMethod)
+
+	// History
+	var (
+		historyHandler = NewHistoryHandler(opts)
+		historyFn      = applyMiddleware(historyHandler.ServeHTTP, defaults, opts.instrumentOptions)
+	)
+	r.HandleFunc(M3DBHistoryURL, historyFn).Methods(HistoryHTTPMethod)
+	r.HandleFunc(M3AggHistoryURL, historyFn).Methods(HistoryHTTPMethod)
+	r.HandleFunc(M3CoordinatorHistoryURL, historyFn).Methods(HistoryHTTPMethod)
+
+	// Rollback
+	var (
+		rollbackHandler = NewRollbackHandler(opts)
+		rollbackFn      = applyMiddleware(rollbackHandler.ServeHTTP, defaults, opts.instrumentOptions)
+	)
+	r.HandleFunc(M3DBRollbackURL, rollbackFn).Methods(RollbackHTTPMethod)
+	r.HandleFunc(M3AggRollbackURL, rollbackFn).Methods(RollbackHTTPMethod)
+	r.HandleFunc(M3CoordinatorRollbackURL, rollbackFn).Methods(RollbackHTTPMethod)
 }
 
 func newPlacementCutoverNanosFn(
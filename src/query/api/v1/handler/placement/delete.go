@@ -91,7 +91,7 @@ func (h *DeleteHandler) ServeHTTP(
 
 	var (
 		force = r.FormValue(placementForceVar) == "true"
-		opts  = handler.NewServiceOptions(svc, r.Header, h.m3AggServiceOptions)
+		opts  = newServiceOptions(svc, r, h.m3AggServiceOptions)
 	)
 
 	service, algo, err := ServiceWithAlgo(h.clusterClient, opts, h.nowFn(), nil)
@@ -152,6 +152,11 @@ func (h *DeleteHandler) ServeHTTP(
 		}
 	}
 
+	if opts.DryRun {
+		writeDryRunResponse(w, r, svc, h.HandlerOptions, h.nowFn(), newPlacement, logger)
+		return
+	}
+
 	placementProto, err := newPlacement.Proto()
 	if err != nil {
 		logger.Error("unable to get placement protobuf", zap.Error(err))
@@ -0,0 +1,169 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/placement/planner"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DeployPlanHTTPMethod is the HTTP method used with this resource.
+	DeployPlanHTTPMethod = http.MethodGet
+
+	deployPlanPathName       = "deploy_plan"
+	deployPlanMaxStepSizeVar = "maxStepSize"
+)
+
+var (
+	// M3DBDeployPlanURL is the url for the placement deploy plan handler
+	// (with the GET method) for the M3DB service.
+	M3DBDeployPlanURL = path.Join(handler.RoutePrefixV1, M3DBServicePlacementPathName, deployPlanPathName)
+
+	// M3AggDeployPlanURL is the url for the placement deploy plan handler
+	// (with the GET method) for the M3Agg service.
+	M3AggDeployPlanURL = path.Join(handler.RoutePrefixV1, M3AggServicePlacementPathName, deployPlanPathName)
+
+	// M3CoordinatorDeployPlanURL is the url for the placement deploy plan
+	// handler (with the GET method) for the M3Coordinator service.
+	M3CoordinatorDeployPlanURL = path.Join(handler.RoutePrefixV1, M3CoordinatorServicePlacementPathName, deployPlanPathName)
+
+	errInvalidMaxStepSize = errors.New("maxStepSize must be a positive integer")
+)
+
+// DeployPlanResponse is the response returned by the deploy plan handler,
+// each step is a group of instances that share no shards and can therefore
+// be restarted in parallel.
+type DeployPlanResponse struct {
+	Version int              `json:"version"`
+	Steps   []DeployPlanStep `json:"steps"`
+}
+
+// DeployPlanStep is a group of instances that can be deployed in parallel.
+type DeployPlanStep struct {
+	Instances []DeployPlanInstance `json:"instances"`
+}
+
+// DeployPlanInstance is an instance to deploy as part of a step.
+type DeployPlanInstance struct {
+	ID             string   `json:"id"`
+	IsolationGroup string   `json:"isolationGroup"`
+	Zone           string   `json:"zone"`
+	Endpoint       string   `json:"endpoint"`
+	Hostname       string   `json:"hostname"`
+	Shards         []uint32 `json:"shards"`
+}
+
+// DeployPlanHandler is the handler for placement deploy plans.
+type DeployPlanHandler Handler
+
+// NewDeployPlanHandler returns a new instance of DeployPlanHandler.
+func NewDeployPlanHandler(opts HandlerOptions) *DeployPlanHandler {
+	return &DeployPlanHandler{HandlerOptions: opts, nowFn: time.Now}
+}
+
+func (h *DeployPlanHandler) ServeHTTP(
+	svc handler.ServiceNameAndDefaults,
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	var (
+		ctx    = r.Context()
+		logger = logging.WithContext(ctx, h.instrumentOptions)
+	)
+
+	deployOpts, err := parseDeploymentOptions(r)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	opts := handler.NewServiceOptions(svc, r.Header, h.m3AggServiceOptions)
+	service, err := Service(h.clusterClient, opts, h.nowFn(), nil)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	p, err := service.Placement()
+	if err != nil {
+		logger.Error("unable to fetch placement", zap.Error(err))
+		xhttp.Error(w, err, http.StatusNotFound)
+		return
+	}
+
+	xhttp.WriteJSONResponse(w, NewDeployPlan(p, deployOpts), logger)
+}
+
+// NewDeployPlan returns the deploy plan for a placement as computed by the
+// shard aware deployment planner.
+func NewDeployPlan(
+	p placement.Placement,
+	opts placement.DeploymentOptions,
+) DeployPlanResponse {
+	var (
+		steps = planner.NewShardAwareDeploymentPlanner(opts).DeploymentSteps(p)
+		resp  = DeployPlanResponse{
+			Version: p.Version(),
+			Steps:   make([]DeployPlanStep, 0, len(steps)),
+		}
+	)
+	for _, step := range steps {
+		instances := make([]DeployPlanInstance, 0, len(step))
+		for _, instance := range step {
+			instances = append(instances, DeployPlanInstance{
+				ID:             instance.ID(),
+				IsolationGroup: instance.IsolationGroup(),
+				Zone:           instance.Zone(),
+				Endpoint:       instance.Endpoint(),
+				Hostname:       instance.Hostname(),
+				Shards:         instance.Shards().AllIDs(),
+			})
+		}
+		resp.Steps = append(resp.Steps, DeployPlanStep{Instances: instances})
+	}
+	return resp
+}
+
+func parseDeploymentOptions(r *http.Request) (placement.DeploymentOptions, error) {
+	opts := placement.NewDeploymentOptions()
+	v := r.URL.Query().Get(deployPlanMaxStepSizeVar)
+	if v == "" {
+		return opts, nil
+	}
+
+	maxStepSize, err := strconv.Atoi(v)
+	if err != nil || maxStepSize <= 0 {
+		return nil, errInvalidMaxStepSize
+	}
+	return opts.SetMaxStepSize(maxStepSize), nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	apihandler "github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeployPlanTestPlacement() placement.Placement {
+	newInstance := func(id string, shards ...uint32) placement.Instance {
+		instance := placement.NewInstance().SetID(id).SetEndpoint(id)
+		for _, s := range shards {
+			instance.Shards().Add(shard.NewShard(s).SetState(shard.Available))
+		}
+		return instance
+	}
+
+	return placement.NewPlacement().
+		SetInstances([]placement.Instance{
+			newInstance("i1", 0, 1),
+			newInstance("i2", 2, 3),
+			newInstance("i3", 0, 2),
+			newInstance("i4", 1, 3),
+		}).
+		SetShards([]uint32{0, 1, 2, 3}).
+		SetReplicaFactor(2).
+		SetIsSharded(true).
+		SetVersion(3)
+}
+
+func TestPlacementDeployPlanHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+	handlerOpts, err := NewHandlerOptions(
+		mockClient, config.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+	handler := NewDeployPlanHandler(handlerOpts)
+
+	mockPlacementService.EXPECT().Placement().Return(newDeployPlanTestPlacement(), nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(DeployPlanHTTPMethod, M3DBDeployPlanURL, nil)
+	svcDefaults := apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}
+	handler.ServeHTTP(svcDefaults, w, req)
+
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var plan DeployPlanResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&plan))
+	assert.Equal(t, 3, plan.Version)
+
+	// No two instances in a step may share a shard and every instance must be
+	// deployed exactly once.
+	seen := make(map[string]struct{})
+	for _, step := range plan.Steps {
+		shards := make(map[uint32]struct{})
+		for _, instance := range step.Instances {
+			for _, s := range instance.Shards {
+				_, ok := shards[s]
+				require.False(t, ok)
+				shards[s] = struct{}{}
+			}
+			seen[instance.ID] = struct{}{}
+		}
+	}
+	assert.Len(t, seen, 4)
+	assert.Len(t, plan.Steps, 2)
+}
+
+func TestPlacementDeployPlanHandlerMaxStepSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+	handlerOpts, err := NewHandlerOptions(
+		mockClient, config.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+	handler := NewDeployPlanHandler(handlerOpts)
+
+	svcDefaults := apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(DeployPlanHTTPMethod, M3DBDeployPlanURL+"?maxStepSize=0", nil)
+	handler.ServeHTTP(svcDefaults, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	mockPlacementService.EXPECT().Placement().Return(newDeployPlanTestPlacement(), nil)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(DeployPlanHTTPMethod, M3DBDeployPlanURL+"?maxStepSize=1", nil)
+	handler.ServeHTTP(svcDefaults, w, req)
+
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var plan DeployPlanResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&plan))
+	assert.Len(t, plan.Steps, 4)
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+const (
+	placementDryRunVar         = "dryRun"
+	placementShardSizeBytesVar = "shardSizeBytes"
+)
+
+// PlacementDryRunResponse is the response returned by the placement add,
+// remove, replace, update weights and rebalance handlers for a dry run, it
+// contains the placement that would have been persisted along with the shard
+// movement it would cause.
+type PlacementDryRunResponse struct {
+	Placement            json.RawMessage         `json:"placement"`
+	Version              int                     `json:"version"`
+	DryRun               bool                    `json:"dryRun"`
+	Diff                 []PlacementInstanceDiff `json:"diff"`
+	ShardsToMove         int                     `json:"shardsToMove"`
+	EstimatedBytesToMove int64                   `json:"estimatedBytesToMove"`
+}
+
+// PlacementInstanceDiff describes the shards an instance would receive and
+// give up as a result of a placement change.
+type PlacementInstanceDiff struct {
+	ID                   string   `json:"id"`
+	AddedShards          []uint32 `json:"addedShards"`
+	RemovedShards        []uint32 `json:"removedShards"`
+	EstimatedBytesToMove int64    `json:"estimatedBytesToMove"`
+}
+
+// DiffPlacements returns the shard movement per instance between the current
+// and the new placement, instances without any shard movement are omitted.
+// The bytes to move for an instance is estimated as the number of shards it
+// receives multiplied by the provided shard size.
+func DiffPlacements(
+	curr placement.Placement,
+	next placement.Placement,
+	shardSizeBytes int64,
+) []PlacementInstanceDiff {
+	ids := make(map[string]struct{})
+	if curr != nil {
+		for _, instance := range curr.Instances() {
+			ids[instance.ID()] = struct{}{}
+		}
+	}
+	for _, instance := range next.Instances() {
+		ids[instance.ID()] = struct{}{}
+	}
+
+	sortedIDs := make([]string, 0, len(ids))
+	for id := range ids {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Strings(sortedIDs)
+
+	var diffs []PlacementInstanceDiff
+	for _, id := range sortedIDs {
+		var (
+			currShards = instanceShards(curr, id)
+			nextShards = instanceShards(next, id)
+			diff       = PlacementInstanceDiff{ID: id}
+		)
+		for _, s := range nextShards.All() {
+			prev, ok := currShards.Shard(s.ID())
+			switch s.State() {
+			case shard.Initializing:
+				if !ok || prev.State() != shard.Initializing {
+					diff.AddedShards = append(diff.AddedShards, s.ID())
+				}
+			case shard.Leaving:
+				if ok && prev.State() != shard.Leaving {
+					diff.RemovedShards = append(diff.RemovedShards, s.ID())
+				}
+			}
+		}
+		for _, s := range currShards.All() {
+			if !nextShards.Contains(s.ID()) && s.State() != shard.Leaving {
+				diff.RemovedShards = append(diff.RemovedShards, s.ID())
+			}
+		}
+		if len(diff.AddedShards) == 0 && len(diff.RemovedShards) == 0 {
+			continue
+		}
+
+		diff.EstimatedBytesToMove = int64(len(diff.AddedShards)) * shardSizeBytes
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+func instanceShards(p placement.Placement, id string) shard.Shards {
+	if p == nil {
+		return shard.NewShards(nil)
+	}
+	instance, ok := p.Instance(id)
+	if !ok {
+		return shard.NewShards(nil)
+	}
+	return instance.Shards()
+}
+
+func isDryRun(r *http.Request) bool {
+	return r.URL.Query().Get(placementDryRunVar) == "true" ||
+		r.Header.Get(handler.HeaderDryRun) == "true"
+}
+
+// newServiceOptions returns the service options for a placement change
+// request, a dry run may be requested with either the dry run header or the
+// dryRun query parameter.
+func newServiceOptions(
+	svc handler.ServiceNameAndDefaults,
+	r *http.Request,
+	m3AggOpts *handler.M3AggServiceOptions,
+) handler.ServiceOptions {
+	opts := handler.NewServiceOptions(svc, r.Header, m3AggOpts)
+	if isDryRun(r) {
+		opts.DryRun = true
+	}
+	return opts
+}
+
+func parseShardSizeBytes(r *http.Request) (int64, error) {
+	v := r.URL.Query().Get(placementShardSizeBytesVar)
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+// writeDryRunResponse writes the placement a dry run would have persisted,
+// diffed against the placement that is currently persisted.
+func writeDryRunResponse(
+	w http.ResponseWriter,
+	r *http.Request,
+	svc handler.ServiceNameAndDefaults,
+	opts HandlerOptions,
+	now time.Time,
+	newPlacement placement.Placement,
+	logger *zap.Logger,
+) {
+	shardSizeBytes, err := parseShardSizeBytes(r)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	serviceOpts := handler.NewServiceOptions(svc, r.Header, opts.m3AggServiceOptions)
+	service, err := Service(opts.clusterClient, serviceOpts, now, nil)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	curPlacement, err := service.Placement()
+	if err != nil {
+		logger.Error("unable to fetch placement", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	placementProto, err := newPlacement.Proto()
+	if err != nil {
+		logger.Error("unable to get placement protobuf", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		buff      bytes.Buffer
+		marshaler = jsonpb.Marshaler{EmitDefaults: true}
+	)
+	if err := marshaler.Marshal(&buff, placementProto); err != nil {
+		logger.Error("unable to marshal placement", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := PlacementDryRunResponse{
+		Placement: json.RawMessage(buff.Bytes()),
+		Version:   newPlacement.Version(),
+		DryRun:    true,
+		Diff:      DiffPlacements(curPlacement, newPlacement, shardSizeBytes),
+	}
+	for _, diff := range resp.Diff {
+		resp.ShardsToMove += len(diff.AddedShards)
+		resp.EstimatedBytesToMove += diff.EstimatedBytesToMove
+	}
+
+	xhttp.WriteJSONResponse(w, resp, logger)
+}
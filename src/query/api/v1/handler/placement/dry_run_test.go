@@ -0,0 +1,144 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	apihandler "github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDryRunTestPlacements() (placement.Placement, placement.Placement) {
+	curr := placement.NewPlacement().
+		SetInstances([]placement.Instance{
+			placement.NewInstance().SetID("A").SetShards(shard.NewShards([]shard.Shard{
+				shard.NewShard(0).SetState(shard.Available),
+			})),
+			placement.NewInstance().SetID("B").SetShards(shard.NewShards([]shard.Shard{
+				shard.NewShard(1).SetState(shard.Available),
+			})),
+		}).
+		SetShards([]uint32{0, 1}).
+		SetReplicaFactor(1).
+		SetIsSharded(true)
+
+	next := placement.NewPlacement().
+		SetInstances([]placement.Instance{
+			placement.NewInstance().SetID("A").SetShards(shard.NewShards([]shard.Shard{
+				shard.NewShard(0).SetState(shard.Leaving),
+			})),
+			placement.NewInstance().SetID("B").SetShards(shard.NewShards([]shard.Shard{
+				shard.NewShard(1).SetState(shard.Available),
+			})),
+			placement.NewInstance().SetID("C").SetShards(shard.NewShards([]shard.Shard{
+				shard.NewShard(0).SetState(shard.Initializing).SetSourceID("A"),
+			})),
+		}).
+		SetShards([]uint32{0, 1}).
+		SetReplicaFactor(1).
+		SetIsSharded(true)
+
+	return curr, next
+}
+
+func TestDiffPlacements(t *testing.T) {
+	curr, next := newDryRunTestPlacements()
+
+	diffs := DiffPlacements(curr, next, 100)
+	require.Equal(t, []PlacementInstanceDiff{
+		{ID: "A", RemovedShards: []uint32{0}},
+		{ID: "C", AddedShards: []uint32{0}, EstimatedBytesToMove: 100},
+	}, diffs)
+
+	// Shards no longer in the placement at all are also removed.
+	diffs = DiffPlacements(curr, placement.NewPlacement().
+		SetInstances([]placement.Instance{curr.Instances()[1]}), 100)
+	require.Equal(t, []PlacementInstanceDiff{
+		{ID: "A", RemovedShards: []uint32{0}},
+	}, diffs)
+
+	assert.Empty(t, DiffPlacements(curr, curr, 100))
+}
+
+func TestPlacementAddHandlerDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+	handlerOpts, err := NewHandlerOptions(
+		mockClient, config.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+
+	handler := NewAddHandler(handlerOpts)
+	handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+	curr, next := newDryRunTestPlacements()
+	mockPlacementService.EXPECT().AddInstances(gomock.Any()).Return(next, nil, nil)
+	mockPlacementService.EXPECT().Placement().Return(curr, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(AddHTTPMethod, M3DBAddURL+"?dryRun=true&shardSizeBytes=100",
+		strings.NewReader(`{"force": true, "instances":[{"id": "C","isolation_group": "rack1","zone": "test","weight": 1,"endpoint": "http://C:1234","hostname": "C","port": 1234}]}`))
+
+	svcDefaults := apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}
+	handler.ServeHTTP(svcDefaults, w, req)
+
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var dryRunResp PlacementDryRunResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&dryRunResp))
+	assert.True(t, dryRunResp.DryRun)
+	assert.Equal(t, int64(100), dryRunResp.EstimatedBytesToMove)
+	assert.Equal(t, 1, dryRunResp.ShardsToMove)
+	assert.Equal(t, []PlacementInstanceDiff{
+		{ID: "A", RemovedShards: []uint32{0}},
+		{ID: "C", AddedShards: []uint32{0}, EstimatedBytesToMove: 100},
+	}, dryRunResp.Diff)
+	assert.Contains(t, string(dryRunResp.Placement), `"C"`)
+}
+
+func TestIsDryRun(t *testing.T) {
+	req := httptest.NewRequest(AddHTTPMethod, M3DBAddURL, nil)
+	assert.False(t, isDryRun(req))
+
+	req = httptest.NewRequest(AddHTTPMethod, M3DBAddURL+"?dryRun=true", nil)
+	assert.True(t, isDryRun(req))
+
+	req = httptest.NewRequest(AddHTTPMethod, M3DBAddURL, nil)
+	req.Header.Set(apihandler.HeaderDryRun, "true")
+	assert.True(t, isDryRun(req))
+}
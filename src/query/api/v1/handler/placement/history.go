@@ -0,0 +1,250 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"net/http"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// HistoryHTTPMethod is the HTTP method used with this resource.
+	HistoryHTTPMethod = http.MethodGet
+
+	historyPathName = "history"
+)
+
+var (
+	// M3DBHistoryURL is the url for the placement history handler (with the
+	// GET method) for the M3DB service.
+	M3DBHistoryURL = path.Join(handler.RoutePrefixV1, M3DBServicePlacementPathName, historyPathName)
+
+	// M3AggHistoryURL is the url for the placement history handler (with the
+	// GET method) for the M3Agg service.
+	M3AggHistoryURL = path.Join(handler.RoutePrefixV1, M3AggServicePlacementPathName, historyPathName)
+
+	// M3CoordinatorHistoryURL is the url for the placement history handler
+	// (with the GET method) for the M3Coordinator service.
+	M3CoordinatorHistoryURL = path.Join(handler.RoutePrefixV1, M3CoordinatorServicePlacementPathName, historyPathName)
+)
+
+// HistoryResponse is the response returned by the placement history handler.
+type HistoryResponse struct {
+	CurrentVersion int                     `json:"currentVersion"`
+	Versions       []PlacementHistoryEntry `json:"versions"`
+}
+
+// PlacementHistoryEntry describes a single historical version of a
+// placement, the diff is relative to the preceding version and is omitted
+// for the first version.
+type PlacementHistoryEntry struct {
+	Version int `json:"version"`
+	// Timestamp is the cutover time of the placement, KV stores do not record
+	// when a version was written so it is omitted for placements that do not
+	// set a cutover time.
+	Timestamp    *time.Time            `json:"timestamp,omitempty"`
+	NumInstances int                   `json:"numInstances"`
+	Diff         *PlacementVersionDiff `json:"diff,omitempty"`
+}
+
+// PlacementVersionDiff is the semantic difference between two versions of a
+// placement.
+type PlacementVersionDiff struct {
+	AddedInstances        []string                `json:"addedInstances,omitempty"`
+	RemovedInstances      []string                `json:"removedInstances,omitempty"`
+	WeightChanges         []PlacementWeightChange `json:"weightChanges,omitempty"`
+	ReplicaFactorChange   *PlacementValueChange   `json:"replicaFactorChange,omitempty"`
+	NumShardsChange       *PlacementValueChange   `json:"numShardsChange,omitempty"`
+	ShardMovements        []PlacementInstanceDiff `json:"shardMovements,omitempty"`
+	ShardsMarkedAvailable map[string][]uint32     `json:"shardsMarkedAvailable,omitempty"`
+}
+
+// PlacementWeightChange is a change of the weight of an instance.
+type PlacementWeightChange struct {
+	ID   string `json:"id"`
+	From uint32 `json:"from"`
+	To   uint32 `json:"to"`
+}
+
+// PlacementValueChange is a change of a placement wide value.
+type PlacementValueChange struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+// HistoryHandler is the handler for placement history.
+type HistoryHandler Handler
+
+// NewHistoryHandler returns a new instance of HistoryHandler.
+func NewHistoryHandler(opts HandlerOptions) *HistoryHandler {
+	return &HistoryHandler{HandlerOptions: opts, nowFn: time.Now}
+}
+
+func (h *HistoryHandler) ServeHTTP(
+	svc handler.ServiceNameAndDefaults,
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	var (
+		ctx    = r.Context()
+		logger = logging.WithContext(ctx, h.instrumentOptions)
+	)
+
+	opts := handler.NewServiceOptions(svc, r.Header, h.m3AggServiceOptions)
+	service, err := Service(h.clusterClient, opts, h.nowFn(), nil)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	curPlacement, err := service.Placement()
+	if err != nil {
+		logger.Error("unable to fetch placement", zap.Error(err))
+		xhttp.Error(w, err, http.StatusNotFound)
+		return
+	}
+
+	rng, err := handler.ParseVersionRange(r, curPlacement.Version())
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	resp := HistoryResponse{
+		CurrentVersion: curPlacement.Version(),
+		Versions:       make([]PlacementHistoryEntry, 0, rng.To-rng.From+1),
+	}
+
+	// Fetch the version preceding the range so the first version returned
+	// can be diffed as well.
+	var prev placement.Placement
+	if rng.From > 1 {
+		if prev, err = service.PlacementForVersion(rng.From - 1); err != nil {
+			logger.Error("unable to fetch placement version",
+				zap.Int("version", rng.From-1), zap.Error(err))
+			xhttp.Error(w, err, http.StatusInternalServerError)
+			return
+		}
+	}
+
+	for version := rng.From; version <= rng.To; version++ {
+		p := curPlacement
+		if version != curPlacement.Version() {
+			if p, err = service.PlacementForVersion(version); err != nil {
+				logger.Error("unable to fetch placement version",
+					zap.Int("version", version), zap.Error(err))
+				xhttp.Error(w, err, http.StatusInternalServerError)
+				return
+			}
+		}
+
+		entry := PlacementHistoryEntry{
+			Version:      version,
+			NumInstances: p.NumInstances(),
+		}
+		if cutover := p.CutoverNanos(); cutover > 0 {
+			ts := time.Unix(0, cutover).UTC()
+			entry.Timestamp = &ts
+		}
+		if prev != nil {
+			diff := DiffPlacementVersions(prev, p)
+			entry.Diff = &diff
+		}
+
+		resp.Versions = append(resp.Versions, entry)
+		prev = p
+	}
+
+	xhttp.WriteJSONResponse(w, resp, logger)
+}
+
+// DiffPlacementVersions returns the semantic difference between two versions
+// of a placement.
+func DiffPlacementVersions(prev, next placement.Placement) PlacementVersionDiff {
+	var diff PlacementVersionDiff
+	for _, instance := range next.Instances() {
+		prevInstance, ok := prev.Instance(instance.ID())
+		if !ok {
+			diff.AddedInstances = append(diff.AddedInstances, instance.ID())
+			continue
+		}
+		if prevInstance.Weight() != instance.Weight() {
+			diff.WeightChanges = append(diff.WeightChanges, PlacementWeightChange{
+				ID:   instance.ID(),
+				From: prevInstance.Weight(),
+				To:   instance.Weight(),
+			})
+		}
+
+		var available []uint32
+		for _, s := range instance.Shards().ShardsForState(shard.Available) {
+			if prevShard, ok := prevInstance.Shards().Shard(s.ID()); ok &&
+				prevShard.State() == shard.Initializing {
+				available = append(available, s.ID())
+			}
+		}
+		if len(available) > 0 {
+			if diff.ShardsMarkedAvailable == nil {
+				diff.ShardsMarkedAvailable = make(map[string][]uint32)
+			}
+			sort.Slice(available, func(i, j int) bool {
+				return available[i] < available[j]
+			})
+			diff.ShardsMarkedAvailable[instance.ID()] = available
+		}
+	}
+	for _, instance := range prev.Instances() {
+		if _, ok := next.Instance(instance.ID()); !ok {
+			diff.RemovedInstances = append(diff.RemovedInstances, instance.ID())
+		}
+	}
+	sort.Strings(diff.AddedInstances)
+	sort.Strings(diff.RemovedInstances)
+	sort.Slice(diff.WeightChanges, func(i, j int) bool {
+		return diff.WeightChanges[i].ID < diff.WeightChanges[j].ID
+	})
+
+	if prev.ReplicaFactor() != next.ReplicaFactor() {
+		diff.ReplicaFactorChange = &PlacementValueChange{
+			From: prev.ReplicaFactor(),
+			To:   next.ReplicaFactor(),
+		}
+	}
+	if prev.NumShards() != next.NumShards() {
+		diff.NumShardsChange = &PlacementValueChange{
+			From: prev.NumShards(),
+			To:   next.NumShards(),
+		}
+	}
+
+	diff.ShardMovements = DiffPlacements(prev, next, 0)
+	return diff
+}
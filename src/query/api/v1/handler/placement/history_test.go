@@ -0,0 +1,145 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	apihandler "github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHistoryTestInstance(
+	id string,
+	weight uint32,
+	state shard.State,
+	shards ...uint32,
+) placement.Instance {
+	instance := placement.NewEmptyInstance(id, "r-"+id, "z1", id, weight)
+	for _, s := range shards {
+		instance.Shards().Add(shard.NewShard(s).SetState(state))
+	}
+	return instance
+}
+
+func newHistoryTestPlacement(version int, instances ...placement.Instance) placement.Placement {
+	return placement.NewPlacement().
+		SetInstances(instances).
+		SetShards([]uint32{0, 1, 2, 3}).
+		SetReplicaFactor(1).
+		SetIsSharded(true).
+		SetVersion(version)
+}
+
+func TestPlacementHistoryHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+	handlerOpts, err := NewHandlerOptions(mockClient, config.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+	handler := NewHistoryHandler(handlerOpts)
+	handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+	v1 := newHistoryTestPlacement(1,
+		newHistoryTestInstance("A", 1, shard.Available, 0, 1, 2, 3))
+	leavingA := newHistoryTestInstance("A", 1, shard.Available, 0, 1)
+	leavingA.Shards().Add(shard.NewShard(2).SetState(shard.Leaving))
+	leavingA.Shards().Add(shard.NewShard(3).SetState(shard.Leaving))
+	v2 := newHistoryTestPlacement(2,
+		leavingA,
+		newHistoryTestInstance("B", 1, shard.Initializing, 2, 3))
+	v3 := newHistoryTestPlacement(3,
+		newHistoryTestInstance("A", 2, shard.Available, 0, 1),
+		newHistoryTestInstance("B", 1, shard.Available, 2, 3)).
+		SetCutoverNanos(time.Unix(100, 0).UnixNano())
+
+	mockPlacementService.EXPECT().Placement().Return(v3, nil)
+	mockPlacementService.EXPECT().PlacementForVersion(1).Return(v1, nil)
+	mockPlacementService.EXPECT().PlacementForVersion(2).Return(v2, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(HistoryHTTPMethod, M3DBHistoryURL+"?from=2", nil)
+	handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}, w, req)
+
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var history HistoryResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&history))
+	assert.Equal(t, 3, history.CurrentVersion)
+	require.Equal(t, 2, len(history.Versions))
+
+	first := history.Versions[0]
+	assert.Equal(t, 2, first.Version)
+	assert.Nil(t, first.Timestamp)
+	require.NotNil(t, first.Diff)
+	assert.Equal(t, []string{"B"}, first.Diff.AddedInstances)
+	assert.Equal(t, []PlacementInstanceDiff{
+		{ID: "A", RemovedShards: []uint32{2, 3}},
+		{ID: "B", AddedShards: []uint32{2, 3}},
+	}, first.Diff.ShardMovements)
+
+	second := history.Versions[1]
+	assert.Equal(t, 3, second.Version)
+	require.NotNil(t, second.Timestamp)
+	assert.True(t, time.Unix(100, 0).Equal(*second.Timestamp))
+	require.NotNil(t, second.Diff)
+	assert.Equal(t, []PlacementWeightChange{{ID: "A", From: 1, To: 2}},
+		second.Diff.WeightChanges)
+	assert.Equal(t, map[string][]uint32{"B": {2, 3}},
+		second.Diff.ShardsMarkedAvailable)
+	assert.Empty(t, second.Diff.ShardMovements)
+}
+
+func TestPlacementHistoryHandler_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+	handlerOpts, err := NewHandlerOptions(mockClient, config.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+	handler := NewHistoryHandler(handlerOpts)
+
+	mockPlacementService.EXPECT().Placement().
+		Return(newHistoryTestPlacement(3), nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(HistoryHTTPMethod, M3DBHistoryURL+"?to=4", nil)
+	handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}, w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
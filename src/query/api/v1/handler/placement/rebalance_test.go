@@ -0,0 +1,135 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	apihandler "github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRebalanceRequest(body string) *http.Request {
+	rb := strings.NewReader(body)
+	return httptest.NewRequest(RebalanceHTTPMethod, M3DBRebalanceURL, rb)
+}
+
+func TestPlacementRebalanceHandler_Force(t *testing.T) {
+	runForAllAllowedServices(func(serviceName string) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+		handlerOpts, err := NewHandlerOptions(mockClient, config.Configuration{}, nil, instrument.NewOptions())
+		require.NoError(t, err)
+		handler := NewRebalanceHandler(handlerOpts)
+		handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+		w := httptest.NewRecorder()
+		req := newRebalanceRequest(`{"force": true}`)
+		mockPlacementService.EXPECT().Rebalance().Return(placement.NewPlacement(), nil)
+		handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+			ServiceName: serviceName,
+		}, w, req)
+
+		resp := w.Result()
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, `{"placement":{"instances":{},"replicaFactor":0,"numShards":0,"isSharded":false,"cutoverTime":"0","isMirrored":false,"maxShardSetId":0},"version":0}`, string(body))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+type placementRebalanceMatcher struct{}
+
+func (placementRebalanceMatcher) Matches(x interface{}) bool {
+	pl := x.(placement.Placement)
+
+	instA, ok := pl.Instance("A")
+	if !ok {
+		return false
+	}
+
+	instB, ok := pl.Instance("B")
+	if !ok {
+		return false
+	}
+
+	return instA.Shards().NumShardsForState(shard.Leaving) == 1 &&
+		instB.Shards().NumShardsForState(shard.Initializing) == 1
+}
+
+func (placementRebalanceMatcher) String() string {
+	return "matches if the placement has one shard moving from A to B"
+}
+
+func TestPlacementRebalanceHandler_SafeOK(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+	handlerOpts, err := NewHandlerOptions(mockClient, config.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+	handler := NewRebalanceHandler(handlerOpts)
+	handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+
+	newInstance := func(id, group string, shards ...uint32) placement.Instance {
+		instance := placement.NewEmptyInstance(id, group, "z1", id, 1)
+		for _, s := range shards {
+			instance.Shards().Add(shard.NewShard(s).SetState(shard.Available))
+		}
+		return instance
+	}
+	pl := placement.NewPlacement().
+		SetInstances([]placement.Instance{
+			newInstance("A", "r1", 0, 1, 2),
+			newInstance("B", "r2", 3),
+		}).
+		SetShards([]uint32{0, 1, 2, 3}).
+		SetReplicaFactor(1).
+		SetIsSharded(true).
+		SetVersion(2)
+
+	mockPlacementService.EXPECT().Placement().Return(pl, nil)
+	mockPlacementService.EXPECT().CheckAndSet(placementRebalanceMatcher{}, 2).
+		Return(pl.Clone().SetVersion(3), nil)
+
+	// The request body is optional.
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(RebalanceHTTPMethod, M3DBRebalanceURL, nil)
+	handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}, w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -88,6 +88,11 @@ func (h *ReplaceHandler) ServeHTTP(
 		return
 	}
 
+	if isDryRun(r) {
+		writeDryRunResponse(w, r, svc, h.HandlerOptions, h.nowFn(), placement, logger)
+		return
+	}
+
 	placementProto, err := placement.Proto()
 	if err != nil {
 		logger.Error("unable to get placement protobuf", zap.Error(err))
@@ -125,7 +130,7 @@ func (h *ReplaceHandler) Replace(
 		return nil, err
 	}
 
-	serviceOpts := handler.NewServiceOptions(svc, httpReq.Header, h.m3AggServiceOptions)
+	serviceOpts := newServiceOptions(svc, httpReq, h.m3AggServiceOptions)
 	service, algo, err := ServiceWithAlgo(h.clusterClient, serviceOpts, h.nowFn(), nil)
 	if err != nil {
 		return nil, err
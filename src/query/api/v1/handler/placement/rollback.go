@@ -0,0 +1,247 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// RollbackHTTPMethod is the HTTP method for the rollback endpoint.
+	RollbackHTTPMethod = http.MethodPost
+
+	rollbackPathName = "rollback"
+)
+
+var (
+	// M3DBRollbackURL is the url for the m3db rollback handler (method POST).
+	M3DBRollbackURL = path.Join(handler.RoutePrefixV1, M3DBServicePlacementPathName, rollbackPathName)
+
+	// M3AggRollbackURL is the url for the m3aggregator rollback handler
+	// (method POST).
+	M3AggRollbackURL = path.Join(handler.RoutePrefixV1, M3AggServicePlacementPathName, rollbackPathName)
+
+	// M3CoordinatorRollbackURL is the url for the m3coordinator rollback
+	// handler (method POST).
+	M3CoordinatorRollbackURL = path.Join(handler.RoutePrefixV1, M3CoordinatorServicePlacementPathName, rollbackPathName)
+
+	errNoRollbackVersion = errors.New("version to roll back to must be specified")
+)
+
+// RollbackRequest is the request to restore a previous version of a
+// placement.
+type RollbackRequest struct {
+	Version int `json:"version"`
+	// By default rollbacks will only succeed if all instances in the current
+	// placement are AVAILABLE for all their shards and the shards the
+	// restored placement expects an instance to own are currently held by
+	// that instance. Force overrides that.
+	Force bool `json:"force"`
+}
+
+// RollbackHandler is the type for placement rollbacks.
+type RollbackHandler Handler
+
+// NewRollbackHandler returns a new RollbackHandler.
+func NewRollbackHandler(opts HandlerOptions) *RollbackHandler {
+	return &RollbackHandler{HandlerOptions: opts, nowFn: time.Now}
+}
+
+func (h *RollbackHandler) ServeHTTP(
+	svc handler.ServiceNameAndDefaults,
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx, h.instrumentOptions)
+
+	req, pErr := h.parseRequest(r)
+	if pErr != nil {
+		xhttp.Error(w, pErr.Inner(), pErr.Code())
+		return
+	}
+
+	placement, err := h.Rollback(svc, r, req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch err.(type) {
+		case unsafeAddError, unsafeRollbackError, invalidRollbackVersionError:
+			status = http.StatusBadRequest
+		}
+		logger.Error("unable to roll back placement", zap.Error(err))
+		xhttp.Error(w, err, status)
+		return
+	}
+
+	if isDryRun(r) {
+		writeDryRunResponse(w, r, svc, h.HandlerOptions, h.nowFn(), placement, logger)
+		return
+	}
+
+	placementProto, err := placement.Proto()
+	if err != nil {
+		logger.Error("unable to get placement protobuf", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := &admin.PlacementGetResponse{
+		Placement: placementProto,
+		Version:   int32(placement.Version()),
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func (h *RollbackHandler) parseRequest(r *http.Request) (*RollbackRequest, *xhttp.ParseError) {
+	defer r.Body.Close()
+
+	req := &RollbackRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	if req.Version <= 0 {
+		return nil, xhttp.NewParseError(errNoRollbackVersion, http.StatusBadRequest)
+	}
+
+	return req, nil
+}
+
+// Rollback restores a previous version of a placement, the restored
+// placement is persisted as a new version.
+func (h *RollbackHandler) Rollback(
+	svc handler.ServiceNameAndDefaults,
+	httpReq *http.Request,
+	req *RollbackRequest,
+) (placement.Placement, error) {
+	serviceOpts := newServiceOptions(svc, httpReq, h.m3AggServiceOptions)
+	service, err := Service(h.clusterClient, serviceOpts, h.nowFn(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	curPlacement, err := service.Placement()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Version >= curPlacement.Version() {
+		return nil, invalidRollbackVersionError{
+			version: req.Version,
+			current: curPlacement.Version(),
+		}
+	}
+
+	target, err := service.PlacementForVersion(req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := placement.Validate(target); err != nil {
+		return nil, err
+	}
+
+	// M3Coordinator isn't sharded, can't check if its shards are available.
+	if !req.Force && !isStateless(svc.ServiceName) {
+		if err := validateAllAvailable(curPlacement); err != nil {
+			return nil, err
+		}
+		if err := validateRollback(curPlacement, target); err != nil {
+			return nil, err
+		}
+	}
+
+	// Ensure the placement we're replacing is still the one the rollback was
+	// validated against.
+	return service.CheckAndSet(target, curPlacement.Version())
+}
+
+type invalidRollbackVersionError struct {
+	version int
+	current int
+}
+
+func (e invalidRollbackVersionError) Error() string {
+	return fmt.Sprintf("cannot roll back to version %d, current version is %d",
+		e.version, e.current)
+}
+
+type unsafeRollbackError struct {
+	shards []string
+}
+
+func (e unsafeRollbackError) Error() string {
+	return fmt.Sprintf("restored placement expects shards [%s] to be held by "+
+		"instances that do not currently hold them", strings.Join(e.shards, ","))
+}
+
+// validateRollback ensures that restoring the target placement does not
+// require data the current placement does not have, every shard an instance
+// serves or streams from in the target must currently be held by it.
+func validateRollback(curr, target placement.Placement) error {
+	var bad []string
+	check := func(id string, s shard.Shard) {
+		instance, ok := curr.Instance(id)
+		if ok {
+			if currShard, ok := instance.Shards().Shard(s.ID()); ok &&
+				currShard.State() != shard.Initializing {
+				return
+			}
+		}
+		bad = append(bad, fmt.Sprintf("%s:%d", id, s.ID()))
+	}
+
+	for _, instance := range target.Instances() {
+		for _, s := range instance.Shards().All() {
+			switch s.State() {
+			case shard.Available, shard.Leaving:
+				check(instance.ID(), s)
+			case shard.Initializing:
+				if s.SourceID() != "" {
+					check(s.SourceID(), s)
+				}
+			}
+		}
+	}
+
+	if len(bad) > 0 {
+		sort.Strings(bad)
+		return unsafeRollbackError{shards: bad}
+	}
+	return nil
+}
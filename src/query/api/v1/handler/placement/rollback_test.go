@@ -0,0 +1,177 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	apihandler "github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRollbackRequest(body string) *http.Request {
+	return httptest.NewRequest(RollbackHTTPMethod, M3DBRollbackURL, strings.NewReader(body))
+}
+
+func newRollbackTestHandler(t *testing.T, ctrl *gomock.Controller) (
+	*RollbackHandler,
+	*placement.MockService,
+) {
+	mockClient, mockPlacementService := SetupPlacementTest(t, ctrl)
+	handlerOpts, err := NewHandlerOptions(mockClient, config.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+	handler := NewRollbackHandler(handlerOpts)
+	handler.nowFn = func() time.Time { return time.Unix(0, 0) }
+	return handler, mockPlacementService
+}
+
+func TestPlacementRollbackHandler_SafeOK(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockPlacementService := newRollbackTestHandler(t, ctrl)
+
+	// Version 3 only changed the weight of A, so both instances still hold
+	// the shards version 2 expects them to.
+	v2 := newHistoryTestPlacement(2,
+		newHistoryTestInstance("A", 1, shard.Available, 0, 1),
+		newHistoryTestInstance("B", 1, shard.Available, 2, 3))
+	v3 := newHistoryTestPlacement(3,
+		newHistoryTestInstance("A", 2, shard.Available, 0, 1),
+		newHistoryTestInstance("B", 1, shard.Available, 2, 3))
+
+	mockPlacementService.EXPECT().Placement().Return(v3, nil)
+	mockPlacementService.EXPECT().PlacementForVersion(2).Return(v2, nil)
+	mockPlacementService.EXPECT().CheckAndSet(v2, 3).Return(v2.Clone().SetVersion(4), nil)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}, w, newRollbackRequest(`{"version": 2}`))
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPlacementRollbackHandler_Unsafe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockPlacementService := newRollbackTestHandler(t, ctrl)
+
+	// Shards 2 and 3 have since moved from A to B, restoring version 1 would
+	// have A serve shards it no longer holds.
+	v1 := newHistoryTestPlacement(1,
+		newHistoryTestInstance("A", 1, shard.Available, 0, 1, 2, 3))
+	v3 := newHistoryTestPlacement(3,
+		newHistoryTestInstance("A", 1, shard.Available, 0, 1),
+		newHistoryTestInstance("B", 1, shard.Available, 2, 3))
+
+	mockPlacementService.EXPECT().Placement().Return(v3, nil)
+	mockPlacementService.EXPECT().PlacementForVersion(1).Return(v1, nil)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}, w, newRollbackRequest(`{"version": 1}`))
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestPlacementRollbackHandler_Force(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockPlacementService := newRollbackTestHandler(t, ctrl)
+
+	v1 := newHistoryTestPlacement(1,
+		newHistoryTestInstance("A", 1, shard.Available, 0, 1, 2, 3))
+	v3 := newHistoryTestPlacement(3,
+		newHistoryTestInstance("A", 1, shard.Available, 0, 1),
+		newHistoryTestInstance("B", 1, shard.Available, 2, 3))
+
+	mockPlacementService.EXPECT().Placement().Return(v3, nil)
+	mockPlacementService.EXPECT().PlacementForVersion(1).Return(v1, nil)
+	mockPlacementService.EXPECT().CheckAndSet(v1, 3).Return(v1.Clone().SetVersion(4), nil)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}, w, newRollbackRequest(`{"version": 1, "force": true}`))
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPlacementRollbackHandler_InvalidVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, mockPlacementService := newRollbackTestHandler(t, ctrl)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}, w, newRollbackRequest(`{}`))
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	mockPlacementService.EXPECT().Placement().
+		Return(newHistoryTestPlacement(3), nil)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(apihandler.ServiceNameAndDefaults{
+		ServiceName: apihandler.M3DBServiceName,
+	}, w, newRollbackRequest(`{"version": 3}`))
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func TestValidateRollback(t *testing.T) {
+	curr := newHistoryTestPlacement(3,
+		newHistoryTestInstance("A", 1, shard.Available, 0, 1),
+		newHistoryTestInstance("B", 1, shard.Available, 2, 3))
+
+	// Moving a shard that is streamed from its current owner is safe.
+	target := newHistoryTestPlacement(2,
+		newHistoryTestInstance("A", 1, shard.Available, 0, 1),
+		newHistoryTestInstance("B", 1, shard.Available, 2, 3),
+		newHistoryTestInstance("C", 1, shard.Initializing))
+	c, _ := target.Instance("C")
+	c.Shards().Add(shard.NewShard(3).SetState(shard.Initializing).SetSourceID("B"))
+	require.NoError(t, validateRollback(curr, target))
+
+	// Streaming a shard from an instance that does not hold it is not.
+	c.Shards().Add(shard.NewShard(1).SetState(shard.Initializing).SetSourceID("B"))
+	err := validateRollback(curr, target)
+	require.Error(t, err)
+	assert.Equal(t, unsafeRollbackError{shards: []string{"B:1"}}, err)
+}
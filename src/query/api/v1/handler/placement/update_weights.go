@@ -0,0 +1,178 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// UpdateWeightsHTTPMethod is the HTTP method for the update weights endpoint.
+	UpdateWeightsHTTPMethod = http.MethodPost
+
+	updateWeightsPathName = "weights"
+)
+
+var (
+	// M3DBUpdateWeightsURL is the url for the m3db update weights handler
+	// (method POST).
+	M3DBUpdateWeightsURL = path.Join(handler.RoutePrefixV1, M3DBServicePlacementPathName, updateWeightsPathName)
+
+	// M3AggUpdateWeightsURL is the url for the m3aggregator update weights
+	// handler (method POST).
+	M3AggUpdateWeightsURL = path.Join(handler.RoutePrefixV1, M3AggServicePlacementPathName, updateWeightsPathName)
+
+	// M3CoordinatorUpdateWeightsURL is the url for the m3coordinator update
+	// weights handler (method POST).
+	M3CoordinatorUpdateWeightsURL = path.Join(handler.RoutePrefixV1, M3CoordinatorServicePlacementPathName, updateWeightsPathName)
+
+	errNoWeights = errors.New("must specify at least one instance weight to update")
+)
+
+// UpdateWeightsRequest is the request to update the weights of instances in
+// a placement.
+type UpdateWeightsRequest struct {
+	// Weights are the new weights keyed by instance ID.
+	Weights map[string]uint32 `json:"weights"`
+	// By default weight updates will only succeed if all instances in the
+	// placement are AVAILABLE for all their shards. Force overrides that.
+	Force bool `json:"force"`
+}
+
+// UpdateWeightsHandler is the type for placement instance weight updates.
+type UpdateWeightsHandler Handler
+
+// NewUpdateWeightsHandler returns a new UpdateWeightsHandler.
+func NewUpdateWeightsHandler(opts HandlerOptions) *UpdateWeightsHandler {
+	return &UpdateWeightsHandler{HandlerOptions: opts, nowFn: time.Now}
+}
+
+func (h *UpdateWeightsHandler) ServeHTTP(
+	svc handler.ServiceNameAndDefaults,
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx, h.instrumentOptions)
+
+	req, pErr := h.parseRequest(r)
+	if pErr != nil {
+		xhttp.Error(w, pErr.Inner(), pErr.Code())
+		return
+	}
+
+	placement, err := h.UpdateWeights(svc, r, req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(unsafeAddError); ok {
+			status = http.StatusBadRequest
+		}
+		logger.Error("unable to update instance weights", zap.Error(err))
+		xhttp.Error(w, err, status)
+		return
+	}
+
+	if isDryRun(r) {
+		writeDryRunResponse(w, r, svc, h.HandlerOptions, h.nowFn(), placement, logger)
+		return
+	}
+
+	placementProto, err := placement.Proto()
+	if err != nil {
+		logger.Error("unable to get placement protobuf", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := &admin.PlacementGetResponse{
+		Placement: placementProto,
+		Version:   int32(placement.Version()),
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func (h *UpdateWeightsHandler) parseRequest(r *http.Request) (*UpdateWeightsRequest, *xhttp.ParseError) {
+	defer r.Body.Close()
+
+	req := &UpdateWeightsRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	if len(req.Weights) == 0 {
+		return nil, xhttp.NewParseError(errNoWeights, http.StatusBadRequest)
+	}
+
+	return req, nil
+}
+
+// UpdateWeights updates the weights of instances.
+func (h *UpdateWeightsHandler) UpdateWeights(
+	svc handler.ServiceNameAndDefaults,
+	httpReq *http.Request,
+	req *UpdateWeightsRequest,
+) (placement.Placement, error) {
+	serviceOpts := newServiceOptions(svc, httpReq, h.m3AggServiceOptions)
+	service, algo, err := ServiceWithAlgo(h.clusterClient, serviceOpts, h.nowFn(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Force {
+		return service.UpdateInstanceWeights(req.Weights)
+	}
+
+	curPlacement, err := service.Placement()
+	if err != nil {
+		return nil, err
+	}
+
+	// M3Coordinator isn't sharded, can't check if its shards are available.
+	if !isStateless(svc.ServiceName) {
+		if err := validateAllAvailable(curPlacement); err != nil {
+			return nil, err
+		}
+	}
+
+	// We use the algorithm directly so that we can CheckAndSet on the placement
+	// to make "atomic" forward progress.
+	newPlacement, err := algo.UpdateInstanceWeights(curPlacement, req.Weights)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the placement we're updating is still the one on which we validated
+	// all shards are available.
+	return service.CheckAndSet(newPlacement, curPlacement.Version())
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/errors"
 	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/explain"
 	"github.com/m3db/m3/src/query/functions/utils"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
@@ -52,6 +53,7 @@ const (
 	timeParam         = "time"
 	queryParam        = "query"
 	debugParam        = "debug"
+	explainParam      = "explain"
 	endExclusiveParam = "end-exclusive"
 	blockTypeParam    = "block-type"
 
@@ -175,6 +177,28 @@ func parseDebugFlag(r *http.Request, instrumentOpts instrument.Options) bool {
 	return debug
 }
 
+// parseExplain attaches an explanation to the request context if the explain
+// param is set, in which case the explanation is returned in place of the
+// query results.
+func parseExplain(r *http.Request) (*http.Request, *explain.Explanation, *xhttp.ParseError) {
+	explainVal := r.FormValue(explainParam)
+	if explainVal == "" {
+		return r, nil, nil
+	}
+
+	explaining, err := strconv.ParseBool(explainVal)
+	if err != nil {
+		return r, nil, xhttp.NewParseError(fmt.Errorf(formatErrStr, explainParam, err), http.StatusBadRequest)
+	}
+
+	if !explaining {
+		return r, nil, nil
+	}
+
+	explanation := explain.New()
+	return r.WithContext(explain.NewContext(r.Context(), explanation)), explanation, nil
+}
+
 func parseBlockType(r *http.Request, instrumentOpts instrument.Options) models.FetchedBlockType {
 	// Use default block type if unable to parse blockTypeParam.
 	useLegacyVal := r.FormValue(blockTypeParam)
@@ -281,11 +305,28 @@ func renderResultsJSON(
 	params models.RequestParams,
 	keepNans bool,
 ) {
-	// NB: if dropping NaNs, drop series with only NaNs from output entirely.
-	if !keepNans {
-		series = filterNaNSeries(series, params.Start, params.End)
-	}
+	rw := newResultsJSONWriter(w, params, keepNans)
+	rw.WriteSeries(series)
+	rw.Close()
+}
+
+// resultsJSONWriter renders a matrix query result incrementally, allowing
+// series to be written in batches so that the whole result does not need
+// to be held in memory at once. Each batch is flushed to the underlying
+// writer, and to the client if the writer supports flushing.
+type resultsJSONWriter struct {
+	jw       *json.Writer
+	flusher  http.Flusher
+	params   models.RequestParams
+	keepNans bool
+}
 
+func newResultsJSONWriter(
+	w io.Writer,
+	params models.RequestParams,
+	keepNans bool,
+) *resultsJSONWriter {
+	flusher, _ := w.(http.Flusher)
 	jw := json.NewWriter(w)
 	jw.BeginObject()
 
@@ -300,6 +341,28 @@ func renderResultsJSON(
 
 	jw.BeginObjectField("result")
 	jw.BeginArray()
+
+	return &resultsJSONWriter{
+		jw:       jw,
+		flusher:  flusher,
+		params:   params,
+		keepNans: keepNans,
+	}
+}
+
+// WriteSeries writes a batch of series and flushes them.
+func (rw *resultsJSONWriter) WriteSeries(series []*ts.Series) error {
+	var (
+		jw       = rw.jw
+		params   = rw.params
+		keepNans = rw.keepNans
+	)
+
+	// NB: if dropping NaNs, drop series with only NaNs from output entirely.
+	if !keepNans {
+		series = filterNaNSeries(series, params.Start, params.End)
+	}
+
 	for _, s := range series {
 		jw.BeginObject()
 		jw.BeginObjectField("metric")
@@ -345,12 +408,27 @@ func renderResultsJSON(
 		}
 		jw.EndObject()
 	}
+
+	if err := jw.Flush(); err != nil {
+		return err
+	}
+
+	if rw.flusher != nil {
+		rw.flusher.Flush()
+	}
+
+	return nil
+}
+
+// Close completes the response.
+func (rw *resultsJSONWriter) Close() error {
+	jw := rw.jw
 	jw.EndArray()
 
 	jw.EndObject()
 
 	jw.EndObject()
-	jw.Close()
+	return jw.Close()
 }
 
 func renderResultsInstantaneousJSON(
@@ -252,6 +252,42 @@ func TestRenderResultsJSON(t *testing.T) {
 	assert.Equal(t, expected, actual, xtest.Diff(expected, actual))
 }
 
+func TestResultsJSONWriterBatches(t *testing.T) {
+	start := time.Unix(1535948880, 0)
+	params := models.RequestParams{}
+	valsWithNaN := ts.NewFixedStepValues(10*time.Second, 2, 1, start)
+	valsWithNaN.SetValueAt(1, math.NaN())
+
+	series := []*ts.Series{
+		ts.NewSeries([]byte("foo"),
+			valsWithNaN, test.TagSliceToTags([]models.Tag{
+				models.Tag{Name: []byte("bar"), Value: []byte("baz")},
+			})),
+		ts.NewSeries([]byte("bar"),
+			ts.NewFixedStepValues(10*time.Second, 2, 2, start), test.TagSliceToTags([]models.Tag{
+				models.Tag{Name: []byte("baz"), Value: []byte("bar")},
+			})),
+		ts.NewSeries([]byte("foobar"),
+			ts.NewFixedStepValues(10*time.Second, 2, math.NaN(), start), test.TagSliceToTags([]models.Tag{
+				models.Tag{Name: []byte("biz"), Value: []byte("baz")},
+			})),
+	}
+
+	expected := bytes.NewBuffer(nil)
+	renderResultsJSON(expected, series, params, false)
+
+	recorder := httptest.NewRecorder()
+	rw := newResultsJSONWriter(recorder, params, false)
+	for _, s := range series {
+		require.NoError(t, rw.WriteSeries([]*ts.Series{s}))
+		assert.True(t, recorder.Flushed)
+	}
+
+	require.NoError(t, rw.Close())
+	assert.Equal(t, mustPrettyJSON(t, expected.String()),
+		mustPrettyJSON(t, recorder.Body.String()))
+}
+
 func TestRenderResultsJSONWithDroppedNaNs(t *testing.T) {
 	var (
 		start       = time.Unix(1535948880, 0)
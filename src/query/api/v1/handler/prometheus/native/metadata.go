@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/metadata"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// PromMetadataURL is the url for the metric metadata handler.
+	PromMetadataURL = handler.RoutePrefixV1 + "/metadata"
+
+	// PromMetadataHTTPMethod is the HTTP method used with this resource.
+	PromMetadataHTTPMethod = http.MethodGet
+
+	metadataLimitParam  = "limit"
+	metadataMetricParam = "metric"
+
+	// counterFunctionOnGaugeWarning is the warning added for each gauge that
+	// a function only meaningful for counters is applied to.
+	counterFunctionOnGaugeWarning = "counter_function_applied_to_gauge"
+)
+
+type metadataResponse struct {
+	Status string                         `json:"status"`
+	Data   map[string][]metadata.Metadata `json:"data"`
+}
+
+// PromMetadataHandler serves the metadata of metrics sent with Prometheus
+// remote writes, in the format of the Prometheus metadata endpoint.
+type PromMetadataHandler struct {
+	store          metadata.Store
+	instrumentOpts instrument.Options
+}
+
+// NewPromMetadataHandler returns a new instance of handler.
+func NewPromMetadataHandler(
+	store metadata.Store,
+	instrumentOpts instrument.Options,
+) http.Handler {
+	return &PromMetadataHandler{
+		store:          store,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+func (h *PromMetadataHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+
+	limit := 0
+	if str := r.URL.Query().Get(metadataLimitParam); str != "" {
+		n, err := strconv.Atoi(str)
+		if err != nil {
+			xhttp.Error(w, fmt.Errorf("invalid limit: %s", str),
+				http.StatusBadRequest)
+			return
+		}
+
+		limit = n
+	}
+
+	data := make(map[string][]metadata.Metadata)
+	if h.store != nil {
+		metric := r.URL.Query().Get(metadataMetricParam)
+		data = h.store.Query(metric, limit)
+	}
+
+	xhttp.WriteJSONResponse(w, metadataResponse{
+		Status: "success",
+		Data:   data,
+	}, logger)
+}
+
+// addCounterFunctionWarnings adds a warning for each gauge that a function
+// only meaningful for counters, such as rate, is applied to in the query.
+func addCounterFunctionWarnings(
+	meta *block.ResultMetadata,
+	query string,
+	store metadata.Store,
+) {
+	if store == nil {
+		return
+	}
+
+	// NB: the query has already been parsed successfully when executed.
+	names, err := promql.CounterFunctionMetrics(query)
+	if err != nil {
+		return
+	}
+
+	for _, name := range names {
+		if m, ok := store.Get(name); ok && m.Type == metadata.GaugeType {
+			meta.AddWarning(name, counterFunctionOnGaugeWarning)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/metadata"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMetadataStore(t *testing.T) metadata.Store {
+	store, err := metadata.Configuration{}.NewStore(nil, instrument.NewOptions())
+	require.NoError(t, err)
+
+	store.Write([]metadata.Metadata{
+		{MetricFamilyName: "a", Type: metadata.CounterType, Help: "a help"},
+		{MetricFamilyName: "b", Type: metadata.GaugeType, Unit: "bytes"},
+		{MetricFamilyName: "c", Type: metadata.SummaryType},
+	})
+	return store
+}
+
+func TestPromMetadataHandler(t *testing.T) {
+	h := NewPromMetadataHandler(newTestMetadataStore(t), instrument.NewOptions())
+
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{
+			url: PromMetadataURL,
+			expected: `{"status":"success","data":{` +
+				`"a":[{"type":"counter","help":"a help","unit":""}],` +
+				`"b":[{"type":"gauge","help":"","unit":"bytes"}],` +
+				`"c":[{"type":"summary","help":"","unit":""}]}}`,
+		},
+		{
+			url: PromMetadataURL + "?limit=1",
+			expected: `{"status":"success","data":{` +
+				`"a":[{"type":"counter","help":"a help","unit":""}]}}`,
+		},
+		{
+			url: PromMetadataURL + "?metric=c",
+			expected: `{"status":"success","data":{` +
+				`"c":[{"type":"summary","help":"","unit":""}]}}`,
+		},
+		{
+			url:      PromMetadataURL + "?metric=d",
+			expected: `{"status":"success","data":{}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			req := httptest.NewRequest(PromMetadataHTTPMethod, tt.url, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			var actual, expected interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &actual))
+			require.NoError(t, json.Unmarshal([]byte(tt.expected), &expected))
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestPromMetadataHandlerInvalidLimit(t *testing.T) {
+	h := NewPromMetadataHandler(newTestMetadataStore(t), instrument.NewOptions())
+	req := httptest.NewRequest(PromMetadataHTTPMethod,
+		PromMetadataURL+"?limit=foo", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAddCounterFunctionWarnings(t *testing.T) {
+	store := newTestMetadataStore(t)
+
+	meta := block.NewResultMetadata()
+	addCounterFunctionWarnings(&meta, "rate(a[1m]) + sum(rate(b[1m]))", store)
+	require.Len(t, meta.Warnings, 1)
+	assert.Equal(t, "b", meta.Warnings[0].Name)
+	assert.Equal(t, counterFunctionOnGaugeWarning, meta.Warnings[0].Message)
+
+	meta = block.NewResultMetadata()
+	addCounterFunctionWarnings(&meta, "b + rate(a[1m])", store)
+	assert.Empty(t, meta.Warnings)
+
+	addCounterFunctionWarnings(&meta, "rate(b[1m])", nil)
+	assert.Empty(t, meta.Warnings)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/metadata"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
@@ -73,6 +74,7 @@ type PromReadHandler struct {
 	promReadMetrics     promReadMetrics
 	timeoutOps          *prometheus.TimeoutOpts
 	keepNans            bool
+	metadataStore       metadata.Store
 	instrumentOpts      instrument.Options
 }
 
@@ -118,6 +120,7 @@ func NewPromReadHandler(
 	limitsCfg *config.LimitsConfiguration,
 	timeoutOpts *prometheus.TimeoutOpts,
 	keepNans bool,
+	metadataStore metadata.Store,
 	instrumentOpts instrument.Options,
 ) *PromReadHandler {
 	taggedScope := instrumentOpts.MetricsScope().
@@ -130,6 +133,7 @@ func NewPromReadHandler(
 		promReadMetrics:     newPromReadMetrics(taggedScope),
 		timeoutOps:          timeoutOpts,
 		keepNans:            keepNans,
+		metadataStore:       metadataStore,
 		instrumentOpts:      instrumentOpts,
 	}
 
@@ -159,24 +163,56 @@ func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		queryOpts.QueryContextOptions.RestrictFetchType = restrict
 	}
 
-	result, params, respErr := h.ServeHTTPWithEngine(w, r, h.engine, queryOpts, fetchOpts)
+	r, explanation, rErr := parseExplain(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	params, respErr := h.parseRequestParams(r, h.engine, fetchOpts)
 	if respErr != nil {
 		xhttp.Error(w, respErr.Err, respErr.Code)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	if params.FormatType == models.FormatM3QL {
-		renderM3QLResultsJSON(w, result, params)
+	if explanation == nil && params.FormatType != models.FormatM3QL {
+		// Stream the results in batches so that the converted series for
+		// the entire result are never held in memory at once.
+		written, respErr := h.serveStreamed(w, r, queryOpts, fetchOpts, params)
+		if respErr != nil {
+			// NB: if the results were partially written the response is left
+			// unterminated so that clients fail to parse it rather than
+			// accepting a truncated result.
+			if !written {
+				xhttp.Error(w, respErr.Err, respErr.Code)
+			}
+			return
+		}
+
 		h.promReadMetrics.fetchSuccess.Inc(1)
 		timer.Stop()
 		return
 	}
 
+	result, respErr := h.readWithParams(w, r, h.engine, queryOpts, fetchOpts,
+		params)
+	if respErr != nil {
+		xhttp.Error(w, respErr.Err, respErr.Code)
+		return
+	}
+
+	if explanation != nil {
+		h.promReadMetrics.fetchSuccess.Inc(1)
+		timer.Stop()
+		xhttp.WriteJSONResponse(w, explanation.Report(),
+			logging.WithContext(r.Context(), h.instrumentOpts))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	renderM3QLResultsJSON(w, result, params)
 	h.promReadMetrics.fetchSuccess.Inc(1)
 	timer.Stop()
-	// TODO: Support multiple result types
-	renderResultsJSON(w, result, params, h.keepNans)
 }
 
 // ServeHTTPWithEngine returns query results from the storage
@@ -187,14 +223,30 @@ func (h *PromReadHandler) ServeHTTPWithEngine(
 	opts *executor.QueryOptions,
 	fetchOpts *storage.FetchOptions,
 ) ([]*ts.Series, models.RequestParams, *RespError) {
-	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
-	logger := logging.WithContext(ctx, h.instrumentOpts)
+	params, respErr := h.parseRequestParams(r, engine, fetchOpts)
+	if respErr != nil {
+		return nil, emptyReqParams, respErr
+	}
+
+	result, respErr := h.readWithParams(w, r, engine, opts, fetchOpts, params)
+	if respErr != nil {
+		return nil, emptyReqParams, respErr
+	}
+
+	return result, params, nil
+}
 
+func (h *PromReadHandler) parseRequestParams(
+	r *http.Request,
+	engine executor.Engine,
+	fetchOpts *storage.FetchOptions,
+) (models.RequestParams, *RespError) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
 	params, rErr := parseParams(r, engine.Options(),
 		h.timeoutOps, fetchOpts, h.instrumentOpts)
 	if rErr != nil {
 		h.promReadMetrics.fetchErrorsClient.Inc(1)
-		return nil, emptyReqParams, &RespError{Err: rErr.Inner(), Code: rErr.Code()}
+		return emptyReqParams, &RespError{Err: rErr.Inner(), Code: rErr.Code()}
 	}
 
 	if params.Debug {
@@ -203,27 +255,109 @@ func (h *PromReadHandler) ServeHTTPWithEngine(
 
 	if err := h.validateRequest(&params); err != nil {
 		h.promReadMetrics.fetchErrorsClient.Inc(1)
-		return nil, emptyReqParams, &RespError{Err: err, Code: http.StatusBadRequest}
+		return emptyReqParams, &RespError{Err: err, Code: http.StatusBadRequest}
 	}
 
+	return params, nil
+}
+
+func (h *PromReadHandler) readWithParams(
+	w http.ResponseWriter,
+	r *http.Request,
+	engine executor.Engine,
+	opts *executor.QueryOptions,
+	fetchOpts *storage.FetchOptions,
+	params models.RequestParams,
+) ([]*ts.Series, *RespError) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
 	result, err := read(ctx, engine, opts, fetchOpts, h.tagOpts,
 		w, params, h.instrumentOpts)
 	if err != nil {
-		sp := xopentracing.SpanFromContextOrNoop(ctx)
-		sp.LogFields(opentracinglog.Error(err))
-		opentracingext.Error.Set(sp, true)
-		logger.Error("unable to fetch data", zap.Error(err))
-		h.promReadMetrics.fetchErrorsServer.Inc(1)
-		return nil, emptyReqParams, &RespError{
-			Err:  err,
-			Code: http.StatusInternalServerError,
-		}
+		return nil, h.fetchError(ctx, err)
 	}
 
 	// TODO: Support multiple result types
 	w.Header().Set("Content-Type", "application/json")
+	addCounterFunctionWarnings(&result.meta, params.Query, h.metadataStore)
 	handler.AddWarningHeaders(w, result.meta)
-	return result.series, params, nil
+	handler.AddNamespacesHeader(w, result.meta)
+	return result.series, nil
+}
+
+// serveStreamed executes the query and writes the results as they are
+// converted from blocks to series, a batch at a time. It returns whether any
+// of the results were written along with any error encountered.
+func (h *PromReadHandler) serveStreamed(
+	w http.ResponseWriter,
+	r *http.Request,
+	opts *executor.QueryOptions,
+	fetchOpts *storage.FetchOptions,
+	params models.RequestParams,
+) (bool, *RespError) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	blocks, err := readBlocks(ctx, h.engine, opts, fetchOpts, h.tagOpts,
+		w, params, h.instrumentOpts)
+	if err != nil {
+		return false, h.fetchError(ctx, err)
+	}
+
+	defer blocks.close()
+
+	iter, err := newSeriesBatchIter(blocks.blocks, defaultSeriesBatchSize)
+	if err != nil {
+		return false, h.fetchError(ctx, err)
+	}
+
+	var rw *resultsJSONWriter
+	for iter.Next() {
+		if rw == nil {
+			rw = h.newStreamedResultsWriter(w, blocks.meta, params)
+		}
+
+		series := prometheus.FilterSeriesByOptions(iter.Current(), fetchOpts)
+		if err := rw.WriteSeries(series); err != nil {
+			return true, h.fetchError(ctx, err)
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return rw != nil, h.fetchError(ctx, err)
+	}
+
+	if rw == nil {
+		rw = h.newStreamedResultsWriter(w, blocks.meta, params)
+	}
+
+	if err := rw.Close(); err != nil {
+		return true, h.fetchError(ctx, err)
+	}
+
+	return true, nil
+}
+
+func (h *PromReadHandler) newStreamedResultsWriter(
+	w http.ResponseWriter,
+	meta block.ResultMetadata,
+	params models.RequestParams,
+) *resultsJSONWriter {
+	w.Header().Set("Content-Type", "application/json")
+	addCounterFunctionWarnings(&meta, params.Query, h.metadataStore)
+	handler.AddWarningHeaders(w, meta)
+	handler.AddNamespacesHeader(w, meta)
+	return newResultsJSONWriter(w, params, h.keepNans)
+}
+
+func (h *PromReadHandler) fetchError(ctx context.Context, err error) *RespError {
+	sp := xopentracing.SpanFromContextOrNoop(ctx)
+	sp.LogFields(opentracinglog.Error(err))
+	opentracingext.Error.Set(sp, true)
+	logging.WithContext(ctx, h.instrumentOpts).
+		Error("unable to fetch data", zap.Error(err))
+	h.promReadMetrics.fetchErrorsServer.Inc(1)
+	return &RespError{
+		Err:  err,
+		Code: handler.ErrorStatusCode(err, http.StatusInternalServerError),
+	}
 }
 
 func (h *PromReadHandler) validateRequest(params *models.RequestParams) error {
@@ -41,6 +41,10 @@ import (
 	opentracinglog "github.com/opentracing/opentracing-go/log"
 )
 
+// defaultSeriesBatchSize is the number of series converted from blocks at a
+// time when streaming results.
+const defaultSeriesBatchSize = 256
+
 type readResult struct {
 	series []*ts.Series
 	meta   block.ResultMetadata
@@ -56,6 +60,53 @@ func read(
 	params models.RequestParams,
 	instrumentOpts instrument.Options,
 ) (readResult, error) {
+	emptyResult := readResult{meta: block.NewResultMetadata()}
+	blocks, err := readBlocks(reqCtx, engine, opts, fetchOpts, tagOpts,
+		w, params, instrumentOpts)
+	if err != nil {
+		return emptyResult, err
+	}
+
+	// Ensure that the blocks are closed.
+	defer blocks.close()
+
+	series, err := sortedBlocksToSeriesList(blocks.blocks)
+	if err != nil {
+		return emptyResult, err
+	}
+
+	series = prometheus.FilterSeriesByOptions(series, fetchOpts)
+	return readResult{
+		series: series,
+		meta:   blocks.meta,
+	}, nil
+}
+
+type readBlocksResult struct {
+	blocks []blockWithMeta
+	meta   block.ResultMetadata
+}
+
+func (r readBlocksResult) close() {
+	for _, b := range r.blocks {
+		// FIXME: this will double close blocks that have gone through the
+		// function pipeline.
+		b.block.Close()
+	}
+}
+
+// readBlocks executes the query and returns the resulting blocks sorted by
+// start time, the caller is responsible for closing the blocks.
+func readBlocks(
+	reqCtx context.Context,
+	engine executor.Engine,
+	opts *executor.QueryOptions,
+	fetchOpts *storage.FetchOptions,
+	tagOpts models.TagOptions,
+	w http.ResponseWriter,
+	params models.RequestParams,
+	instrumentOpts instrument.Options,
+) (readBlocksResult, error) {
 	ctx, cancel := context.WithTimeout(reqCtx, params.Timeout)
 	defer cancel()
 
@@ -70,7 +121,7 @@ func read(
 
 	// Detect clients closing connections.
 	handler.CloseWatcher(ctx, cancel, w, instrumentOpts)
-	emptyResult := readResult{meta: block.NewResultMetadata()}
+	emptyResult := readBlocksResult{meta: block.NewResultMetadata()}
 
 	// TODO: Capture timing
 	parser, err := promql.Parse(params.Query, params.Step, tagOpts)
@@ -101,7 +152,6 @@ func read(
 	)
 
 	meta := block.NewResultMetadata()
-	// TODO(nikunj): Stream blocks to client
 	for blkResult := range resultChan {
 		if err := blkResult.Err; err != nil {
 			return emptyResult, err
@@ -136,24 +186,8 @@ func read(
 		meta = meta.CombineMetadata(insertResult.meta)
 	}
 
-	// Ensure that the blocks are closed. Can't do this above since
-	// sortedBlockList might change.
-	defer func() {
-		for _, b := range sortedBlockList {
-			// FIXME: this will double close blocks that have gone through the
-			// function pipeline.
-			b.block.Close()
-		}
-	}()
-
-	series, err := sortedBlocksToSeriesList(sortedBlockList)
-	if err != nil {
-		return emptyResult, err
-	}
-
-	series = prometheus.FilterSeriesByOptions(series, fetchOpts)
-	return readResult{
-		series: series,
+	return readBlocksResult{
+		blocks: sortedBlockList,
 		meta:   meta,
 	}, nil
 }
@@ -163,11 +197,50 @@ func sortedBlocksToSeriesList(blockList []blockWithMeta) ([]*ts.Series, error) {
 		return emptySeriesList, nil
 	}
 
+	iter, err := newSeriesBatchIter(blockList, defaultSeriesBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	seriesList := make([]*ts.Series, 0, iter.numSeries)
+	for iter.Next() {
+		seriesList = append(seriesList, iter.Current()...)
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	return seriesList, nil
+}
+
+// seriesBatchIter combines the series of sorted blocks into series spanning
+// all of the blocks, a batch of series at a time.
+type seriesBatchIter struct {
+	bounds      models.Bounds
+	commonTags  []models.Tag
+	seriesMeta  []block.SeriesMeta
+	seriesIters []block.SeriesIter
+	numSeries   int
+	numValues   int
+	batchSize   int
+
+	idx     int
+	current []*ts.Series
+	err     error
+}
+
+func newSeriesBatchIter(
+	blockList []blockWithMeta,
+	batchSize int,
+) (*seriesBatchIter, error) {
+	if len(blockList) == 0 {
+		return &seriesBatchIter{batchSize: batchSize}, nil
+	}
+
 	var (
 		firstBlock = blockList[0].block
 		meta       = firstBlock.Meta()
-		bounds     = meta.Bounds
-		commonTags = meta.Tags.Tags
 	)
 
 	firstSeriesIter, err := firstBlock.SeriesIter()
@@ -175,16 +248,10 @@ func sortedBlocksToSeriesList(blockList []blockWithMeta) ([]*ts.Series, error) {
 		return nil, err
 	}
 
-	var (
-		numSeries   = firstSeriesIter.SeriesCount()
-		seriesMeta  = firstSeriesIter.SeriesMeta()
-		seriesList  = make([]*ts.Series, 0, numSeries)
-		seriesIters = make([]block.SeriesIter, 0, len(blockList))
-	)
-
 	// To create individual series, we iterate over seriesIterators for each
 	// block in the block list.  For each iterator, the nth current() will
 	// be combined to give the nth series.
+	seriesIters := make([]block.SeriesIter, 0, len(blockList))
 	for _, b := range blockList {
 		seriesIter, err := b.block.SeriesIter()
 		if err != nil {
@@ -204,41 +271,91 @@ func sortedBlocksToSeriesList(blockList []blockWithMeta) ([]*ts.Series, error) {
 		numValues += b.StepCount()
 	}
 
-	for i := 0; i < numSeries; i++ {
-		values := ts.NewFixedStepValues(bounds.StepSize, numValues,
-			math.NaN(), bounds.Start)
-		valIdx := 0
-		for idx, iter := range seriesIters {
-			if !iter.Next() {
-				if err = iter.Err(); err != nil {
-					return nil, err
-				}
-
-				return nil, fmt.Errorf(
-					"invalid number of datapoints for series: %d, block: %d", i, idx)
-			}
+	if batchSize <= 0 {
+		batchSize = defaultSeriesBatchSize
+	}
+
+	return &seriesBatchIter{
+		bounds:      meta.Bounds,
+		commonTags:  meta.Tags.Tags,
+		seriesMeta:  firstSeriesIter.SeriesMeta(),
+		seriesIters: seriesIters,
+		numSeries:   firstSeriesIter.SeriesCount(),
+		numValues:   numValues,
+		batchSize:   batchSize,
+	}, nil
+}
 
-			if err = iter.Err(); err != nil {
+// Next moves to the next batch of series, returning false when there are no
+// more series or an error has occurred.
+func (it *seriesBatchIter) Next() bool {
+	if it.err != nil || it.idx >= it.numSeries {
+		return false
+	}
+
+	end := it.idx + it.batchSize
+	if end > it.numSeries {
+		end = it.numSeries
+	}
+
+	// NB: a new slice is used per batch since the caller may retain the
+	// series from previous batches.
+	it.current = make([]*ts.Series, 0, end-it.idx)
+	for ; it.idx < end; it.idx++ {
+		series, err := it.nextSeries(it.idx)
+		if err != nil {
+			it.err = err
+			it.current = nil
+			return false
+		}
+
+		it.current = append(it.current, series)
+	}
+
+	return true
+}
+
+func (it *seriesBatchIter) nextSeries(i int) (*ts.Series, error) {
+	values := ts.NewFixedStepValues(it.bounds.StepSize, it.numValues,
+		math.NaN(), it.bounds.Start)
+	valIdx := 0
+	for idx, iter := range it.seriesIters {
+		if !iter.Next() {
+			if err := iter.Err(); err != nil {
 				return nil, err
 			}
 
-			blockSeries := iter.Current()
-			for j := 0; j < blockSeries.Len(); j++ {
-				values.SetValueAt(valIdx, blockSeries.ValueAtStep(j))
-				valIdx++
-			}
+			return nil, fmt.Errorf(
+				"invalid number of datapoints for series: %d, block: %d", i, idx)
 		}
 
-		var (
-			meta   = seriesMeta[i]
-			tags   = meta.Tags.AddTags(commonTags)
-			series = ts.NewSeries(meta.Name, values, tags)
-		)
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
 
-		seriesList = append(seriesList, series)
+		blockSeries := iter.Current()
+		for j := 0; j < blockSeries.Len(); j++ {
+			values.SetValueAt(valIdx, blockSeries.ValueAtStep(j))
+			valIdx++
+		}
 	}
 
-	return seriesList, nil
+	var (
+		meta = it.seriesMeta[i]
+		tags = meta.Tags.AddTags(it.commonTags)
+	)
+
+	return ts.NewSeries(meta.Name, values, tags), nil
+}
+
+// Current returns the current batch of series.
+func (it *seriesBatchIter) Current() []*ts.Series {
+	return it.current
+}
+
+// Err returns any error encountered.
+func (it *seriesBatchIter) Err() error {
+	return it.err
 }
 
 type insertBlockResult struct {
@@ -77,6 +77,12 @@ func NewPromReadInstantHandler(
 }
 
 func (h *PromReadInstantHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r, explanation, rErr := parseExplain(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
 	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
 	logger := logging.WithContext(ctx, h.instrumentOpts)
 
@@ -115,12 +121,19 @@ func (h *PromReadInstantHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		h.tagOpts, w, params, h.instrumentOpts)
 	if err != nil {
 		logger.Error("unable to fetch data", zap.Error(err))
-		xhttp.Error(w, err, http.StatusInternalServerError)
+		xhttp.Error(w, err,
+			handler.ErrorStatusCode(err, http.StatusInternalServerError))
+		return
+	}
+
+	if explanation != nil {
+		xhttp.WriteJSONResponse(w, explanation.Report(), logger)
 		return
 	}
 
 	// TODO: Support multiple result types
 	w.Header().Set("Content-Type", "application/json")
 	handler.AddWarningHeaders(w, result.meta)
+	handler.AddNamespacesHeader(w, result.meta)
 	renderResultsInstantaneousJSON(w, result.series)
 }
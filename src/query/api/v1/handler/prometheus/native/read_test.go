@@ -23,6 +23,7 @@ package native
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -35,6 +36,8 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/explain"
+	"github.com/m3db/m3/src/query/functions"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/mock"
@@ -93,6 +96,91 @@ func testPromReadHandlerRead(
 	}
 }
 
+func TestPromReadHandlerReadBlocksInBatches(t *testing.T) {
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	setup := newTestSetup()
+	promRead := setup.Handlers.Read
+
+	seriesMeta := test.NewSeriesMeta("dummy", len(values))
+	m := block.Metadata{
+		Bounds:         bounds,
+		Tags:           models.NewTags(0, models.NewTagOptions()),
+		ResultMetadata: block.NewResultMetadata(),
+	}
+
+	b := test.NewBlockFromValuesWithMetaAndSeriesMeta(m, seriesMeta, values)
+	setup.Storage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	req, _ := http.NewRequest("GET", PromReadURL, nil)
+	req.URL.RawQuery = defaultParams().Encode()
+
+	r, parseErr := testParseParams(req)
+	require.Nil(t, parseErr)
+	result, err := readBlocks(context.TODO(), promRead.engine,
+		setup.QueryOpts, setup.FetchOpts, promRead.tagOpts, httptest.NewRecorder(),
+		r, instrument.NewOptions())
+	require.NoError(t, err)
+	defer result.close()
+
+	iter, err := newSeriesBatchIter(result.blocks, 1)
+	require.NoError(t, err)
+
+	batches := 0
+	for iter.Next() {
+		seriesList := iter.Current()
+		require.Len(t, seriesList, 1)
+		s := seriesList[0]
+		assert.Equal(t, 5, s.Values().Len())
+		for i := 0; i < s.Values().Len(); i++ {
+			assert.Equal(t, float64(i), s.Values().ValueAt(i))
+		}
+
+		batches++
+	}
+
+	require.NoError(t, iter.Err())
+	assert.Equal(t, 2, batches)
+}
+
+type errResponseWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (w errResponseWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write error")
+}
+
+func TestPromReadHandlerServeStreamedWriteError(t *testing.T) {
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	setup := newTestSetup()
+	promRead := setup.Handlers.Read
+
+	seriesMeta := test.NewSeriesMeta("dummy", len(values))
+	m := block.Metadata{
+		Bounds:         bounds,
+		Tags:           models.NewTags(0, models.NewTagOptions()),
+		ResultMetadata: block.NewResultMetadata(),
+	}
+
+	b := test.NewBlockFromValuesWithMetaAndSeriesMeta(m, seriesMeta, values)
+	setup.Storage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	req, _ := http.NewRequest("GET", PromReadURL, nil)
+	req.URL.RawQuery = defaultParams().Encode()
+
+	r, parseErr := testParseParams(req)
+	require.Nil(t, parseErr)
+
+	w := errResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+	written, respErr := promRead.serveStreamed(w, req, setup.QueryOpts,
+		setup.FetchOpts, r)
+	assert.True(t, written)
+	require.NotNil(t, respErr)
+	assert.Error(t, respErr.Err)
+}
+
 type M3QLResp []struct {
 	Target     string            `json:"target"`
 	Tags       map[string]string `json:"tags"`
@@ -150,6 +238,46 @@ func testM3PromReadHandlerRead(
 
 }
 
+func TestPromReadHandlerExplain(t *testing.T) {
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	setup := newTestSetup()
+	promRead := setup.Handlers.Read
+
+	seriesMeta := test.NewSeriesMeta("dummy", len(values))
+	meta := block.Metadata{
+		Bounds:         bounds,
+		Tags:           models.NewTags(0, models.NewTagOptions()),
+		ResultMetadata: block.NewResultMetadata(),
+	}
+
+	b := test.NewBlockFromValuesWithMetaAndSeriesMeta(meta, seriesMeta, values)
+	setup.Storage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	params := defaultParams()
+	params.Add(explainParam, "true")
+	recorder := httptest.NewRecorder()
+	promRead.ServeHTTP(recorder, newReadRequest(t, params))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var report explain.Report
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+	require.NotEmpty(t, report.LogicalPlan)
+	require.NotNil(t, report.PhysicalPlan)
+	assert.Equal(t, len(report.LogicalPlan), len(report.PhysicalPlan.Transforms))
+	require.Equal(t, len(report.LogicalPlan), len(report.Nodes))
+
+	fetch := report.Nodes[0]
+	assert.Equal(t, functions.FetchType, fetch.Op)
+	assert.Equal(t, 1, fetch.BlocksOut)
+	assert.Equal(t, 2, fetch.SeriesOut)
+
+	params.Set(explainParam, "not_a_bool")
+	recorder = httptest.NewRecorder()
+	promRead.ServeHTTP(recorder, newReadRequest(t, params))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
 func newReadRequest(t *testing.T, params url.Values) *http.Request {
 	req, err := http.NewRequest("GET", PromReadURL, nil)
 	require.NoError(t, err)
@@ -187,7 +315,7 @@ func newTestSetup() *testSetup {
 	keepNans := false
 
 	read := NewPromReadHandler(engine, fetchOptsBuilder, tagOpts,
-		limitsConfig, timeoutOpts, keepNans, instrumentOpts)
+		limitsConfig, timeoutOpts, keepNans, nil, instrumentOpts)
 
 	instantRead := NewPromReadInstantHandler(engine, fetchOptsBuilder,
 		tagOpts, timeoutOpts, instrumentOpts)
@@ -0,0 +1,314 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// PromFederateURL is the url for the prom federation handler.
+	PromFederateURL = "/federate"
+
+	textContentType        = "text/plain; version=0.0.4; charset=utf-8"
+	openMetricsContentType = "application/openmetrics-text; version=0.0.1; charset=utf-8"
+	openMetricsAcceptType  = "application/openmetrics-text"
+)
+
+var (
+	// PromFederateHTTPMethods are the HTTP methods for this handler.
+	PromFederateHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+	labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// PromFederateHandler represents a handler for the prometheus federation
+// endpoint, serving the latest sample of each series matching any of the
+// given selectors in the text exposition or OpenMetrics format.
+type PromFederateHandler struct {
+	storage             storage.Storage
+	tagOptions          models.TagOptions
+	fetchOptionsBuilder handler.FetchOptionsBuilder
+	enforcer            cost.ChainedEnforcer
+	lookbackDuration    time.Duration
+	nowFn               clock.NowFn
+	instrumentOpts      instrument.Options
+}
+
+// NewPromFederateHandler returns a new instance of handler.
+func NewPromFederateHandler(
+	storage storage.Storage,
+	tagOptions models.TagOptions,
+	fetchOptionsBuilder handler.FetchOptionsBuilder,
+	enforcer cost.ChainedEnforcer,
+	lookbackDuration time.Duration,
+	nowFn clock.NowFn,
+	instrumentOpts instrument.Options,
+) http.Handler {
+	if enforcer == nil {
+		enforcer = cost.NoopChainedEnforcer()
+	}
+
+	return &PromFederateHandler{
+		storage:             storage,
+		tagOptions:          tagOptions,
+		fetchOptionsBuilder: fetchOptionsBuilder,
+		enforcer:            enforcer,
+		lookbackDuration:    lookbackDuration,
+		nowFn:               nowFn,
+		instrumentOpts:      instrumentOpts,
+	}
+}
+
+// federatedSample is the latest sample of a federated series.
+type federatedSample struct {
+	name      []byte
+	labels    []models.Tag
+	value     float64
+	timestamp time.Time
+}
+
+func (h *PromFederateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	queries, rErr := prometheus.ParseSeriesMatchQuery(r, h.tagOptions)
+	if rErr != nil {
+		logger.Error("unable to parse federation selectors", zap.Error(rErr))
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	opts, rErr := h.fetchOptionsBuilder.NewFetchOptions(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	perQueryEnforcer := h.enforcer.Child(cost.QueryLevel)
+	defer perQueryEnforcer.Close()
+	opts.Enforcer = perQueryEnforcer
+
+	// NB: only the latest sample within the lookback of each series is
+	// federated, as with Prometheus.
+	end := h.nowFn()
+	start := end.Add(-1 * h.lookbackDuration)
+
+	var (
+		samples = make([]federatedSample, 0)
+		seen    = make(map[string]struct{})
+		meta    = block.NewResultMetadata()
+	)
+
+	for _, query := range queries {
+		query.Start = start
+		query.End = end
+		result, err := h.storage.Fetch(ctx, query, opts)
+		if err != nil {
+			logger.Error("unable to fetch federated series", zap.Error(err))
+			xhttp.Error(w, err,
+				handler.ErrorStatusCode(err, http.StatusInternalServerError))
+			return
+		}
+
+		meta = meta.CombineMetadata(result.Metadata)
+		for _, series := range result.SeriesList {
+			id := string(series.Tags.ID())
+			if _, ok := seen[id]; ok {
+				continue
+			}
+
+			// Series limits apply across all the selectors combined.
+			if opts.Limit > 0 && len(seen) >= opts.Limit {
+				meta.Exhaustive = false
+				break
+			}
+
+			seen[id] = struct{}{}
+			if sample, ok := latestSample(series); ok {
+				samples = append(samples, sample)
+			}
+		}
+	}
+
+	sort.Slice(samples, func(i, j int) bool {
+		if c := bytes.Compare(samples[i].name, samples[j].name); c != 0 {
+			return c < 0
+		}
+
+		return compareLabels(samples[i].labels, samples[j].labels) < 0
+	})
+
+	openMetrics := strings.Contains(r.Header.Get("Accept"), openMetricsAcceptType)
+	if openMetrics {
+		w.Header().Set("Content-Type", openMetricsContentType)
+	} else {
+		w.Header().Set("Content-Type", textContentType)
+	}
+
+	handler.AddWarningHeaders(w, meta)
+	if err := writeFederatedSamples(w, samples, openMetrics); err != nil {
+		logger.Error("unable to write federated series", zap.Error(err))
+	}
+}
+
+// latestSample returns the most recent non-NaN sample of the series, which
+// must have a name.
+func latestSample(series *ts.Series) (federatedSample, bool) {
+	name, ok := series.Tags.Name()
+	if !ok || len(name) == 0 {
+		return federatedSample{}, false
+	}
+
+	values := series.Values()
+	for i := values.Len() - 1; i >= 0; i-- {
+		dp := values.DatapointAt(i)
+		if math.IsNaN(dp.Value) {
+			continue
+		}
+
+		labels := series.Tags.WithoutName().Tags
+		sort.Slice(labels, func(i, j int) bool {
+			return bytes.Compare(labels[i].Name, labels[j].Name) < 0
+		})
+
+		return federatedSample{
+			name:      name,
+			labels:    labels,
+			value:     dp.Value,
+			timestamp: dp.Timestamp,
+		}, true
+	}
+
+	return federatedSample{}, false
+}
+
+func compareLabels(a, b []models.Tag) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := bytes.Compare(a[i].Name, b[i].Name); c != 0 {
+			return c
+		}
+
+		if c := bytes.Compare(a[i].Value, b[i].Value); c != 0 {
+			return c
+		}
+	}
+
+	return len(a) - len(b)
+}
+
+// writeFederatedSamples writes samples, which must be sorted by name, in the
+// Prometheus text exposition format, or the OpenMetrics format if set.
+func writeFederatedSamples(
+	w io.Writer,
+	samples []federatedSample,
+	openMetrics bool,
+) error {
+	var (
+		bw       = bufio.NewWriter(w)
+		lastName []byte
+		typeName = "untyped"
+	)
+
+	if openMetrics {
+		typeName = "unknown"
+	}
+
+	for _, sample := range samples {
+		if !bytes.Equal(sample.name, lastName) {
+			bw.WriteString("# TYPE ")
+			bw.Write(sample.name)
+			bw.WriteString(" ")
+			bw.WriteString(typeName)
+			bw.WriteString("\n")
+			lastName = sample.name
+		}
+
+		bw.Write(sample.name)
+		if len(sample.labels) > 0 {
+			bw.WriteString("{")
+			for i, label := range sample.labels {
+				if i > 0 {
+					bw.WriteString(",")
+				}
+
+				bw.Write(label.Name)
+				bw.WriteString(`="`)
+				labelValueReplacer.WriteString(bw, string(label.Value))
+				bw.WriteString(`"`)
+			}
+
+			bw.WriteString("}")
+		}
+
+		bw.WriteString(" ")
+		bw.WriteString(formatSampleValue(sample.value))
+		bw.WriteString(" ")
+		millis := sample.timestamp.UnixNano() / int64(time.Millisecond)
+		if openMetrics {
+			bw.WriteString(strconv.FormatFloat(float64(millis)/1000, 'f', -1, 64))
+		} else {
+			bw.WriteString(strconv.FormatInt(millis, 10))
+		}
+
+		bw.WriteString("\n")
+	}
+
+	if openMetrics {
+		bw.WriteString("# EOF\n")
+	}
+
+	return bw.Flush()
+}
+
+func formatSampleValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/mock"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFederateTestSeries(
+	name string,
+	labels map[string]string,
+	dps ts.Datapoints,
+) *ts.Series {
+	tags := models.NewTags(len(labels)+1, models.NewTagOptions()).
+		SetName([]byte(name))
+	for k, v := range labels {
+		tags = tags.AddTag(models.Tag{Name: []byte(k), Value: []byte(v)})
+	}
+
+	return ts.NewSeries(tags.ID(), dps, tags)
+}
+
+func newFederateTestHandler(
+	store storage.Storage,
+	now time.Time,
+	limit int,
+) http.Handler {
+	return NewPromFederateHandler(store, models.NewTagOptions(),
+		handler.NewFetchOptionsBuilder(handler.FetchOptionsBuilderOptions{
+			Limit: limit,
+		}), nil, 5*time.Minute, func() time.Time { return now },
+		instrument.NewOptions())
+}
+
+func newFederateTestRequest(selectors ...string) *http.Request {
+	values := url.Values{}
+	for _, selector := range selectors {
+		values.Add("match[]", selector)
+	}
+
+	return httptest.NewRequest(http.MethodGet,
+		PromFederateURL+"?"+values.Encode(), nil)
+}
+
+func TestPromFederate(t *testing.T) {
+	now := time.Unix(1000, 0)
+	store := mock.NewMockStorage()
+	store.SetFetchResults(
+		&storage.FetchResult{
+			SeriesList: ts.SeriesList{
+				newFederateTestSeries("up", map[string]string{"job": "b"},
+					ts.Datapoints{
+						{Timestamp: now.Add(-time.Minute), Value: 0},
+						{Timestamp: now.Add(-30 * time.Second), Value: 1},
+					}),
+				newFederateTestSeries("up", map[string]string{"job": "a"},
+					ts.Datapoints{
+						{Timestamp: now.Add(-time.Minute), Value: 1},
+						{Timestamp: now.Add(-30 * time.Second), Value: math.NaN()},
+					}),
+				newFederateTestSeries("up", map[string]string{"job": "c"},
+					ts.Datapoints{
+						{Timestamp: now.Add(-time.Minute), Value: math.NaN()},
+					}),
+			},
+			Metadata: block.NewResultMetadata(),
+		},
+		&storage.FetchResult{
+			SeriesList: ts.SeriesList{
+				newFederateTestSeries("up", map[string]string{"job": "a"},
+					ts.Datapoints{{Timestamp: now.Add(-time.Minute), Value: 1}}),
+				newFederateTestSeries("errors", map[string]string{
+					"path": "a\"b\\c\nd",
+				}, ts.Datapoints{
+					{Timestamp: now.Add(-1500 * time.Millisecond), Value: math.Inf(1)},
+				}),
+			},
+			Metadata: block.NewResultMetadata(),
+		},
+	)
+
+	h := newFederateTestHandler(store, now, 0)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newFederateTestRequest(`up`, `{__name__="errors"}`))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, textContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, `# TYPE errors untyped
+errors{path="a\"b\\c\nd"} +Inf 998500
+# TYPE up untyped
+up{job="a"} 1 940000
+up{job="b"} 1 970000
+`, w.Body.String())
+
+	opts := store.LastFetchOptions()
+	assert.NotNil(t, opts.Enforcer)
+}
+
+func TestPromFederateOpenMetrics(t *testing.T) {
+	now := time.Unix(1000, 0)
+	store := mock.NewMockStorage()
+	store.SetFetchResult(&storage.FetchResult{
+		SeriesList: ts.SeriesList{
+			newFederateTestSeries("up", nil, ts.Datapoints{
+				{Timestamp: now.Add(-1500 * time.Millisecond), Value: 0.5},
+			}),
+		},
+		Metadata: block.NewResultMetadata(),
+	}, nil)
+
+	h := newFederateTestHandler(store, now, 0)
+	req := newFederateTestRequest(`up`)
+	req.Header.Set("Accept", "application/openmetrics-text; version=0.0.1")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, openMetricsContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, `# TYPE up unknown
+up 0.5 998.5
+# EOF
+`, w.Body.String())
+}
+
+func TestPromFederateSeriesLimit(t *testing.T) {
+	now := time.Unix(1000, 0)
+	dps := ts.Datapoints{{Timestamp: now.Add(-time.Minute), Value: 1}}
+	store := mock.NewMockStorage()
+	store.SetFetchResult(&storage.FetchResult{
+		SeriesList: ts.SeriesList{
+			newFederateTestSeries("up", map[string]string{"job": "a"}, dps),
+			newFederateTestSeries("up", map[string]string{"job": "b"}, dps),
+			newFederateTestSeries("up", map[string]string{"job": "c"}, dps),
+		},
+		Metadata: block.NewResultMetadata(),
+	}, nil)
+
+	h := newFederateTestHandler(store, now, 2)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newFederateTestRequest(`up`))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, handler.LimitHeaderSeriesLimitApplied,
+		w.Header().Get(handler.LimitHeader))
+	assert.Equal(t, `# TYPE up untyped
+up{job="a"} 1 940000
+up{job="b"} 1 940000
+`, w.Body.String())
+}
+
+func TestPromFederateNoSelectors(t *testing.T) {
+	h := newFederateTestHandler(mock.NewMockStorage(), time.Now(), 0)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newFederateTestRequest())
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestPromFederateFetchError(t *testing.T) {
+	store := mock.NewMockStorage()
+	store.SetFetchResult(nil, errors.New("fetch error"))
+
+	h := newFederateTestHandler(store, time.Now(), 0)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newFederateTestRequest(`up`))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
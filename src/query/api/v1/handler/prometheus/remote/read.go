@@ -23,17 +23,22 @@ package remote
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/block"
+	qcost "github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/util/logging"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/instrument"
@@ -51,6 +56,19 @@ const (
 
 	// PromReadHTTPMethod is the HTTP method used with this resource.
 	PromReadHTTPMethod = http.MethodPost
+
+	// streamedContentType is the content type of streamed XOR chunk responses.
+	streamedContentType = "application/x-streamed-protobuf; " +
+		"proto=prometheus.ChunkedReadResponse"
+
+	// maxBytesInFrame is the soft limit on the size of each streamed chunked
+	// read response frame, a frame is written once this size is exceeded.
+	maxBytesInFrame = 1024 * 1024
+)
+
+var (
+	errStreamingUnsupported = errors.New("response writer does not " +
+		"support streaming")
 )
 
 // PromReadHandler represents a handler for prometheus read endpoint.
@@ -125,11 +143,37 @@ func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	responseType, err := negotiateResponseType(req)
+	if err != nil {
+		h.promReadMetrics.fetchErrorsClient.Inc(1)
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if responseType == prompb.ReadRequest_STREAMED_XOR_CHUNKS {
+		streaming, err := h.readStreamed(ctx, w, req, timeout, fetchOpts)
+		if err != nil {
+			h.promReadMetrics.fetchErrorsServer.Inc(1)
+			logger.Error("unable to stream read results", zap.Error(err))
+			if !streaming {
+				// Can only write an error if no frames have been written yet.
+				xhttp.Error(w, err,
+					handler.ErrorStatusCode(err, http.StatusInternalServerError))
+			}
+			return
+		}
+
+		timer.Stop()
+		h.promReadMetrics.fetchSuccess.Inc(1)
+		return
+	}
+
 	readResult, err := h.read(ctx, w, req, timeout, fetchOpts)
 	if err != nil {
 		h.promReadMetrics.fetchErrorsServer.Inc(1)
 		logger.Error("unable to fetch data", zap.Error(err))
-		xhttp.Error(w, err, http.StatusInternalServerError)
+		xhttp.Error(w, err,
+			handler.ErrorStatusCode(err, http.StatusInternalServerError))
 		return
 	}
 
@@ -178,6 +222,27 @@ func (h *PromReadHandler) parseRequest(
 	return &req, nil
 }
 
+// negotiateResponseType returns the first response type accepted by the
+// request that is supported, defaulting to samples if none are specified.
+func negotiateResponseType(
+	req *prompb.ReadRequest,
+) (prompb.ReadRequest_ResponseType, error) {
+	accepted := req.GetAcceptedResponseTypes()
+	if len(accepted) == 0 {
+		return prompb.ReadRequest_SAMPLES, nil
+	}
+
+	for _, responseType := range accepted {
+		switch responseType {
+		case prompb.ReadRequest_SAMPLES, prompb.ReadRequest_STREAMED_XOR_CHUNKS:
+			return responseType, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no supported response type in accepted types: %v",
+		accepted)
+}
+
 type readResult struct {
 	meta   block.ResultMetadata
 	result []*prompb.QueryResult
@@ -299,3 +364,238 @@ func filterLabels(
 
 	return filtered
 }
+
+// streamedQueryResult is the result of a single query in a streamed read,
+// holding either compressed series iterators or, if the configured storage
+// cannot return compressed results, decompressed series.
+type streamedQueryResult struct {
+	iters   []encoding.SeriesIterator
+	series  []*prompb.TimeSeries
+	cleanup m3.Cleanup
+}
+
+// readStreamed executes the queries and streams the results as XOR encoded
+// chunks, one frame at a time. The results of each query are written as soon
+// as they are fetched, before the next query is executed. Results are fetched
+// compressed where possible so that only the series in the frame being
+// written are decompressed. The returned bool indicates whether the response
+// has started streaming.
+func (h *PromReadHandler) readStreamed(
+	reqCtx context.Context,
+	w http.ResponseWriter,
+	r *prompb.ReadRequest,
+	timeout time.Duration,
+	fetchOpts *storage.FetchOptions,
+) (bool, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return false, errStreamingUnsupported
+	}
+
+	ctx, cancel := context.WithTimeout(reqCtx, timeout)
+	defer cancel()
+
+	// Detect clients closing connections.
+	handler.CloseWatcher(ctx, cancel, w, h.instrumentOpts)
+
+	enforcer := h.engine.Options().GlobalEnforcer()
+	if enforcer == nil {
+		enforcer = qcost.NoopChainedEnforcer()
+	}
+
+	enforcer = enforcer.Child(qcost.QueryLevel)
+	defer enforcer.Close()
+
+	w.Header().Set("Content-Type", streamedContentType)
+
+	var (
+		meta      = block.NewResultMetadata()
+		writer    = newChunkedWriter(w, flusher)
+		streaming bool
+	)
+	for i, promQuery := range r.Queries {
+		query, err := storage.PromReadQueryToM3(promQuery)
+		if err != nil {
+			return streaming, err
+		}
+
+		result, resultMeta, err := h.fetchStreamed(ctx, query, fetchOpts)
+		if err != nil {
+			return streaming, err
+		}
+
+		// NB: headers can only be set until the first frame is written, so
+		// the warnings of queries executed after that are not returned.
+		meta = meta.CombineMetadata(resultMeta)
+		if !streaming {
+			handler.AddWarningHeaders(w, meta)
+		}
+
+		frame := newChunkedReadFrame(i, writer, fetchOpts)
+		written, err := frame.addResult(result, enforcer)
+		if result.cleanup != nil {
+			result.cleanup()
+		}
+
+		streaming = streaming || written
+		if err != nil {
+			return streaming, err
+		}
+	}
+
+	return streaming, nil
+}
+
+// fetchStreamed fetches the compressed results for a query if the storage
+// serving it supports it, otherwise it falls back to executing the query.
+func (h *PromReadHandler) fetchStreamed(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	fetchOpts *storage.FetchOptions,
+) (streamedQueryResult, block.ResultMetadata, error) {
+	store := h.engine.Options().Store()
+	if resolver, ok := store.(storage.SingleStoreStorage); ok {
+		// NB: compressed results can only be returned as is when a single
+		// store serves the query, otherwise the results must be merged.
+		if single, ok := resolver.SingleStore(query); ok {
+			store = single
+		}
+	}
+
+	if querier, ok := store.(m3.Querier); ok {
+		result, cleanup, err := querier.FetchCompressed(ctx, query, fetchOpts)
+		if err != nil {
+			return streamedQueryResult{}, result.Metadata, err
+		}
+
+		var iters []encoding.SeriesIterator
+		if result.SeriesIterators != nil {
+			iters = result.SeriesIterators.Iters()
+		}
+
+		return streamedQueryResult{
+			iters:   iters,
+			cleanup: cleanup,
+		}, result.Metadata, nil
+	}
+
+	queryOpts := &executor.QueryOptions{
+		QueryContextOptions: models.QueryContextOptions{
+			LimitMaxTimeseries: fetchOpts.Limit,
+		},
+	}
+
+	result, err := h.engine.ExecuteProm(ctx, query, queryOpts, fetchOpts)
+	if err != nil {
+		return streamedQueryResult{}, result.Metadata, err
+	}
+
+	return streamedQueryResult{
+		series: result.PromResult.GetTimeseries(),
+	}, result.Metadata, nil
+}
+
+// chunkedReadFrame accumulates chunked series for a query until the frame
+// exceeds the maximum frame size, at which point it is written.
+type chunkedReadFrame struct {
+	queryIndex int
+	writer     *chunkedWriter
+	filter     [][]byte
+	series     []*prompb.ChunkedSeries
+	size       int
+}
+
+func newChunkedReadFrame(
+	queryIndex int,
+	writer *chunkedWriter,
+	fetchOpts *storage.FetchOptions,
+) *chunkedReadFrame {
+	var filter [][]byte
+	if fetchOpts != nil {
+		filter = fetchOpts.RestrictQueryOptions.GetRestrictByTag().GetFilterByNames()
+	}
+
+	return &chunkedReadFrame{
+		queryIndex: queryIndex,
+		writer:     writer,
+		filter:     filter,
+	}
+}
+
+// add adds a series to the frame, returning true if the frame was written.
+func (f *chunkedReadFrame) add(series *prompb.ChunkedSeries) (bool, error) {
+	if len(series.Chunks) == 0 {
+		return false, nil
+	}
+
+	series.Labels = filterLabels(series.Labels, f.filter)
+	f.series = append(f.series, series)
+	f.size += series.Size()
+	if f.size < maxBytesInFrame {
+		return false, nil
+	}
+
+	return f.flush()
+}
+
+// addResult adds every series of a query result to the frame and flushes
+// it, returning true if a frame was written.
+func (f *chunkedReadFrame) addResult(
+	result streamedQueryResult,
+	enforcer qcost.ChainedEnforcer,
+) (bool, error) {
+	streaming := false
+	for _, iter := range result.iters {
+		series, err := storage.SeriesIteratorToPromChunkedSeries(iter, enforcer)
+		if err != nil {
+			return streaming, err
+		}
+
+		written, err := f.add(series)
+		streaming = streaming || written
+		if err != nil {
+			return streaming, err
+		}
+	}
+
+	for _, s := range result.series {
+		series, err := storage.PromTimeSeriesToChunkedSeries(s)
+		if err != nil {
+			return streaming, err
+		}
+
+		written, err := f.add(series)
+		streaming = streaming || written
+		if err != nil {
+			return streaming, err
+		}
+	}
+
+	written, err := f.flush()
+	return streaming || written, err
+}
+
+// flush writes any accumulated series, returning true if a frame was written.
+func (f *chunkedReadFrame) flush() (bool, error) {
+	if len(f.series) == 0 {
+		return false, nil
+	}
+
+	resp := &prompb.ChunkedReadResponse{
+		ChunkedSeries: f.series,
+		QueryIndex:    int64(f.queryIndex),
+	}
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		return false, err
+	}
+
+	f.series = f.series[:0]
+	f.size = 0
+	if _, err := f.writer.Write(data); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
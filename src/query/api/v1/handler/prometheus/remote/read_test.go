@@ -21,14 +21,21 @@
 package remote
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/encoding"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
@@ -37,7 +44,9 @@ import (
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/policy/filter"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/fanout"
 	"github.com/m3db/m3/src/query/test"
 	"github.com/m3db/m3/src/query/test/m3"
 	xclock "github.com/m3db/m3/src/x/clock"
@@ -379,3 +388,274 @@ func TestReadWithOptions(t *testing.T) {
 	result := res.result
 	assert.Equal(t, expected.Timeseries[0], result[0].Timeseries[0])
 }
+
+func TestNegotiateResponseType(t *testing.T) {
+	tests := []struct {
+		accepted []prompb.ReadRequest_ResponseType
+		expected prompb.ReadRequest_ResponseType
+		err      bool
+	}{
+		{
+			expected: prompb.ReadRequest_SAMPLES,
+		},
+		{
+			accepted: []prompb.ReadRequest_ResponseType{
+				prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+				prompb.ReadRequest_SAMPLES,
+			},
+			expected: prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		},
+		{
+			accepted: []prompb.ReadRequest_ResponseType{
+				prompb.ReadRequest_ResponseType(100),
+				prompb.ReadRequest_SAMPLES,
+			},
+			expected: prompb.ReadRequest_SAMPLES,
+		},
+		{
+			accepted: []prompb.ReadRequest_ResponseType{
+				prompb.ReadRequest_ResponseType(100),
+			},
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		req := &prompb.ReadRequest{AcceptedResponseTypes: tt.accepted}
+		actual, err := negotiateResponseType(req)
+		if tt.err {
+			require.Error(t, err)
+			continue
+		}
+
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, actual)
+	}
+}
+
+func readChunkedFrames(t *testing.T, r io.Reader) []prompb.ChunkedReadResponse {
+	var (
+		reader = bufio.NewReader(r)
+		frames []prompb.ChunkedReadResponse
+	)
+
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if err == io.EOF {
+			return frames
+		}
+
+		require.NoError(t, err)
+		var crc [4]byte
+		_, err = io.ReadFull(reader, crc[:])
+		require.NoError(t, err)
+
+		data := make([]byte, size)
+		_, err = io.ReadFull(reader, data)
+		require.NoError(t, err)
+		require.Equal(t, binary.BigEndian.Uint32(crc[:]),
+			crc32.Checksum(data, castagnoliTable))
+
+		var frame prompb.ChunkedReadResponse
+		require.NoError(t, frame.Unmarshal(data))
+		frames = append(frames, frame)
+	}
+}
+
+func TestChunkedWriter(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer := newChunkedWriter(recorder, recorder)
+
+	resp := &prompb.ChunkedReadResponse{QueryIndex: 3}
+	data, err := resp.Marshal()
+	require.NoError(t, err)
+
+	n, err := writer.Write(data)
+	require.NoError(t, err)
+	assert.Equal(t, len(data), n)
+	assert.True(t, recorder.Flushed)
+
+	frames := readChunkedFrames(t, bytes.NewReader(recorder.Body.Bytes()))
+	require.Equal(t, 1, len(frames))
+	assert.Equal(t, int64(3), frames[0].QueryIndex)
+}
+
+func TestReadStreamed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().Truncate(time.Second)
+	samples := make([]prompb.Sample, 0, 200)
+	for i := 0; i < 200; i++ {
+		samples = append(samples, prompb.Sample{
+			Timestamp: storage.TimeToPromTimestamp(now.Add(time.Duration(i) * time.Second)),
+			Value:     float64(i),
+		})
+	}
+
+	r := storage.PromResult{
+		PromResult: &prompb.QueryResult{
+			Timeseries: []*prompb.TimeSeries{
+				&prompb.TimeSeries{
+					Samples: samples,
+					Labels: []prompb.Label{
+						{Name: []byte("b"), Value: []byte("c")},
+						{Name: []byte("a"), Value: []byte("b")},
+					},
+				},
+				// Empty series are not streamed.
+				&prompb.TimeSeries{
+					Labels: []prompb.Label{{Name: []byte("c"), Value: []byte("d")}},
+				},
+			},
+		},
+		Metadata: block.ResultMetadata{
+			Exhaustive: false,
+			LocalOnly:  true,
+		},
+	}
+
+	req := &prompb.ReadRequest{
+		Queries: []*prompb.Query{{StartTimestampMs: 10}},
+		AcceptedResponseTypes: []prompb.ReadRequest_ResponseType{
+			prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		},
+	}
+
+	q, err := storage.PromReadQueryToM3(req.Queries[0])
+	require.NoError(t, err)
+
+	// The engine has no store that can return compressed results, so the
+	// handler falls back to re-encoding the executed query results.
+	engine := executor.NewMockEngine(ctrl)
+	engine.EXPECT().Options().Return(executor.NewEngineOptions()).AnyTimes()
+	engine.EXPECT().
+		ExecuteProm(gomock.Any(), q, gomock.Any(), gomock.Any()).
+		Return(r, nil)
+
+	h := NewPromReadHandler(engine, nil, nil, true, instrument.NewOptions()).(*PromReadHandler)
+	recorder := httptest.NewRecorder()
+	streaming, err := h.readStreamed(context.TODO(), recorder, req,
+		time.Minute, storage.NewFetchOptions())
+	require.NoError(t, err)
+	assert.True(t, streaming)
+
+	assert.Equal(t, streamedContentType, recorder.Header().Get("Content-Type"))
+	assert.Equal(t, handler.LimitHeaderSeriesLimitApplied,
+		recorder.Header().Get(handler.LimitHeader))
+
+	frames := readChunkedFrames(t, recorder.Body)
+	require.Equal(t, 1, len(frames))
+	assert.Equal(t, int64(0), frames[0].QueryIndex)
+	require.Equal(t, 1, len(frames[0].ChunkedSeries))
+
+	series := frames[0].ChunkedSeries[0]
+	assert.Equal(t, []prompb.Label{
+		{Name: []byte("a"), Value: []byte("b")},
+		{Name: []byte("b"), Value: []byte("c")},
+	}, series.Labels)
+
+	require.Equal(t, 2, len(series.Chunks))
+	assert.Equal(t, samples[0].Timestamp, series.Chunks[0].MinTimeMs)
+	assert.Equal(t, samples[len(samples)-1].Timestamp,
+		series.Chunks[len(series.Chunks)-1].MaxTimeMs)
+	for _, chunk := range series.Chunks {
+		assert.Equal(t, prompb.Chunk_XOR, chunk.Type)
+	}
+}
+
+func TestReadStreamedUnwrapsFanout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store, session := m3.NewStorageAndSession(t, ctrl)
+	session.EXPECT().
+		FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(encoding.EmptySeriesIterators, true, nil)
+	session.EXPECT().IteratorPools().Return(nil, nil).AnyTimes()
+
+	fanoutStore := fanout.NewStorage([]storage.Storage{store},
+		filter.AllowAll, filter.AllowAll, filter.CompleteTagsAllowAll,
+		instrument.NewOptions())
+
+	req := &prompb.ReadRequest{
+		Queries: []*prompb.Query{{StartTimestampMs: 10}},
+		AcceptedResponseTypes: []prompb.ReadRequest_ResponseType{
+			prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		},
+	}
+
+	// The compressed results are fetched from the store behind the fanout
+	// rather than by executing the query.
+	engine := executor.NewMockEngine(ctrl)
+	engine.EXPECT().Options().
+		Return(executor.NewEngineOptions().SetStore(fanoutStore)).AnyTimes()
+
+	h := NewPromReadHandler(engine, nil, nil, true, instrument.NewOptions()).(*PromReadHandler)
+	recorder := httptest.NewRecorder()
+	_, err := h.readStreamed(context.TODO(), recorder, req,
+		time.Minute, storage.NewFetchOptions())
+	require.NoError(t, err)
+	assert.Equal(t, streamedContentType, recorder.Header().Get("Content-Type"))
+}
+
+func TestReadStreamedWritesEachQueryOnceFetched(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().Truncate(time.Second)
+	r := storage.PromResult{
+		PromResult: &prompb.QueryResult{
+			Timeseries: []*prompb.TimeSeries{
+				&prompb.TimeSeries{
+					Samples: []prompb.Sample{{
+						Timestamp: storage.TimeToPromTimestamp(now),
+						Value:     1,
+					}},
+					Labels: []prompb.Label{{Name: []byte("a"), Value: []byte("b")}},
+				},
+			},
+		},
+		Metadata: block.NewResultMetadata(),
+	}
+
+	req := &prompb.ReadRequest{
+		Queries: []*prompb.Query{
+			{StartTimestampMs: 10},
+			{StartTimestampMs: 20},
+		},
+		AcceptedResponseTypes: []prompb.ReadRequest_ResponseType{
+			prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		},
+	}
+
+	first, err := storage.PromReadQueryToM3(req.Queries[0])
+	require.NoError(t, err)
+	second, err := storage.PromReadQueryToM3(req.Queries[1])
+	require.NoError(t, err)
+
+	engine := executor.NewMockEngine(ctrl)
+	engine.EXPECT().Options().Return(executor.NewEngineOptions()).AnyTimes()
+	gomock.InOrder(
+		engine.EXPECT().
+			ExecuteProm(gomock.Any(), first, gomock.Any(), gomock.Any()).
+			Return(r, nil),
+		engine.EXPECT().
+			ExecuteProm(gomock.Any(), second, gomock.Any(), gomock.Any()).
+			Return(storage.PromResult{}, errors.New("fetch error")),
+	)
+
+	h := NewPromReadHandler(engine, nil, nil, true, instrument.NewOptions()).(*PromReadHandler)
+	recorder := httptest.NewRecorder()
+	streaming, err := h.readStreamed(context.TODO(), recorder, req,
+		time.Minute, storage.NewFetchOptions())
+	require.Error(t, err)
+
+	// The results of the first query are written before the second query
+	// is executed.
+	assert.True(t, streaming)
+	frames := readChunkedFrames(t, recorder.Body)
+	require.Equal(t, 1, len(frames))
+	assert.Equal(t, int64(0), frames[0].QueryIndex)
+	require.Equal(t, 1, len(frames[0].ChunkedSeries))
+}
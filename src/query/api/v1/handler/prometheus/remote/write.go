@@ -36,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/metadata"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
@@ -82,6 +83,7 @@ type PromWriteHandler struct {
 	forwardHTTPClient      *http.Client
 	forwardingBoundWorkers xsync.WorkerPool
 	forwardContext         context.Context
+	metadataStore          metadata.Store
 	nowFn                  clock.NowFn
 	instrumentOpts         instrument.Options
 	metrics                promWriteMetrics
@@ -108,6 +110,7 @@ func NewPromWriteHandler(
 	downsamplerAndWriter ingest.DownsamplerAndWriter,
 	tagOptions models.TagOptions,
 	forwarding PromWriteHandlerForwardingOptions,
+	metadataStore metadata.Store,
 	nowFn clock.NowFn,
 	instrumentOpts instrument.Options,
 ) (http.Handler, error) {
@@ -153,6 +156,7 @@ func NewPromWriteHandler(
 		forwardHTTPClient:      xhttp.NewHTTPClient(forwardHTTPOpts),
 		forwardingBoundWorkers: forwardingBoundWorkers,
 		forwardContext:         context.Background(),
+		metadataStore:          metadataStore,
 		nowFn:                  nowFn,
 		metrics:                metrics,
 		instrumentOpts:         instrumentOpts,
@@ -273,6 +277,10 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if h.metadataStore != nil && len(req.Metadata) > 0 {
+		h.writeMetadata(req.Metadata)
+	}
+
 	batchErr := h.write(r.Context(), req, opts)
 
 	// Record ingestion delay latency
@@ -407,6 +415,15 @@ func (h *PromWriteHandler) parseRequest(
 	return &req, opts, result, nil
 }
 
+func (h *PromWriteHandler) writeMetadata(promMetadata []prompb.MetricMetadata) {
+	metadataList := make([]metadata.Metadata, 0, len(promMetadata))
+	for _, m := range promMetadata {
+		metadataList = append(metadataList, metadata.FromPromMetadata(m))
+	}
+
+	h.metadataStore.Write(metadataList)
+}
+
 func (h *PromWriteHandler) write(
 	ctx context.Context,
 	r *prompb.WriteRequest,
@@ -35,6 +35,8 @@ import (
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote/test"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/metadata"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	xclock "github.com/m3db/m3/src/x/clock"
@@ -52,7 +54,7 @@ func TestPromWriteParsing(t *testing.T) {
 
 	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
 	handler, err := NewPromWriteHandler(mockDownsamplerAndWriter,
-		models.NewTagOptions(), PromWriteHandlerForwardingOptions{},
+		models.NewTagOptions(), PromWriteHandlerForwardingOptions{}, nil,
 		time.Now, instrument.NewOptions())
 	require.NoError(t, err)
 
@@ -76,7 +78,7 @@ func TestPromWrite(t *testing.T) {
 		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
 
 	handler, err := NewPromWriteHandler(mockDownsamplerAndWriter,
-		models.NewTagOptions(), PromWriteHandlerForwardingOptions{},
+		models.NewTagOptions(), PromWriteHandlerForwardingOptions{}, nil,
 		time.Now, instrument.NewOptions())
 	require.NoError(t, err)
 
@@ -90,6 +92,46 @@ func TestPromWrite(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPromWriteMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	metadataStore, err := metadata.Configuration{}.NewStore(nil,
+		instrument.NewOptions())
+	require.NoError(t, err)
+
+	handler, err := NewPromWriteHandler(mockDownsamplerAndWriter,
+		models.NewTagOptions(), PromWriteHandlerForwardingOptions{},
+		metadataStore, time.Now, instrument.NewOptions())
+	require.NoError(t, err)
+
+	promReq := test.GeneratePromWriteRequest()
+	promReq.Metadata = []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricMetadata_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total HTTP requests.",
+		},
+	}
+	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	m, ok := metadataStore.Get("http_requests_total")
+	require.True(t, ok)
+	require.Equal(t, metadata.CounterType, m.Type)
+	require.Equal(t, "Total HTTP requests.", m.Help)
+}
+
 func TestPromWriteError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -103,7 +145,7 @@ func TestPromWriteError(t *testing.T) {
 		Return(batchErr)
 
 	handler, err := NewPromWriteHandler(mockDownsamplerAndWriter,
-		models.NewTagOptions(), PromWriteHandlerForwardingOptions{},
+		models.NewTagOptions(), PromWriteHandlerForwardingOptions{}, nil,
 		time.Now, instrument.NewOptions())
 	require.NoError(t, err)
 
@@ -135,7 +177,7 @@ func TestWriteErrorMetricCount(t *testing.T) {
 		SetMetricsScope(scope)
 
 	handler, err := NewPromWriteHandler(mockDownsamplerAndWriter,
-		models.NewTagOptions(), PromWriteHandlerForwardingOptions{},
+		models.NewTagOptions(), PromWriteHandlerForwardingOptions{}, nil,
 		time.Now, iopts)
 	require.NoError(t, err)
 
@@ -162,7 +204,7 @@ func TestWriteDatapointDelayMetric(t *testing.T) {
 		map[string]string{"test": "delay-metric-test"})
 
 	handler, err := NewPromWriteHandler(mockDownsamplerAndWriter,
-		models.NewTagOptions(), PromWriteHandlerForwardingOptions{},
+		models.NewTagOptions(), PromWriteHandlerForwardingOptions{}, nil,
 		time.Now, instrument.NewOptions().SetMetricsScope(scope))
 	require.NoError(t, err)
 
@@ -224,7 +266,7 @@ func TestPromWriteUnaggregatedMetricsWithHeader(t *testing.T) {
 		WriteBatch(gomock.Any(), gomock.Any(), expectedIngestWriteOptions)
 
 	writeHandler, err := NewPromWriteHandler(mockDownsamplerAndWriter,
-		models.NewTagOptions(), PromWriteHandlerForwardingOptions{},
+		models.NewTagOptions(), PromWriteHandlerForwardingOptions{}, nil,
 		time.Now, instrument.NewOptions())
 	require.NoError(t, err)
 
@@ -259,7 +301,7 @@ func TestPromWriteAggregatedMetricsWithHeader(t *testing.T) {
 		WriteBatch(gomock.Any(), gomock.Any(), expectedIngestWriteOptions)
 
 	writeHandler, err := NewPromWriteHandler(mockDownsamplerAndWriter,
-		models.NewTagOptions(), PromWriteHandlerForwardingOptions{},
+		models.NewTagOptions(), PromWriteHandlerForwardingOptions{}, nil,
 		time.Now, instrument.NewOptions())
 	require.NoError(t, err)
 
@@ -314,6 +314,7 @@ func newServer() (*httptest.Server, *PromDebugHandler) {
 			&config.LimitsConfiguration{},
 			timeoutOpts,
 			true,
+			nil,
 			instrumentOpts,
 		),
 		handler.NewFetchOptionsBuilder(handler.FetchOptionsBuilderOptions{}),
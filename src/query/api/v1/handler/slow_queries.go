@@ -0,0 +1,117 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/slowlog"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// SlowQueriesURL is the url for the slow query log debug handler.
+	SlowQueriesURL = RoutePrefixV1 + "/debug/slow_queries"
+
+	// SlowQueriesHTTPMethod is the HTTP method used with this resource.
+	SlowQueriesHTTPMethod = http.MethodGet
+
+	slowQueriesLimitParam  = "limit"
+	slowQueriesQueryParam  = "query"
+	slowQueriesSourceParam = "source"
+)
+
+var errSlowQueryLogDisabled = errors.New("slow query log is not enabled")
+
+// SlowQueriesHandler serves the most recent entries of the slow query log,
+// optionally filtered by query text and source.
+type SlowQueriesHandler struct {
+	engine         executor.Engine
+	instrumentOpts instrument.Options
+}
+
+// NewSlowQueriesHandler returns a new instance of SlowQueriesHandler.
+func NewSlowQueriesHandler(
+	engine executor.Engine,
+	instrumentOpts instrument.Options,
+) http.Handler {
+	return &SlowQueriesHandler{
+		engine:         engine,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+type slowQueriesResponse struct {
+	Queries []slowlog.Entry `json:"queries"`
+}
+
+func (h *SlowQueriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+	slowQueryLogger := h.engine.Options().SlowQueryLogger()
+	if slowQueryLogger == nil {
+		xhttp.Error(w, errSlowQueryLogDisabled, http.StatusNotFound)
+		return
+	}
+
+	limit := 0
+	if str := r.FormValue(slowQueriesLimitParam); str != "" {
+		n, err := strconv.Atoi(str)
+		if err != nil || n < 0 {
+			xhttp.Error(w, fmt.Errorf("invalid limit: %s", str),
+				http.StatusBadRequest)
+			return
+		}
+
+		limit = n
+	}
+
+	var (
+		query   = r.FormValue(slowQueriesQueryParam)
+		source  = r.FormValue(slowQueriesSourceParam)
+		entries = slowQueryLogger.Entries()
+		queries = make([]slowlog.Entry, 0, len(entries))
+	)
+
+	for _, entry := range entries {
+		if limit > 0 && len(queries) >= limit {
+			break
+		}
+
+		if query != "" && !strings.Contains(entry.Query, query) {
+			continue
+		}
+
+		if source != "" && entry.Source != source {
+			continue
+		}
+
+		queries = append(queries, entry)
+	}
+
+	xhttp.WriteJSONResponse(w, slowQueriesResponse{Queries: queries}, logger)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/msg/topic"
 	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/instrument"
 	xhttp "github.com/m3db/m3/src/x/net/http"
 
@@ -54,6 +55,7 @@ type Handler struct {
 
 	serviceFn      serviceFn
 	instrumentOpts instrument.Options
+	nowFn          clock.NowFn
 }
 
 // Service gets a topic service from m3cluster client
@@ -87,6 +89,24 @@ func RegisterRoutes(
 	r.HandleFunc(DeleteURL,
 		wrapped(NewDeleteHandler(client, cfg, instrumentOpts)).ServeHTTP).
 		Methods(DeleteHTTPMethod)
+	r.HandleFunc(HistoryURL,
+		wrapped(NewHistoryHandler(client, cfg, instrumentOpts)).ServeHTTP).
+		Methods(HistoryHTTPMethod)
+	r.HandleFunc(RollbackURL,
+		wrapped(NewRollbackHandler(client, cfg, instrumentOpts)).ServeHTTP).
+		Methods(RollbackHTTPMethod)
+	r.HandleFunc(UpdateURL,
+		wrapped(NewUpdateHandler(client, cfg, instrumentOpts)).ServeHTTP).
+		Methods(UpdateHTTPMethod)
+	r.HandleFunc(ReshardURL,
+		wrapped(NewReshardHandler(client, cfg, instrumentOpts)).ServeHTTP).
+		Methods(ReshardHTTPMethod)
+	r.HandleFunc(ReshardCompleteURL,
+		wrapped(NewReshardCompleteHandler(client, cfg, instrumentOpts)).ServeHTTP).
+		Methods(ReshardCompleteHTTPMethod)
+	r.HandleFunc(ReshardURL,
+		wrapped(NewReshardAbortHandler(client, cfg, instrumentOpts)).ServeHTTP).
+		Methods(ReshardAbortHTTPMethod)
 }
 
 func topicName(headers http.Header) string {
@@ -0,0 +1,210 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"net/http"
+	"sort"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// HistoryURL is the url for the topic history handler (with the GET
+	// method).
+	HistoryURL = handler.RoutePrefixV1 + "/topic/history"
+
+	// HistoryHTTPMethod is the HTTP method used with this resource.
+	HistoryHTTPMethod = http.MethodGet
+)
+
+// HistoryResponse is the response returned by the topic history handler.
+type HistoryResponse struct {
+	CurrentVersion int                 `json:"currentVersion"`
+	Versions       []TopicHistoryEntry `json:"versions"`
+}
+
+// TopicHistoryEntry describes a single historical version of a topic, the
+// diff is relative to the preceding version and is omitted for the first
+// version. KV stores do not record when a version was written so no
+// timestamp is available for topic versions.
+type TopicHistoryEntry struct {
+	Version          int               `json:"version"`
+	NumberOfShards   uint32            `json:"numberOfShards"`
+	ConsumerServices []string          `json:"consumerServices"`
+	Diff             *TopicVersionDiff `json:"diff,omitempty"`
+}
+
+// TopicVersionDiff is the semantic difference between two versions of a
+// topic.
+type TopicVersionDiff struct {
+	NumberOfShardsChange     *TopicShardsChange `json:"numberOfShardsChange,omitempty"`
+	AddedConsumerServices    []string           `json:"addedConsumerServices,omitempty"`
+	RemovedConsumerServices  []string           `json:"removedConsumerServices,omitempty"`
+	ModifiedConsumerServices []string           `json:"modifiedConsumerServices,omitempty"`
+}
+
+// TopicShardsChange is a change of the number of shards of a topic.
+type TopicShardsChange struct {
+	From uint32 `json:"from"`
+	To   uint32 `json:"to"`
+}
+
+// HistoryHandler is the handler for topic history.
+type HistoryHandler Handler
+
+// NewHistoryHandler returns a new instance of HistoryHandler.
+func NewHistoryHandler(
+	client clusterclient.Client,
+	cfg config.Configuration,
+	instrumentOpts instrument.Options,
+) *HistoryHandler {
+	return &HistoryHandler{
+		client:         client,
+		cfg:            cfg,
+		serviceFn:      Service,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+func (h *HistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx    = r.Context()
+		logger = logging.WithContext(ctx, h.instrumentOpts)
+		name   = topicName(r.Header)
+	)
+
+	service, err := h.serviceFn(h.client)
+	if err != nil {
+		logger.Error("unable to get service", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	current, err := service.Get(name)
+	if err != nil {
+		logger.Error("unable to get topic", zap.Error(err))
+		xhttp.Error(w, err, http.StatusNotFound)
+		return
+	}
+
+	rng, err := handler.ParseVersionRange(r, current.Version())
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	// Fetch the version preceding the range so the first version returned
+	// can be diffed as well.
+	from := rng.From
+	if from > 1 {
+		from--
+	}
+	topics, err := service.History(name, from, rng.To+1)
+	if err != nil {
+		logger.Error("unable to get topic history", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := HistoryResponse{
+		CurrentVersion: current.Version(),
+		Versions:       make([]TopicHistoryEntry, 0, len(topics)),
+	}
+
+	var prev topic.Topic
+	for _, t := range topics {
+		if t.Version() >= rng.From {
+			entry := TopicHistoryEntry{
+				Version:          t.Version(),
+				NumberOfShards:   t.NumberOfShards(),
+				ConsumerServices: consumerServiceIDs(t),
+			}
+			if prev != nil {
+				diff := DiffTopics(prev, t)
+				entry.Diff = &diff
+			}
+			resp.Versions = append(resp.Versions, entry)
+		}
+		prev = t
+	}
+
+	xhttp.WriteJSONResponse(w, resp, logger)
+}
+
+// DiffTopics returns the semantic difference between two versions of a topic.
+func DiffTopics(prev, next topic.Topic) TopicVersionDiff {
+	var diff TopicVersionDiff
+	if prev.NumberOfShards() != next.NumberOfShards() {
+		diff.NumberOfShardsChange = &TopicShardsChange{
+			From: prev.NumberOfShards(),
+			To:   next.NumberOfShards(),
+		}
+	}
+
+	prevServices := consumerServicesByID(prev)
+	nextServices := consumerServicesByID(next)
+	for id, cs := range nextServices {
+		prevCS, ok := prevServices[id]
+		if !ok {
+			diff.AddedConsumerServices = append(diff.AddedConsumerServices, id)
+			continue
+		}
+		if prevCS.ConsumptionType() != cs.ConsumptionType() ||
+			prevCS.MessageTTLNanos() != cs.MessageTTLNanos() {
+			diff.ModifiedConsumerServices = append(diff.ModifiedConsumerServices, id)
+		}
+	}
+	for id := range prevServices {
+		if _, ok := nextServices[id]; !ok {
+			diff.RemovedConsumerServices = append(diff.RemovedConsumerServices, id)
+		}
+	}
+	sort.Strings(diff.AddedConsumerServices)
+	sort.Strings(diff.RemovedConsumerServices)
+	sort.Strings(diff.ModifiedConsumerServices)
+	return diff
+}
+
+func consumerServicesByID(t topic.Topic) map[string]topic.ConsumerService {
+	services := make(map[string]topic.ConsumerService, len(t.ConsumerServices()))
+	for _, cs := range t.ConsumerServices() {
+		services[cs.ServiceID().String()] = cs
+	}
+	return services
+}
+
+func consumerServiceIDs(t topic.Topic) []string {
+	ids := make([]string, 0, len(t.ConsumerServices()))
+	for _, cs := range t.ConsumerServices() {
+		ids = append(ids, cs.ServiceID().String())
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHistoryTestTopic(version int, numShards uint32, consumers ...string) topic.Topic {
+	css := make([]topic.ConsumerService, 0, len(consumers))
+	for _, c := range consumers {
+		css = append(css, topic.NewConsumerService().
+			SetConsumptionType(topic.Shared).
+			SetServiceID(services.NewServiceID().SetName(c)))
+	}
+	return topic.NewTopic().
+		SetName(DefaultTopicName).
+		SetNumberOfShards(numShards).
+		SetConsumerServices(css).
+		SetVersion(version)
+}
+
+func TestTopicHistoryHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewHistoryHandler(nil, config.Configuration{}, instrument.NewOptions())
+	handler.serviceFn = testServiceFn(mockService)
+
+	var (
+		v1 = newHistoryTestTopic(1, 64, "a")
+		v2 = newHistoryTestTopic(2, 64, "a", "b")
+		v3 = newHistoryTestTopic(3, 128, "b")
+	)
+	mockService.EXPECT().Get(DefaultTopicName).Return(v3, nil)
+	mockService.EXPECT().History(DefaultTopicName, 1, 4).
+		Return([]topic.Topic{v1, v2, v3}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(HistoryHTTPMethod, HistoryURL+"?from=2", nil)
+	handler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var history HistoryResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&history))
+	assert.Equal(t, 3, history.CurrentVersion)
+	require.Equal(t, 2, len(history.Versions))
+
+	var (
+		idA = services.NewServiceID().SetName("a").String()
+		idB = services.NewServiceID().SetName("b").String()
+	)
+	assert.Equal(t, TopicHistoryEntry{
+		Version:          2,
+		NumberOfShards:   64,
+		ConsumerServices: []string{idA, idB},
+		Diff:             &TopicVersionDiff{AddedConsumerServices: []string{idB}},
+	}, history.Versions[0])
+	assert.Equal(t, TopicHistoryEntry{
+		Version:          3,
+		NumberOfShards:   128,
+		ConsumerServices: []string{idB},
+		Diff: &TopicVersionDiff{
+			NumberOfShardsChange:    &TopicShardsChange{From: 64, To: 128},
+			RemovedConsumerServices: []string{idA},
+		},
+	}, history.Versions[1])
+}
+
+func TestTopicRollbackHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewRollbackHandler(nil, config.Configuration{}, instrument.NewOptions())
+	handler.serviceFn = testServiceFn(mockService)
+
+	var (
+		v1 = newHistoryTestTopic(1, 64, "a")
+		v2 = newHistoryTestTopic(2, 64, "a", "b")
+		v3 = newHistoryTestTopic(3, 128, "b")
+	)
+
+	// Changing the number of shards is rejected unless forced.
+	mockService.EXPECT().Get(DefaultTopicName).Return(v3, nil)
+	mockService.EXPECT().History(DefaultTopicName, 2, 3).Return([]topic.Topic{v2}, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(RollbackHTTPMethod, RollbackURL,
+		strings.NewReader(`{"version": 2}`))
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	mockService.EXPECT().Get(DefaultTopicName).Return(v3, nil)
+	mockService.EXPECT().History(DefaultTopicName, 1, 2).Return([]topic.Topic{v1}, nil)
+	mockService.EXPECT().CheckAndSet(v1, 3).Return(v1.SetVersion(4), nil)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(RollbackHTTPMethod, RollbackURL,
+		strings.NewReader(`{"version": 1, "force": true}`))
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	// The current version cannot be rolled back to.
+	mockService.EXPECT().Get(DefaultTopicName).Return(v3, nil)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(RollbackHTTPMethod, RollbackURL,
+		strings.NewReader(`{"version": 3}`))
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// ReshardURL is the url for the topic reshard handler (with the POST
+	// method), and the topic reshard abort handler (with the DELETE method).
+	ReshardURL = handler.RoutePrefixV1 + "/topic/reshard"
+
+	// ReshardHTTPMethod is the HTTP method used to start resharding a topic.
+	ReshardHTTPMethod = http.MethodPost
+
+	// ReshardCompleteURL is the url for the topic reshard complete handler
+	// (with the POST method).
+	ReshardCompleteURL = handler.RoutePrefixV1 + "/topic/reshard/complete"
+
+	// ReshardCompleteHTTPMethod is the HTTP method used to complete resharding
+	// a topic.
+	ReshardCompleteHTTPMethod = http.MethodPost
+
+	// ReshardAbortHTTPMethod is the HTTP method used to abort resharding a
+	// topic.
+	ReshardAbortHTTPMethod = http.MethodDelete
+
+	reshardMinDrainDurationVar = "minDrainDuration"
+)
+
+var errInvalidMinDrainDuration = errors.New("invalid minDrainDuration")
+
+type reshardFn func(t topic.Topic) (topic.Topic, error)
+
+// ReshardHandler is the handler to start resharding a topic. While a topic is
+// being resharded producers write messages to their shards for both the
+// current and the pending number of shards, so the placements of the consumer
+// services need to own the pending shards before resharding starts.
+type ReshardHandler Handler
+
+// NewReshardHandler returns a new instance of ReshardHandler.
+func NewReshardHandler(
+	client clusterclient.Client,
+	cfg config.Configuration,
+	instrumentOpts instrument.Options,
+) *ReshardHandler {
+	return &ReshardHandler{
+		client:         client,
+		cfg:            cfg,
+		serviceFn:      Service,
+		instrumentOpts: instrumentOpts,
+		nowFn:          time.Now,
+	}
+}
+
+func (h *ReshardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx    = r.Context()
+		logger = logging.WithContext(ctx, h.instrumentOpts)
+		req    admin.TopicReshardRequest
+	)
+	rErr := parseRequest(r, &req)
+	if rErr != nil {
+		logger.Error("unable to parse request", zap.Error(rErr))
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	serveReshard((*Handler)(h), w, r, func(t topic.Topic) (topic.Topic, error) {
+		return t.StartReshard(req.NumberOfShards, h.nowFn().UnixNano())
+	})
+}
+
+// ReshardCompleteHandler is the handler to complete resharding a topic, once
+// consumers have drained the messages of the old shards. Producers cut over
+// to the new number of shards, and stop writing to the old shards. The old
+// shards are considered drained once the longest message ttl of the consumer
+// services has elapsed since resharding started, the minDrainDuration query
+// parameter extends this and is required when a consumer service retries
+// messages without a ttl.
+type ReshardCompleteHandler Handler
+
+// NewReshardCompleteHandler returns a new instance of ReshardCompleteHandler.
+func NewReshardCompleteHandler(
+	client clusterclient.Client,
+	cfg config.Configuration,
+	instrumentOpts instrument.Options,
+) *ReshardCompleteHandler {
+	return &ReshardCompleteHandler{
+		client:         client,
+		cfg:            cfg,
+		serviceFn:      Service,
+		instrumentOpts: instrumentOpts,
+		nowFn:          time.Now,
+	}
+}
+
+func (h *ReshardCompleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	minDrain, err := parseMinDrainDuration(r)
+	if err != nil {
+		logger := logging.WithContext(r.Context(), h.instrumentOpts)
+		logger.Error("unable to parse request", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	serveReshard((*Handler)(h), w, r, func(t topic.Topic) (topic.Topic, error) {
+		return t.CompleteReshard(h.nowFn().UnixNano(), int64(minDrain))
+	})
+}
+
+func parseMinDrainDuration(r *http.Request) (time.Duration, error) {
+	v := r.URL.Query().Get(reshardMinDrainDurationVar)
+	if v == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("%v: %s", errInvalidMinDrainDuration, v)
+	}
+	return d, nil
+}
+
+// ReshardAbortHandler is the handler to abort resharding a topic, producers
+// stop writing to the pending shards.
+type ReshardAbortHandler Handler
+
+// NewReshardAbortHandler returns a new instance of ReshardAbortHandler.
+func NewReshardAbortHandler(
+	client clusterclient.Client,
+	cfg config.Configuration,
+	instrumentOpts instrument.Options,
+) *ReshardAbortHandler {
+	return &ReshardAbortHandler{
+		client:         client,
+		cfg:            cfg,
+		serviceFn:      Service,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+func (h *ReshardAbortHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveReshard((*Handler)(h), w, r, func(t topic.Topic) (topic.Topic, error) {
+		return t.AbortReshard()
+	})
+}
+
+func serveReshard(
+	h *Handler,
+	w http.ResponseWriter,
+	r *http.Request,
+	fn reshardFn,
+) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+	service, err := h.serviceFn(h.client)
+	if err != nil {
+		logger.Error("unable to get service", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	t, err := service.Get(topicName(r.Header))
+	if err != nil {
+		logger.Error("unable to get topic", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	t, err = fn(t)
+	if err != nil {
+		logger.Error("unable to reshard topic", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	t, err = service.CheckAndSet(t, t.Version())
+	if err != nil {
+		logger.Error("unable to persist topic", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	topicProto, err := topic.ToProto(t)
+	if err != nil {
+		logger.Error("unable to get topic protobuf", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := &admin.TopicGetResponse{
+		Topic:   topicProto,
+		Version: uint32(t.Version()),
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicReshardHandlers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	opts := instrument.NewOptions()
+	startHandler := NewReshardHandler(nil, config.Configuration{}, opts)
+	startHandler.serviceFn = testServiceFn(mockService)
+	completeHandler := NewReshardCompleteHandler(nil, config.Configuration{}, opts)
+	completeHandler.serviceFn = testServiceFn(mockService)
+	abortHandler := NewReshardAbortHandler(nil, config.Configuration{}, opts)
+	abortHandler.serviceFn = testServiceFn(mockService)
+
+	current := topic.NewTopic().
+		SetName(DefaultTopicName).
+		SetNumberOfShards(256).
+		SetVersion(1)
+	mockService.EXPECT().Get(gomock.Any()).DoAndReturn(func(string) (topic.Topic, error) {
+		return current, nil
+	}).AnyTimes()
+	mockService.
+		EXPECT().
+		CheckAndSet(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(updated topic.Topic, version int) (topic.Topic, error) {
+			current = updated.SetVersion(version + 1)
+			return current, nil
+		}).
+		AnyTimes()
+
+	serve := func(h http.Handler, method, url string, body []byte) (int, admin.TopicGetResponse) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(method, url, bytes.NewBuffer(body)))
+		resp := w.Result()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		var respProto admin.TopicGetResponse
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, jsonUnmarshaler.Unmarshal(bytes.NewBuffer(respBody), &respProto))
+		}
+		return resp.StatusCode, respProto
+	}
+
+	b := bytes.NewBuffer(nil)
+	require.NoError(t, jsonMarshaler.Marshal(b, &admin.TopicReshardRequest{NumberOfShards: 1024}))
+	reshardBody := b.Bytes()
+
+	// Completing or aborting requires a reshard in progress.
+	code, _ := serve(completeHandler, ReshardCompleteHTTPMethod, ReshardCompleteURL, nil)
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = serve(abortHandler, ReshardAbortHTTPMethod, ReshardURL, nil)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, resp := serve(startHandler, ReshardHTTPMethod, ReshardURL, reshardBody)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, uint32(256), resp.Topic.NumberOfShards)
+	require.Equal(t, uint32(1024), resp.Topic.PendingNumberOfShards)
+	require.Equal(t, uint32(2), resp.Version)
+
+	// Only one reshard may be in progress.
+	code, _ = serve(startHandler, ReshardHTTPMethod, ReshardURL, reshardBody)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, resp = serve(abortHandler, ReshardAbortHTTPMethod, ReshardURL, nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, uint32(256), resp.Topic.NumberOfShards)
+	require.Equal(t, uint32(0), resp.Topic.PendingNumberOfShards)
+
+	code, _ = serve(startHandler, ReshardHTTPMethod, ReshardURL, reshardBody)
+	require.Equal(t, http.StatusOK, code)
+	code, resp = serve(completeHandler, ReshardCompleteHTTPMethod, ReshardCompleteURL, nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, uint32(1024), resp.Topic.NumberOfShards)
+	require.Equal(t, uint32(0), resp.Topic.PendingNumberOfShards)
+	require.Equal(t, uint32(5), resp.Version)
+}
+
+func TestTopicReshardCompleteHandlerWaitsForDrain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	completeHandler := NewReshardCompleteHandler(nil, config.Configuration{}, instrument.NewOptions())
+	completeHandler.serviceFn = testServiceFn(mockService)
+
+	start := time.Now()
+	now := start
+	completeHandler.nowFn = func() time.Time { return now }
+
+	cs := topic.NewConsumerService().
+		SetConsumptionType(topic.Shared).
+		SetServiceID(services.NewServiceID().SetName("s1")).
+		SetMessageTTLNanos(int64(time.Minute))
+	current, err := topic.NewTopic().
+		SetName(DefaultTopicName).
+		SetNumberOfShards(256).
+		SetConsumerServices([]topic.ConsumerService{cs}).
+		SetVersion(1).
+		StartReshard(1024, start.UnixNano())
+	require.NoError(t, err)
+	mockService.EXPECT().Get(gomock.Any()).Return(current, nil).AnyTimes()
+
+	serve := func(url string) int {
+		w := httptest.NewRecorder()
+		completeHandler.ServeHTTP(w, httptest.NewRequest(ReshardCompleteHTTPMethod, url, nil))
+		return w.Result().StatusCode
+	}
+
+	// The consumers have not drained the old shards before the message ttl
+	// elapsed.
+	now = start.Add(30 * time.Second)
+	require.Equal(t, http.StatusBadRequest, serve(ReshardCompleteURL))
+
+	// The minimum drain duration extends the message ttl.
+	now = start.Add(time.Minute)
+	require.Equal(t, http.StatusBadRequest, serve(ReshardCompleteURL+"?minDrainDuration=2m"))
+	require.Equal(t, http.StatusBadRequest, serve(ReshardCompleteURL+"?minDrainDuration=foo"))
+
+	mockService.
+		EXPECT().
+		CheckAndSet(gomock.Any(), 1).
+		DoAndReturn(func(updated topic.Topic, version int) (topic.Topic, error) {
+			return updated.SetVersion(version + 1), nil
+		})
+	require.Equal(t, http.StatusOK, serve(ReshardCompleteURL))
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// RollbackURL is the url for the topic rollback handler (with the POST
+	// method).
+	RollbackURL = handler.RoutePrefixV1 + "/topic/rollback"
+
+	// RollbackHTTPMethod is the HTTP method used with this resource.
+	RollbackHTTPMethod = http.MethodPost
+)
+
+var errNoRollbackVersion = errors.New("version to roll back to must be specified")
+
+// RollbackRequest is the request to restore a previous version of a topic.
+type RollbackRequest struct {
+	Version int `json:"version"`
+	// By default rollbacks that change the number of shards of the topic are
+	// rejected since producers and consumers shard messages by it. Force
+	// overrides that.
+	Force bool `json:"force"`
+}
+
+// RollbackHandler is the handler for topic rollbacks.
+type RollbackHandler Handler
+
+// NewRollbackHandler returns a new instance of RollbackHandler.
+func NewRollbackHandler(
+	client clusterclient.Client,
+	cfg config.Configuration,
+	instrumentOpts instrument.Options,
+) *RollbackHandler {
+	return &RollbackHandler{
+		client:         client,
+		cfg:            cfg,
+		serviceFn:      Service,
+		instrumentOpts: instrumentOpts,
+	}
+}
+
+func (h *RollbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx    = r.Context()
+		logger = logging.WithContext(ctx, h.instrumentOpts)
+		req    RollbackRequest
+	)
+
+	defer r.Body.Close()
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error("unable to parse request", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+	if req.Version <= 0 {
+		xhttp.Error(w, errNoRollbackVersion, http.StatusBadRequest)
+		return
+	}
+
+	service, err := h.serviceFn(h.client)
+	if err != nil {
+		logger.Error("unable to get service", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	t, err := h.rollback(service, topicName(r.Header), req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(invalidRollbackError); ok {
+			status = http.StatusBadRequest
+		}
+		logger.Error("unable to roll back topic", zap.Error(err))
+		xhttp.Error(w, err, status)
+		return
+	}
+
+	pb, err := topic.ToProto(t)
+	if err != nil {
+		logger.Error("unable to get topic protobuf", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := &admin.TopicGetResponse{
+		Topic:   pb,
+		Version: uint32(t.Version()),
+	}
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func (h *RollbackHandler) rollback(
+	service topic.Service,
+	name string,
+	req RollbackRequest,
+) (topic.Topic, error) {
+	current, err := service.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Version >= current.Version() {
+		return nil, invalidRollbackError{fmt.Errorf(
+			"cannot roll back to version %d, current version is %d",
+			req.Version, current.Version())}
+	}
+
+	topics, err := service.History(name, req.Version, req.Version+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(topics) != 1 {
+		return nil, invalidRollbackError{fmt.Errorf(
+			"topic version %d is not available", req.Version)}
+	}
+
+	target := topics[0]
+	if !req.Force && target.NumberOfShards() != current.NumberOfShards() {
+		return nil, invalidRollbackError{fmt.Errorf(
+			"topic version %d has %d shards, current version has %d shards",
+			req.Version, target.NumberOfShards(), current.NumberOfShards())}
+	}
+
+	// Ensure the topic being replaced is still the current one.
+	return service.CheckAndSet(target, current.Version())
+}
+
+type invalidRollbackError struct {
+	error
+}
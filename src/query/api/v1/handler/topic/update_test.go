@@ -0,0 +1,196 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/generated/proto/topicpb"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicUpdateHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewUpdateHandler(nil, config.Configuration{}, instrument.NewOptions())
+	handler.serviceFn = testServiceFn(mockService)
+
+	cs := topic.NewConsumerService().
+		SetServiceID(services.NewServiceID().
+			SetEnvironment("env1").
+			SetZone("zone1").
+			SetName("name1")).
+		SetConsumptionType(topic.Shared).
+		SetMessageTTLNanos(int64(time.Minute))
+	t1 := topic.NewTopic().
+		SetName(DefaultTopicName).
+		SetNumberOfShards(256).
+		SetConsumerServices([]topic.ConsumerService{cs}).
+		SetVersion(2)
+
+	// The consumption type is not specified, only the message ttl is updated.
+	updateProto := admin.TopicUpdateRequest{
+		ConsumerService: &topicpb.ConsumerService{
+			ServiceId: &topicpb.ServiceID{
+				Environment: "env1",
+				Zone:        "zone1",
+				Name:        "name1",
+			},
+			MessageTtlNanos: int64(5 * time.Minute),
+		},
+	}
+	w := httptest.NewRecorder()
+	b := bytes.NewBuffer(nil)
+	require.NoError(t, jsonMarshaler.Marshal(b, &updateProto))
+	mockService.
+		EXPECT().
+		Get(gomock.Any()).
+		Return(t1, nil)
+	mockService.
+		EXPECT().
+		CheckAndSet(gomock.Any(), 2).
+		DoAndReturn(func(updated topic.Topic, version int) (topic.Topic, error) {
+			return updated.SetVersion(3), nil
+		})
+	req := httptest.NewRequest(UpdateHTTPMethod, "/topic", b)
+	require.NotNil(t, req)
+	handler.ServeHTTP(w, req)
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var respProto admin.TopicGetResponse
+	require.NoError(t, jsonUnmarshaler.Unmarshal(bytes.NewBuffer(body), &respProto))
+
+	validateEqualTopicProto(t, topicpb.Topic{
+		Name:           DefaultTopicName,
+		NumberOfShards: 256,
+		ConsumerServices: []*topicpb.ConsumerService{
+			&topicpb.ConsumerService{
+				ConsumptionType: topicpb.ConsumptionType_SHARED,
+				ServiceId: &topicpb.ServiceID{
+					Environment: "env1",
+					Zone:        "zone1",
+					Name:        "name1",
+				},
+				MessageTtlNanos: int64(5 * time.Minute),
+			},
+		},
+	}, *respProto.Topic)
+
+	require.Equal(t, uint32(3), respProto.Version)
+}
+
+func TestTopicUpdateHandlerChangeConsumptionType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewUpdateHandler(nil, config.Configuration{}, instrument.NewOptions())
+	handler.serviceFn = testServiceFn(mockService)
+
+	cs := topic.NewConsumerService().
+		SetServiceID(services.NewServiceID().SetName("name1")).
+		SetConsumptionType(topic.Shared)
+	t1 := topic.NewTopic().
+		SetName(DefaultTopicName).
+		SetNumberOfShards(256).
+		SetConsumerServices([]topic.ConsumerService{cs})
+
+	updateProto := admin.TopicUpdateRequest{
+		ConsumerService: &topicpb.ConsumerService{
+			ConsumptionType: topicpb.ConsumptionType_REPLICATED,
+			ServiceId:       &topicpb.ServiceID{Name: "name1"},
+		},
+	}
+	w := httptest.NewRecorder()
+	b := bytes.NewBuffer(nil)
+	require.NoError(t, jsonMarshaler.Marshal(b, &updateProto))
+	mockService.
+		EXPECT().
+		Get(gomock.Any()).
+		Return(t1, nil)
+	mockService.
+		EXPECT().
+		CheckAndSet(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(updated topic.Topic, version int) (topic.Topic, error) {
+			return updated.SetVersion(1), nil
+		})
+	req := httptest.NewRequest(UpdateHTTPMethod, "/topic", b)
+	handler.ServeHTTP(w, req)
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var respProto admin.TopicGetResponse
+	require.NoError(t, jsonUnmarshaler.Unmarshal(bytes.NewBuffer(body), &respProto))
+	require.Equal(t, 1, len(respProto.Topic.ConsumerServices))
+	require.Equal(t, topicpb.ConsumptionType_REPLICATED, respProto.Topic.ConsumerServices[0].ConsumptionType)
+}
+
+func TestTopicUpdateHandlerNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewUpdateHandler(nil, config.Configuration{}, instrument.NewOptions())
+	handler.serviceFn = testServiceFn(mockService)
+
+	t1 := topic.NewTopic().SetName(DefaultTopicName).SetNumberOfShards(256)
+	updateProto := admin.TopicUpdateRequest{
+		ConsumerService: &topicpb.ConsumerService{
+			ConsumptionType: topicpb.ConsumptionType_SHARED,
+			ServiceId:       &topicpb.ServiceID{Name: "name1"},
+		},
+	}
+	w := httptest.NewRecorder()
+	b := bytes.NewBuffer(nil)
+	require.NoError(t, jsonMarshaler.Marshal(b, &updateProto))
+	mockService.
+		EXPECT().
+		Get(gomock.Any()).
+		Return(t1, nil)
+	req := httptest.NewRequest(UpdateHTTPMethod, "/topic", b)
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	// The consumer service must be specified.
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(UpdateHTTPMethod, "/topic", bytes.NewBufferString("{}"))
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/topic"
 	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/metadata"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
@@ -84,6 +85,7 @@ type Handler struct {
 	storage               storage.Storage
 	downsamplerAndWriter  ingest.DownsamplerAndWriter
 	engine                executor.Engine
+	metadataStore         metadata.Store
 	clusters              m3.Clusters
 	clusterClient         clusterclient.Client
 	config                config.Configuration
@@ -110,6 +112,7 @@ func NewHandler(
 	downsamplerAndWriter ingest.DownsamplerAndWriter,
 	tagOptions models.TagOptions,
 	engine executor.Engine,
+	metadataStore metadata.Store,
 	m3dbClusters m3.Clusters,
 	clusterClient clusterclient.Client,
 	cfg config.Configuration,
@@ -141,6 +144,7 @@ func NewHandler(
 		storage:               downsamplerAndWriter.Storage(),
 		downsamplerAndWriter:  downsamplerAndWriter,
 		engine:                engine,
+		metadataStore:         metadataStore,
 		clusters:              m3dbClusters,
 		clusterClient:         clusterClient,
 		config:                cfg,
@@ -212,7 +216,8 @@ func (h *Handler) RegisterRoutes() error {
 	promRemoteReadHandler := remote.NewPromReadHandler(h.engine,
 		h.fetchOptionsBuilder, h.timeoutOpts, keepNans, remoteSourceInstrumentOpts)
 	promRemoteWriteHandler, err := remote.NewPromWriteHandler(h.downsamplerAndWriter,
-		h.tagOptions, h.config.WriteForwarding.PromRemoteWrite, nowFn, remoteSourceInstrumentOpts)
+		h.tagOptions, h.config.WriteForwarding.PromRemoteWrite, h.metadataStore,
+		nowFn, remoteSourceInstrumentOpts)
 	if err != nil {
 		return err
 	}
@@ -224,7 +229,7 @@ func (h *Handler) RegisterRoutes() error {
 		)
 	nativePromReadHandler := native.NewPromReadHandler(h.engine,
 		h.fetchOptionsBuilder, h.tagOptions, &h.config.Limits,
-		h.timeoutOpts, keepNans, nativeSourceInstrumentOpts)
+		h.timeoutOpts, keepNans, h.metadataStore, nativeSourceInstrumentOpts)
 
 	h.router.HandleFunc(remote.PromReadURL,
 		wrapped(promRemoteReadHandler).ServeHTTP,
@@ -239,6 +244,24 @@ func (h *Handler) RegisterRoutes() error {
 		wrapped(native.NewPromReadInstantHandler(h.engine, h.fetchOptionsBuilder,
 			h.tagOptions, h.timeoutOpts, h.instrumentOpts)).ServeHTTP,
 	).Methods(native.PromReadInstantHTTPMethods...)
+	h.router.HandleFunc(native.PromMetadataURL,
+		wrapped(native.NewPromMetadataHandler(h.metadataStore,
+			h.instrumentOpts)).ServeHTTP,
+	).Methods(native.PromMetadataHTTPMethod)
+
+	// Active query endpoints
+	h.router.HandleFunc(handler.ActiveQueriesURL,
+		wrapped(handler.NewActiveQueriesHandler(h.engine,
+			h.instrumentOpts)).ServeHTTP,
+	).Methods(handler.ActiveQueriesHTTPMethod)
+	h.router.HandleFunc(handler.CancelQueryURL,
+		wrapped(handler.NewCancelQueryHandler(h.engine,
+			h.instrumentOpts)).ServeHTTP,
+	).Methods(handler.CancelQueryHTTPMethod)
+	h.router.HandleFunc(handler.SlowQueriesURL,
+		wrapped(handler.NewSlowQueriesHandler(h.engine,
+			h.instrumentOpts)).ServeHTTP,
+	).Methods(handler.SlowQueriesHTTPMethod)
 
 	// Native M3 search and write endpoints
 	h.router.HandleFunc(handler.SearchURL,
@@ -279,6 +302,13 @@ func (h *Handler) RegisterRoutes() error {
 			h.tagOptions, h.fetchOptionsBuilder, h.instrumentOpts)).ServeHTTP,
 	).Methods(remote.PromSeriesMatchHTTPMethods...)
 
+	// Federation endpoint
+	h.router.HandleFunc(remote.PromFederateURL,
+		wrapped(remote.NewPromFederateHandler(h.storage, h.tagOptions,
+			h.fetchOptionsBuilder, h.enforcer, *h.config.LookbackDuration,
+			nowFn, h.instrumentOpts)).ServeHTTP,
+	).Methods(remote.PromFederateHTTPMethods...)
+
 	// Debug endpoints
 	h.router.HandleFunc(validator.PromDebugURL,
 		wrapped(validator.NewPromDebugHandler(
@@ -296,6 +326,19 @@ func (h *Handler) RegisterRoutes() error {
 			h.fetchOptionsBuilder, h.instrumentOpts)).ServeHTTP,
 	).Methods(graphite.FindHTTPMethods...)
 
+	// Graphite events are stored in a dedicated namespace of the cluster, so
+	// they're only served when the namespace is configured.
+	if h.clusters != nil && h.config.GraphiteEvents != nil {
+		eventsStore := h.config.GraphiteEvents.NewStore(
+			h.clusters.UnaggregatedClusterNamespace().Session())
+		eventsHandler := wrapped(graphite.NewEventsHandler(eventsStore,
+			h.instrumentOpts)).ServeHTTP
+		h.router.HandleFunc(graphite.EventsURL, eventsHandler).
+			Methods(graphite.EventsHTTPMethods...)
+		h.router.HandleFunc(graphite.EventsGetDataURL, eventsHandler).
+			Methods(graphite.EventsGetDataHTTPMethods...)
+	}
+
 	placementOpts, err := h.placementOpts()
 	if err != nil {
 		return err
@@ -93,6 +93,7 @@ func setupHandler(store storage.Storage) (*Handler, error) {
 		engine,
 		nil,
 		nil,
+		nil,
 		config.Configuration{LookbackDuration: &defaultLookbackDuration},
 		nil,
 		nil,
@@ -119,6 +120,7 @@ func TestHandlerFetchTimeoutError(t *testing.T) {
 		engine,
 		nil,
 		nil,
+		nil,
 		cfg,
 		dbconfig,
 		nil,
@@ -147,6 +149,7 @@ func TestHandlerFetchTimeout(t *testing.T) {
 		engine,
 		nil,
 		nil,
+		nil,
 		cfg,
 		dbconfig,
 		nil,
@@ -22,6 +22,7 @@ package block
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/m3db/m3/src/query/models"
 )
@@ -65,6 +66,12 @@ type ResultMetadata struct {
 	Warnings Warnings
 	// Resolutions is a list of resolutions for series obtained by this query.
 	Resolutions []int64
+	// Namespaces is the list of namespaces selected to serve this query, and
+	// the portion of the query range each was queried for.
+	Namespaces []NamespaceRange
+	// FetchedSeriesCount is the number of series fetched from storage to
+	// serve this query.
+	FetchedSeriesCount int
 }
 
 // NewResultMetadata creates a new result metadata.
@@ -94,6 +101,34 @@ func combineResolutions(a, b []int64) []int64 {
 	return nil
 }
 
+func combineNamespaces(a, b []NamespaceRange) []NamespaceRange {
+	if len(a) == 0 {
+		return b
+	}
+
+	if len(b) == 0 {
+		return a
+	}
+
+	combined := make([]NamespaceRange, 0, len(a)+len(b))
+	combined = append(combined, a...)
+	for _, ns := range b {
+		exists := false
+		for _, existing := range a {
+			if ns.equals(existing) {
+				exists = true
+				break
+			}
+		}
+
+		if !exists {
+			combined = append(combined, ns)
+		}
+	}
+
+	return combined
+}
+
 func combineWarnings(a, b Warnings) Warnings {
 	if len(a) == 0 {
 		if len(b) != 0 {
@@ -115,10 +150,12 @@ func combineWarnings(a, b Warnings) Warnings {
 // CombineMetadata combines two result metadatas.
 func (m ResultMetadata) CombineMetadata(other ResultMetadata) ResultMetadata {
 	meta := ResultMetadata{
-		LocalOnly:   m.LocalOnly && other.LocalOnly,
-		Exhaustive:  m.Exhaustive && other.Exhaustive,
-		Warnings:    combineWarnings(m.Warnings, other.Warnings),
-		Resolutions: combineResolutions(m.Resolutions, other.Resolutions),
+		LocalOnly:          m.LocalOnly && other.LocalOnly,
+		Exhaustive:         m.Exhaustive && other.Exhaustive,
+		Warnings:           combineWarnings(m.Warnings, other.Warnings),
+		Resolutions:        combineResolutions(m.Resolutions, other.Resolutions),
+		Namespaces:         combineNamespaces(m.Namespaces, other.Namespaces),
+		FetchedSeriesCount: m.FetchedSeriesCount + other.FetchedSeriesCount,
 	}
 
 	return meta
@@ -175,3 +212,30 @@ func (w Warning) Header() string {
 func (w Warning) equals(warning Warning) bool {
 	return w.Name == warning.Name && w.Message == warning.Message
 }
+
+// NamespaceRange describes a namespace selected to serve a query, and the
+// portion of the query range it was queried for.
+type NamespaceRange struct {
+	// Namespace is the name of the namespace.
+	Namespace string
+	// Resolution is the resolution of the namespace.
+	Resolution time.Duration
+	// Start is the inclusive start of the range served by the namespace.
+	Start time.Time
+	// End is the exclusive end of the range served by the namespace.
+	End time.Time
+}
+
+// Header formats the namespace range into a format to send in a response
+// header.
+func (r NamespaceRange) Header() string {
+	return fmt.Sprintf("%s_%s_%d_%d", r.Namespace, r.Resolution,
+		r.Start.Unix(), r.End.Unix())
+}
+
+func (r NamespaceRange) equals(other NamespaceRange) bool {
+	return r.Namespace == other.Namespace &&
+		r.Resolution == other.Resolution &&
+		r.Start.Equal(other.Start) &&
+		r.End.Equal(other.End)
+}
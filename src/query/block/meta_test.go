@@ -22,6 +22,7 @@ package block
 
 import (
 	"testing"
+	"time"
 
 	"github.com/m3db/m3/src/query/models"
 
@@ -134,3 +135,41 @@ func TestMergeResolutions(t *testing.T) {
 	require.Equal(t, 6, len(merge.Resolutions))
 	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, merge.Resolutions)
 }
+
+func TestMergeNamespaces(t *testing.T) {
+	now := time.Unix(1000, 0)
+	short := NamespaceRange{
+		Namespace:  "short",
+		Resolution: time.Minute,
+		Start:      now.Add(-time.Hour),
+		End:        now,
+	}
+	long := NamespaceRange{
+		Namespace:  "long",
+		Resolution: time.Hour,
+		Start:      now.Add(-2 * time.Hour),
+		End:        now.Add(-time.Hour),
+	}
+
+	r := ResultMetadata{}
+	merge := r.CombineMetadata(ResultMetadata{})
+	assert.Nil(t, merge.Namespaces)
+
+	r = ResultMetadata{Namespaces: []NamespaceRange{short}}
+	merge = r.CombineMetadata(ResultMetadata{})
+	assert.Equal(t, []NamespaceRange{short}, merge.Namespaces)
+
+	rTwo := ResultMetadata{Namespaces: []NamespaceRange{short, long}}
+	merge = r.CombineMetadata(rTwo)
+	assert.Equal(t, []NamespaceRange{short, long}, merge.Namespaces)
+	assert.Equal(t, "short_1m0s_-2600_1000", merge.Namespaces[0].Header())
+}
+
+func TestMergeFetchedSeriesCount(t *testing.T) {
+	r := ResultMetadata{FetchedSeriesCount: 3}
+	rTwo := ResultMetadata{FetchedSeriesCount: 4}
+	merge := r.CombineMetadata(rTwo)
+	assert.Equal(t, 7, merge.FetchedSeriesCount)
+	assert.Equal(t, 3, r.FetchedSeriesCount)
+	assert.Equal(t, 4, rTwo.FetchedSeriesCount)
+}
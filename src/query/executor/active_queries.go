@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	qcost "github.com/m3db/m3/src/query/cost"
+)
+
+// ActiveQuery is a snapshot of a query currently being executed.
+type ActiveQuery struct {
+	ID                string    `json:"id"`
+	Query             string    `json:"query"`
+	Source            string    `json:"source,omitempty"`
+	Start             time.Time `json:"start"`
+	FetchedDatapoints float64   `json:"fetchedDatapoints"`
+	Stage             string    `json:"stage"`
+}
+
+// ActiveQueries is a registry of the queries currently being executed.
+type ActiveQueries interface {
+	// List returns the active queries ordered by start time.
+	List() []ActiveQuery
+
+	// Cancel cancels the active query with the given ID, returning false
+	// if no such query is active.
+	Cancel(id string) bool
+}
+
+type activeQuery struct {
+	id       string
+	query    string
+	source   string
+	start    time.Time
+	enforcer qcost.ChainedEnforcer
+	cancel   context.CancelFunc
+	stage    int32
+}
+
+func (q *activeQuery) setStage(s State) {
+	atomic.StoreInt32(&q.stage, int32(s))
+}
+
+func (q *activeQuery) snapshot() ActiveQuery {
+	report, _ := q.enforcer.State()
+	return ActiveQuery{
+		ID:                q.id,
+		Query:             q.query,
+		Source:            q.source,
+		Start:             q.start,
+		FetchedDatapoints: float64(report.Cost),
+		Stage:             State(atomic.LoadInt32(&q.stage)).String(),
+	}
+}
+
+type activeQueries struct {
+	sync.RWMutex
+
+	nowFn   func() time.Time
+	nextID  uint64
+	queries map[string]*activeQuery
+}
+
+func newActiveQueries(nowFn func() time.Time) *activeQueries {
+	return &activeQueries{
+		nowFn:   nowFn,
+		queries: make(map[string]*activeQuery),
+	}
+}
+
+func (r *activeQueries) add(
+	query string,
+	source string,
+	enforcer qcost.ChainedEnforcer,
+	cancel context.CancelFunc,
+) *activeQuery {
+	r.Lock()
+	r.nextID++
+	q := &activeQuery{
+		id:       strconv.FormatUint(r.nextID, 10),
+		query:    query,
+		source:   source,
+		start:    r.nowFn(),
+		enforcer: enforcer,
+		cancel:   cancel,
+		stage:    int32(compiling),
+	}
+	r.queries[q.id] = q
+	r.Unlock()
+	return q
+}
+
+func (r *activeQueries) remove(q *activeQuery) {
+	r.Lock()
+	delete(r.queries, q.id)
+	r.Unlock()
+}
+
+func (r *activeQueries) List() []ActiveQuery {
+	r.RLock()
+	result := make([]ActiveQuery, 0, len(r.queries))
+	for _, q := range r.queries {
+		result = append(result, q.snapshot())
+	}
+	r.RUnlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+	return result
+}
+
+func (r *activeQueries) Cancel(id string) bool {
+	r.RLock()
+	q, ok := r.queries[id]
+	r.RUnlock()
+	if !ok {
+		return false
+	}
+
+	q.cancel()
+	return true
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	qcost "github.com/m3db/m3/src/query/cost"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveQueriesListAndCancel(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	nowFn := func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	registry := newActiveQueries(nowFn)
+	firstCtx, firstCancel := context.WithCancel(context.Background())
+	first := registry.add("sum(foo)", "tenant-a", qcost.NoopChainedEnforcer(),
+		firstCancel)
+	secondCtx, secondCancel := context.WithCancel(context.Background())
+	defer secondCancel()
+	second := registry.add("bar", "", qcost.NoopChainedEnforcer(),
+		secondCancel)
+	second.setStage(executing)
+
+	queries := registry.List()
+	require.Len(t, queries, 2)
+	assert.Equal(t, first.id, queries[0].ID)
+	assert.Equal(t, "sum(foo)", queries[0].Query)
+	assert.Equal(t, "tenant-a", queries[0].Source)
+	assert.Equal(t, compiling.String(), queries[0].Stage)
+	assert.Equal(t, second.id, queries[1].ID)
+	assert.Equal(t, executing.String(), queries[1].Stage)
+	assert.True(t, queries[0].Start.Before(queries[1].Start))
+
+	assert.False(t, registry.Cancel("unknown"))
+	assert.True(t, registry.Cancel(first.id))
+	assert.Equal(t, context.Canceled, firstCtx.Err())
+	assert.NoError(t, secondCtx.Err())
+
+	registry.remove(first)
+	queries = registry.List()
+	require.Len(t, queries, 1)
+	assert.Equal(t, second.id, queries[0].ID)
+	assert.False(t, registry.Cancel(first.id))
+}
@@ -24,9 +24,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/m3db/m3/src/query/block"
 	qcost "github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/explain"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/slowlog"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/x/opentracing"
 
@@ -34,8 +37,9 @@ import (
 )
 
 type engine struct {
-	opts    EngineOptions
-	metrics *engineMetrics
+	opts          EngineOptions
+	metrics       *engineMetrics
+	activeQueries *activeQueries
 }
 
 // QueryOptions can be used to pass custom flags to engine.
@@ -58,8 +62,9 @@ func NewEngine(
 	}
 
 	return &engine{
-		metrics: newEngineMetrics(engineOpts.InstrumentOptions().MetricsScope()),
-		opts:    engineOpts,
+		metrics:       newEngineMetrics(engineOpts.InstrumentOptions().MetricsScope()),
+		opts:          engineOpts,
+		activeQueries: newActiveQueries(time.Now),
 	}
 }
 
@@ -137,19 +142,51 @@ func (e *engine) ExecuteExpr(
 ) (Result, error) {
 	perQueryEnforcer := e.opts.GlobalEnforcer().Child(qcost.QueryLevel)
 	defer perQueryEnforcer.Close()
-	req := newRequest(e, params, fetchOpts, e.opts.InstrumentOptions())
+
+	// NB: the query context is cancelled either once execution completes or
+	// when the query is cancelled through the active query registry; in the
+	// latter case the cancellation propagates to any outstanding fetches.
+	ctx, cancel := context.WithCancel(ctx)
+	var source string
+	if fetchOpts != nil {
+		source = string(fetchOpts.Source)
+	}
+
+	var (
+		start     = time.Now()
+		durations slowlog.Durations
+	)
+
+	active := e.activeQueries.add(params.Query, source, perQueryEnforcer, cancel)
+	finish := func(meta block.ResultMetadata, err error) {
+		e.activeQueries.remove(active)
+		cancel()
+
+		durations.Total = time.Since(start)
+		e.logSlowQuery(start, params, source, meta, perQueryEnforcer,
+			durations, err)
+	}
+
+	req := newRequest(e, params, opts, fetchOpts, e.opts.InstrumentOptions())
 	nodes, edges, err := req.compile(ctx, parser)
+	durations.Compiling = time.Since(start)
 	if err != nil {
+		finish(block.NewResultMetadata(), err)
 		return nil, err
 	}
 
+	active.setStage(planning)
 	pp, err := req.plan(ctx, nodes, edges)
 	if err != nil {
+		durations.Planning = time.Since(start) - durations.Compiling
+		finish(block.NewResultMetadata(), err)
 		return nil, err
 	}
 
 	state, err := req.generateExecutionState(ctx, pp)
+	durations.Planning = time.Since(start) - durations.Compiling
 	if err != nil {
+		finish(block.NewResultMetadata(), err)
 		return nil, err
 	}
 
@@ -162,8 +199,20 @@ func (e *engine) ExecuteExpr(
 	queryCtx := models.NewQueryContext(ctx, scope, perQueryEnforcer,
 		opts.QueryContextOptions)
 
+	active.setStage(executing)
+	executingStart := time.Now()
 	go func() {
-		if err := state.Execute(queryCtx); err != nil {
+		err := state.Execute(queryCtx)
+		durations.Executing = time.Since(executingStart)
+		if explanation, ok := explain.FromContext(ctx); ok {
+			queryCost, queryLimit := perQueryEnforcer.State()
+			explanation.AddCost(queryCost.Cost, queryLimit)
+			globalCost, globalLimit := e.opts.GlobalEnforcer().State()
+			explanation.SetGlobalCost(globalCost.Cost, globalLimit)
+		}
+
+		finish(result.metadata(), err)
+		if err != nil {
 			result.abort(err)
 		} else {
 			result.done()
@@ -173,6 +222,50 @@ func (e *engine) ExecuteExpr(
 	return result, nil
 }
 
+func (e *engine) logSlowQuery(
+	start time.Time,
+	params models.RequestParams,
+	source string,
+	meta block.ResultMetadata,
+	enforcer qcost.ChainedEnforcer,
+	durations slowlog.Durations,
+	err error,
+) {
+	logger := e.opts.SlowQueryLogger()
+	if logger == nil {
+		return
+	}
+
+	namespaces := make([]slowlog.Namespace, 0, len(meta.Namespaces))
+	for _, ns := range meta.Namespaces {
+		namespaces = append(namespaces, slowlog.Namespace{
+			Name:       ns.Namespace,
+			Resolution: ns.Resolution,
+			Start:      ns.Start,
+			End:        ns.End,
+		})
+	}
+
+	report, _ := enforcer.State()
+	logger.Log(slowlog.Entry{
+		Timestamp:         start,
+		Query:             params.Query,
+		Source:            source,
+		Start:             params.Start,
+		End:               params.End,
+		Step:              params.Step,
+		Namespaces:        namespaces,
+		FetchedSeries:     meta.FetchedSeriesCount,
+		FetchedDatapoints: float64(report.Cost),
+		Durations:         durations,
+		Err:               err,
+	})
+}
+
+func (e *engine) ActiveQueries() ActiveQueries {
+	return e.activeQueries
+}
+
 func (e *engine) Options() EngineOptions {
 	return e.opts
 }
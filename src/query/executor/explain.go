@@ -0,0 +1,142 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/explain"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/plan"
+)
+
+// explainNode wraps an OpNode to record execution statistics for explain
+// queries. Blocks it receives are recorded as the output of the parent node
+// and, unless this is the result node, as the input of the wrapped node.
+type explainNode struct {
+	node        transform.OpNode
+	stats       *explain.Node
+	explanation *explain.Explanation
+}
+
+func newExplainNode(
+	node transform.OpNode,
+	stats *explain.Node,
+	explanation *explain.Explanation,
+) *explainNode {
+	return &explainNode{
+		node:        node,
+		stats:       stats,
+		explanation: explanation,
+	}
+}
+
+// Process records the block statistics and processes the block with the
+// wrapped node.
+func (n *explainNode) Process(
+	queryCtx *models.QueryContext,
+	ID parser.NodeID,
+	b block.Block,
+) error {
+	series, datapoints := blockStats(queryCtx, b)
+	parent := n.explanation.Node(string(ID), "")
+	parent.RecordOutput(series, datapoints)
+	if n.stats != nil {
+		n.stats.RecordInput(series, datapoints)
+	}
+
+	start := time.Now()
+	err := n.node.Process(queryCtx, ID, b)
+	elapsed := time.Since(start)
+	parent.RecordDownstream(elapsed)
+	if n.stats != nil {
+		n.stats.RecordDuration(elapsed)
+	}
+
+	return err
+}
+
+// blockStats returns the number of series fetched for a block, and the
+// number of datapoints fetched by the query so far. They are taken from the
+// block result metadata and the query cost rather than by iterating the
+// block, which may only be iterated once by the wrapped node.
+func blockStats(queryCtx *models.QueryContext, b block.Block) (int, int) {
+	series := b.Meta().ResultMetadata.FetchedSeriesCount
+	if queryCtx == nil || queryCtx.Enforcer == nil {
+		return series, 0
+	}
+
+	report, _ := queryCtx.Enforcer.State()
+	return series, int(report.Cost)
+}
+
+func explainStep(step plan.LogicalStep) explain.Step {
+	return explain.Step{
+		ID:       string(step.ID()),
+		Op:       step.Transform.Op.OpType(),
+		Params:   step.Transform.Op.String(),
+		Parents:  nodeIDStrings(step.Parents),
+		Children: nodeIDStrings(step.Children),
+	}
+}
+
+func nodeIDStrings(ids []parser.NodeID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, string(id))
+	}
+
+	return strs
+}
+
+func explainLogicalPlan(explanation *explain.Explanation, lp plan.LogicalPlan) {
+	for _, id := range lp.Pipeline {
+		if step, ok := lp.Steps[id]; ok {
+			explanation.AddLogicalStep(explainStep(step))
+		}
+	}
+}
+
+func explainPhysicalPlan(explanation *explain.Explanation, pp plan.PhysicalPlan) {
+	steps := pp.Transforms()
+	transforms := make([]explain.Step, 0, len(steps))
+	for _, step := range steps {
+		transforms = append(transforms, explainStep(step))
+		// NB: create the node statistics in pipeline order so that the
+		// rendered nodes follow the plan.
+		explanation.Node(string(step.ID()), step.Transform.Op.OpType())
+	}
+
+	explanation.SetPhysicalPlan(explain.PhysicalPlan{
+		Start:        pp.TimeSpec.Start,
+		End:          pp.TimeSpec.End,
+		Step:         explain.Duration(pp.TimeSpec.Step),
+		Transforms:   transforms,
+		ResultParent: string(pp.ResultStep.Parent),
+	})
+}
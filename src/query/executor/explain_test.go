@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/m3db/m3/src/query/block"
+	qcost "github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/explain"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/plan"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/mock"
+	xcost "github.com/m3db/m3/src/x/cost"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestExplainNodeProcess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	enforcer := qcost.NewMockChainedEnforcer(ctrl)
+	enforcer.EXPECT().State().Return(xcost.Report{Cost: 6}, xcost.Limit{})
+
+	var (
+		explanation = explain.New()
+		meta        = block.Metadata{
+			ResultMetadata: block.ResultMetadata{FetchedSeriesCount: 2},
+		}
+		queryCtx = models.NewQueryContext(context.Background(),
+			tally.NoopScope, enforcer, models.QueryContextOptions{})
+		errProc = errors.New("process error")
+	)
+
+	// NB: the block is only given to the wrapped node, it is not iterated
+	// to record its statistics.
+	b := block.NewMockBlock(ctrl)
+	b.EXPECT().Meta().Return(meta)
+
+	// NB: create the parent first so it is rendered first.
+	explanation.Node("1", "fetch")
+	child := explanation.Node("2", "count")
+
+	node := transform.NewMockOpNode(ctrl)
+	node.EXPECT().Process(queryCtx, parser.NodeID("1"), b).Return(errProc)
+
+	explained := newExplainNode(node, child, explanation)
+	require.Equal(t, errProc, explained.Process(queryCtx, parser.NodeID("1"), b))
+
+	report := explanation.Report()
+	require.Equal(t, 2, len(report.Nodes))
+
+	fetch := report.Nodes[0]
+	assert.Equal(t, "fetch", fetch.Op)
+	assert.Equal(t, 1, fetch.BlocksOut)
+	assert.Equal(t, 2, fetch.SeriesOut)
+	assert.Equal(t, 6, fetch.DatapointsOut)
+	assert.Equal(t, 0, fetch.BlocksIn)
+
+	count := report.Nodes[1]
+	assert.Equal(t, "count", count.Op)
+	assert.Equal(t, 1, count.BlocksIn)
+	assert.Equal(t, 2, count.SeriesIn)
+	assert.Equal(t, 6, count.DatapointsIn)
+	assert.Equal(t, 0, count.BlocksOut)
+}
+
+func TestExplainExecutionState(t *testing.T) {
+	fetchTransform := parser.NewTransformFromOperation(functions.FetchOp{}, 1)
+	agg, err := aggregation.NewAggregationOp(aggregation.CountType, aggregation.NodeParams{})
+	require.NoError(t, err)
+	countTransform := parser.NewTransformFromOperation(agg, 2)
+	transforms := parser.Nodes{fetchTransform, countTransform}
+	edges := parser.Edges{
+		parser.Edge{
+			ParentID: fetchTransform.ID,
+			ChildID:  countTransform.ID,
+		},
+	}
+
+	lp, err := plan.NewLogicalPlan(transforms, edges)
+	require.NoError(t, err)
+	p, err := plan.NewPhysicalPlan(lp, testRequestParams())
+	require.NoError(t, err)
+
+	explanation := explain.New()
+	explainLogicalPlan(explanation, lp)
+	explainPhysicalPlan(explanation, p)
+	state, err := generateExecutionState(p, mock.NewMockStorage(),
+		storage.NewFetchOptions(), instrument.NewOptions(), explanation)
+	require.NoError(t, err)
+	require.Len(t, state.sources, 1)
+	require.Len(t, state.sourceStats, 1)
+	require.NoError(t, state.Execute(models.NoopQueryContext()))
+
+	report := explanation.Report()
+	require.Equal(t, 2, len(report.LogicalPlan))
+	assert.Equal(t, explain.Step{
+		ID:       string(fetchTransform.ID),
+		Op:       functions.FetchType,
+		Params:   fetchTransform.Op.String(),
+		Children: []string{string(countTransform.ID)},
+	}, report.LogicalPlan[0])
+	assert.Equal(t, []string{string(fetchTransform.ID)},
+		report.LogicalPlan[1].Parents)
+
+	require.NotNil(t, report.PhysicalPlan)
+	assert.Equal(t, string(countTransform.ID), report.PhysicalPlan.ResultParent)
+	assert.Equal(t, report.LogicalPlan, report.PhysicalPlan.Transforms)
+
+	require.Equal(t, 2, len(report.Nodes))
+	assert.Equal(t, string(fetchTransform.ID), report.Nodes[0].ID)
+	assert.Equal(t, functions.FetchType, report.Nodes[0].Op)
+	assert.Equal(t, string(countTransform.ID), report.Nodes[1].ID)
+	assert.Equal(t, aggregation.CountType, report.Nodes[1].Op)
+}
@@ -24,15 +24,18 @@ import (
 	"time"
 
 	qcost "github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/slowlog"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/x/instrument"
 )
 
 type engineOptions struct {
-	instrumentOpts   instrument.Options
-	globalEnforcer   qcost.ChainedEnforcer
-	store            storage.Storage
-	lookbackDuration time.Duration
+	instrumentOpts             instrument.Options
+	globalEnforcer             qcost.ChainedEnforcer
+	store                      storage.Storage
+	lookbackDuration           time.Duration
+	aggregationPushdownEnabled bool
+	slowQueryLogger            slowlog.Logger
 }
 
 // NewEngineOptions returns a new instance of options used to create an engine.
@@ -79,3 +82,23 @@ func (o *engineOptions) SetLookbackDuration(v time.Duration) EngineOptions {
 	opts.lookbackDuration = v
 	return &opts
 }
+
+func (o *engineOptions) AggregationPushdownEnabled() bool {
+	return o.aggregationPushdownEnabled
+}
+
+func (o *engineOptions) SetAggregationPushdownEnabled(v bool) EngineOptions {
+	opts := *o
+	opts.aggregationPushdownEnabled = v
+	return &opts
+}
+
+func (o *engineOptions) SlowQueryLogger() slowlog.Logger {
+	return o.slowQueryLogger
+}
+
+func (o *engineOptions) SetSlowQueryLogger(v slowlog.Logger) EngineOptions {
+	opts := *o
+	opts.slowQueryLogger = v
+	return &opts
+}
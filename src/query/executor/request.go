@@ -23,7 +23,9 @@ package executor
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/m3db/m3/src/query/explain"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
 	"github.com/m3db/m3/src/query/plan"
@@ -68,6 +70,7 @@ func (s State) durationString() string {
 type Request struct {
 	engine         *engine
 	params         models.RequestParams
+	queryOpts      *QueryOptions
 	fetchOpts      *storage.FetchOptions
 	instrumentOpts instrument.Options
 }
@@ -75,12 +78,14 @@ type Request struct {
 func newRequest(
 	engine *engine,
 	params models.RequestParams,
+	queryOpts *QueryOptions,
 	fetchOpts *storage.FetchOptions,
 	instrumentOpts instrument.Options,
 ) *Request {
 	return &Request{
 		engine:         engine,
 		params:         params,
+		queryOpts:      queryOpts,
 		fetchOpts:      fetchOpts,
 		instrumentOpts: instrumentOpts,
 	}
@@ -89,12 +94,17 @@ func newRequest(
 func (r *Request) compile(ctx context.Context, parser parser.Parser) (parser.Nodes, parser.Edges, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "compile")
 	defer sp.Finish()
+	start := time.Now()
 	// TODO: Change DAG interface to take in a context
 	nodes, edges, err := parser.DAG()
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if explanation, ok := explain.FromContext(ctx); ok {
+		explanation.RecordPhase(compiling.String(), time.Since(start))
+	}
+
 	if r.params.Debug {
 		logging.WithContext(ctx, r.instrumentOpts).
 			Info("compiling dag", zap.Any("nodes", nodes), zap.Any("edges", edges))
@@ -106,6 +116,7 @@ func (r *Request) compile(ctx context.Context, parser parser.Parser) (parser.Nod
 func (r *Request) plan(ctx context.Context, nodes parser.Nodes, edges parser.Edges) (plan.PhysicalPlan, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "plan")
 	defer sp.Finish()
+	start := time.Now()
 
 	lp, err := plan.NewLogicalPlan(nodes, edges)
 	if err != nil {
@@ -122,21 +133,63 @@ func (r *Request) plan(ctx context.Context, nodes parser.Nodes, edges parser.Edg
 		return plan.PhysicalPlan{}, err
 	}
 
+	pp, err = r.pushdownAggregations(pp)
+	if err != nil {
+		return plan.PhysicalPlan{}, err
+	}
+
 	if r.params.Debug {
 		logging.WithContext(ctx, r.instrumentOpts).
 			Info("physical plan", zap.String("plan", pp.String()))
 	}
 
+	if explanation, ok := explain.FromContext(ctx); ok {
+		explanation.RecordPhase(planning.String(), time.Since(start))
+		explainLogicalPlan(explanation, lp)
+		explainPhysicalPlan(explanation, pp)
+	}
+
 	return pp, nil
 }
 
+// pushdownAggregations rewrites the physical plan to evaluate supported
+// aggregations within the storage, if enabled and supported by the storage.
+func (r *Request) pushdownAggregations(
+	pp plan.PhysicalPlan,
+) (plan.PhysicalPlan, error) {
+	if !r.engine.opts.AggregationPushdownEnabled() {
+		return pp, nil
+	}
+
+	store, ok := r.engine.opts.Store().(storage.AggregateSeriesStorage)
+	if !ok {
+		return pp, nil
+	}
+
+	// NB: resolve the fetch options the same way the fetch would so that the
+	// capabilities reflect any restrictions applied to the query.
+	queryCtx := models.NewQueryContext(context.Background(), nil, nil,
+		r.queryOpts.QueryContextOptions)
+	fetchOpts, err := r.fetchOpts.QueryFetchOptions(queryCtx, pp.BlockType)
+	if err != nil {
+		return plan.PhysicalPlan{}, err
+	}
+
+	return pp.PushdownAggregations(func(
+		query *storage.FetchQuery,
+	) storage.AggregateSeriesCapabilities {
+		return store.AggregateSeriesCapabilities(query, fetchOpts)
+	}), nil
+}
+
 func (r *Request) generateExecutionState(ctx context.Context, pp plan.PhysicalPlan) (*ExecutionState, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx,
 		"generate_execution_state")
 	defer sp.Finish()
 
-	state, err := GenerateExecutionState(pp, r.engine.opts.Store(),
-		r.fetchOpts, r.instrumentOpts)
+	explanation, _ := explain.FromContext(ctx)
+	state, err := generateExecutionState(pp, r.engine.opts.Store(),
+		r.fetchOpts, r.instrumentOpts, explanation)
 	// free up resources
 	if err != nil {
 		return nil, err
@@ -43,6 +43,7 @@ var (
 type Result interface {
 	abort(err error)
 	done()
+	metadata() block.ResultMetadata
 	ResultChan() chan ResultChan
 }
 
@@ -51,6 +52,7 @@ type ResultNode struct {
 	mu         sync.Mutex
 	resultChan chan ResultChan
 	aborted    bool
+	meta       block.ResultMetadata
 }
 
 // ResultChan has the result from a block
@@ -61,7 +63,10 @@ type ResultChan struct {
 
 func newResultNode() *ResultNode {
 	blocks := make(chan ResultChan, channelSize)
-	return &ResultNode{resultChan: blocks}
+	return &ResultNode{
+		resultChan: blocks,
+		meta:       block.NewResultMetadata(),
+	}
 }
 
 // Process the block
@@ -70,6 +75,19 @@ func (r *ResultNode) Process(queryCtx *models.QueryContext, ID parser.NodeID, bl
 		return errAborted
 	}
 
+	r.mu.Lock()
+	// NB: every block carries the metadata of all the fetches it was computed
+	// from, so take the largest fetched series count rather than summing it
+	// across the blocks of the same result.
+	meta := block.Meta().ResultMetadata
+	fetched := r.meta.FetchedSeriesCount
+	if meta.FetchedSeriesCount > fetched {
+		fetched = meta.FetchedSeriesCount
+	}
+	r.meta = r.meta.CombineMetadata(meta)
+	r.meta.FetchedSeriesCount = fetched
+	r.mu.Unlock()
+
 	r.resultChan <- ResultChan{
 		Block: block,
 	}
@@ -82,6 +100,13 @@ func (r *ResultNode) ResultChan() chan ResultChan {
 	return r.resultChan
 }
 
+// metadata returns the combined result metadata of the processed blocks.
+func (r *ResultNode) metadata() block.ResultMetadata {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.meta
+}
+
 // TODO: Signal error downstream
 func (r *ResultNode) abort(err error) {
 	r.mu.Lock()
@@ -23,8 +23,10 @@ package executor
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/explain"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
 	"github.com/m3db/m3/src/query/plan"
@@ -37,10 +39,12 @@ import (
 
 // ExecutionState represents the execution hierarchy.
 type ExecutionState struct {
-	plan       plan.PhysicalPlan
-	sources    []parser.Source
-	resultNode Result
-	storage    storage.Storage
+	plan        plan.PhysicalPlan
+	sources     []parser.Source
+	sourceStats []*explain.Node
+	resultNode  Result
+	storage     storage.Storage
+	explanation *explain.Explanation
 }
 
 // CreateSource creates a source node.
@@ -93,11 +97,25 @@ func GenerateExecutionState(
 	storage storage.Storage,
 	fetchOpts *storage.FetchOptions,
 	instrumentOpts instrument.Options,
+) (*ExecutionState, error) {
+	return generateExecutionState(pplan, storage, fetchOpts,
+		instrumentOpts, nil)
+}
+
+// generateExecutionState creates an execution state from the physical plan,
+// recording execution statistics in the explanation if it is set.
+func generateExecutionState(
+	pplan plan.PhysicalPlan,
+	storage storage.Storage,
+	fetchOpts *storage.FetchOptions,
+	instrumentOpts instrument.Options,
+	explanation *explain.Explanation,
 ) (*ExecutionState, error) {
 	result := pplan.ResultStep
 	state := &ExecutionState{
-		plan:    pplan,
-		storage: storage,
+		plan:        pplan,
+		storage:     storage,
+		explanation: explanation,
 	}
 
 	step, ok := pplan.Step(result.Parent)
@@ -128,7 +146,11 @@ func GenerateExecutionState(
 
 	rNode := newResultNode()
 	state.resultNode = rNode
-	controller.AddTransform(rNode)
+	if explanation != nil {
+		controller.AddTransform(newExplainNode(rNode, nil, explanation))
+	} else {
+		controller.AddTransform(rNode)
+	}
 
 	return state, nil
 }
@@ -143,14 +165,14 @@ func (s *ExecutionState) createNode(
 	if ok {
 		source, controller := CreateSource(step.ID(), sourceParams,
 			s.storage, options)
-		s.sources = append(s.sources, source)
+		s.addSource(step, source)
 		return controller, nil
 	}
 
 	scalarParams, ok := step.Transform.Op.(ScalarParams)
 	if ok {
 		source, controller := CreateScalarSource(step.ID(), scalarParams, options)
-		s.sources = append(s.sources, source)
+		s.addSource(step, source)
 		return controller, nil
 	}
 
@@ -161,6 +183,11 @@ func (s *ExecutionState) createNode(
 
 	transformNode, controller := CreateTransform(step.ID(),
 		transformParams, options)
+	if s.explanation != nil {
+		stats := s.explanation.Node(string(step.ID()), transformParams.OpType())
+		transformNode = newExplainNode(transformNode, stats, s.explanation)
+	}
+
 	for _, parentID := range step.Parents {
 		parentStep, ok := s.plan.Step(parentID)
 		if !ok {
@@ -179,14 +206,28 @@ func (s *ExecutionState) createNode(
 	return controller, nil
 }
 
+func (s *ExecutionState) addSource(step plan.LogicalStep, source parser.Source) {
+	s.sources = append(s.sources, source)
+	if s.explanation != nil {
+		stats := s.explanation.Node(string(step.ID()), step.Transform.Op.OpType())
+		s.sourceStats = append(s.sourceStats, stats)
+	}
+}
+
 // Execute the sources in parallel and return the first error.
 func (s *ExecutionState) Execute(queryCtx *models.QueryContext) error {
 	requests := make([]execution.Request, len(s.sources))
 	for idx, source := range s.sources {
-		requests[idx] = sourceRequest{
+		request := sourceRequest{
 			source:   source,
 			queryCtx: queryCtx,
 		}
+
+		if idx < len(s.sourceStats) {
+			request.stats = s.sourceStats[idx]
+		}
+
+		requests[idx] = request
 	}
 
 	return execution.ExecuteParallel(queryCtx.Ctx, requests)
@@ -201,10 +242,17 @@ func (s *ExecutionState) String() string {
 type sourceRequest struct {
 	source   parser.Source
 	queryCtx *models.QueryContext
+	stats    *explain.Node
 }
 
 // Process processes the new request.
 func (s sourceRequest) Process(ctx context.Context) error {
+	start := time.Now()
 	// make sure to propagate the new context.Context object down.
-	return s.source.Execute(s.queryCtx.WithContext(ctx))
+	err := s.source.Execute(s.queryCtx.WithContext(ctx))
+	if s.stats != nil {
+		s.stats.RecordDuration(time.Since(start))
+	}
+
+	return err
 }
@@ -27,6 +27,7 @@ import (
 	qcost "github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/slowlog"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/x/instrument"
 )
@@ -60,6 +61,9 @@ type Engine interface {
 		params models.RequestParams,
 	) (Result, error)
 
+	// ActiveQueries returns the registry of queries currently executing.
+	ActiveQueries() ActiveQueries
+
 	// Options returns the currently configured options.
 	Options() EngineOptions
 
@@ -90,4 +94,16 @@ type EngineOptions interface {
 	LookbackDuration() time.Duration
 	// SetLookbackDuration sets the query lookback duration.
 	SetLookbackDuration(time.Duration) EngineOptions
+
+	// AggregationPushdownEnabled returns whether supported aggregations are
+	// evaluated within the storage.
+	AggregationPushdownEnabled() bool
+	// SetAggregationPushdownEnabled sets whether supported aggregations are
+	// evaluated within the storage.
+	SetAggregationPushdownEnabled(bool) EngineOptions
+
+	// SlowQueryLogger returns the slow query logger, if any.
+	SlowQueryLogger() slowlog.Logger
+	// SetSlowQueryLogger sets the slow query logger.
+	SetSlowQueryLogger(slowlog.Logger) EngineOptions
 }
@@ -58,6 +58,20 @@ func (m *MockEngine) EXPECT() *MockEngineMockRecorder {
 	return m.recorder
 }
 
+// ActiveQueries mocks base method
+func (m *MockEngine) ActiveQueries() ActiveQueries {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveQueries")
+	ret0, _ := ret[0].(ActiveQueries)
+	return ret0
+}
+
+// ActiveQueries indicates an expected call of ActiveQueries
+func (mr *MockEngineMockRecorder) ActiveQueries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveQueries", reflect.TypeOf((*MockEngine)(nil).ActiveQueries))
+}
+
 // Close mocks base method
 func (m *MockEngine) Close() error {
 	m.ctrl.T.Helper()
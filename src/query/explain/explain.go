@@ -0,0 +1,332 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package explain collects the execution details of a query so they can be
+// returned to the user in place of the query results.
+package explain
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/x/cost"
+)
+
+type explainKey struct{}
+
+// NewContext returns a context carrying the given explanation.
+func NewContext(ctx context.Context, e *Explanation) context.Context {
+	return context.WithValue(ctx, explainKey{}, e)
+}
+
+// FromContext returns the explanation carried by the context, if any.
+func FromContext(ctx context.Context) (*Explanation, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	e, ok := ctx.Value(explainKey{}).(*Explanation)
+	return e, ok && e != nil
+}
+
+// Duration is a time.Duration that is rendered as a human readable string.
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+
+	parsed, err := time.ParseDuration(str)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+	return nil
+}
+
+// Step is a single step of a query plan.
+type Step struct {
+	ID       string   `json:"id"`
+	Op       string   `json:"op"`
+	Params   string   `json:"params,omitempty"`
+	Parents  []string `json:"parents,omitempty"`
+	Children []string `json:"children,omitempty"`
+}
+
+// PhysicalPlan describes the transforms that are executed for a query.
+type PhysicalPlan struct {
+	Start        time.Time `json:"start"`
+	End          time.Time `json:"end"`
+	Step         Duration  `json:"step"`
+	Transforms   []Step    `json:"transforms"`
+	ResultParent string    `json:"resultParent"`
+}
+
+// Phase is the wall time spent in a single phase of the query,
+// such as compiling or planning.
+type Phase struct {
+	Name     string   `json:"name"`
+	WallTime Duration `json:"wallTime"`
+}
+
+// Fetch describes a single storage fetch against a namespace.
+type Fetch struct {
+	Namespace   string   `json:"namespace"`
+	MetricsType string   `json:"metricsType"`
+	Retention   Duration `json:"retention"`
+	Resolution  Duration `json:"resolution"`
+	FanoutType  string   `json:"fanoutType"`
+	Series      int      `json:"series"`
+	Exhaustive  bool     `json:"exhaustive"`
+	WallTime    Duration `json:"wallTime"`
+	Error       string   `json:"error,omitempty"`
+}
+
+// Cost is the cost accounted for a query by the cost enforcers.
+type Cost struct {
+	Datapoints       float64 `json:"datapoints"`
+	Limit            float64 `json:"limit"`
+	LimitEnabled     bool    `json:"limitEnabled"`
+	GlobalDatapoints float64 `json:"globalDatapoints"`
+	GlobalLimit      float64 `json:"globalLimit"`
+}
+
+// NodeReport is the execution statistics of a single node.
+type NodeReport struct {
+	ID            string   `json:"id"`
+	Op            string   `json:"op"`
+	WallTime      Duration `json:"wallTime"`
+	BlocksIn      int      `json:"blocksIn"`
+	SeriesIn      int      `json:"seriesIn"`
+	DatapointsIn  int      `json:"datapointsIn"`
+	BlocksOut     int      `json:"blocksOut"`
+	SeriesOut     int      `json:"seriesOut"`
+	DatapointsOut int      `json:"datapointsOut"`
+}
+
+// Report is the rendered explanation of a query.
+type Report struct {
+	LogicalPlan  []Step        `json:"logicalPlan"`
+	PhysicalPlan *PhysicalPlan `json:"physicalPlan,omitempty"`
+	Phases       []Phase       `json:"phases"`
+	Nodes        []NodeReport  `json:"nodes"`
+	Fetches      []Fetch       `json:"fetches"`
+	Cost         Cost          `json:"cost"`
+}
+
+// Node records the execution statistics of a single node of a query.
+type Node struct {
+	sync.Mutex
+
+	id            string
+	op            string
+	blocksIn      int
+	seriesIn      int
+	datapointsIn  int
+	blocksOut     int
+	seriesOut     int
+	datapointsOut int
+	// total is the time spent processing in this node, including the time
+	// spent in nodes downstream of it.
+	total time.Duration
+	// downstream is the time spent in nodes downstream of this node.
+	downstream time.Duration
+}
+
+// RecordInput records a block received by the node.
+func (n *Node) RecordInput(series, datapoints int) {
+	n.Lock()
+	n.blocksIn++
+	n.seriesIn += series
+	n.datapointsIn += datapoints
+	n.Unlock()
+}
+
+// RecordOutput records a block emitted by the node.
+func (n *Node) RecordOutput(series, datapoints int) {
+	n.Lock()
+	n.blocksOut++
+	n.seriesOut += series
+	n.datapointsOut += datapoints
+	n.Unlock()
+}
+
+// RecordDuration records time spent processing in the node, including the
+// time spent in any downstream nodes it called.
+func (n *Node) RecordDuration(d time.Duration) {
+	n.Lock()
+	n.total += d
+	n.Unlock()
+}
+
+// RecordDownstream records time the node spent waiting on downstream nodes,
+// which is excluded from the node's own wall time.
+func (n *Node) RecordDownstream(d time.Duration) {
+	n.Lock()
+	n.downstream += d
+	n.Unlock()
+}
+
+func (n *Node) report() NodeReport {
+	n.Lock()
+	defer n.Unlock()
+
+	wallTime := n.total - n.downstream
+	if wallTime < 0 {
+		wallTime = 0
+	}
+
+	return NodeReport{
+		ID:            n.id,
+		Op:            n.op,
+		WallTime:      Duration(wallTime),
+		BlocksIn:      n.blocksIn,
+		SeriesIn:      n.seriesIn,
+		DatapointsIn:  n.datapointsIn,
+		BlocksOut:     n.blocksOut,
+		SeriesOut:     n.seriesOut,
+		DatapointsOut: n.datapointsOut,
+	}
+}
+
+// Explanation collects the execution details of a query. It is safe for
+// concurrent use.
+type Explanation struct {
+	sync.Mutex
+
+	logicalPlan  []Step
+	physicalPlan *PhysicalPlan
+	phases       []Phase
+	nodes        map[string]*Node
+	nodeOrder    []string
+	fetches      []Fetch
+	cost         Cost
+}
+
+// New returns a new, empty explanation.
+func New() *Explanation {
+	return &Explanation{nodes: make(map[string]*Node)}
+}
+
+// AddLogicalStep adds a step to the logical plan.
+func (e *Explanation) AddLogicalStep(step Step) {
+	e.Lock()
+	e.logicalPlan = append(e.logicalPlan, step)
+	e.Unlock()
+}
+
+// SetPhysicalPlan sets the physical plan.
+func (e *Explanation) SetPhysicalPlan(plan PhysicalPlan) {
+	e.Lock()
+	e.physicalPlan = &plan
+	e.Unlock()
+}
+
+// RecordPhase records the wall time of a query phase.
+func (e *Explanation) RecordPhase(name string, d time.Duration) {
+	e.Lock()
+	e.phases = append(e.phases, Phase{Name: name, WallTime: Duration(d)})
+	e.Unlock()
+}
+
+// Node returns the statistics of the node with the given ID, creating
+// them if this is the first time the node is seen.
+func (e *Explanation) Node(id, op string) *Node {
+	e.Lock()
+	defer e.Unlock()
+
+	n, ok := e.nodes[id]
+	if !ok {
+		n = &Node{id: id, op: op}
+		e.nodes[id] = n
+		e.nodeOrder = append(e.nodeOrder, id)
+	}
+
+	return n
+}
+
+// RecordFetch records a storage fetch.
+func (e *Explanation) RecordFetch(f Fetch) {
+	e.Lock()
+	e.fetches = append(e.fetches, f)
+	e.Unlock()
+}
+
+// AddCost adds the cost accounted by a per query enforcer.
+func (e *Explanation) AddCost(current cost.Cost, limit cost.Limit) {
+	e.Lock()
+	e.cost.Datapoints += float64(current)
+	e.cost.Limit = float64(limit.Threshold)
+	e.cost.LimitEnabled = limit.Enabled
+	e.Unlock()
+}
+
+// SetGlobalCost sets the cost accounted by the global enforcer.
+func (e *Explanation) SetGlobalCost(current cost.Cost, limit cost.Limit) {
+	e.Lock()
+	e.cost.GlobalDatapoints = float64(current)
+	e.cost.GlobalLimit = float64(limit.Threshold)
+	e.Unlock()
+}
+
+// Report renders the explanation.
+func (e *Explanation) Report() Report {
+	e.Lock()
+	defer e.Unlock()
+
+	nodes := make([]NodeReport, 0, len(e.nodeOrder))
+	for _, id := range e.nodeOrder {
+		nodes = append(nodes, e.nodes[id].report())
+	}
+
+	logicalPlan := make([]Step, len(e.logicalPlan))
+	copy(logicalPlan, e.logicalPlan)
+
+	phases := make([]Phase, len(e.phases))
+	copy(phases, e.phases)
+
+	fetches := make([]Fetch, len(e.fetches))
+	copy(fetches, e.fetches)
+	// NB: fetches are recorded concurrently, sort them for a stable output.
+	sort.SliceStable(fetches, func(i, j int) bool {
+		return fetches[i].Namespace < fetches[j].Namespace
+	})
+
+	return Report{
+		LogicalPlan:  logicalPlan,
+		PhysicalPlan: e.physicalPlan,
+		Phases:       phases,
+		Nodes:        nodes,
+		Fetches:      fetches,
+		Cost:         e.cost,
+	}
+}
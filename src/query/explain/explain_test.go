@@ -0,0 +1,102 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package explain
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/x/cost"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	e := New()
+	fromCtx, ok := FromContext(NewContext(context.Background(), e))
+	require.True(t, ok)
+	assert.True(t, e == fromCtx)
+
+	_, ok = FromContext(NewContext(context.Background(), nil))
+	assert.False(t, ok)
+}
+
+func TestNodeWallTimeExcludesDownstream(t *testing.T) {
+	e := New()
+	n := e.Node("1", "fetch")
+	assert.True(t, n == e.Node("1", ""))
+
+	n.RecordDuration(5 * time.Second)
+	n.RecordDownstream(3 * time.Second)
+	n.RecordInput(1, 10)
+	n.RecordOutput(2, 20)
+	n.RecordOutput(2, 20)
+
+	report := e.Report()
+	require.Equal(t, 1, len(report.Nodes))
+	assert.Equal(t, NodeReport{
+		ID:            "1",
+		Op:            "fetch",
+		WallTime:      Duration(2 * time.Second),
+		BlocksIn:      1,
+		SeriesIn:      1,
+		DatapointsIn:  10,
+		BlocksOut:     2,
+		SeriesOut:     4,
+		DatapointsOut: 40,
+	}, report.Nodes[0])
+}
+
+func TestReportJSON(t *testing.T) {
+	e := New()
+	e.RecordPhase("compiling", 1500*time.Microsecond)
+	e.RecordFetch(Fetch{Namespace: "b", Series: 2})
+	e.RecordFetch(Fetch{Namespace: "a", Series: 1})
+	e.AddCost(10, cost.Limit{Threshold: 100, Enabled: true})
+	e.AddCost(5, cost.Limit{Threshold: 100, Enabled: true})
+	e.SetGlobalCost(20, cost.Limit{Threshold: 1000})
+
+	report := e.Report()
+	require.Equal(t, 2, len(report.Fetches))
+	assert.Equal(t, "a", report.Fetches[0].Namespace)
+	assert.Equal(t, "b", report.Fetches[1].Namespace)
+	assert.Equal(t, Cost{
+		Datapoints:       15,
+		Limit:            100,
+		LimitEnabled:     true,
+		GlobalDatapoints: 20,
+		GlobalLimit:      1000,
+	}, report.Cost)
+
+	b, err := json.Marshal(report.Phases)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"name":"compiling","wallTime":"1.5ms"}]`, string(b))
+
+	var phases []Phase
+	require.NoError(t, json.Unmarshal(b, &phases))
+	assert.Equal(t, report.Phases, phases)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package functions
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/functions/utils"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/x/opentracing"
+)
+
+// AggregateSeriesType evaluates an aggregation within the storage.
+const AggregateSeriesType = "aggregate_series"
+
+var errAggregateSeriesNotSupported = errors.New("storage does not support " +
+	"aggregate series queries")
+
+// AggregateSeriesOp stores required properties for an aggregation that is
+// pushed down to storage, replacing a fetch followed by a temporal function
+// and an aggregation.
+type AggregateSeriesOp struct {
+	Name             string
+	Matchers         models.Matchers
+	Range            time.Duration
+	TemporalFunction seriesagg.TemporalFunction
+	Aggregation      seriesagg.Aggregation
+	GroupBy          [][]byte
+}
+
+// aggregateSeriesNode is an aggregate series execution node.
+type aggregateSeriesNode struct {
+	op         AggregateSeriesOp
+	controller *transform.Controller
+	storage    storage.Storage
+	timespec   transform.TimeSpec
+	fetchOpts  *storage.FetchOptions
+	blockType  models.FetchedBlockType
+}
+
+// OpType for the operator.
+func (o AggregateSeriesOp) OpType() string {
+	return AggregateSeriesType
+}
+
+// String is the string representation for this operation.
+func (o AggregateSeriesOp) String() string {
+	return fmt.Sprintf("type: %s. name: %s, range: %v, temporal: %s, "+
+		"aggregation: %s, by: %s, matchers: %v", o.OpType(), o.Name, o.Range,
+		o.TemporalFunction, o.Aggregation, o.GroupBy, o.Matchers)
+}
+
+// Node creates the aggregate series execution node for this operation.
+func (o AggregateSeriesOp) Node(
+	controller *transform.Controller,
+	storage storage.Storage,
+	options transform.Options,
+) parser.Source {
+	return &aggregateSeriesNode{
+		op:         o,
+		controller: controller,
+		storage:    storage,
+		timespec:   options.TimeSpec(),
+		fetchOpts:  options.FetchOptions(),
+		blockType:  options.BlockType(),
+	}
+}
+
+// Execute runs the aggregate series node operation.
+func (n *aggregateSeriesNode) Execute(queryCtx *models.QueryContext) error {
+	sp, ctx := opentracing.StartSpanFromContext(queryCtx.Ctx, AggregateSeriesType)
+	defer sp.Finish()
+
+	store, ok := n.storage.(storage.AggregateSeriesStorage)
+	if !ok {
+		return errAggregateSeriesNotSupported
+	}
+
+	opts, err := n.fetchOpts.QueryFetchOptions(queryCtx, n.blockType)
+	if err != nil {
+		return err
+	}
+
+	timeSpec := n.timespec
+	result, err := store.AggregateSeries(ctx, &storage.AggregateSeriesQuery{
+		FetchQuery: storage.FetchQuery{
+			Start:       timeSpec.Start,
+			End:         timeSpec.End,
+			TagMatchers: n.op.Matchers,
+			Interval:    timeSpec.Step,
+		},
+		Range:            n.op.Range,
+		TemporalFunction: n.op.TemporalFunction,
+		Aggregation:      n.op.Aggregation,
+		GroupBy:          n.op.GroupBy,
+	}, opts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		name    = []byte(n.op.Aggregation.String())
+		tagOpts = models.NewTagOptions()
+		metas   = make([]block.SeriesMeta, 0, len(result.Series))
+	)
+	for _, series := range result.Series {
+		tagOpts = series.Tags.Opts
+		metas = append(metas, block.SeriesMeta{
+			Name: name,
+			Tags: series.Tags,
+		})
+	}
+
+	meta := block.Metadata{
+		Bounds:         timeSpec.Bounds(),
+		ResultMetadata: result.Metadata,
+	}
+
+	meta.Tags, metas = utils.DedupeMetadata(metas, tagOpts)
+	builder, err := n.controller.BlockBuilder(queryCtx, meta, metas)
+	if err != nil {
+		return err
+	}
+
+	steps := meta.Bounds.Steps()
+	if err := builder.AddCols(steps); err != nil {
+		return err
+	}
+
+	values := make([]float64, len(result.Series))
+	for i := 0; i < steps; i++ {
+		for j, series := range result.Series {
+			if i >= len(series.Values) {
+				return fmt.Errorf("aggregated series has %d values, expected %d",
+					len(series.Values), steps)
+			}
+
+			values[j] = series.Values[i]
+		}
+
+		if err := builder.AppendValues(i, values); err != nil {
+			return err
+		}
+	}
+
+	bl := builder.Build()
+	err = n.controller.Process(queryCtx.WithContext(ctx), bl)
+	bl.Close()
+	return err
+}
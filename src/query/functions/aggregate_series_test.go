@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package functions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/test/executor"
+	"github.com/m3db/m3/src/query/test/transformtest"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type aggregateSeriesStorage struct {
+	*storage.MockStorage
+	*storage.MockAggregateSeriesStorage
+}
+
+func TestAggregateSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now     = time.Now().Truncate(time.Minute)
+		start   = now.Add(-3 * time.Minute)
+		tagOpts = models.NewTagOptions()
+		op      = AggregateSeriesOp{
+			Range:            5 * time.Minute,
+			TemporalFunction: seriesagg.Rate,
+			Aggregation:      seriesagg.Sum,
+			GroupBy:          [][]byte{[]byte("region")},
+		}
+	)
+
+	aggStore := storage.NewMockAggregateSeriesStorage(ctrl)
+	store := aggregateSeriesStorage{
+		MockStorage:                storage.NewMockStorage(ctrl),
+		MockAggregateSeriesStorage: aggStore,
+	}
+
+	aggStore.EXPECT().
+		AggregateSeries(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			query *storage.AggregateSeriesQuery,
+			_ *storage.FetchOptions,
+		) (storage.AggregateSeriesResult, error) {
+			assert.Equal(t, start, query.Start)
+			assert.Equal(t, now, query.End)
+			assert.Equal(t, time.Minute, query.Interval)
+			assert.Equal(t, op.Range, query.Range)
+			assert.Equal(t, seriesagg.Rate, query.TemporalFunction)
+			assert.Equal(t, seriesagg.Sum, query.Aggregation)
+			assert.Equal(t, op.GroupBy, query.GroupBy)
+			return storage.AggregateSeriesResult{
+				Series: []storage.AggregatedSeries{
+					{
+						Tags: models.NewTags(1, tagOpts).AddTag(models.Tag{
+							Name: []byte("region"), Value: []byte("east"),
+						}),
+						Values: []float64{1, 2, 3},
+					},
+					{
+						Tags: models.NewTags(1, tagOpts).AddTag(models.Tag{
+							Name: []byte("region"), Value: []byte("west"),
+						}),
+						Values: []float64{4, 5, 6},
+					},
+				},
+			}, nil
+		})
+
+	c, sink := executor.NewControllerWithSink(parser.NodeID(1))
+	source := op.Node(c, store, transformtest.Options(t, transform.OptionsParams{
+		TimeSpec: transform.TimeSpec{
+			Start: start,
+			End:   now,
+			Now:   now,
+			Step:  time.Minute,
+		},
+	}))
+
+	require.NoError(t, source.Execute(models.NoopQueryContext()))
+	assert.Equal(t, [][]float64{{1, 2, 3}, {4, 5, 6}}, sink.Values)
+	require.Equal(t, 2, len(sink.Metas))
+	assert.Equal(t, []byte("sum"), sink.Metas[0].Name)
+	region, ok := sink.Metas[1].Tags.Get([]byte("region"))
+	require.True(t, ok)
+	assert.Equal(t, []byte("west"), region)
+}
+
+func TestAggregateSeriesUnsupportedStorage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c, _ := executor.NewControllerWithSink(parser.NodeID(1))
+	source := AggregateSeriesOp{}.Node(c, storage.NewMockStorage(ctrl),
+		transformtest.Options(t, transform.OptionsParams{}))
+
+	err := source.Execute(models.NoopQueryContext())
+	assert.Equal(t, errAggregateSeriesNotSupported, err)
+}
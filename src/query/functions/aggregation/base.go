@@ -90,6 +90,11 @@ func (o baseOp) String() string {
 	return fmt.Sprintf("type: %s", o.OpType())
 }
 
+// NodeParams returns the parameters of the operation.
+func (o baseOp) NodeParams() NodeParams {
+	return o.params
+}
+
 // Node creates an execution node.
 func (o baseOp) Node(
 	controller *transform.Controller,
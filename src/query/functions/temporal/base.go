@@ -84,6 +84,11 @@ func (o baseOp) String() string {
 	return fmt.Sprintf("type: %s, duration: %v", o.OpType(), o.duration)
 }
 
+// Duration returns the duration of the window the operation is evaluated over.
+func (o baseOp) Duration() time.Duration {
+	return o.duration
+}
+
 // Node creates an execution node.
 func (o baseOp) Node(
 	controller *transform.Controller,
@@ -0,0 +1,132 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package temporal
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	dbts "github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPushdownMatchesTemporalFunctions ensures that the temporal functions
+// evaluated by dbnodes for pushed down queries return the same results as
+// the coordinator's temporal functions for the same input.
+func TestPushdownMatchesTemporalFunctions(t *testing.T) {
+	var (
+		now   = time.Now().Truncate(time.Hour)
+		step  = 10 * time.Second
+		rng   = time.Minute
+		start = now.Add(2 * rng)
+		end   = start.Add(10 * step)
+	)
+
+	// NB: datapoints span from before the first window through the end of
+	// the query, including a counter reset and a missing value.
+	var (
+		dps   ts.Datapoints
+		value float64
+	)
+	for at := now; at.Before(end); at = at.Add(7 * time.Second) {
+		value += 3
+		if len(dps) == 20 {
+			value = 1
+		}
+		v := value
+		if len(dps) == 12 {
+			v = math.NaN()
+		}
+		dps = append(dps, ts.Datapoint{Timestamp: at, Value: v})
+	}
+
+	var dbDps []dbts.Datapoint
+	for _, dp := range dps {
+		dbDps = append(dbDps, dbts.Datapoint{Timestamp: dp.Timestamp, Value: dp.Value})
+	}
+
+	tests := []struct {
+		opType   string
+		newOp    func(args []interface{}, optype string) (transform.Params, error)
+		temporal seriesagg.TemporalFunction
+	}{
+		{RateType, NewRateOp, seriesagg.Rate},
+		{IncreaseType, NewRateOp, seriesagg.Increase},
+		{DeltaType, NewRateOp, seriesagg.Delta},
+		{AvgType, NewAggOp, seriesagg.AvgOverTime},
+		{CountType, NewAggOp, seriesagg.CountOverTime},
+		{MinType, NewAggOp, seriesagg.MinOverTime},
+		{MaxType, NewAggOp, seriesagg.MaxOverTime},
+		{SumType, NewAggOp, seriesagg.SumOverTime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.opType, func(t *testing.T) {
+			params, err := tt.newOp([]interface{}{rng}, tt.opType)
+			require.NoError(t, err)
+
+			op, ok := params.(baseOp)
+			require.True(t, ok)
+			p := op.processorFn.initialize(rng, nil, transform.Options{})
+
+			var (
+				steps    = int(end.Sub(start) / step)
+				expected = make([]float64, 0, steps)
+				init     = 0
+				rEnd     = start.UnixNano()
+				rStart   = rEnd - int64(rng)
+			)
+			for i := 0; i < steps; i++ {
+				bounds := iterationBounds{start: rStart, end: rEnd}
+				l, r, ok := getIndices(dps, rStart, rEnd, init)
+				if !ok {
+					expected = append(expected, p.process(ts.Datapoints{}, bounds))
+				} else {
+					init = l
+					expected = append(expected, p.process(dps[l:r], bounds))
+				}
+				rStart += int64(step)
+				rEnd += int64(step)
+			}
+
+			acc := seriesagg.NewAccumulator(seriesagg.Options{
+				Start:            start,
+				End:              end,
+				Step:             step,
+				Range:            rng,
+				TemporalFunction: tt.temporal,
+				Aggregation:      seriesagg.Sum,
+			})
+			require.NoError(t, acc.Add(ident.EmptyTagIterator, dbDps))
+
+			groups := acc.Groups()
+			require.Equal(t, 1, len(groups))
+			test.EqualsWithNansWithDelta(t, expected, groups[0].Values, 0.0001)
+		})
+	}
+}
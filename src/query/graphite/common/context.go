@@ -234,9 +234,10 @@ type Trace struct {
 	Outputs TraceStats
 }
 
-// TraceStats tracks the number of timeseries used by a trace.
+// TraceStats tracks the number of timeseries and datapoints used by a trace.
 type TraceStats struct {
-	NumSeries int // number of timeseries being acted on
+	NumSeries     int // number of timeseries being acted on
+	NumDatapoints int // number of datapoints across the timeseries
 }
 
 // A Tracer is used to record a Trace.
@@ -0,0 +1,46 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package events
+
+import (
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+const defaultNamespace = "graphite_events"
+
+// Configuration is the Graphite events configuration.
+type Configuration struct {
+	// Namespace is the dedicated M3DB namespace events are stored in, its
+	// index must be enabled and its buffer past and retention should cover
+	// how late and for how long events are posted and queried.
+	Namespace string `yaml:"namespace"`
+}
+
+// NewStore returns a new Graphite events store backed by the session.
+func (c Configuration) NewStore(session client.Session) Store {
+	namespace := defaultNamespace
+	if c.Namespace != "" {
+		namespace = c.Namespace
+	}
+
+	return newStore(session, ident.StringID(namespace))
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/cespare/xxhash"
+)
+
+const (
+	// tagFieldPrefix prefixes the index field of each event tag, so that any
+	// tag can be indexed without clashing with reserved field names.
+	tagFieldPrefix = "tag."
+	// eventValue is the value of the single datapoint of each event, the
+	// event itself is stored in the datapoint annotation.
+	eventValue = 1
+)
+
+// payload is the part of an event stored in the datapoint annotation.
+type payload struct {
+	What string   `json:"what"`
+	Tags []string `json:"tags"`
+	Data string   `json:"data"`
+}
+
+// store stores each event as a series with a single datapoint, whose
+// annotation holds the event and whose index fields are the event tags.
+type store struct {
+	session   client.Session
+	namespace ident.ID
+}
+
+func newStore(session client.Session, namespace ident.ID) Store {
+	return &store{
+		session:   session,
+		namespace: namespace,
+	}
+}
+
+func (s *store) Write(event Event) (string, error) {
+	annotation, err := json.Marshal(payload{
+		What: event.What,
+		Tags: event.Tags,
+		Data: event.Data,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// NB: events are identified by their time and contents so that retried
+	// writes of the same event are idempotent.
+	when := event.When.Truncate(time.Millisecond)
+	id := fmt.Sprintf("%d.%016x", when.UnixNano(), xxhash.Sum64(annotation))
+	tags := make([]ident.Tag, 0, len(event.Tags))
+	seen := make(map[string]struct{}, len(event.Tags))
+	for _, tag := range event.Tags {
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		tags = append(tags, ident.StringTag(tagFieldPrefix+tag, tag))
+	}
+
+	err = s.session.WriteTagged(s.namespace, ident.StringID(id),
+		ident.NewTagsIterator(ident.NewTags(tags...)), when, eventValue,
+		xtime.Millisecond, annotation)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (s *store) Fetch(query Query) ([]Event, error) {
+	iters, _, err := s.session.FetchTagged(s.namespace,
+		index.Query{Query: newIndexQuery(query.Tags, query.Union)},
+		index.QueryOptions{
+			StartInclusive: query.Start,
+			EndExclusive:   query.End.Add(time.Nanosecond),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	defer iters.Close()
+
+	var events []Event
+	for _, iter := range iters.Iters() {
+		for iter.Next() {
+			dp, _, annotation := iter.Current()
+			if dp.Timestamp.Before(query.Start) || dp.Timestamp.After(query.End) {
+				continue
+			}
+
+			var p payload
+			if err := json.Unmarshal(annotation, &p); err != nil {
+				return nil, fmt.Errorf("invalid event %s: %v", iter.ID().String(), err)
+			}
+
+			events = append(events, Event{
+				ID:   iter.ID().String(),
+				When: dp.Timestamp,
+				What: p.What,
+				Tags: p.Tags,
+				Data: p.Data,
+			})
+		}
+
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].When.Before(events[j].When)
+	})
+
+	return events, nil
+}
+
+func newIndexQuery(tags []string, union bool) idx.Query {
+	if len(tags) == 0 {
+		return idx.NewAllQuery()
+	}
+
+	queries := make([]idx.Query, 0, len(tags))
+	for _, tag := range tags {
+		queries = append(queries, idx.NewFieldQuery([]byte(tagFieldPrefix+tag)))
+	}
+
+	if union {
+		return idx.NewDisjunctionQuery(queries...)
+	}
+
+	return idx.NewConjunctionQuery(queries...)
+}
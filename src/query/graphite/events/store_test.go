@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package events
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockSession(ctrl)
+	s := Configuration{Namespace: "events"}.NewStore(session)
+
+	when := time.Unix(1500000000, 0)
+	session.EXPECT().WriteTagged(ident.NewIDMatcher("events"), gomock.Any(),
+		ident.NewTagIterMatcher(ident.MustNewTagStringsIterator(
+			"tag.deploy", "deploy", "tag.api", "api")),
+		when, float64(eventValue), xtime.Millisecond, gomock.Any()).
+		DoAndReturn(func(
+			_, _ ident.ID, _ ident.TagIterator, _ time.Time, _ float64,
+			_ xtime.Unit, annotation []byte,
+		) error {
+			var p payload
+			require.NoError(t, json.Unmarshal(annotation, &p))
+			assert.Equal(t, payload{
+				What: "deployed api",
+				Tags: []string{"deploy", "api", "deploy"},
+				Data: "v1.2.3",
+			}, p)
+			return nil
+		}).Times(2)
+
+	event := Event{
+		When: when,
+		What: "deployed api",
+		Tags: []string{"deploy", "api", "deploy"},
+		Data: "v1.2.3",
+	}
+	id, err := s.Write(event)
+	require.NoError(t, err)
+
+	// Writing the same event again is idempotent.
+	retryID, err := s.Write(event)
+	require.NoError(t, err)
+	assert.Equal(t, id, retryID)
+}
+
+func TestStoreFetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockSession(ctrl)
+	s := Configuration{}.NewStore(session)
+
+	start := time.Unix(1500000000, 0)
+	end := start.Add(time.Hour)
+	newIter := func(id string, when time.Time, p payload) encoding.SeriesIterator {
+		annotation, err := json.Marshal(p)
+		require.NoError(t, err)
+
+		iter := encoding.NewMockSeriesIterator(ctrl)
+		iter.EXPECT().Next().Return(true)
+		iter.EXPECT().Current().Return(ts.Datapoint{
+			Timestamp: when,
+			Value:     eventValue,
+		}, xtime.Millisecond, ts.Annotation(annotation))
+		iter.EXPECT().Next().Return(false)
+		iter.EXPECT().Err().Return(nil)
+		iter.EXPECT().ID().Return(ident.StringID(id)).AnyTimes()
+		return iter
+	}
+
+	iters := encoding.NewMockSeriesIterators(ctrl)
+	iters.EXPECT().Iters().Return([]encoding.SeriesIterator{
+		newIter("b", end, payload{What: "second", Tags: []string{"deploy"}}),
+		newIter("a", start, payload{What: "first", Tags: []string{"deploy", "api"}, Data: "v1"}),
+		newIter("c", end.Add(time.Millisecond), payload{What: "too late"}),
+	})
+	iters.EXPECT().Close()
+
+	expectedQuery := index.Query{Query: idx.NewConjunctionQuery(
+		idx.NewFieldQuery([]byte("tag.deploy")),
+		idx.NewFieldQuery([]byte("tag.api")),
+	)}
+	session.EXPECT().FetchTagged(ident.NewIDMatcher(defaultNamespace), expectedQuery,
+		index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end.Add(time.Nanosecond),
+		}).Return(iters, true, nil)
+
+	events, err := s.Fetch(Query{
+		Start: start,
+		End:   end,
+		Tags:  []string{"deploy", "api"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Event{
+		{ID: "a", When: start, What: "first", Tags: []string{"deploy", "api"}, Data: "v1"},
+		{ID: "b", When: end, What: "second", Tags: []string{"deploy"}},
+	}, events)
+}
+
+func TestNewIndexQuery(t *testing.T) {
+	assert.Equal(t, idx.NewAllQuery(), newIndexQuery(nil, false))
+	assert.Equal(t, idx.NewDisjunctionQuery(
+		idx.NewFieldQuery([]byte("tag.a")),
+		idx.NewFieldQuery([]byte("tag.b")),
+	), newIndexQuery([]string{"a", "b"}, true))
+	assert.Equal(t, idx.NewConjunctionQuery(
+		idx.NewFieldQuery([]byte("tag.a")),
+	), newIndexQuery([]string{"a"}, false))
+}
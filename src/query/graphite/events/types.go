@@ -0,0 +1,64 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package events stores Graphite events, such as deploys, which dashboards
+// display as annotations.
+package events
+
+import (
+	"time"
+)
+
+// Event is a Graphite event.
+type Event struct {
+	// ID uniquely identifies the event, it is assigned when the event is
+	// written.
+	ID string
+	// When is the time the event happened.
+	When time.Time
+	// What is a short description of the event.
+	What string
+	// Tags are the tags the event can be filtered by.
+	Tags []string
+	// Data is any additional information about the event.
+	Data string
+}
+
+// Query selects the events to fetch.
+type Query struct {
+	// Start is the inclusive start of the time range.
+	Start time.Time
+	// End is the inclusive end of the time range.
+	End time.Time
+	// Tags filters the events by their tags, all events in the time range
+	// are matched if empty.
+	Tags []string
+	// Union matches events with any of the tags rather than all of them.
+	Union bool
+}
+
+// Store stores Graphite events.
+type Store interface {
+	// Write stores an event, returning the ID assigned to it.
+	Write(event Event) (string, error)
+
+	// Fetch returns the events matching the query, ordered by time.
+	Fetch(query Query) ([]Event, error)
+}
@@ -23,6 +23,7 @@ package native
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/m3db/m3/src/query/graphite/common"
@@ -34,6 +35,136 @@ func wrapPathExpr(wrapper string, series ts.SeriesList) string {
 	return fmt.Sprintf("%s(%s)", wrapper, joinPathExpr(series))
 }
 
+// aggregationFunc reduces a non-empty set of non-NaN values to a single value.
+type aggregationFunc func(values []float64) float64
+
+var aggregationFuncs = map[string]aggregationFunc{
+	"average":  aggregateAvg,
+	"avg":      aggregateAvg,
+	"median":   aggregateMedian,
+	"sum":      aggregateSum,
+	"total":    aggregateSum,
+	"min":      aggregateMin,
+	"max":      aggregateMax,
+	"diff":     aggregateDiff,
+	"stddev":   aggregateStdDev,
+	"count":    aggregateCount,
+	"range":    aggregateRange,
+	"rangeOf":  aggregateRange,
+	"multiply": aggregateMultiply,
+	"last":     aggregateLast,
+	"current":  aggregateLast,
+}
+
+func aggregateSum(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+func aggregateAvg(values []float64) float64 {
+	return aggregateSum(values) / float64(len(values))
+}
+
+func aggregateMedian(values []float64) float64 {
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
+
+func aggregateMin(values []float64) float64 {
+	min := values[0]
+	for _, v := range values[1:] {
+		min = math.Min(min, v)
+	}
+	return min
+}
+
+func aggregateMax(values []float64) float64 {
+	max := values[0]
+	for _, v := range values[1:] {
+		max = math.Max(max, v)
+	}
+	return max
+}
+
+func aggregateDiff(values []float64) float64 {
+	return values[0] - aggregateSum(values[1:])
+}
+
+func aggregateStdDev(values []float64) float64 {
+	avg := aggregateAvg(values)
+	sum := 0.0
+	for _, v := range values {
+		sum += (v - avg) * (v - avg)
+	}
+	return math.Sqrt(sum / float64(len(values)))
+}
+
+func aggregateCount(values []float64) float64 {
+	return float64(len(values))
+}
+
+func aggregateRange(values []float64) float64 {
+	return aggregateMax(values) - aggregateMin(values)
+}
+
+func aggregateMultiply(values []float64) float64 {
+	product := 1.0
+	for _, v := range values {
+		product *= v
+	}
+	return product
+}
+
+func aggregateLast(values []float64) float64 {
+	return values[len(values)-1]
+}
+
+// getAggregationFunc returns the aggregation function registered under the given name.
+func getAggregationFunc(fname string) (aggregationFunc, error) {
+	fn, ok := aggregationFuncs[fname]
+	if !ok {
+		return nil, errors.NewInvalidParamsError(fmt.Errorf("invalid func %s", fname))
+	}
+	return fn, nil
+}
+
+// safeAggregate applies an aggregation function to the non-NaN values of the
+// given slice, returning NaN if there are none or if the ratio of non-NaN values
+// is below the xFilesFactor. NB: values is reordered in place.
+func safeAggregate(fn aggregationFunc, values []float64, xFilesFactor float64) float64 {
+	n := 0
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			values[n] = v
+			n++
+		}
+	}
+
+	if n == 0 || float64(n)/float64(len(values)) < xFilesFactor {
+		return math.NaN()
+	}
+	return fn(values[:n])
+}
+
+// aggregationReducer returns a series reducer which applies the given aggregation
+// function to all non-NaN values of a series.
+func aggregationReducer(fn aggregationFunc) ts.SeriesReducer {
+	return func(series *ts.Series) float64 {
+		values := series.SafeValues()
+		if len(values) == 0 {
+			return math.NaN()
+		}
+		return fn(values)
+	}
+}
+
 // sumSeries adds metrics together and returns the sum at each datapoint.
 // If the time series have different intervals, the coarsest interval will be used.
 func sumSeries(ctx *common.Context, series multiplePathSpecs) (ts.SeriesList, error) {
@@ -89,6 +220,62 @@ func maxSeries(ctx *common.Context, series multiplePathSpecs) (ts.SeriesList, er
 	return combineSeries(ctx, series, wrapPathExpr("maxSeries", ts.SeriesList(series)), ts.Max)
 }
 
+// aggregate aggregates the series using the named aggregation function, e.g.
+// "sum", "avg", "median" or "stddev", returning one series containing the result
+// at each datapoint. A datapoint is only produced when the ratio of non-null
+// values is at least xFilesFactor.
+func aggregate(
+	ctx *common.Context,
+	series singlePathSpec,
+	fname string,
+	xFilesFactor float64,
+) (ts.SeriesList, error) {
+	fn, err := getAggregationFunc(fname)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+
+	name := wrapPathExpr(fname+"Series", ts.SeriesList(series))
+	return aggregateSeries(ctx, ts.SeriesList(series), name, fn, xFilesFactor)
+}
+
+// aggregateSeries normalizes the series and applies an aggregation function
+// across them at each datapoint.
+func aggregateSeries(
+	ctx *common.Context,
+	series ts.SeriesList,
+	name string,
+	fn aggregationFunc,
+	xFilesFactor float64,
+) (ts.SeriesList, error) {
+	if len(series.Values) == 0 { // no data; no work
+		return series, nil
+	}
+
+	normalized, start, _, millisPerStep, err := common.Normalize(ctx, series)
+	if err != nil {
+		err := errors.NewInvalidParamsError(fmt.Errorf("aggregate series error: %v", err))
+		return ts.NewSeriesList(), err
+	}
+
+	var (
+		numSteps = normalized.Values[0].Len()
+		vals     = ts.NewValues(ctx, millisPerStep, numSteps)
+		row      = make([]float64, len(normalized.Values))
+	)
+	for i := 0; i < numSteps; i++ {
+		for j, s := range normalized.Values {
+			row[j] = s.ValueAt(i)
+		}
+		vals.SetValueAt(i, safeAggregate(fn, row, xFilesFactor))
+	}
+
+	return ts.SeriesList{
+		Values:   []*ts.Series{ts.NewSeries(ctx, name, start, vals)},
+		Metadata: series.Metadata,
+	}, nil
+}
+
 // divideSeries divides one series list by another series
 func divideSeries(ctx *common.Context, dividendSeriesList, divisorSeriesList singlePathSpec) (ts.SeriesList, error) {
 	if len(divisorSeriesList.Values) != 1 {
@@ -171,30 +358,7 @@ func combineSeriesWithWildcards(
 		return ts.SeriesList(series), nil
 	}
 
-	var (
-		toCombine = make(map[string][]*ts.Series)
-		wildcards = make(map[int]struct{})
-	)
-
-	for _, position := range positions {
-		wildcards[position] = struct{}{}
-	}
-
-	for _, series := range series.Values {
-		var (
-			parts    = strings.Split(series.Name(), ".")
-			newParts = make([]string, 0, len(parts))
-		)
-		for i, part := range parts {
-			if _, wildcard := wildcards[i]; !wildcard {
-				newParts = append(newParts, part)
-			}
-		}
-
-		newName := strings.Join(newParts, ".")
-		toCombine[newName] = append(toCombine[newName], series)
-	}
-
+	toCombine := groupByWildcards(series, positions)
 	newSeries := make([]*ts.Series, 0, len(toCombine))
 	for name, combinedSeries := range toCombine {
 		seriesList := ts.SeriesList{
@@ -220,6 +384,80 @@ func combineSeriesWithWildcards(
 	return r, nil
 }
 
+// aggregateWithWildcards splits the given set of series into sub-groupings
+// based on wildcard matches in the hierarchy, then aggregates the values in
+// each grouping using the named aggregation function
+func aggregateWithWildcards(
+	ctx *common.Context,
+	series singlePathSpec,
+	fname string,
+	positions ...int,
+) (ts.SeriesList, error) {
+	fn, err := getAggregationFunc(fname)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+
+	if len(series.Values) == 0 {
+		return ts.SeriesList(series), nil
+	}
+
+	toAggregate := groupByWildcards(series, positions)
+	newSeries := make([]*ts.Series, 0, len(toAggregate))
+	for name, aggregatedSeries := range toAggregate {
+		seriesList := ts.SeriesList{
+			Values:   aggregatedSeries,
+			Metadata: series.Metadata,
+		}
+		aggregated, err := aggregateSeries(ctx, seriesList, name, fn, 0)
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+		aggregated.Values[0].Specification = wrapPathExpr(fname+"Series", seriesList)
+		newSeries = append(newSeries, aggregated.Values...)
+	}
+
+	r := ts.SeriesList(series)
+
+	r.Values = newSeries
+
+	// Ranging over hash map to create results destroys
+	// any sort order on the incoming series list
+	r.SortApplied = false
+
+	return r, nil
+}
+
+// groupByWildcards groups series by their names with the nodes at the
+// given positions removed.
+func groupByWildcards(series singlePathSpec, positions []int) map[string][]*ts.Series {
+	var (
+		groups    = make(map[string][]*ts.Series)
+		wildcards = make(map[int]struct{})
+	)
+
+	for _, position := range positions {
+		wildcards[position] = struct{}{}
+	}
+
+	for _, series := range series.Values {
+		var (
+			parts    = strings.Split(series.Name(), ".")
+			newParts = make([]string, 0, len(parts))
+		)
+		for i, part := range parts {
+			if _, wildcard := wildcards[i]; !wildcard {
+				newParts = append(newParts, part)
+			}
+		}
+
+		newName := strings.Join(newParts, ".")
+		groups[newName] = append(groups[newName], series)
+	}
+
+	return groups
+}
+
 // groupByNode takes a serieslist and maps a callback to subgroups within as defined by a common node
 //
 //    &target=groupByNode(foo.by-function.*.*.cpu.load5,2,"sumSeries")
@@ -281,6 +519,72 @@ func groupByNode(ctx *common.Context, series singlePathSpec, node int, fname str
 	return r, nil
 }
 
+// applyByNode takes a seriesList and applies the template function to each
+// unique prefix of the series names up to and including nodeNum. Every '%' in
+// the template is replaced with the prefix and the resulting expression is
+// evaluated. If newName is set, each resulting series is renamed to it, again
+// with '%' replaced by the prefix.
+//
+//    &target=applyByNode(servers.*.disk.bytes_free,1,"divideSeries(%.disk.bytes_free,sumSeries(%.disk.bytes_*))")
+//
+//  Would return a series per server with the fraction of free disk space.
+func applyByNode(
+	ctx *common.Context,
+	series singlePathSpec,
+	nodeNum int,
+	templateFunction string,
+	newName string,
+) (ts.SeriesList, error) {
+	var (
+		prefixes = make([]string, 0, len(series.Values))
+		seen     = make(map[string]struct{}, len(series.Values))
+	)
+	for _, s := range series.Values {
+		parts := strings.Split(s.Name(), ".")
+		if nodeNum < 0 || nodeNum >= len(parts) {
+			err := errors.NewInvalidParamsError(fmt.Errorf("could not apply to %s by node %d; not enough parts", s.Name(), nodeNum))
+			return ts.NewSeriesList(), err
+		}
+
+		prefix := strings.Join(parts[:nodeNum+1], ".")
+		if _, ok := seen[prefix]; ok {
+			continue
+		}
+		seen[prefix] = struct{}{}
+		prefixes = append(prefixes, prefix)
+	}
+
+	var (
+		newSeries = make([]*ts.Series, 0, len(prefixes))
+		meta      = series.Metadata
+	)
+	for _, prefix := range prefixes {
+		expr, err := compile(strings.Replace(templateFunction, "%", prefix, -1))
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+
+		output, err := expr.Execute(ctx)
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+
+		meta = meta.CombineMetadata(output.Metadata)
+		for _, s := range output.Values {
+			if newName != "" {
+				s = s.RenamedTo(strings.Replace(newName, "%", prefix, -1))
+			}
+			newSeries = append(newSeries, s)
+		}
+	}
+
+	r := ts.SeriesList(series)
+	r.Values = newSeries
+	r.Metadata = meta
+	r.SortApplied = false
+	return r, nil
+}
+
 // combineSeries combines multiple series into a single series using a
 // consolidation func.  If the series use different time intervals, the
 // coarsest time will apply.
@@ -202,6 +202,125 @@ func TestAverageSeries(t *testing.T) {
 	testAggregatedSeries(t, averageSeries, 15.0, 28.0/3, 10.0, 17.0, "invalid avg value for step %d")
 }
 
+func TestAggregate(t *testing.T) {
+	ctx, consolidationTestSeries := newConsolidationTestSeries()
+	defer ctx.Close()
+
+	input := singlePathSpec{Values: consolidationTestSeries}
+	tests := []struct {
+		fname                string
+		ev1, ev2, ev3, ev4   float64
+		expectedSeriesPrefix string
+	}{
+		{"sum", 15.0, 28.0, 30.0, 17.0, "sumSeries"},
+		{"max", 15.0, 15.0, 17.0, 17.0, "maxSeries"},
+		{"count", 1.0, 3.0, 3.0, 1.0, "countSeries"},
+		{"median", 15.0, 10.0, 10.0, 17.0, "medianSeries"},
+		{"range", 0.0, 12.0, 14.0, 0.0, "rangeSeries"},
+	}
+
+	for _, test := range tests {
+		r, err := aggregate(ctx, input, test.fname, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, r.Len())
+
+		series := r.Values[0]
+		assert.Equal(t, test.expectedSeriesPrefix+"()", series.Name())
+		require.Equal(t, 12, series.Len())
+		for i, expected := range []float64{test.ev1, test.ev2, test.ev3, test.ev4} {
+			for j := 3 * i; j < 3*(i+1); j++ {
+				assert.Equal(t, expected, series.ValueAt(j), "invalid %s value for step %d", test.fname, j)
+			}
+		}
+	}
+
+	// xFilesFactor of 0.5 requires at least two of the four series to be present
+	r, err := aggregate(ctx, input, "sum", 0.5)
+	require.NoError(t, err)
+	series := r.Values[0]
+	assert.True(t, math.IsNaN(series.ValueAt(0)))
+	assert.Equal(t, 28.0, series.ValueAt(3))
+	assert.True(t, math.IsNaN(series.ValueAt(9)))
+
+	_, err = aggregate(ctx, input, "foo", 0)
+	require.Error(t, err)
+}
+
+func TestAggregateWithWildcards(t *testing.T) {
+	ctx, _ := newConsolidationTestSeries()
+	defer ctx.Close()
+
+	input := []common.TestSeries{
+		common.TestSeries{"web.host-1.avg-response.value", []float64{70.0, 20.0, 30.0, 40.0, 50.0}},
+		common.TestSeries{"web.host-2.avg-response.value", []float64{20.0, 30.0, 40.0, 50.0, 60.0}},
+		common.TestSeries{"web.host-3.avg-response.value", []float64{30.0, 40.0, 80.0, 60.0, 70.0}},
+		common.TestSeries{"web.host-4.num-requests.value", []float64{10.0, 10.0, 15.0, 10.0, 15.0}},
+	}
+	expected := []common.TestSeries{
+		common.TestSeries{"web.avg-response", []float64{70.0, 40.0, 80.0, 60.0, 70.0}},
+		common.TestSeries{"web.num-requests", []float64{10.0, 10.0, 15.0, 10.0, 15.0}},
+	}
+
+	start := consolidationStartTime
+	step := 12000
+	timeSeries := generateSeriesList(ctx, start, input, step)
+	output, err := aggregateWithWildcards(ctx, singlePathSpec{
+		Values: timeSeries,
+	}, "max", 1, 3)
+	require.NoError(t, err)
+	sort.Sort(TimeSeriesPtrVector(output.Values))
+	common.CompareOutputsAndExpected(t, step, start, expected, output.Values)
+}
+
+func TestApplyByNode(t *testing.T) {
+	expr, err := compile(`applyByNode(servers.*.disk.bytes_free, 1, "divideSeries(%.disk.bytes_free,sumSeries(%.disk.bytes_*))", "%.disk.pct_free")`)
+	require.NoError(t, err)
+	ctx := common.NewTestContext()
+	ctx.Engine = mockEngine{fn: func(
+		ctx context.Context,
+		query string,
+		options storage.FetchOptions,
+	) (*storage.FetchResult, error) {
+		start := options.StartTime
+		newSeries := func(name string, value float64) *ts.Series {
+			return ts.NewSeries(ctx, name, start, ts.NewConstantValues(ctx, value, 3, 1000))
+		}
+		var series []*ts.Series
+		switch query {
+		case "servers.*.disk.bytes_free":
+			series = []*ts.Series{
+				newSeries("servers.s1.disk.bytes_free", 10),
+				newSeries("servers.s2.disk.bytes_free", 30),
+			}
+		case "servers.s1.disk.bytes_free":
+			series = []*ts.Series{newSeries("servers.s1.disk.bytes_free", 10)}
+		case "servers.s2.disk.bytes_free":
+			series = []*ts.Series{newSeries("servers.s2.disk.bytes_free", 30)}
+		case "servers.s1.disk.bytes_*":
+			series = []*ts.Series{
+				newSeries("servers.s1.disk.bytes_free", 10),
+				newSeries("servers.s1.disk.bytes_used", 90),
+			}
+		case "servers.s2.disk.bytes_*":
+			series = []*ts.Series{
+				newSeries("servers.s2.disk.bytes_free", 30),
+				newSeries("servers.s2.disk.bytes_used", 30),
+			}
+		default:
+			return nil, fmt.Errorf("unexpected query: %s", query)
+		}
+		return storage.NewFetchResult(ctx, series, block.NewResultMetadata()), nil
+	}}
+
+	r, err := expr.Execute(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, r.Len())
+	assert.Equal(t, "servers.s1.disk.pct_free", r.Values[0].Name())
+	assert.Equal(t, []float64{0.1, 0.1, 0.1}, r.Values[0].SafeValues())
+	assert.Equal(t, "servers.s2.disk.pct_free", r.Values[1].Name())
+	assert.Equal(t, []float64{0.5, 0.5, 0.5}, r.Values[1].SafeValues())
+}
+
 func TestDivideSeries(t *testing.T) {
 	ctx, consolidationTestSeries := newConsolidationTestSeries()
 	defer ctx.Close()
@@ -32,6 +32,7 @@ import (
 
 	"github.com/m3db/m3/src/query/graphite/common"
 	"github.com/m3db/m3/src/query/graphite/errors"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -92,6 +93,31 @@ func sortByMaxima(ctx *common.Context, series singlePathSpec) (ts.SeriesList, er
 	return highestMax(ctx, series, len(series.Values))
 }
 
+// sortBy sorts timeseries results by the value of the named aggregation function,
+// e.g. "average", "sum", "max" or "last", applied to each series. Series are
+// sorted in ascending order unless reverse is set.
+func sortBy(_ *common.Context, series singlePathSpec, fname string, reverse bool) (ts.SeriesList, error) {
+	fn, err := getAggregationFunc(fname)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+
+	dir := ts.Ascending
+	if reverse {
+		dir = ts.Descending
+	}
+
+	sorted, err := ts.SortSeries(series.Values, aggregationReducer(fn), dir)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+
+	r := ts.SeriesList(series)
+	r.Values = sorted
+	r.SortApplied = true
+	return r, nil
+}
+
 type valueComparator func(v, threshold float64) bool
 
 func compareByFunction(
@@ -261,6 +287,53 @@ func scale(ctx *common.Context, input singlePathSpec, scale float64) (ts.SeriesL
 	)
 }
 
+// pow raises each element of a collection of time series to the given power
+func pow(ctx *common.Context, input singlePathSpec, factor float64) (ts.SeriesList, error) {
+	return transform(
+		ctx,
+		input,
+		func(fname string) string {
+			newName := fmt.Sprintf("%s,"+common.FloatingPointFormat, fname, factor)
+			return fmt.Sprintf(wrappingFmt, "pow", newName)
+		},
+		common.MaintainNaNTransformer(func(v float64) float64 {
+			return math.Pow(v, factor)
+		}),
+	)
+}
+
+// powSeries takes a list of series and returns a new series containing the
+// first series raised to the power of each subsequent series at each datapoint.
+// A datapoint is null if any of the input values is null.
+func powSeries(ctx *common.Context, input multiplePathSpecs) (ts.SeriesList, error) {
+	if len(input.Values) == 0 {
+		return ts.SeriesList(input), nil
+	}
+
+	normalized, start, _, millisPerStep, err := common.Normalize(ctx, ts.SeriesList(input))
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+
+	numSteps := normalized.Values[0].Len()
+	vals := ts.NewValues(ctx, millisPerStep, numSteps)
+	for i := 0; i < numSteps; i++ {
+		result := normalized.Values[0].ValueAt(i)
+		for _, series := range normalized.Values[1:] {
+			result = math.Pow(result, series.ValueAt(i))
+		}
+		if !math.IsNaN(result) && !math.IsInf(result, 0) {
+			vals.SetValueAt(i, result)
+		}
+	}
+
+	name := wrapPathExpr("powSeries", ts.SeriesList(input))
+	return ts.SeriesList{
+		Values:   []*ts.Series{ts.NewSeries(ctx, name, start, vals)},
+		Metadata: input.Metadata,
+	}, nil
+}
+
 // scaleToSeconds makes a wildcard seriesList and returns "value per seconds"
 func scaleToSeconds(
 	ctx *common.Context,
@@ -299,6 +372,28 @@ func offset(ctx *common.Context, input singlePathSpec, factor float64) (ts.Serie
 	)
 }
 
+// delay shifts all values in a series later by the given number of steps,
+// filling the start of the series with nulls. A negative number of steps
+// shifts values earlier instead.
+func delay(ctx *common.Context, input singlePathSpec, steps int) (ts.SeriesList, error) {
+	output := make([]*ts.Series, 0, len(input.Values))
+	for _, series := range input.Values {
+		numSteps := series.Len()
+		vals := ts.NewValues(ctx, series.MillisPerStep(), numSteps)
+		for i := 0; i < numSteps; i++ {
+			if j := i - steps; j >= 0 && j < numSteps {
+				vals.SetValueAt(i, series.ValueAt(j))
+			}
+		}
+		name := fmt.Sprintf("delay(%s,%d)", series.Name(), steps)
+		output = append(output, ts.NewSeries(ctx, name, series.StartTime(), vals))
+	}
+
+	r := ts.SeriesList(input)
+	r.Values = output
+	return r, nil
+}
+
 // transform converts values in a timeseries according to the valueTransformer.
 func transform(ctx *common.Context, input singlePathSpec,
 	fname func(inputName string) string, fn common.TransformFunc) (ts.SeriesList, error) {
@@ -386,6 +481,40 @@ func keepLastValue(ctx *common.Context, input singlePathSpec, limit int) (ts.Ser
 	return r, nil
 }
 
+// interpolate takes one metric or a wildcard seriesList, and optionally a limit to the number of
+// consecutive NaN values to fill. NaN values which lie between two non-NaN values are replaced
+// by a linear interpolation of those values. If not specified, limit has a default value of -1,
+// meaning gaps of any length will be filled.
+func interpolate(ctx *common.Context, input singlePathSpec, limit int) (ts.SeriesList, error) {
+	output := make([]*ts.Series, 0, len(input.Values))
+	for _, series := range input.Values {
+		numSteps := series.Len()
+		vals := ts.NewValues(ctx, series.MillisPerStep(), numSteps)
+		last := -1
+		for i := 0; i < numSteps; i++ {
+			value := series.ValueAt(i)
+			if math.IsNaN(value) {
+				continue
+			}
+			vals.SetValueAt(i, value)
+			if gap := i - last - 1; last >= 0 && gap > 0 && (limit == -1 || gap <= limit) {
+				prev := series.ValueAt(last)
+				delta := (value - prev) / float64(i-last)
+				for index := last + 1; index < i; index++ {
+					vals.SetValueAt(index, prev+delta*float64(index-last))
+				}
+			}
+			last = i
+		}
+		name := fmt.Sprintf("interpolate(%s)", series.Name())
+		output = append(output, ts.NewSeries(ctx, name, series.StartTime(), vals))
+	}
+
+	r := ts.SeriesList(input)
+	r.Values = output
+	return r, nil
+}
+
 type comparator func(float64, float64) bool
 
 // lessOrEqualFunc checks whether x is less than or equal to y
@@ -517,6 +646,17 @@ func highestAverage(_ *common.Context, input singlePathSpec, n int) (ts.SeriesLi
 	return takeByFunction(input, n, sr, ts.Descending)
 }
 
+// highest takes one metric or a wildcard seriesList followed by an integer
+// n and an aggregation function name.  Out of all metrics passed, draws only
+// the N metrics with the highest aggregated value in the time period specified.
+func highest(_ *common.Context, input singlePathSpec, n int, fname string) (ts.SeriesList, error) {
+	fn, err := getAggregationFunc(fname)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+	return takeByFunction(input, n, aggregationReducer(fn), ts.Descending)
+}
+
 // fallbackSeries takes one metric or a wildcard seriesList, and a second fallback metric.
 // If the wildcard does not match any series, draws the fallback metric.
 func fallbackSeries(_ *common.Context, input singlePathSpec, fallback singlePathSpec) (ts.SeriesList, error) {
@@ -552,15 +692,81 @@ func lowestCurrent(_ *common.Context, input singlePathSpec, n int) (ts.SeriesLis
 	return takeByFunction(input, n, sr, ts.Ascending)
 }
 
+// lowest takes one metric or a wildcard seriesList followed by an integer
+// n and an aggregation function name.  Out of all metrics passed, draws only
+// the N metrics with the lowest aggregated value in the time period specified.
+func lowest(_ *common.Context, input singlePathSpec, n int, fname string) (ts.SeriesList, error) {
+	fn, err := getAggregationFunc(fname)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+	return takeByFunction(input, n, aggregationReducer(fn), ts.Ascending)
+}
+
 // windowSizeFunc calculates window size for moving average calculation
 type windowSizeFunc func(stepSize int) int
 
 // movingAverage calculates the moving average of a metric (or metrics) over a time interval.
-func movingAverage(ctx *common.Context, input singlePathSpec, windowSizeValue genericInterface) (*binaryContextShifter, error) {
+func movingAverage(
+	ctx *common.Context,
+	input singlePathSpec,
+	windowSizeValue genericInterface,
+	xFilesFactor float64,
+) (*binaryContextShifter, error) {
+	return movingWindow(ctx, input, windowSizeValue, "average", xFilesFactor)
+}
+
+// movingSum calculates the moving sum of a metric (or metrics) over a time interval.
+func movingSum(
+	ctx *common.Context,
+	input singlePathSpec,
+	windowSizeValue genericInterface,
+	xFilesFactor float64,
+) (*binaryContextShifter, error) {
+	return movingWindow(ctx, input, windowSizeValue, "sum", xFilesFactor)
+}
+
+// movingMin calculates the moving minimum of a metric (or metrics) over a time interval.
+func movingMin(
+	ctx *common.Context,
+	input singlePathSpec,
+	windowSizeValue genericInterface,
+	xFilesFactor float64,
+) (*binaryContextShifter, error) {
+	return movingWindow(ctx, input, windowSizeValue, "min", xFilesFactor)
+}
+
+// movingMax calculates the moving maximum of a metric (or metrics) over a time interval.
+func movingMax(
+	ctx *common.Context,
+	input singlePathSpec,
+	windowSizeValue genericInterface,
+	xFilesFactor float64,
+) (*binaryContextShifter, error) {
+	return movingWindow(ctx, input, windowSizeValue, "max", xFilesFactor)
+}
+
+// movingWindow applies the named aggregation function to the datapoints preceding
+// each point of a metric (or metrics), over a window given either as a number of
+// points or as a time interval. The window is bootstrapped by fetching data from
+// before the start of the query. A point is only produced when the ratio of
+// non-null values in its window is at least xFilesFactor.
+func movingWindow(
+	ctx *common.Context,
+	input singlePathSpec,
+	windowSizeValue genericInterface,
+	fname string,
+	xFilesFactor float64,
+) (*binaryContextShifter, error) {
 	if len(input.Values) == 0 {
 		return nil, nil
 	}
 
+	fn, err := getAggregationFunc(fname)
+	if err != nil {
+		return nil, err
+	}
+
 	var delta time.Duration
 	var wf windowSizeFunc
 	var ws string
@@ -609,6 +815,16 @@ func movingAverage(ctx *common.Context, input singlePathSpec, windowSizeValue ge
 		return childCtx
 	}
 
+	var incremental, average bool
+	switch fname {
+	case "sum", "total":
+		incremental = true
+	case "average", "avg":
+		incremental, average = true, true
+	}
+
+	// NB: names follow graphite-web, e.g. "sum" yields movingSum(...).
+	prefix := "moving" + strings.ToUpper(fname[:1]) + fname[1:]
 	bootstrapStartTime, bootstrapEndTime := ctx.StartTime.Add(-delta), ctx.StartTime
 	transformerFn := func(bootstrapped, original ts.SeriesList) (ts.SeriesList, error) {
 		bootstrapList, err := combineBootstrapWithOriginal(ctx,
@@ -633,39 +849,53 @@ func movingAverage(ctx *common.Context, input singlePathSpec, windowSizeValue ge
 			numSteps := series.Len()
 			offset := bootstrap.Len() - numSteps
 			vals := ts.NewValues(ctx, series.MillisPerStep(), numSteps)
-			sum := 0.0
-			num := 0
-			for i := 0; i < numSteps; i++ {
-				// skip if the number of points received is less than the number of points
-				// in the lookback window.
-				if offset < windowPoints {
-					continue
-				}
-				if i == 0 {
-					for j := offset - windowPoints; j < offset; j++ {
-						v := bootstrap.ValueAt(j)
-						if !math.IsNaN(v) {
-							sum += v
+			// skip if the number of points received is less than the number of points
+			// in the lookback window.
+			if offset >= windowPoints && incremental {
+				// NB: sums and averages are maintained incrementally as the window
+				// slides rather than re-aggregating the whole window at each step.
+				sum := 0.0
+				num := 0
+				for i := 0; i < numSteps; i++ {
+					if i == 0 {
+						for j := offset - windowPoints; j < offset; j++ {
+							v := bootstrap.ValueAt(j)
+							if !math.IsNaN(v) {
+								sum += v
+								num++
+							}
+						}
+					} else {
+						prev := bootstrap.ValueAt(i + offset - windowPoints - 1)
+						next := bootstrap.ValueAt(i + offset - 1)
+						if !math.IsNaN(prev) {
+							sum -= prev
+							num--
+						}
+						if !math.IsNaN(next) {
+							sum += next
 							num++
 						}
 					}
-				} else {
-					prev := bootstrap.ValueAt(i + offset - windowPoints - 1)
-					next := bootstrap.ValueAt(i + offset - 1)
-					if !math.IsNaN(prev) {
-						sum -= prev
-						num--
+					if num == 0 || float64(num)/float64(windowPoints) < xFilesFactor {
+						continue
 					}
-					if !math.IsNaN(next) {
-						sum += next
-						num++
+					if average {
+						vals.SetValueAt(i, sum/float64(num))
+					} else {
+						vals.SetValueAt(i, sum)
 					}
 				}
-				if num > 0 {
-					vals.SetValueAt(i, sum/float64(num))
+			} else if offset >= windowPoints {
+				window := make([]float64, windowPoints)
+				for i := 0; i < numSteps; i++ {
+					for j := range window {
+						window[j] = bootstrap.ValueAt(i + offset - windowPoints + j)
+					}
+					vals.SetValueAt(i, safeAggregate(fn, window, xFilesFactor))
 				}
 			}
-			name := fmt.Sprintf("movingAverage(%s,%s)", series.Name(), ws)
+			name := fmt.Sprintf("%s(%s,%s)", prefix, series.Name(), ws)
 			newSeries := ts.NewSeries(ctx, name, series.StartTime(), vals)
 			results = append(results, newSeries)
 		}
@@ -902,6 +1132,48 @@ func integral(ctx *common.Context, input singlePathSpec) (ts.SeriesList, error)
 	return r, nil
 }
 
+// integralByInterval shows the sum over time, restarting from zero at the
+// start of every interval of the given length, measured from the start of the query.
+func integralByInterval(ctx *common.Context, input singlePathSpec, intervalString string) (ts.SeriesList, error) {
+	interval, err := common.ParseInterval(intervalString)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+	if interval <= 0 {
+		err := errors.NewInvalidParamsError(fmt.Errorf(
+			"interval must be positive but instead is %v", interval))
+		return ts.NewSeriesList(), err
+	}
+
+	results := make([]*ts.Series, 0, len(input.Values))
+	for _, series := range input.Values {
+		var (
+			outvals = ts.NewValues(ctx, series.MillisPerStep(), series.Len())
+			current float64
+			bucket  = series.StartTime().Sub(ctx.StartTime) / interval
+		)
+		for i := 0; i < series.Len(); i++ {
+			// reset the integral whenever we cross an interval boundary
+			if b := series.StartTimeForStep(i).Sub(ctx.StartTime) / interval; b != bucket {
+				bucket = b
+				current = 0
+			}
+			n := series.ValueAt(i)
+			if !math.IsNaN(n) {
+				current += n
+				outvals.SetValueAt(i, current)
+			}
+		}
+
+		newName := fmt.Sprintf("integralByInterval(%s,%q)", series.Name(), intervalString)
+		results = append(results, ts.NewSeries(ctx, newName, series.StartTime(), outvals))
+	}
+
+	r := ts.SeriesList(input)
+	r.Values = results
+	return r, nil
+}
+
 // This is the opposite of the integral function.  This is useful for taking a
 // running total metric and calculating the delta between subsequent data
 // points.
@@ -1771,6 +2043,277 @@ func offsetToZero(ctx *common.Context, seriesList singlePathSpec) (ts.SeriesList
 	return r, nil
 }
 
+// minMax applies min-max normalization to each series, scaling its values
+// to the range [0, 1].
+func minMax(ctx *common.Context, seriesList singlePathSpec) (ts.SeriesList, error) {
+	results := make([]*ts.Series, len(seriesList.Values))
+	for idx, series := range seriesList.Values {
+		var (
+			stats    = series.CalcStatistics()
+			numSteps = series.Len()
+			vals     = ts.NewValues(ctx, series.MillisPerStep(), numSteps)
+		)
+		for i := 0; i < numSteps; i++ {
+			v := series.ValueAt(i)
+			if math.IsNaN(v) {
+				continue
+			}
+			if stats.Max == stats.Min {
+				vals.SetValueAt(i, 0)
+			} else {
+				vals.SetValueAt(i, (v-stats.Min)/(stats.Max-stats.Min))
+			}
+		}
+		name := fmt.Sprintf("minMax(%s)", series.Name())
+		results[idx] = ts.NewSeries(ctx, name, series.StartTime(), vals)
+	}
+
+	r := ts.SeriesList(seriesList)
+	r.Values = results
+	return r, nil
+}
+
+// linearRegression graphs the linear regression function by the least squares
+// method. The regression is computed over the series itself unless a source
+// time range is given with startSourceAt and endSourceAt, in which case the
+// regression is computed over that range and projected over the query range.
+func linearRegression(
+	ctx *common.Context,
+	_ singlePathSpec,
+	startSourceAt string,
+	endSourceAt string,
+) (*binaryContextShifter, error) {
+	var (
+		now         = time.Now()
+		sourceStart = ctx.StartTime
+		sourceEnd   = ctx.EndTime
+		err         error
+	)
+	if startSourceAt != "" {
+		sourceStart, err = graphite.ParseTime(startSourceAt, now, 0)
+		if err != nil {
+			return nil, errors.NewInvalidParamsError(err)
+		}
+	}
+	if endSourceAt != "" {
+		sourceEnd, err = graphite.ParseTime(endSourceAt, now, 0)
+		if err != nil {
+			return nil, errors.NewInvalidParamsError(err)
+		}
+	}
+	if !sourceStart.Before(sourceEnd) {
+		err := errors.NewInvalidParamsError(fmt.Errorf(
+			"startSourceAt must be before endSourceAt, start=%v, end=%v",
+			sourceStart, sourceEnd))
+		return nil, err
+	}
+
+	contextShiftingFn := func(c *common.Context) *common.Context {
+		opts := common.NewChildContextOptions()
+		opts.AdjustTimeRange(sourceStart.Sub(c.StartTime), sourceEnd.Sub(c.EndTime), 0, 0)
+		childCtx := c.NewChildContext(opts)
+		return childCtx
+	}
+
+	transformerFn := func(source, original ts.SeriesList) (ts.SeriesList, error) {
+		nameToSource := make(map[string]*ts.Series, source.Len())
+		for _, series := range source.Values {
+			nameToSource[series.Name()] = series
+		}
+
+		results := make([]*ts.Series, 0, original.Len())
+		for _, series := range original.Values {
+			src, found := nameToSource[series.Name()]
+			if !found {
+				continue
+			}
+			factor, offset, ok := linearRegressionAnalysis(src)
+			if !ok {
+				continue
+			}
+
+			vals := ts.NewValues(ctx, series.MillisPerStep(), series.Len())
+			for i := 0; i < series.Len(); i++ {
+				t := float64(series.StartTimeForStep(i).UnixNano()) / float64(time.Second)
+				vals.SetValueAt(i, offset+factor*t)
+			}
+			name := fmt.Sprintf("linearRegression(%s, %d, %d)",
+				series.Name(), sourceStart.Unix(), sourceEnd.Unix())
+			results = append(results, ts.NewSeries(ctx, name, series.StartTime(), vals))
+		}
+
+		original.Values = results
+		return original, nil
+	}
+
+	return &binaryContextShifter{
+		ContextShiftFunc:  contextShiftingFn,
+		BinaryTransformer: transformerFn,
+	}, nil
+}
+
+// linearRegressionAnalysis returns the factor and offset of the least squares
+// line through the non-NaN values of a series, as a function of time in seconds.
+func linearRegressionAnalysis(series *ts.Series) (float64, float64, bool) {
+	var n, sumI, sumV, sumII, sumIV float64
+	for i := 0; i < series.Len(); i++ {
+		v := series.ValueAt(i)
+		if math.IsNaN(v) {
+			continue
+		}
+		n++
+		sumI += float64(i)
+		sumV += v
+		sumII += float64(i * i)
+		sumIV += float64(i) * v
+	}
+
+	denominator := n*sumII - sumI*sumI
+	if denominator == 0 {
+		return 0, 0, false
+	}
+
+	stepSecs := float64(series.MillisPerStep()) / millisPerSecond
+	startSecs := float64(series.StartTime().UnixNano()) / float64(time.Second)
+	factor := (n*sumIV - sumI*sumV) / denominator / stepSecs
+	offset := (sumII*sumV-sumIV*sumI)/denominator - factor*startSecs
+	return factor, offset, true
+}
+
+// timeSlice takes one metric or a wildcard metric, followed by a quoted string with the
+// time to start the line and another quoted string with the time to end the line.
+// The values outside of the given time range are set to None.
+func timeSlice(ctx *common.Context, seriesList singlePathSpec, startSliceAt, endSliceAt string) (ts.SeriesList, error) {
+	now := time.Now()
+	start, err := graphite.ParseTime(startSliceAt, now, 0)
+	if err != nil {
+		return ts.NewSeriesList(), errors.NewInvalidParamsError(err)
+	}
+	end, err := graphite.ParseTime(endSliceAt, now, 0)
+	if err != nil {
+		return ts.NewSeriesList(), errors.NewInvalidParamsError(err)
+	}
+
+	results := make([]*ts.Series, len(seriesList.Values))
+	for idx, series := range seriesList.Values {
+		vals := ts.NewValues(ctx, series.MillisPerStep(), series.Len())
+		for i := 0; i < series.Len(); i++ {
+			t := series.StartTimeForStep(i)
+			if !t.Before(start) && !t.After(end) {
+				vals.SetValueAt(i, series.ValueAt(i))
+			}
+		}
+		name := fmt.Sprintf("timeSlice(%s, %d, %d)", series.Name(), start.Unix(), end.Unix())
+		results[idx] = ts.NewSeries(ctx, name, series.StartTime(), vals)
+	}
+
+	r := ts.SeriesList(seriesList)
+	r.Values = results
+	return r, nil
+}
+
+// timeStack takes one metric or a wildcard seriesList, followed by a quoted string with
+// the length of time, and draws the selected metrics shifted back in time by that length
+// once for each multiple between timeShiftStart and timeShiftEnd, stacked on top of the
+// current time range.
+func timeStack(
+	ctx *common.Context,
+	_ singlePathSpec,
+	timeShiftUnit string,
+	timeShiftStart int,
+	timeShiftEnd int,
+) (*unaryContextShifter, error) {
+	unit, err := common.ParseInterval(timeShiftUnit)
+	if err != nil {
+		return nil, errors.NewInvalidParamsError(fmt.Errorf("invalid timeShiftUnit %s: %v", timeShiftUnit, err))
+	}
+	if unit < 0 {
+		unit = -unit
+	}
+	if unit == 0 || timeShiftStart < 0 || timeShiftStart > timeShiftEnd {
+		err := errors.NewInvalidParamsError(fmt.Errorf(
+			"invalid timeStack parameters, timeShiftUnit=%s, timeShiftStart=%d, timeShiftEnd=%d",
+			timeShiftUnit, timeShiftStart, timeShiftEnd))
+		return nil, err
+	}
+
+	contextShiftingFn := func(c *common.Context) *common.Context {
+		opts := common.NewChildContextOptions()
+		opts.AdjustTimeRange(0, 0, time.Duration(timeShiftEnd)*unit, 0)
+		childCtx := c.NewChildContext(opts)
+		return childCtx
+	}
+
+	start, end := ctx.StartTime, ctx.EndTime
+	transformerFn := func(input ts.SeriesList) (ts.SeriesList, error) {
+		output := make([]*ts.Series, 0, input.Len()*(timeShiftEnd-timeShiftStart+1))
+		for _, in := range input.Values {
+			var (
+				millisPerStep = in.MillisPerStep()
+				step          = time.Duration(millisPerStep) * time.Millisecond
+				numSteps      = ts.NumSteps(start, end, millisPerStep)
+			)
+			for shift := timeShiftStart; shift <= timeShiftEnd; shift++ {
+				shiftedStart := start.Add(-time.Duration(shift) * unit)
+				vals := ts.NewValues(ctx, millisPerStep, numSteps)
+				for i := 0; i < numSteps; i++ {
+					if t := shiftedStart.Add(time.Duration(i) * step); in.Contains(t) {
+						vals.SetValueAt(i, in.ValueAtTime(t))
+					}
+				}
+				name := fmt.Sprintf("timeShift(%s, -%s, %d)", in.Name(), timeShiftUnit, shift)
+				output = append(output, in.DerivedSeries(start, vals).RenamedTo(name))
+			}
+		}
+		input.Values = output
+		return input, nil
+	}
+
+	return &unaryContextShifter{
+		ContextShiftFunc: contextShiftingFn,
+		UnaryTransformer: transformerFn,
+	}, nil
+}
+
+// useSeriesAbove compares the maximum of each series against the given value. If the
+// series maximum is greater than value, the regular expression search and replace is
+// applied against the series name to plot a related metric.
+func useSeriesAbove(
+	ctx *common.Context,
+	seriesList singlePathSpec,
+	value float64,
+	search string,
+	replace string,
+) (ts.SeriesList, error) {
+	re, err := regexp.Compile(search)
+	if err != nil {
+		return ts.NewSeriesList(), errors.NewInvalidParamsError(err)
+	}
+
+	var (
+		results = make([]*ts.Series, 0, len(seriesList.Values))
+		meta    = seriesList.Metadata
+	)
+	for _, series := range seriesList.Values {
+		if !(series.SafeMax() > value) {
+			continue
+		}
+
+		related, err := newFetchExpression(re.ReplaceAllString(series.Name(), replace)).Execute(ctx)
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+
+		meta = meta.CombineMetadata(related.Metadata)
+		results = append(results, related.Values...)
+	}
+
+	r := ts.SeriesList(seriesList)
+	r.Values = results
+	r.Metadata = meta
+	return r, nil
+}
+
 // timeFunction returns the timestamp for each X value.
 // Note: step is measured in seconds.
 func timeFunction(ctx *common.Context, name string, step int) (ts.SeriesList, error) {
@@ -1829,13 +2372,20 @@ func threshold(ctx *common.Context, value float64, label string, color string) (
 func init() {
 	// functions - in alpha ordering
 	MustRegisterFunction(absolute)
+	MustRegisterFunction(aggregate).WithDefaultParams(map[uint8]interface{}{
+		3: 0.0, // xFilesFactor
+	})
 	MustRegisterFunction(aggregateLine).WithDefaultParams(map[uint8]interface{}{
 		2: "avg", // f
 	})
+	MustRegisterFunction(aggregateWithWildcards)
 	MustRegisterFunction(alias)
 	MustRegisterFunction(aliasByMetric)
 	MustRegisterFunction(aliasByNode)
 	MustRegisterFunction(aliasSub)
+	MustRegisterFunction(applyByNode).WithDefaultParams(map[uint8]interface{}{
+		4: "", // newName
+	})
 	MustRegisterFunction(asPercent).WithDefaultParams(map[uint8]interface{}{
 		2: []*ts.Series(nil), // total
 	})
@@ -1852,6 +2402,7 @@ func init() {
 	MustRegisterFunction(dashed).WithDefaultParams(map[uint8]interface{}{
 		2: 5.0, // dashLength
 	})
+	MustRegisterFunction(delay)
 	MustRegisterFunction(derivative)
 	MustRegisterFunction(diffSeries)
 	MustRegisterFunction(divideSeries)
@@ -1859,6 +2410,10 @@ func init() {
 	MustRegisterFunction(fallbackSeries)
 	MustRegisterFunction(group)
 	MustRegisterFunction(groupByNode)
+	MustRegisterFunction(highest).WithDefaultParams(map[uint8]interface{}{
+		2: 1,         // n
+		3: "average", // func
+	})
 	MustRegisterFunction(highestAverage)
 	MustRegisterFunction(highestCurrent)
 	MustRegisterFunction(highestMax)
@@ -1868,24 +2423,52 @@ func init() {
 	MustRegisterFunction(holtWintersForecast)
 	MustRegisterFunction(identity)
 	MustRegisterFunction(integral)
+	MustRegisterFunction(integralByInterval)
+	MustRegisterFunction(interpolate).WithDefaultParams(map[uint8]interface{}{
+		2: -1, // limit
+	})
 	MustRegisterFunction(isNonNull)
 	MustRegisterFunction(keepLastValue).WithDefaultParams(map[uint8]interface{}{
 		2: -1, // limit
 	})
 	MustRegisterFunction(legendValue)
 	MustRegisterFunction(limit)
+	MustRegisterFunction(linearRegression).WithDefaultParams(map[uint8]interface{}{
+		2: "", // startSourceAt
+		3: "", // endSourceAt
+	})
 	MustRegisterFunction(logarithm).WithDefaultParams(map[uint8]interface{}{
 		2: 10, // base
 	})
+	MustRegisterFunction(lowest).WithDefaultParams(map[uint8]interface{}{
+		2: 1,         // n
+		3: "average", // func
+	})
 	MustRegisterFunction(lowestAverage)
 	MustRegisterFunction(lowestCurrent)
 	MustRegisterFunction(maxSeries)
 	MustRegisterFunction(maximumAbove)
+	MustRegisterFunction(minMax)
 	MustRegisterFunction(minSeries)
 	MustRegisterFunction(minimumAbove)
 	MustRegisterFunction(mostDeviant)
-	MustRegisterFunction(movingAverage)
+	MustRegisterFunction(movingAverage).WithDefaultParams(map[uint8]interface{}{
+		3: 0.0, // xFilesFactor
+	})
+	MustRegisterFunction(movingMax).WithDefaultParams(map[uint8]interface{}{
+		3: 0.0, // xFilesFactor
+	})
 	MustRegisterFunction(movingMedian)
+	MustRegisterFunction(movingMin).WithDefaultParams(map[uint8]interface{}{
+		3: 0.0, // xFilesFactor
+	})
+	MustRegisterFunction(movingSum).WithDefaultParams(map[uint8]interface{}{
+		3: 0.0, // xFilesFactor
+	})
+	MustRegisterFunction(movingWindow).WithDefaultParams(map[uint8]interface{}{
+		3: "average", // func
+		4: 0.0,       // xFilesFactor
+	})
 	MustRegisterFunction(multiplySeries)
 	MustRegisterFunction(nonNegativeDerivative).WithDefaultParams(map[uint8]interface{}{
 		2: math.NaN(), // maxValue
@@ -1899,6 +2482,8 @@ func init() {
 	MustRegisterFunction(perSecond).WithDefaultParams(map[uint8]interface{}{
 		2: math.NaN(), // maxValue
 	})
+	MustRegisterFunction(pow)
+	MustRegisterFunction(powSeries)
 	MustRegisterFunction(rangeOfSeries)
 	MustRegisterFunction(randomWalkFunction).WithDefaultParams(map[uint8]interface{}{
 		2: 60, // step
@@ -1910,6 +2495,10 @@ func init() {
 	MustRegisterFunction(removeEmptySeries)
 	MustRegisterFunction(scale)
 	MustRegisterFunction(scaleToSeconds)
+	MustRegisterFunction(sortBy).WithDefaultParams(map[uint8]interface{}{
+		2: "average", // func
+		3: false,     // reverse
+	})
 	MustRegisterFunction(sortByMaxima)
 	MustRegisterFunction(sortByName)
 	MustRegisterFunction(sortByTotal)
@@ -1939,9 +2528,18 @@ func init() {
 	MustRegisterFunction(timeShift).WithDefaultParams(map[uint8]interface{}{
 		3: true, // resetEnd
 	})
+	MustRegisterFunction(timeSlice).WithDefaultParams(map[uint8]interface{}{
+		3: "now", // endSliceAt
+	})
+	MustRegisterFunction(timeStack).WithDefaultParams(map[uint8]interface{}{
+		2: "1d", // timeShiftUnit
+		3: 0,    // timeShiftStart
+		4: 7,    // timeShiftEnd
+	})
 	MustRegisterFunction(transformNull).WithDefaultParams(map[uint8]interface{}{
 		2: 0.0, // defaultValue
 	})
+	MustRegisterFunction(useSeriesAbove)
 	MustRegisterFunction(weightedAverage)
 
 	// alias functions - in alpha ordering
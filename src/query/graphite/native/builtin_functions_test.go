@@ -184,6 +184,31 @@ func TestSortByTotal(t *testing.T) {
 	testSortingFuncs(t, sortByTotal, []int{4, 0, 2, 3, 1})
 }
 
+func TestSortBy(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	start := time.Now()
+	step := 100
+	input := singlePathSpec{
+		Values: generateSeriesList(ctx, start, testSmallInput, step),
+	}
+
+	r, err := sortBy(ctx, input, "max", false)
+	require.NoError(t, err)
+	assert.True(t, r.SortApplied)
+	common.CompareOutputsAndExpected(t, step, start,
+		[]common.TestSeries{testSmallInput[1], testSmallInput[0]}, r.Values)
+
+	r, err = sortBy(ctx, input, "max", true)
+	require.NoError(t, err)
+	common.CompareOutputsAndExpected(t, step, start,
+		[]common.TestSeries{testSmallInput[0], testSmallInput[1]}, r.Values)
+
+	_, err = sortBy(ctx, input, "foo", false)
+	require.Error(t, err)
+}
+
 func TestSortByMaxima(t *testing.T) {
 	testSortingFuncs(t, sortByMaxima, []int{4, 0, 3, 2, 1})
 }
@@ -652,6 +677,24 @@ func TestMovingAverageSuccess(t *testing.T) {
 	testMovingAverage(t, "movingAverage(foo.bar.baz, 3)", "movingAverage(foo.bar.baz,3)", values, bootstrapEntireSeries, expected)
 }
 
+func TestMovingWindowFamily(t *testing.T) {
+	values := []float64{12.0, 19.0, -10.0, math.NaN(), 10.0}
+	bootstrap := []float64{3.0, 4.0, 5.0}
+
+	testMovingAverage(t, "movingSum(foo.bar.baz, 3)", "movingSum(foo.bar.baz,3)",
+		values, bootstrap, []float64{12.0, 21.0, 36.0, 21.0, 9.0})
+	testMovingAverage(t, "movingMin(foo.bar.baz, '30s')", "movingMin(foo.bar.baz,\"30s\")",
+		values, bootstrap, []float64{3.0, 4.0, 5.0, -10.0, -10.0})
+	testMovingAverage(t, "movingMax(foo.bar.baz, 3)", "movingMax(foo.bar.baz,3)",
+		values, bootstrap, []float64{5.0, 12.0, 19.0, 19.0, 19.0})
+	testMovingAverage(t, "movingWindow(foo.bar.baz, '30s', 'median')", "movingMedian(foo.bar.baz,\"30s\")",
+		values, bootstrap, []float64{4.0, 5.0, 12.0, 12.0, 4.5})
+	testMovingAverage(t, "movingWindow(foo.bar.baz, 3)", "movingAverage(foo.bar.baz,3)",
+		values, bootstrap, []float64{4.0, 7.0, 12.0, 7.0, 4.5})
+	testMovingAverage(t, "movingAverage(foo.bar.baz, 3, 0.9)", "movingAverage(foo.bar.baz,3)",
+		values, bootstrap, []float64{4.0, 7.0, 12.0, 7.0, math.NaN()})
+}
+
 func testMovingAverageError(t *testing.T, target string) {
 	ctx := common.NewTestContext()
 	defer ctx.Close()
@@ -680,6 +723,79 @@ func testMovingAverageError(t *testing.T, target string) {
 func TestMovingAverageError(t *testing.T) {
 	testMovingAverageError(t, "movingAverage(foo.bar.baz, '-30s')")
 	testMovingAverageError(t, "movingAverage(foo.bar.baz, 0)")
+	testMovingAverageError(t, "movingWindow(foo.bar.baz, 3, 'foo')")
+}
+
+func TestDelay(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	nan := math.NaN()
+	tests := []struct {
+		steps  int
+		output []float64
+	}{
+		{0, []float64{1, 2, 3, 4, 5}},
+		{2, []float64{nan, nan, 1, 2, 3}},
+		{-1, []float64{2, 3, 4, 5, nan}},
+		{10, []float64{nan, nan, nan, nan, nan}},
+	}
+
+	start := time.Now()
+	step := 100
+	for _, test := range tests {
+		input := []common.TestSeries{{"foo", []float64{1, 2, 3, 4, 5}}}
+		expected := []common.TestSeries{{fmt.Sprintf("delay(foo,%d)", test.steps), test.output}}
+		timeSeries := generateSeriesList(ctx, start, input, step)
+		output, err := delay(ctx, singlePathSpec{
+			Values: timeSeries,
+		}, test.steps)
+		require.NoError(t, err)
+		common.CompareOutputsAndExpected(t, step, start,
+			expected, output.Values)
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	nan := math.NaN()
+	tests := []struct {
+		inputs []float64
+		limit  int
+		output []float64
+	}{
+		{
+			[]float64{1, nan, 3, nan, nan, 6, nan},
+			-1,
+			[]float64{1, 2, 3, 4, 5, 6, nan},
+		},
+		{
+			[]float64{1, nan, 3, nan, nan, 6, nan},
+			1,
+			[]float64{1, 2, 3, nan, nan, 6, nan},
+		},
+		{
+			[]float64{nan, nan, 2, nan, 4},
+			-1,
+			[]float64{nan, nan, 2, 3, 4},
+		},
+	}
+
+	start := time.Now()
+	step := 100
+	for _, test := range tests {
+		input := []common.TestSeries{{"foo", test.inputs}}
+		expected := []common.TestSeries{{"interpolate(foo)", test.output}}
+		timeSeries := generateSeriesList(ctx, start, input, step)
+		output, err := interpolate(ctx, singlePathSpec{
+			Values: timeSeries,
+		}, test.limit)
+		require.NoError(t, err)
+		common.CompareOutputsAndExpected(t, step, start,
+			expected, output.Values)
+	}
 }
 
 func TestIsNonNull(t *testing.T) {
@@ -1087,6 +1203,41 @@ func TestHighestMax(t *testing.T) {
 	testRanking(t, ctx, tests, highestMax)
 }
 
+func TestHighestAndLowest(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	start := time.Now()
+	step := 100
+	tests := []struct {
+		f       func(*common.Context, singlePathSpec, int, string) (ts.SeriesList, error)
+		inputs  []common.TestSeries
+		n       int
+		fname   string
+		outputs []common.TestSeries
+	}{
+		{highest, testInput, 1, "max", []common.TestSeries{testInput[4]}},
+		{highest, testInput, 2, "max", []common.TestSeries{testInput[4], testInput[0]}},
+		{highest, testSmallInput, 1, "average", []common.TestSeries{testSmallInput[1]}},
+		{lowest, testSmallInput, 1, "average", []common.TestSeries{testSmallInput[0]}},
+		{lowest, testSmallInput, 2, "last", []common.TestSeries{testSmallInput[1], testSmallInput[0]}},
+	}
+
+	for _, test := range tests {
+		outputs, err := test.f(ctx, singlePathSpec{
+			Values: generateSeriesList(ctx, start, test.inputs, step),
+		}, test.n, test.fname)
+		require.NoError(t, err)
+		common.CompareOutputsAndExpected(t, step, start,
+			test.outputs, outputs.Values)
+	}
+
+	_, err := highest(ctx, singlePathSpec{
+		Values: generateSeriesList(ctx, start, testInput, step),
+	}, 1, "foo")
+	require.Error(t, err)
+}
+
 func TestFallbackSeries(t *testing.T) {
 	ctx := common.NewTestContext()
 	defer ctx.Close()
@@ -1399,6 +1550,39 @@ func generateSeriesList(ctx *common.Context, start time.Time, inputs []common.Te
 	return tSeriesList
 }
 
+func TestPow(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	start := time.Now()
+	step := 100
+	input := []common.TestSeries{{"foo", []float64{1, 2, math.NaN(), 3}}}
+	expected := []common.TestSeries{{"pow(foo,2.000)", []float64{1, 4, math.NaN(), 9}}}
+	output, err := pow(ctx, singlePathSpec{
+		Values: generateSeriesList(ctx, start, input, step),
+	}, 2)
+	require.NoError(t, err)
+	common.CompareOutputsAndExpected(t, step, start, expected, output.Values)
+}
+
+func TestPowSeries(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	start := time.Now()
+	step := 100
+	input := []common.TestSeries{
+		{"foo", []float64{2, 3, math.NaN(), 4}},
+		{"bar", []float64{3, 2, 1, 0.5}},
+	}
+	expected := []common.TestSeries{{"powSeries()", []float64{8, 9, math.NaN(), 2}}}
+	output, err := powSeries(ctx, multiplePathSpecs{
+		Values: generateSeriesList(ctx, start, input, step),
+	})
+	require.NoError(t, err)
+	common.CompareOutputsAndExpected(t, step, start, expected, output.Values)
+}
+
 func TestScaleToSeconds(t *testing.T) {
 	ctx := common.NewTestContext()
 	defer ctx.Close()
@@ -1696,6 +1880,24 @@ func TestIntegral(t *testing.T) {
 	}
 }
 
+func TestIntegralByInterval(t *testing.T) {
+	start := time.Now().Truncate(time.Minute)
+	ctx := common.NewContext(common.ContextOptions{Start: start, End: start.Add(time.Minute)})
+	defer ctx.Close()
+
+	step := 10000
+	input := []common.TestSeries{{"foo", []float64{1, 2, 3, 4, 5, math.NaN()}}}
+	expected := []common.TestSeries{{"integralByInterval(foo,\"20s\")", []float64{1, 3, 3, 7, 5, math.NaN()}}}
+	output, err := integralByInterval(ctx, singlePathSpec{
+		Values: generateSeriesList(ctx, start, input, step),
+	}, "20s")
+	require.NoError(t, err)
+	common.CompareOutputsAndExpected(t, step, start, expected, output.Values)
+
+	_, err = integralByInterval(ctx, singlePathSpec{}, "0s")
+	require.Error(t, err)
+}
+
 func TestDerivative(t *testing.T) {
 	ctx := common.NewTestContext()
 	defer ctx.Close()
@@ -2662,6 +2864,138 @@ func TestOffsetToZero(t *testing.T) {
 	}
 }
 
+func TestMinMax(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	start := time.Now()
+	step := 100
+	input := []common.TestSeries{
+		{"foo", []float64{1, 3, 5, math.NaN()}},
+		{"bar", []float64{2, 2}},
+	}
+	expected := []common.TestSeries{
+		{"minMax(foo)", []float64{0, 0.5, 1, math.NaN()}},
+		{"minMax(bar)", []float64{0, 0}},
+	}
+	output, err := minMax(ctx, singlePathSpec{
+		Values: generateSeriesList(ctx, start, input, step),
+	})
+	require.NoError(t, err)
+	common.CompareOutputsAndExpected(t, step, start, expected, output.Values)
+}
+
+func TestLinearRegression(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	engine := NewEngine(
+		&common.MovingAverageStorage{
+			StepMillis: 10000,
+			Values:     []float64{1.0, math.NaN(), 3.0, 4.0, math.NaN()},
+		},
+	)
+	phonyContext := common.NewContext(common.ContextOptions{
+		Start:  testMovingAverageStart,
+		End:    testMovingAverageEnd,
+		Engine: engine,
+	})
+
+	expr, err := phonyContext.Engine.(*Engine).Compile("linearRegression(foo.bar.baz)")
+	require.NoError(t, err)
+	res, err := expr.Execute(phonyContext)
+	require.NoError(t, err)
+	expected := common.TestSeries{
+		Name: fmt.Sprintf("linearRegression(foo.bar.baz, %d, %d)",
+			testMovingAverageStart.Unix(), testMovingAverageEnd.Unix()),
+		Data: []float64{1.0, 2.0, 3.0, 4.0, 5.0},
+	}
+	common.CompareOutputsAndExpected(t, 10000, testMovingAverageStart,
+		[]common.TestSeries{expected}, res.Values)
+}
+
+func TestTimeSlice(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	start := time.Now().Truncate(time.Minute)
+	step := 10000
+	input := []common.TestSeries{{"foo", []float64{1, 2, 3, 4, 5, 6}}}
+	sliceStart, sliceEnd := start.Add(10*time.Second).Unix(), start.Add(30*time.Second).Unix()
+	expected := []common.TestSeries{{
+		fmt.Sprintf("timeSlice(foo, %d, %d)", sliceStart, sliceEnd),
+		[]float64{math.NaN(), 2, 3, 4, math.NaN(), math.NaN()},
+	}}
+	output, err := timeSlice(ctx, singlePathSpec{
+		Values: generateSeriesList(ctx, start, input, step),
+	}, fmt.Sprintf("%d", sliceStart), fmt.Sprintf("%d", sliceEnd))
+	require.NoError(t, err)
+	common.CompareOutputsAndExpected(t, step, start, expected, output.Values)
+}
+
+func TestTimeStack(t *testing.T) {
+	start := time.Now().Truncate(time.Minute)
+	ctx := common.NewContext(common.ContextOptions{Start: start, End: start.Add(30 * time.Second)})
+	defer ctx.Close()
+
+	ctx.Engine = mockEngine{fn: func(
+		ctx xctx.Context,
+		query string,
+		options storage.FetchOptions,
+	) (*storage.FetchResult, error) {
+		require.Equal(t, start.Add(-time.Minute), options.StartTime)
+		series := ts.NewSeries(ctx, query, options.StartTime,
+			common.NewTestSeriesValues(ctx, 10000, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}))
+		return storage.NewFetchResult(ctx, []*ts.Series{series}, block.NewResultMetadata()), nil
+	}}
+
+	expr, err := compile("timeStack(foo, '1min', 0, 1)")
+	require.NoError(t, err)
+	res, err := expr.Execute(ctx)
+	require.NoError(t, err)
+	expected := []common.TestSeries{
+		{"timeShift(foo, -1min, 0)", []float64{7, 8, 9}},
+		{"timeShift(foo, -1min, 1)", []float64{1, 2, 3}},
+	}
+	common.CompareOutputsAndExpected(t, 10000, start, expected, res.Values)
+}
+
+func TestUseSeriesAbove(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	ctx.Engine = mockEngine{fn: func(
+		ctx xctx.Context,
+		query string,
+		options storage.FetchOptions,
+	) (*storage.FetchResult, error) {
+		start := options.StartTime
+		var series []*ts.Series
+		switch query {
+		case "foo.*.requests":
+			series = []*ts.Series{
+				ts.NewSeries(ctx, "foo.a.requests", start, ts.NewConstantValues(ctx, 5, 3, 10000)),
+				ts.NewSeries(ctx, "foo.b.requests", start, ts.NewConstantValues(ctx, 20, 3, 10000)),
+			}
+		case "foo.b.errors":
+			series = []*ts.Series{
+				ts.NewSeries(ctx, "foo.b.errors", start, ts.NewConstantValues(ctx, 1, 3, 10000)),
+			}
+		default:
+			return nil, fmt.Errorf("unexpected query: %s", query)
+		}
+		return storage.NewFetchResult(ctx, series, block.NewResultMetadata()), nil
+	}}
+
+	expr, err := compile(`useSeriesAbove(foo.*.requests, 10, "requests", "errors")`)
+	require.NoError(t, err)
+	res, err := expr.Execute(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Len())
+	assert.Equal(t, "foo.b.errors", res.Values[0].Name())
+	assert.Equal(t, []float64{1, 1, 1}, res.Values[0].SafeValues())
+}
+
 func TestTimeFunction(t *testing.T) {
 	ctx := common.NewTestContext()
 	now := time.Now()
@@ -2779,11 +3113,14 @@ func TestFunctionsRegistered(t *testing.T) {
 	fnames := []string{
 		"abs",
 		"absolute",
+		"aggregate",
 		"aggregateLine",
+		"aggregateWithWildcards",
 		"alias",
 		"aliasByMetric",
 		"aliasByNode",
 		"aliasSub",
+		"applyByNode",
 		"asPercent",
 		"averageAbove",
 		"averageSeries",
@@ -2797,6 +3134,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"currentAbove",
 		"currentBelow",
 		"dashed",
+		"delay",
 		"derivative",
 		"diffSeries",
 		"divideSeries",
@@ -2804,6 +3142,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"fallbackSeries",
 		"group",
 		"groupByNode",
+		"highest",
 		"highestAverage",
 		"highestCurrent",
 		"highestMax",
@@ -2813,29 +3152,40 @@ func TestFunctionsRegistered(t *testing.T) {
 		"holtWintersForecast",
 		"identity",
 		"integral",
+		"integralByInterval",
+		"interpolate",
 		"isNonNull",
 		"keepLastValue",
 		"legendValue",
 		"limit",
+		"linearRegression",
 		"log",
 		"logarithm",
+		"lowest",
 		"lowestAverage",
 		"lowestCurrent",
 		"max",
 		"maxSeries",
 		"maximumAbove",
 		"min",
+		"minMax",
 		"minSeries",
 		"minimumAbove",
 		"mostDeviant",
 		"movingAverage",
+		"movingMax",
 		"movingMedian",
+		"movingMin",
+		"movingSum",
+		"movingWindow",
 		"multiplySeries",
 		"nonNegativeDerivative",
 		"nPercentile",
 		"offset",
 		"offsetToZero",
 		"perSecond",
+		"pow",
+		"powSeries",
 		"randomWalk",
 		"randomWalkFunction",
 		"rangeOfSeries",
@@ -2846,6 +3196,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"removeEmptySeries",
 		"scale",
 		"scaleToSeconds",
+		"sortBy",
 		"sortByMaxima",
 		"sortByName",
 		"sortByTotal",
@@ -2859,7 +3210,10 @@ func TestFunctionsRegistered(t *testing.T) {
 		"time",
 		"timeFunction",
 		"timeShift",
+		"timeSlice",
+		"timeStack",
 		"transformNull",
+		"useSeriesAbove",
 		"weightedAverage",
 	}
 
@@ -105,7 +105,10 @@ func (f *fetchExpression) Execute(ctx *common.Context) (ts.SeriesList, error) {
 		ctx.Trace(common.Trace{
 			ActivityName: fmt.Sprintf("fetch %s", f.pathArg.path),
 			Duration:     time.Since(begin),
-			Outputs:      common.TraceStats{NumSeries: len(result.SeriesList)},
+			Outputs: common.TraceStats{
+				NumSeries:     len(result.SeriesList),
+				NumDatapoints: numDatapoints(result.SeriesList),
+			},
 		})
 	}
 
@@ -572,9 +572,23 @@ func isTimeSeries(v reflect.Value) bool {
 // getStats gets trace stats for the given timeseries argument
 func getStats(v reflect.Value) common.TraceStats {
 	if v.Type() == timeSeriesType {
-		return common.TraceStats{NumSeries: 1}
+		s := v.Interface().(*ts.Series)
+		return common.TraceStats{NumSeries: 1, NumDatapoints: s.Len()}
 	}
 
 	l := v.Interface().(ts.SeriesList)
-	return common.TraceStats{NumSeries: l.Len()}
+	return common.TraceStats{
+		NumSeries:     l.Len(),
+		NumDatapoints: numDatapoints(l.Values),
+	}
+}
+
+// numDatapoints returns the number of datapoints across the given timeseries
+func numDatapoints(series []*ts.Series) int {
+	n := 0
+	for _, s := range series {
+		n += s.Len()
+	}
+
+	return n
 }
@@ -29,6 +29,7 @@ import (
 
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/explain"
 	xctx "github.com/m3db/m3/src/query/graphite/context"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
@@ -217,6 +218,13 @@ func (s *m3WrappedStore) FetchByQuery(
 	}
 
 	m3result, err := s.m3.Fetch(m3ctx, m3query, fetchOptions)
+	if explanation, ok := explain.FromContext(m3ctx); ok {
+		queryCost, queryLimit := perQueryEnforcer.State()
+		explanation.AddCost(queryCost.Cost, queryLimit)
+		globalCost, globalLimit := s.enforcer.State()
+		explanation.SetGlobalCost(globalCost.Cost, globalLimit)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,106 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metadata
+
+import (
+	"errors"
+	"time"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultKey           = "m3query.metric.metadata"
+	defaultNumShards     = 16
+	defaultTTL           = 24 * time.Hour
+	defaultFlushInterval = time.Minute
+)
+
+var (
+	errNoClusterClient = errors.New(
+		"persisting metric metadata requires a cluster management client")
+	errInvalidNumShards = errors.New("metric metadata shards must be positive")
+)
+
+// Configuration is the metric metadata configuration.
+type Configuration struct {
+	// Persist stores the metadata in the cluster KV store so that it is
+	// shared between coordinators and survives restarts.
+	Persist bool `yaml:"persist"`
+
+	// Key is the prefix of the KV keys the metadata is persisted under.
+	Key string `yaml:"key"`
+
+	// Shards is the number of KV keys the metadata is spread across, so
+	// that no single key grows with the number of metric families.
+	Shards *int `yaml:"shards"`
+
+	// TTL is how long metadata is kept after it was last written.
+	TTL *time.Duration `yaml:"ttl"`
+
+	// FlushInterval is how often the metadata is persisted and expired.
+	FlushInterval *time.Duration `yaml:"flushInterval"`
+}
+
+// NewStore returns a new metric metadata store, the cluster client is only
+// required if the metadata is persisted.
+func (c Configuration) NewStore(
+	clusterClient clusterclient.Client,
+	instrumentOpts instrument.Options,
+) (Store, error) {
+	var newKVStoreFn func() (kv.Store, error)
+	if c.Persist {
+		if clusterClient == nil {
+			return nil, errNoClusterClient
+		}
+
+		newKVStoreFn = clusterClient.KV
+	}
+
+	key := defaultKey
+	if c.Key != "" {
+		key = c.Key
+	}
+
+	numShards := defaultNumShards
+	if c.Shards != nil {
+		numShards = *c.Shards
+	}
+
+	if numShards <= 0 {
+		return nil, errInvalidNumShards
+	}
+
+	ttl := defaultTTL
+	if c.TTL != nil {
+		ttl = *c.TTL
+	}
+
+	flushInterval := defaultFlushInterval
+	if c.FlushInterval != nil {
+		flushInterval = *c.FlushInterval
+	}
+
+	return newStore(newKVStoreFn, key, numShards, ttl, flushInterval,
+		time.Now, instrumentOpts), nil
+}
@@ -0,0 +1,402 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/spaolacci/murmur3"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+type entry struct {
+	metadata Metadata
+	lastSeen time.Time
+}
+
+// persistedEntry is the compact form of an entry stored in KV.
+type persistedEntry struct {
+	Type     MetricType `json:"t"`
+	Help     string     `json:"h,omitempty"`
+	Unit     string     `json:"u,omitempty"`
+	LastSeen int64      `json:"s"`
+}
+
+type storeMetrics struct {
+	written      tally.Counter
+	flushSuccess tally.Counter
+	flushErrors  tally.Counter
+	entries      tally.Gauge
+}
+
+func newStoreMetrics(scope tally.Scope) storeMetrics {
+	return storeMetrics{
+		written:      scope.Counter("written"),
+		flushSuccess: scope.Counter("flush-success"),
+		flushErrors:  scope.Counter("flush-errors"),
+		entries:      scope.Gauge("entries"),
+	}
+}
+
+type store struct {
+	sync.RWMutex
+
+	entries       map[string]entry
+	newKVStoreFn  func() (kv.Store, error)
+	kvStore       kv.Store
+	key           string
+	numShards     int
+	ttl           time.Duration
+	flushInterval time.Duration
+	nowFn         func() time.Time
+	logger        *zap.Logger
+	metrics       storeMetrics
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+// newStore returns a metadata store which expires metric families not
+// written for longer than ttl, persisting them to KV across numShards keys
+// prefixed by the given key if newKVStoreFn is non-nil. The KV store is
+// resolved lazily since the cluster client may not yet be available when the
+// store is created.
+func newStore(
+	newKVStoreFn func() (kv.Store, error),
+	key string,
+	numShards int,
+	ttl time.Duration,
+	flushInterval time.Duration,
+	nowFn func() time.Time,
+	instrumentOpts instrument.Options,
+) *store {
+	scope := instrumentOpts.MetricsScope().SubScope("metric-metadata")
+	return &store{
+		entries:       make(map[string]entry),
+		newKVStoreFn:  newKVStoreFn,
+		key:           key,
+		numShards:     numShards,
+		ttl:           ttl,
+		flushInterval: flushInterval,
+		nowFn:         nowFn,
+		logger:        instrumentOpts.Logger(),
+		metrics:       newStoreMetrics(scope),
+		closeCh:       make(chan struct{}),
+	}
+}
+
+func (s *store) Write(metadata []Metadata) {
+	now := s.nowFn()
+	s.Lock()
+	for _, m := range metadata {
+		if m.MetricFamilyName == "" {
+			continue
+		}
+
+		s.entries[m.MetricFamilyName] = entry{metadata: m, lastSeen: now}
+	}
+	s.Unlock()
+	s.metrics.written.Inc(int64(len(metadata)))
+}
+
+func (s *store) Get(metric string) (Metadata, bool) {
+	now := s.nowFn()
+	s.RLock()
+	e, ok := s.entries[metric]
+	s.RUnlock()
+	if !ok || s.expired(e, now) {
+		return Metadata{}, false
+	}
+
+	return e.metadata, true
+}
+
+func (s *store) Query(metric string, limit int) map[string][]Metadata {
+	now := s.nowFn()
+	s.RLock()
+	names := make([]string, 0, len(s.entries))
+	for name, e := range s.entries {
+		if metric != "" && name != metric {
+			continue
+		}
+
+		if !s.expired(e, now) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	if limit > 0 && len(names) > limit {
+		names = names[:limit]
+	}
+
+	result := make(map[string][]Metadata, len(names))
+	for _, name := range names {
+		result[name] = []Metadata{s.entries[name].metadata}
+	}
+
+	s.RUnlock()
+	return result
+}
+
+func (s *store) Open() error {
+	if s.newKVStoreFn != nil {
+		// Load persisted metadata up front so that it is served straight away.
+		if err := s.flush(); err != nil {
+			s.logger.Warn("could not load persisted metric metadata", zap.Error(err))
+		}
+	}
+
+	s.wg.Add(1)
+	go s.flushLoop()
+	return nil
+}
+
+func (s *store) Close() error {
+	close(s.closeCh)
+	s.wg.Wait()
+	if s.newKVStoreFn == nil {
+		return nil
+	}
+
+	return s.flush()
+}
+
+func (s *store) expired(e entry, now time.Time) bool {
+	return now.Sub(e.lastSeen) > s.ttl
+}
+
+func (s *store) flushLoop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.closeCh:
+			return
+		case <-ticker.C:
+		}
+
+		if s.newKVStoreFn == nil {
+			s.expire()
+			continue
+		}
+
+		if err := s.flush(); err != nil {
+			s.metrics.flushErrors.Inc(1)
+			s.logger.Warn("could not persist metric metadata", zap.Error(err))
+			continue
+		}
+
+		s.metrics.flushSuccess.Inc(1)
+	}
+}
+
+func (s *store) expire() {
+	now := s.nowFn()
+	s.Lock()
+	for name, e := range s.entries {
+		if s.expired(e, now) {
+			delete(s.entries, name)
+		}
+	}
+
+	s.metrics.entries.Update(float64(len(s.entries)))
+	s.Unlock()
+}
+
+// flush merges the metadata persisted by other coordinators into the store
+// and writes back every shard in which anything has been added, changed,
+// expired or has been seen again a sizeable fraction of the TTL since it was
+// last persisted.
+func (s *store) flush() error {
+	if s.kvStore == nil {
+		kvStore, err := s.newKVStoreFn()
+		if err != nil {
+			return err
+		}
+
+		s.kvStore = kvStore
+	}
+
+	var (
+		remote   = make(map[string]entry)
+		versions = make([]int, s.numShards)
+	)
+	for shard := range versions {
+		entries, version, err := s.load(shard)
+		if err != nil {
+			return err
+		}
+
+		for name, e := range entries {
+			remote[name] = e
+		}
+
+		versions[shard] = version
+	}
+
+	now := s.nowFn()
+	// Refreshing the last seen time of every entry on each flush would
+	// rewrite the keys constantly, so tolerate some staleness.
+	var (
+		refreshAfter = s.ttl / 4
+		needsWrite   = make([]bool, s.numShards)
+		persisted    = make([]map[string]persistedEntry, s.numShards)
+	)
+	for shard := range persisted {
+		persisted[shard] = make(map[string]persistedEntry)
+	}
+
+	s.Lock()
+	for name, r := range remote {
+		if s.expired(r, now) {
+			needsWrite[s.shardFor(name)] = true
+			continue
+		}
+
+		if e, ok := s.entries[name]; !ok || r.lastSeen.After(e.lastSeen) {
+			s.entries[name] = r
+		}
+	}
+
+	for name, e := range s.entries {
+		if s.expired(e, now) {
+			delete(s.entries, name)
+			continue
+		}
+
+		shard := s.shardFor(name)
+		r, ok := remote[name]
+		if !ok || r.metadata != e.metadata || e.lastSeen.Sub(r.lastSeen) >= refreshAfter {
+			needsWrite[shard] = true
+		}
+
+		persisted[shard][name] = persistedEntry{
+			Type:     e.metadata.Type,
+			Help:     e.metadata.Help,
+			Unit:     e.metadata.Unit,
+			LastSeen: e.lastSeen.Unix(),
+		}
+	}
+
+	s.metrics.entries.Update(float64(len(s.entries)))
+	s.Unlock()
+
+	multiErr := xerrors.NewMultiError()
+	for shard, write := range needsWrite {
+		if !write {
+			continue
+		}
+
+		// Losing a race with another coordinator is retried on the next
+		// flush, after merging in what it wrote.
+		if err := s.persist(shard, versions[shard], persisted[shard]); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+// shardKey returns the KV key the metadata of the given shard is persisted
+// under.
+func (s *store) shardKey(shard int) string {
+	return fmt.Sprintf("%s/%d", s.key, shard)
+}
+
+// shardFor returns the shard the metadata of a metric family is persisted in.
+func (s *store) shardFor(name string) int {
+	return int(murmur3.Sum32([]byte(name)) % uint32(s.numShards))
+}
+
+// persist writes the entries of a shard to KV, the version is that of the
+// key when it was loaded, zero if it has not yet been set.
+func (s *store) persist(
+	shard int,
+	version int,
+	persisted map[string]persistedEntry,
+) error {
+	data, err := json.Marshal(persisted)
+	if err != nil {
+		return err
+	}
+
+	key := s.shardKey(shard)
+	value := &commonpb.StringProto{Value: string(data)}
+	if version == 0 {
+		_, err = s.kvStore.SetIfNotExists(key, value)
+	} else {
+		_, err = s.kvStore.CheckAndSet(key, version, value)
+	}
+
+	return err
+}
+
+// load returns the persisted entries of a shard and the version of the key
+// holding them, zero if it has not yet been set.
+func (s *store) load(shard int) (map[string]entry, int, error) {
+	value, err := s.kvStore.Get(s.shardKey(shard))
+	if err == kv.ErrNotFound {
+		return nil, 0, nil
+	}
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var proto commonpb.StringProto
+	if err := value.Unmarshal(&proto); err != nil {
+		return nil, 0, err
+	}
+
+	var persisted map[string]persistedEntry
+	if err := json.Unmarshal([]byte(proto.Value), &persisted); err != nil {
+		return nil, 0, err
+	}
+
+	entries := make(map[string]entry, len(persisted))
+	for name, p := range persisted {
+		entries[name] = entry{
+			metadata: Metadata{
+				MetricFamilyName: name,
+				Type:             p.Type,
+				Help:             p.Help,
+				Unit:             p.Unit,
+			},
+			lastSeen: time.Unix(p.LastSeen, 0),
+		}
+	}
+
+	return entries, value.Version(), nil
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metadata
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNumShards = 4
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func newTestStore(kvStore kv.Store, clock *testClock) *store {
+	var newKVStoreFn func() (kv.Store, error)
+	if kvStore != nil {
+		newKVStoreFn = func() (kv.Store, error) { return kvStore, nil }
+	}
+
+	return newStore(newKVStoreFn, defaultKey, testNumShards, time.Hour,
+		time.Hour, clock.Now, instrument.NewOptions())
+}
+
+func TestFromPromMetadata(t *testing.T) {
+	m := FromPromMetadata(prompb.MetricMetadata{
+		Type:             prompb.MetricMetadata_GAUGEHISTOGRAM,
+		MetricFamilyName: "foo",
+		Help:             "help",
+		Unit:             "seconds",
+	})
+	assert.Equal(t, Metadata{
+		MetricFamilyName: "foo",
+		Type:             GaugeHistogramType,
+		Help:             "help",
+		Unit:             "seconds",
+	}, m)
+
+	m = FromPromMetadata(prompb.MetricMetadata{Type: 42})
+	assert.Equal(t, UnknownType, m.Type)
+}
+
+func TestStoreQuery(t *testing.T) {
+	clock := &testClock{now: time.Unix(1000, 0)}
+	s := newTestStore(nil, clock)
+
+	s.Write([]Metadata{
+		{MetricFamilyName: "c", Type: CounterType},
+		{MetricFamilyName: "a", Type: GaugeType, Help: "a gauge"},
+		{MetricFamilyName: "b", Type: SummaryType},
+		{Type: CounterType},
+	})
+
+	assert.Len(t, s.Query("", 0), 3)
+	assert.Equal(t, map[string][]Metadata{
+		"a": {{MetricFamilyName: "a", Type: GaugeType, Help: "a gauge"}},
+		"b": {{MetricFamilyName: "b", Type: SummaryType}},
+	}, s.Query("", 2))
+	assert.Equal(t, map[string][]Metadata{
+		"c": {{MetricFamilyName: "c", Type: CounterType}},
+	}, s.Query("c", 0))
+	assert.Empty(t, s.Query("d", 0))
+
+	m, ok := s.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, GaugeType, m.Type)
+
+	clock.now = clock.now.Add(30 * time.Minute)
+	s.Write([]Metadata{{MetricFamilyName: "b", Type: SummaryType}})
+
+	clock.now = clock.now.Add(45 * time.Minute)
+	_, ok = s.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, map[string][]Metadata{
+		"b": {{MetricFamilyName: "b", Type: SummaryType}},
+	}, s.Query("", 0))
+
+	s.expire()
+	assert.Len(t, s.entries, 1)
+}
+
+func TestStorePersist(t *testing.T) {
+	kvStore := mem.NewStore()
+	clock := &testClock{now: time.Unix(1000, 0)}
+
+	first := newTestStore(kvStore, clock)
+	first.Write([]Metadata{{MetricFamilyName: "a", Type: CounterType}})
+	require.NoError(t, first.flush())
+
+	keyA := first.shardKey(first.shardFor("a"))
+	value, err := kvStore.Get(keyA)
+	require.NoError(t, err)
+	version := value.Version()
+
+	// Nothing has changed so the key is not rewritten.
+	require.NoError(t, first.flush())
+	value, err = kvStore.Get(keyA)
+	require.NoError(t, err)
+	assert.Equal(t, version, value.Version())
+
+	clock.now = clock.now.Add(time.Minute)
+	second := newTestStore(kvStore, clock)
+	second.Write([]Metadata{{MetricFamilyName: "b", Type: GaugeType}})
+	require.NoError(t, second.flush())
+
+	_, ok := second.Get("a")
+	assert.True(t, ok)
+
+	require.NoError(t, first.flush())
+	assert.Len(t, first.Query("", 0), 2)
+
+	// A restarted coordinator loads the persisted metadata on open.
+	third := newTestStore(kvStore, clock)
+	require.NoError(t, third.Open())
+	assert.Len(t, third.Query("", 0), 2)
+	require.NoError(t, third.Close())
+
+	// Expired metadata is removed from KV.
+	clock.now = clock.now.Add(90 * time.Minute)
+	second.Write([]Metadata{{MetricFamilyName: "b", Type: GaugeType}})
+	require.NoError(t, second.flush())
+
+	value, err = kvStore.Get(keyA)
+	require.NoError(t, err)
+	assert.True(t, value.Version() > version)
+
+	var entries []string
+	for shard := 0; shard < testNumShards; shard++ {
+		shardEntries, _, err := second.load(shard)
+		require.NoError(t, err)
+		for name := range shardEntries {
+			assert.Equal(t, shard, second.shardFor(name))
+			entries = append(entries, name)
+		}
+	}
+	assert.Equal(t, []string{"b"}, entries)
+}
+
+func TestStorePersistSharded(t *testing.T) {
+	kvStore := mem.NewStore()
+	clock := &testClock{now: time.Unix(1000, 0)}
+
+	s := newTestStore(kvStore, clock)
+	var metadata []Metadata
+	for i := 0; i < 100; i++ {
+		metadata = append(metadata, Metadata{
+			MetricFamilyName: fmt.Sprintf("metric_%d", i),
+			Type:             CounterType,
+		})
+	}
+	s.Write(metadata)
+	require.NoError(t, s.flush())
+
+	total := 0
+	for shard := 0; shard < testNumShards; shard++ {
+		entries, version, err := s.load(shard)
+		require.NoError(t, err)
+		assert.Equal(t, 1, version)
+		assert.NotEmpty(t, entries)
+		total += len(entries)
+	}
+	assert.Equal(t, 100, total)
+
+	// Only the shard holding a changed metric family is rewritten.
+	clock.now = clock.now.Add(time.Minute)
+	s.Write([]Metadata{{MetricFamilyName: "metric_0", Type: GaugeType}})
+	require.NoError(t, s.flush())
+
+	changed := s.shardFor("metric_0")
+	for shard := 0; shard < testNumShards; shard++ {
+		_, version, err := s.load(shard)
+		require.NoError(t, err)
+		if shard == changed {
+			assert.Equal(t, 2, version)
+		} else {
+			assert.Equal(t, 1, version)
+		}
+	}
+}
+
+func TestStoreFlushMergesPersisted(t *testing.T) {
+	kvStore := mem.NewStore()
+	clock := &testClock{now: time.Unix(1000, 0)}
+
+	s := newTestStore(kvStore, clock)
+	for _, name := range []string{"a", "b"} {
+		_, err := kvStore.Set(s.shardKey(s.shardFor(name)), &commonpb.StringProto{
+			Value: fmt.Sprintf(`{%q:{"t":"gauge","s":900}}`, name),
+		})
+		require.NoError(t, err)
+	}
+
+	s.Write([]Metadata{{MetricFamilyName: "a", Type: CounterType}})
+	require.NoError(t, s.flush())
+
+	entries, version, err := s.load(s.shardFor("a"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+	assert.Equal(t, CounterType, entries["a"].metadata.Type)
+
+	entries, version, err = s.load(s.shardFor("b"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+	assert.Equal(t, GaugeType, entries["b"].metadata.Type)
+
+	m, ok := s.Get("b")
+	require.True(t, ok)
+	assert.Equal(t, GaugeType, m.Type)
+}
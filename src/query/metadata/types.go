@@ -0,0 +1,94 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package metadata stores the metric metadata sent alongside Prometheus
+// remote writes.
+package metadata
+
+import (
+	"strings"
+
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+)
+
+// MetricType is the type of a metric family.
+type MetricType string
+
+const (
+	// UnknownType is a metric of unknown type.
+	UnknownType MetricType = "unknown"
+	// CounterType is a monotonically increasing metric.
+	CounterType MetricType = "counter"
+	// GaugeType is a metric which can arbitrarily go up and down.
+	GaugeType MetricType = "gauge"
+	// HistogramType is a metric of bucketed observations.
+	HistogramType MetricType = "histogram"
+	// GaugeHistogramType is a histogram whose buckets can go up and down.
+	GaugeHistogramType MetricType = "gaugehistogram"
+	// SummaryType is a metric of observation quantiles.
+	SummaryType MetricType = "summary"
+	// InfoType is a metric exposing textual information.
+	InfoType MetricType = "info"
+	// StateSetType is a metric exposing a set of states.
+	StateSetType MetricType = "stateset"
+)
+
+// Metadata is the metadata of a metric family.
+type Metadata struct {
+	MetricFamilyName string     `json:"-"`
+	Type             MetricType `json:"type"`
+	Help             string     `json:"help"`
+	Unit             string     `json:"unit"`
+}
+
+// FromPromMetadata converts Prometheus remote write metadata.
+func FromPromMetadata(m prompb.MetricMetadata) Metadata {
+	metricType := MetricType(strings.ToLower(m.Type.String()))
+	if _, ok := prompb.MetricMetadata_MetricType_value[m.Type.String()]; !ok {
+		metricType = UnknownType
+	}
+
+	return Metadata{
+		MetricFamilyName: m.MetricFamilyName,
+		Type:             metricType,
+		Help:             m.Help,
+		Unit:             m.Unit,
+	}
+}
+
+// Store stores metric metadata.
+type Store interface {
+	// Write records the given metadata as seen now.
+	Write(metadata []Metadata)
+
+	// Get returns the metadata of the given metric family.
+	Get(metric string) (Metadata, bool)
+
+	// Query returns metadata keyed by metric family name, restricted to the
+	// given metric family if non-empty and to at most limit families if
+	// limit is positive.
+	Query(metric string, limit int) map[string][]Metadata
+
+	// Open starts persisting the metadata if configured to do so.
+	Open() error
+
+	// Close stops persisting the metadata.
+	Close() error
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"sort"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	pql "github.com/prometheus/prometheus/promql"
+)
+
+// counterFunctions are the functions which are only meaningful when applied
+// to counters.
+var counterFunctions = map[string]struct{}{
+	"rate":     {},
+	"irate":    {},
+	"increase": {},
+}
+
+// CounterFunctionMetrics returns the sorted names of the metrics that a
+// function only meaningful for counters, such as rate, is applied to in the
+// given query.
+func CounterFunctionMetrics(query string) ([]string, error) {
+	expr, err := pql.ParseExpr(query)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]struct{})
+	addCounterFunctionMetrics(expr, names)
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+func addCounterFunctionMetrics(expr pql.Expr, names map[string]struct{}) {
+	switch n := expr.(type) {
+	case *pql.AggregateExpr:
+		addCounterFunctionMetrics(n.Expr, names)
+
+	case *pql.BinaryExpr:
+		addCounterFunctionMetrics(n.LHS, names)
+		addCounterFunctionMetrics(n.RHS, names)
+
+	case *pql.ParenExpr:
+		addCounterFunctionMetrics(n.Expr, names)
+
+	case *pql.UnaryExpr:
+		addCounterFunctionMetrics(n.Expr, names)
+
+	case *pql.Call:
+		_, isCounterFunction := counterFunctions[n.Func.Name]
+		for _, arg := range n.Args {
+			selector, ok := arg.(*pql.MatrixSelector)
+			if !ok || !isCounterFunction {
+				addCounterFunctionMetrics(arg, names)
+				continue
+			}
+
+			if name := matrixSelectorName(selector); name != "" {
+				names[name] = struct{}{}
+			}
+		}
+	}
+}
+
+func matrixSelectorName(selector *pql.MatrixSelector) string {
+	if selector.Name != "" {
+		return selector.Name
+	}
+
+	for _, matcher := range selector.LabelMatchers {
+		if matcher.Name == labels.MetricName && matcher.Type == labels.MatchEqual {
+			return matcher.Value
+		}
+	}
+
+	return ""
+}
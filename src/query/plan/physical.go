@@ -158,6 +158,18 @@ func (p PhysicalPlan) Step(ID parser.NodeID) (LogicalStep, bool) {
 	return step, ok
 }
 
+// Transforms returns the steps of the physical plan in pipeline order.
+func (p PhysicalPlan) Transforms() []LogicalStep {
+	steps := make([]LogicalStep, 0, len(p.pipeline))
+	for _, transformID := range p.pipeline {
+		if step, ok := p.steps[transformID]; ok {
+			steps = append(steps, step)
+		}
+	}
+
+	return steps
+}
+
 // String representation of the physical plan.
 func (p PhysicalPlan) String() string {
 	return fmt.Sprintf("StepCount: %s, Pipeline: %s, Result: %s, TimeSpec: %v",
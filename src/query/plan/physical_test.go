@@ -67,6 +67,11 @@ func TestResultNode(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, node.ID(), countTransform.ID)
 	assert.Equal(t, p.ResultStep.Parent, countTransform.ID)
+
+	steps := p.Transforms()
+	require.Equal(t, 2, len(steps))
+	assert.Equal(t, fetchTransform.ID, steps[0].ID())
+	assert.Equal(t, countTransform.ID, steps[1].ID())
 }
 
 func TestShiftTime(t *testing.T) {
@@ -0,0 +1,190 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plan
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/temporal"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+)
+
+var pushdownTemporalFunctions = map[string]seriesagg.TemporalFunction{
+	temporal.RateType:     seriesagg.Rate,
+	temporal.IncreaseType: seriesagg.Increase,
+	temporal.DeltaType:    seriesagg.Delta,
+	temporal.AvgType:      seriesagg.AvgOverTime,
+	temporal.CountType:    seriesagg.CountOverTime,
+	temporal.MinType:      seriesagg.MinOverTime,
+	temporal.MaxType:      seriesagg.MaxOverTime,
+	temporal.SumType:      seriesagg.SumOverTime,
+}
+
+var pushdownAggregations = map[string]seriesagg.Aggregation{
+	aggregation.SumType:   seriesagg.Sum,
+	aggregation.MinType:   seriesagg.Min,
+	aggregation.MaxType:   seriesagg.Max,
+	aggregation.CountType: seriesagg.Count,
+}
+
+// AggregateSeriesCapabilitiesFn returns the capabilities of the storage to
+// evaluate an aggregation over the series matching a fetch query.
+type AggregateSeriesCapabilitiesFn func(
+	query *storage.FetchQuery,
+) storage.AggregateSeriesCapabilities
+
+type temporalOp interface {
+	Duration() time.Duration
+}
+
+type aggregationOp interface {
+	NodeParams() aggregation.NodeParams
+}
+
+// PushdownAggregations replaces each fetch that is followed by a temporal
+// function and an aggregation, such as sum by (tags) (rate(series[range])),
+// with a single step that evaluates the aggregation within the storage.
+//
+// Aggregations that are not idempotent, such as sum and count, are only pushed
+// down when the storage guarantees each series contributes exactly once.
+func (p PhysicalPlan) PushdownAggregations(
+	capabilities AggregateSeriesCapabilitiesFn,
+) PhysicalPlan {
+	steps := make(map[parser.NodeID]LogicalStep, len(p.steps))
+	for id, step := range p.steps {
+		steps[id] = step
+	}
+
+	removed := make(map[parser.NodeID]struct{})
+	for _, id := range p.pipeline {
+		step, ok := steps[id]
+		if !ok {
+			continue
+		}
+
+		op, fetchID, temporalID, ok := p.aggregateSeriesOp(steps, step,
+			capabilities)
+		if !ok {
+			continue
+		}
+
+		steps[id] = LogicalStep{
+			Parents:  []parser.NodeID{},
+			Children: step.Children,
+			Transform: parser.Node{
+				ID: id,
+				Op: op,
+			},
+		}
+
+		delete(steps, fetchID)
+		delete(steps, temporalID)
+		removed[fetchID] = struct{}{}
+		removed[temporalID] = struct{}{}
+	}
+
+	if len(removed) == 0 {
+		return p
+	}
+
+	pipeline := make([]parser.NodeID, 0, len(p.pipeline)-len(removed))
+	for _, id := range p.pipeline {
+		if _, ok := removed[id]; !ok {
+			pipeline = append(pipeline, id)
+		}
+	}
+
+	p.steps = steps
+	p.pipeline = pipeline
+	return p
+}
+
+// aggregateSeriesOp returns the operation that replaces the aggregation step
+// along with the fetch and temporal function steps it consumes, if the
+// aggregation can be pushed down.
+func (p PhysicalPlan) aggregateSeriesOp(
+	steps map[parser.NodeID]LogicalStep,
+	aggStep LogicalStep,
+	capabilities AggregateSeriesCapabilitiesFn,
+) (functions.AggregateSeriesOp, parser.NodeID, parser.NodeID, bool) {
+	var none functions.AggregateSeriesOp
+	agg, ok := pushdownAggregations[aggStep.Transform.Op.OpType()]
+	if !ok || len(aggStep.Parents) != 1 {
+		return none, "", "", false
+	}
+
+	aggOp, ok := aggStep.Transform.Op.(aggregationOp)
+	if !ok || aggOp.NodeParams().Without {
+		return none, "", "", false
+	}
+
+	temporalStep, ok := steps[aggStep.Parents[0]]
+	if !ok || len(temporalStep.Parents) != 1 || len(temporalStep.Children) != 1 {
+		return none, "", "", false
+	}
+
+	fn, ok := pushdownTemporalFunctions[temporalStep.Transform.Op.OpType()]
+	if !ok {
+		return none, "", "", false
+	}
+
+	tempOp, ok := temporalStep.Transform.Op.(temporalOp)
+	if !ok {
+		return none, "", "", false
+	}
+
+	fetchStep, ok := steps[temporalStep.Parents[0]]
+	if !ok || len(fetchStep.Children) != 1 {
+		return none, "", "", false
+	}
+
+	fetchOp, ok := fetchStep.Transform.Op.(functions.FetchOp)
+	if !ok || fetchOp.Offset != 0 {
+		return none, "", "", false
+	}
+
+	caps := capabilities(&storage.FetchQuery{
+		Start:       p.TimeSpec.Start,
+		End:         p.TimeSpec.End,
+		TagMatchers: fetchOp.Matchers,
+		Interval:    p.TimeSpec.Step,
+	})
+	if !caps.Enabled {
+		return none, "", "", false
+	}
+
+	if (agg == seriesagg.Sum || agg == seriesagg.Count) && !caps.ShardPartitioned {
+		return none, "", "", false
+	}
+
+	return functions.AggregateSeriesOp{
+		Name:             fetchOp.Name,
+		Matchers:         fetchOp.Matchers,
+		Range:            tempOp.Duration(),
+		TemporalFunction: fn,
+		Aggregation:      agg,
+		GroupBy:          aggOp.NodeParams().MatchingTags,
+	}, fetchStep.ID(), temporalStep.ID(), true
+}
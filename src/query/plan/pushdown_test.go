@@ -0,0 +1,240 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/temporal"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPushdownPlan(
+	t *testing.T,
+	fetch functions.FetchOp,
+	temporalType string,
+	aggType string,
+	params aggregation.NodeParams,
+) PhysicalPlan {
+	temporalOp, err := temporal.NewRateOp([]interface{}{fetch.Range}, temporalType)
+	if err != nil {
+		temporalOp, err = temporal.NewAggOp([]interface{}{fetch.Range}, temporalType)
+	}
+	require.NoError(t, err)
+
+	aggOp, err := aggregation.NewAggregationOp(aggType, params)
+	require.NoError(t, err)
+
+	var (
+		fetchTransform    = parser.NewTransformFromOperation(fetch, 1)
+		temporalTransform = parser.NewTransformFromOperation(temporalOp, 2)
+		aggTransform      = parser.NewTransformFromOperation(aggOp, 3)
+		transforms        = parser.Nodes{fetchTransform, temporalTransform, aggTransform}
+		edges             = parser.Edges{
+			{ParentID: fetchTransform.ID, ChildID: temporalTransform.ID},
+			{ParentID: temporalTransform.ID, ChildID: aggTransform.ID},
+		}
+	)
+
+	lp, err := NewLogicalPlan(transforms, edges)
+	require.NoError(t, err)
+
+	params := testRequestParams()
+	params.Start = params.Now.Add(-1 * time.Hour)
+	params.End = params.Now
+	p, err := NewPhysicalPlan(lp, params)
+	require.NoError(t, err)
+	return p
+}
+
+func testCapabilities(
+	caps storage.AggregateSeriesCapabilities,
+) AggregateSeriesCapabilitiesFn {
+	return func(*storage.FetchQuery) storage.AggregateSeriesCapabilities {
+		return caps
+	}
+}
+
+var testGroupBy = aggregation.NodeParams{
+	MatchingTags: [][]byte{[]byte("region")},
+}
+
+func TestPushdownAggregations(t *testing.T) {
+	matchers := models.Matchers{{
+		Type:  models.MatchEqual,
+		Name:  []byte("__name__"),
+		Value: []byte("requests"),
+	}}
+
+	fetch := functions.FetchOp{
+		Name:     "requests",
+		Range:    5 * time.Minute,
+		Matchers: matchers,
+	}
+
+	p := testPushdownPlan(t, fetch, temporal.RateType, aggregation.SumType,
+		testGroupBy)
+
+	var query *storage.FetchQuery
+	pushed := p.PushdownAggregations(func(
+		q *storage.FetchQuery,
+	) storage.AggregateSeriesCapabilities {
+		query = q
+		return storage.AggregateSeriesCapabilities{
+			Enabled:          true,
+			ShardPartitioned: true,
+		}
+	})
+
+	require.NotNil(t, query)
+	assert.Equal(t, p.TimeSpec.Start, query.Start)
+	assert.Equal(t, p.TimeSpec.End, query.End)
+	assert.Equal(t, p.TimeSpec.Step, query.Interval)
+	assert.Equal(t, matchers, query.TagMatchers)
+
+	steps := pushed.Transforms()
+	require.Equal(t, 1, len(steps))
+	assert.Equal(t, parser.NodeID("3"), steps[0].ID())
+	assert.Equal(t, 0, len(steps[0].Parents))
+	assert.Equal(t, parser.NodeID("3"), pushed.ResultStep.Parent)
+	assert.Equal(t, functions.AggregateSeriesOp{
+		Name:             "requests",
+		Matchers:         matchers,
+		Range:            5 * time.Minute,
+		TemporalFunction: seriesagg.Rate,
+		Aggregation:      seriesagg.Sum,
+		GroupBy:          testGroupBy.MatchingTags,
+	}, steps[0].Transform.Op)
+
+	// The original plan is unchanged.
+	assert.Equal(t, 3, len(p.Transforms()))
+	_, ok := pushed.Step(parser.NodeID("1"))
+	assert.False(t, ok)
+	_, ok = p.Step(parser.NodeID("1"))
+	assert.True(t, ok)
+}
+
+func TestPushdownAggregationsRequiresShardPartitioned(t *testing.T) {
+	caps := testCapabilities(storage.AggregateSeriesCapabilities{Enabled: true})
+	fetch := functions.FetchOp{Range: 5 * time.Minute}
+
+	for _, aggType := range []string{
+		aggregation.SumType,
+		aggregation.CountType,
+	} {
+		p := testPushdownPlan(t, fetch, temporal.SumType, aggType, testGroupBy)
+		assert.Equal(t, 3, len(p.PushdownAggregations(caps).Transforms()),
+			aggType)
+	}
+
+	for _, aggType := range []string{
+		aggregation.MinType,
+		aggregation.MaxType,
+	} {
+		p := testPushdownPlan(t, fetch, temporal.MaxType, aggType, testGroupBy)
+		assert.Equal(t, 1, len(p.PushdownAggregations(caps).Transforms()),
+			aggType)
+	}
+}
+
+func TestPushdownAggregationsUnsupported(t *testing.T) {
+	var (
+		enabled = testCapabilities(storage.AggregateSeriesCapabilities{
+			Enabled:          true,
+			ShardPartitioned: true,
+		})
+		fetch = functions.FetchOp{Range: 5 * time.Minute}
+	)
+
+	tests := []struct {
+		name         string
+		fetch        functions.FetchOp
+		temporalType string
+		aggType      string
+		params       aggregation.NodeParams
+		caps         AggregateSeriesCapabilitiesFn
+	}{
+		{
+			name:         "storage not capable",
+			fetch:        fetch,
+			temporalType: temporal.RateType,
+			aggType:      aggregation.SumType,
+			params:       testGroupBy,
+			caps:         testCapabilities(storage.AggregateSeriesCapabilities{}),
+		},
+		{
+			name: "offset",
+			fetch: functions.FetchOp{
+				Range:  5 * time.Minute,
+				Offset: time.Minute,
+			},
+			temporalType: temporal.RateType,
+			aggType:      aggregation.SumType,
+			params:       testGroupBy,
+			caps:         enabled,
+		},
+		{
+			name:         "unsupported temporal function",
+			fetch:        fetch,
+			temporalType: temporal.IRateType,
+			aggType:      aggregation.SumType,
+			params:       testGroupBy,
+			caps:         enabled,
+		},
+		{
+			name:         "unsupported aggregation",
+			fetch:        fetch,
+			temporalType: temporal.RateType,
+			aggType:      aggregation.AverageType,
+			params:       testGroupBy,
+			caps:         enabled,
+		},
+		{
+			name:         "without",
+			fetch:        fetch,
+			temporalType: temporal.RateType,
+			aggType:      aggregation.SumType,
+			params: aggregation.NodeParams{
+				MatchingTags: testGroupBy.MatchingTags,
+				Without:      true,
+			},
+			caps: enabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := testPushdownPlan(t, tt.fetch, tt.temporalType, tt.aggType,
+				tt.params)
+			pushed := p.PushdownAggregations(tt.caps)
+			assert.Equal(t, p.Transforms(), pushed.Transforms())
+		})
+	}
+}
@@ -45,6 +45,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/httpd"
 	m3dbcluster "github.com/m3db/m3/src/query/cluster/m3db"
 	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/metadata"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/policy/filter"
 	"github.com/m3db/m3/src/query/pools"
@@ -275,9 +276,20 @@ func Run(runOpts RunOptions) {
 	engineOpts := executor.NewEngineOptions().
 		SetStore(backendStorage).
 		SetLookbackDuration(*cfg.LookbackDuration).
+		SetAggregationPushdownEnabled(cfg.AggregationPushdown).
 		SetGlobalEnforcer(perQueryEnforcer).
 		SetInstrumentOptions(instrumentOptions.
 			SetMetricsScope(instrumentOptions.MetricsScope().SubScope("engine")))
+	if slowQueryLogCfg := cfg.SlowQueryLog; slowQueryLogCfg != nil {
+		slowQueryLogger, err := slowQueryLogCfg.NewLogger(instrumentOptions)
+		if err != nil {
+			logger.Fatal("unable to setup slow query log", zap.Error(err))
+		}
+
+		defer slowQueryLogger.Close()
+		engineOpts = engineOpts.SetSlowQueryLogger(slowQueryLogger)
+	}
+
 	engine := executor.NewEngine(engineOpts)
 	downsamplerAndWriter, err := newDownsamplerAndWriter(backendStorage, downsampler)
 	if err != nil {
@@ -299,8 +311,24 @@ func Run(runOpts RunOptions) {
 		}
 	}
 
+	metadataCfg := metadata.Configuration{}
+	if cfg.MetricMetadata != nil {
+		metadataCfg = *cfg.MetricMetadata
+	}
+
+	metadataStore, err := metadataCfg.NewStore(clusterClient, instrumentOptions)
+	if err != nil {
+		logger.Fatal("unable to create metric metadata store", zap.Error(err))
+	}
+
+	if err := metadataStore.Open(); err != nil {
+		logger.Fatal("unable to open metric metadata store", zap.Error(err))
+	}
+
+	defer metadataStore.Close()
+
 	handler, err := httpd.NewHandler(downsamplerAndWriter, tagOptions, engine,
-		m3dbClusters, clusterClient, cfg, runOpts.DBConfig, perQueryEnforcer,
+		metadataStore, m3dbClusters, clusterClient, cfg, runOpts.DBConfig, perQueryEnforcer,
 		fetchOptsBuilder, queryCtxOpts, instrumentOptions, cpuProfileDuration,
 		[]string{handler.M3DBServiceName}, serviceOptionDefaults)
 	if err != nil {
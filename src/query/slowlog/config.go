@@ -0,0 +1,123 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package slowlog
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultSampleRate   = 1.0
+	defaultMaxSizeBytes = 100 * 1024 * 1024
+	defaultMaxBackups   = 5
+	defaultDebugEntries = 100
+)
+
+var (
+	errNoPath       = errors.New("slow query log path must be set")
+	errNoThresholds = errors.New("slow query log requires at least one threshold")
+)
+
+// Configuration is the slow query log configuration.
+type Configuration struct {
+	// Path is the file slow queries are written to as JSON lines.
+	Path string `yaml:"path"`
+
+	// LatencyThreshold logs queries which take at least this long.
+	LatencyThreshold time.Duration `yaml:"latencyThreshold"`
+
+	// FetchedSeriesThreshold logs queries which fetch at least this many
+	// series.
+	FetchedSeriesThreshold int `yaml:"fetchedSeriesThreshold"`
+
+	// FetchedDatapointsThreshold logs queries which fetch at least this many
+	// datapoints.
+	FetchedDatapointsThreshold int64 `yaml:"fetchedDatapointsThreshold"`
+
+	// SampleRate is the fraction of slow queries that are logged, defaults
+	// to logging all of them.
+	SampleRate *float64 `yaml:"sampleRate"`
+
+	// MaxSizeBytes is the size at which the log file is rotated.
+	MaxSizeBytes *int64 `yaml:"maxSizeBytes"`
+
+	// MaxBackups is the number of rotated log files to keep.
+	MaxBackups *int `yaml:"maxBackups"`
+
+	// DebugEntries is the number of recent slow queries kept in memory to
+	// serve from the debug endpoint.
+	DebugEntries *int `yaml:"debugEntries"`
+}
+
+// NewLogger returns a new slow query logger.
+func (c Configuration) NewLogger(
+	instrumentOpts instrument.Options,
+) (Logger, error) {
+	if c.Path == "" {
+		return nil, errNoPath
+	}
+
+	thresholds := Thresholds{
+		Latency:           c.LatencyThreshold,
+		FetchedSeries:     c.FetchedSeriesThreshold,
+		FetchedDatapoints: float64(c.FetchedDatapointsThreshold),
+	}
+	if thresholds == (Thresholds{}) {
+		return nil, errNoThresholds
+	}
+
+	sampleRate := defaultSampleRate
+	if c.SampleRate != nil {
+		sampleRate = *c.SampleRate
+	}
+	if sampleRate <= 0 || sampleRate > 1 {
+		return nil, fmt.Errorf(
+			"slow query log sample rate must be in (0, 1]: %v", sampleRate)
+	}
+
+	maxSize := int64(defaultMaxSizeBytes)
+	if c.MaxSizeBytes != nil {
+		maxSize = *c.MaxSizeBytes
+	}
+
+	maxBackups := defaultMaxBackups
+	if c.MaxBackups != nil {
+		maxBackups = *c.MaxBackups
+	}
+
+	debugEntries := defaultDebugEntries
+	if c.DebugEntries != nil {
+		debugEntries = *c.DebugEntries
+	}
+
+	file, err := newRotatingFile(c.Path, maxSize, maxBackups)
+	if err != nil {
+		return nil, fmt.Errorf("could not open slow query log: %v", err)
+	}
+
+	return newLogger(file, thresholds, sampleRate, debugEntries,
+		rand.Float64, instrumentOpts), nil
+}
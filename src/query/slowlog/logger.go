@@ -0,0 +1,161 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package slowlog
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+// Thresholds are the limits beyond which a query is considered slow, a zero
+// value disables the respective threshold.
+type Thresholds struct {
+	// Latency is the total query duration threshold.
+	Latency time.Duration
+	// FetchedSeries is the fetched series threshold.
+	FetchedSeries int
+	// FetchedDatapoints is the fetched datapoints threshold.
+	FetchedDatapoints float64
+}
+
+func (t Thresholds) exceededBy(e Entry) bool {
+	return (t.Latency > 0 && e.Durations.Total >= t.Latency) ||
+		(t.FetchedSeries > 0 && e.FetchedSeries >= t.FetchedSeries) ||
+		(t.FetchedDatapoints > 0 && e.FetchedDatapoints >= t.FetchedDatapoints)
+}
+
+type loggerMetrics struct {
+	logged      tally.Counter
+	sampledOut  tally.Counter
+	writeErrors tally.Counter
+}
+
+func newLoggerMetrics(scope tally.Scope) loggerMetrics {
+	return loggerMetrics{
+		logged:      scope.Counter("logged"),
+		sampledOut:  scope.Counter("sampled-out"),
+		writeErrors: scope.Counter("write-errors"),
+	}
+}
+
+type logger struct {
+	sync.Mutex
+
+	writer     io.WriteCloser
+	thresholds Thresholds
+	sampleRate float64
+	randFn     func() float64
+	recent     []Entry
+	next       int
+	full       bool
+	logger     *zap.Logger
+	metrics    loggerMetrics
+}
+
+func newLogger(
+	writer io.WriteCloser,
+	thresholds Thresholds,
+	sampleRate float64,
+	capacity int,
+	randFn func() float64,
+	instrumentOpts instrument.Options,
+) *logger {
+	return &logger{
+		writer:     writer,
+		thresholds: thresholds,
+		sampleRate: sampleRate,
+		randFn:     randFn,
+		recent:     make([]Entry, capacity),
+		logger:     instrumentOpts.Logger(),
+		metrics: newLoggerMetrics(instrumentOpts.MetricsScope().
+			SubScope("slow-query-log")),
+	}
+}
+
+func (l *logger) Log(entry Entry) {
+	if !l.thresholds.exceededBy(entry) {
+		return
+	}
+
+	if l.sampleRate < 1 && l.randFn() >= l.sampleRate {
+		l.metrics.sampledOut.Inc(1)
+		return
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		l.metrics.writeErrors.Inc(1)
+		l.logger.Error("could not marshal slow query", zap.Error(err))
+		return
+	}
+
+	line = append(line, '\n')
+
+	l.Lock()
+	defer l.Unlock()
+
+	if len(l.recent) > 0 {
+		l.recent[l.next] = entry
+		l.next = (l.next + 1) % len(l.recent)
+		if l.next == 0 {
+			l.full = true
+		}
+	}
+
+	if _, err := l.writer.Write(line); err != nil {
+		l.metrics.writeErrors.Inc(1)
+		l.logger.Error("could not write slow query", zap.Error(err))
+		return
+	}
+
+	l.metrics.logged.Inc(1)
+}
+
+func (l *logger) Entries() []Entry {
+	l.Lock()
+	defer l.Unlock()
+
+	n := l.next
+	if l.full {
+		n = len(l.recent)
+	}
+
+	entries := make([]Entry, 0, n)
+	for i := 1; i <= n; i++ {
+		idx := (l.next - i + len(l.recent)) % len(l.recent)
+		entries = append(entries, l.recent[idx])
+	}
+
+	return entries
+}
+
+func (l *logger) Close() error {
+	l.Lock()
+	defer l.Unlock()
+	return l.writer.Close()
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package slowlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testWriteCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (w *testWriteCloser) Close() error {
+	w.closed = true
+	return nil
+}
+
+func newTestLogger(
+	thresholds Thresholds,
+	sampleRate float64,
+	capacity int,
+	randFn func() float64,
+) (*logger, *testWriteCloser) {
+	w := &testWriteCloser{}
+	return newLogger(w, thresholds, sampleRate, capacity, randFn,
+		instrument.NewOptions()), w
+}
+
+func TestLoggerThresholds(t *testing.T) {
+	l, w := newTestLogger(Thresholds{
+		Latency:           time.Second,
+		FetchedSeries:     100,
+		FetchedDatapoints: 1000,
+	}, 1, 10, nil)
+
+	l.Log(Entry{Query: "fast", Durations: Durations{Total: time.Millisecond}})
+	l.Log(Entry{Query: "slow", Durations: Durations{Total: 2 * time.Second}})
+	l.Log(Entry{Query: "series", FetchedSeries: 100})
+	l.Log(Entry{Query: "datapoints", FetchedDatapoints: 5000})
+
+	entries := l.Entries()
+	require.Len(t, entries, 3)
+	assert.Equal(t, "datapoints", entries[0].Query)
+	assert.Equal(t, "series", entries[1].Query)
+	assert.Equal(t, "slow", entries[2].Query)
+
+	lines := bytes.Split(bytes.TrimSpace(w.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+
+	require.NoError(t, l.Close())
+	assert.True(t, w.closed)
+}
+
+func TestLoggerSampling(t *testing.T) {
+	samples := []float64{0.1, 0.9, 0.4}
+	randFn := func() float64 {
+		v := samples[0]
+		samples = samples[1:]
+		return v
+	}
+
+	l, _ := newTestLogger(Thresholds{FetchedSeries: 1}, 0.5, 10, randFn)
+	for _, query := range []string{"a", "b", "c"} {
+		l.Log(Entry{Query: query, FetchedSeries: 1})
+	}
+
+	entries := l.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "c", entries[0].Query)
+	assert.Equal(t, "a", entries[1].Query)
+}
+
+func TestLoggerEntriesWrap(t *testing.T) {
+	l, _ := newTestLogger(Thresholds{FetchedSeries: 1}, 1, 2, nil)
+	for _, query := range []string{"a", "b", "c"} {
+		l.Log(Entry{Query: query, FetchedSeries: 1})
+	}
+
+	entries := l.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "c", entries[0].Query)
+	assert.Equal(t, "b", entries[1].Query)
+}
+
+func TestEntryMarshalJSON(t *testing.T) {
+	start := time.Unix(1500000000, 0).UTC()
+	entry := Entry{
+		Timestamp: start,
+		Query:     "sum(foo)",
+		Source:    "tenant-a",
+		Start:     start.Add(-time.Hour),
+		End:       start,
+		Step:      15 * time.Second,
+		Namespaces: []Namespace{{
+			Name:       "agg",
+			Resolution: time.Minute,
+			Start:      start.Add(-time.Hour),
+			End:        start,
+		}},
+		FetchedSeries:     10,
+		FetchedDatapoints: 2400,
+		Durations: Durations{
+			Compiling: time.Millisecond,
+			Planning:  2 * time.Millisecond,
+			Executing: time.Second,
+			Total:     time.Second + 3*time.Millisecond,
+		},
+		Err: errors.New("boom"),
+	}
+
+	data, err := json.Marshal(entry)
+	require.NoError(t, err)
+
+	var actual map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &actual))
+	assert.Equal(t, "sum(foo)", actual["query"])
+	assert.Equal(t, "tenant-a", actual["source"])
+	assert.Equal(t, "15s", actual["step"])
+	assert.Equal(t, float64(10), actual["fetchedSeries"])
+	assert.Equal(t, float64(2400), actual["fetchedDatapoints"])
+	assert.Equal(t, "boom", actual["error"])
+	assert.Equal(t, map[string]interface{}{
+		"compiling": "1ms",
+		"planning":  "2ms",
+		"executing": "1s",
+		"total":     "1.003s",
+	}, actual["durations"])
+
+	namespaces, ok := actual["namespaces"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, namespaces, 1)
+	ns, ok := namespaces[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "agg", ns["name"])
+	assert.Equal(t, "1m0s", ns["resolution"])
+}
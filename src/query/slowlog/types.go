@@ -0,0 +1,152 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package slowlog records queries which exceed configured latency or
+// resource thresholds.
+package slowlog
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Logger records slow queries.
+type Logger interface {
+	// Log records the entry if it exceeds any of the configured thresholds
+	// and is selected by sampling.
+	Log(entry Entry)
+
+	// Entries returns the most recently recorded entries, newest first.
+	Entries() []Entry
+
+	// Close closes the logger and the underlying log file.
+	Close() error
+}
+
+// Entry describes a single executed query.
+type Entry struct {
+	// Timestamp is the time the query was received.
+	Timestamp time.Time
+	// Query is the query text.
+	Query string
+	// Source is the user or tenant that issued the query, if known.
+	Source string
+	// Start is the start of the query range.
+	Start time.Time
+	// End is the end of the query range.
+	End time.Time
+	// Step is the query step size.
+	Step time.Duration
+	// Namespaces are the namespaces resolved to serve the query.
+	Namespaces []Namespace
+	// FetchedSeries is the number of series fetched from storage.
+	FetchedSeries int
+	// FetchedDatapoints is the number of datapoints fetched from storage.
+	FetchedDatapoints float64
+	// Durations is the time spent in each query stage.
+	Durations Durations
+	// Err is the error the query failed with, if any.
+	Err error
+}
+
+// Namespace is a namespace selected to serve part of a query range.
+type Namespace struct {
+	// Name is the name of the namespace.
+	Name string
+	// Resolution is the resolution of the namespace.
+	Resolution time.Duration
+	// Start is the inclusive start of the range served by the namespace.
+	Start time.Time
+	// End is the exclusive end of the range served by the namespace.
+	End time.Time
+}
+
+// Durations is the time spent in each stage of a query.
+type Durations struct {
+	Compiling time.Duration
+	Planning  time.Duration
+	Executing time.Duration
+	Total     time.Duration
+}
+
+type namespaceJSON struct {
+	Name       string    `json:"name"`
+	Resolution string    `json:"resolution"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+}
+
+type durationsJSON struct {
+	Compiling string `json:"compiling"`
+	Planning  string `json:"planning"`
+	Executing string `json:"executing"`
+	Total     string `json:"total"`
+}
+
+type entryJSON struct {
+	Timestamp         time.Time       `json:"timestamp"`
+	Query             string          `json:"query"`
+	Source            string          `json:"source,omitempty"`
+	Start             time.Time       `json:"start"`
+	End               time.Time       `json:"end"`
+	Step              string          `json:"step"`
+	Namespaces        []namespaceJSON `json:"namespaces"`
+	FetchedSeries     int             `json:"fetchedSeries"`
+	FetchedDatapoints float64         `json:"fetchedDatapoints"`
+	Durations         durationsJSON   `json:"durations"`
+	Error             string          `json:"error,omitempty"`
+}
+
+// MarshalJSON marshals the entry with human readable durations.
+func (e Entry) MarshalJSON() ([]byte, error) {
+	namespaces := make([]namespaceJSON, 0, len(e.Namespaces))
+	for _, ns := range e.Namespaces {
+		namespaces = append(namespaces, namespaceJSON{
+			Name:       ns.Name,
+			Resolution: ns.Resolution.String(),
+			Start:      ns.Start,
+			End:        ns.End,
+		})
+	}
+
+	var errString string
+	if e.Err != nil {
+		errString = e.Err.Error()
+	}
+
+	return json.Marshal(entryJSON{
+		Timestamp:         e.Timestamp,
+		Query:             e.Query,
+		Source:            e.Source,
+		Start:             e.Start,
+		End:               e.End,
+		Step:              e.Step.String(),
+		Namespaces:        namespaces,
+		FetchedSeries:     e.FetchedSeries,
+		FetchedDatapoints: e.FetchedDatapoints,
+		Durations: durationsJSON{
+			Compiling: e.Durations.Compiling.String(),
+			Planning:  e.Durations.Planning.String(),
+			Executing: e.Durations.Executing.String(),
+			Total:     e.Durations.Total.String(),
+		},
+		Error: errString,
+	})
+}
@@ -23,6 +23,7 @@ package fanout
 import (
 	"bytes"
 	"context"
+	goerrors "errors"
 	"fmt"
 	"sync"
 
@@ -40,6 +41,9 @@ import (
 	"go.uber.org/zap"
 )
 
+var errAggregateSeriesNotSupported = goerrors.New("aggregate series query " +
+	"must be served by a single store")
+
 type fanoutStorage struct {
 	stores             []storage.Storage
 	fetchFilter        filter.Storage
@@ -418,6 +422,57 @@ func (s *fanoutStorage) CompleteTags(
 	return &built, nil
 }
 
+func (s *fanoutStorage) AggregateSeriesCapabilities(
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) storage.AggregateSeriesCapabilities {
+	store, ok := s.aggregateSeriesStore(query)
+	if !ok {
+		return storage.AggregateSeriesCapabilities{}
+	}
+
+	return store.AggregateSeriesCapabilities(query, options)
+}
+
+func (s *fanoutStorage) AggregateSeries(
+	ctx context.Context,
+	query *storage.AggregateSeriesQuery,
+	options *storage.FetchOptions,
+) (storage.AggregateSeriesResult, error) {
+	store, ok := s.aggregateSeriesStore(&query.FetchQuery)
+	if !ok {
+		return storage.AggregateSeriesResult{}, errAggregateSeriesNotSupported
+	}
+
+	return store.AggregateSeries(ctx, query, options)
+}
+
+// aggregateSeriesStore returns the store an aggregate series query can be
+// delegated to, which is only possible when the query is served by a single
+// store since the aggregations cannot be merged across stores.
+func (s *fanoutStorage) aggregateSeriesStore(
+	query *storage.FetchQuery,
+) (storage.AggregateSeriesStorage, bool) {
+	single, ok := s.SingleStore(query)
+	if !ok {
+		return nil, false
+	}
+
+	store, ok := single.(storage.AggregateSeriesStorage)
+	return store, ok
+}
+
+func (s *fanoutStorage) SingleStore(
+	query *storage.FetchQuery,
+) (storage.Storage, bool) {
+	stores := filterStores(s.stores, s.fetchFilter, query)
+	if len(stores) != 1 {
+		return nil, false
+	}
+
+	return stores[0], true
+}
+
 func applyOptions(
 	result storage.CompleteTagsResult,
 	opts *storage.FetchOptions,
@@ -157,6 +157,28 @@ func TestFanoutReadSuccess(t *testing.T) {
 	assert.NoError(t, store.Close())
 }
 
+func TestFanoutSingleStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store1, _ := m3.NewStorageAndSession(t, ctrl)
+	store2, _ := m3.NewStorageAndSession(t, ctrl)
+	query := &storage.FetchQuery{}
+
+	single := NewStorage([]storage.Storage{store1}, filterFunc(true),
+		filterFunc(true), filterCompleteTagsFunc(true), instrument.NewOptions())
+	resolver, ok := single.(storage.SingleStoreStorage)
+	require.True(t, ok)
+	store, ok := resolver.SingleStore(query)
+	require.True(t, ok)
+	assert.Equal(t, store1, store)
+
+	multi := NewStorage([]storage.Storage{store1, store2}, filterFunc(true),
+		filterFunc(true), filterCompleteTagsFunc(true), instrument.NewOptions())
+	_, ok = multi.(storage.SingleStoreStorage).SingleStore(query)
+	assert.False(t, ok)
+}
+
 func TestFanoutSearchEmpty(t *testing.T) {
 	store := setupFanoutRead(t, false)
 	res, err := store.SearchSeries(context.TODO(), nil, nil)
@@ -0,0 +1,162 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"context"
+	goerrors "errors"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+)
+
+var errAggregateSeriesNotSupported = goerrors.New("aggregate series query " +
+	"must be served by a single namespace covering the entire query range")
+
+var _ storage.AggregateSeriesStorage = (*m3storage)(nil)
+
+func (s *m3storage) AggregateSeriesCapabilities(
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) storage.AggregateSeriesCapabilities {
+	namespace, err := s.aggregateSeriesNamespace(query, options)
+	if err != nil {
+		return storage.AggregateSeriesCapabilities{}
+	}
+
+	// NB: every shard is read from exactly one replica, so each series
+	// contributes to the aggregation exactly once. This only satisfies the
+	// read consistency level of the session if it does not require reading
+	// a majority of replicas.
+	level := namespace.Session().ReadConsistencyLevel()
+	if !client.AggregateSeriesReadConsistencyLevel(level) {
+		return storage.AggregateSeriesCapabilities{}
+	}
+
+	return storage.AggregateSeriesCapabilities{
+		Enabled:          true,
+		ShardPartitioned: true,
+	}
+}
+
+func (s *m3storage) AggregateSeries(
+	ctx context.Context,
+	query *storage.AggregateSeriesQuery,
+	options *storage.FetchOptions,
+) (storage.AggregateSeriesResult, error) {
+	// Check if the query was interrupted.
+	select {
+	case <-ctx.Done():
+		return storage.AggregateSeriesResult{}, ctx.Err()
+	default:
+	}
+
+	namespace, err := s.aggregateSeriesNamespace(&query.FetchQuery, options)
+	if err != nil {
+		return storage.AggregateSeriesResult{}, err
+	}
+
+	m3query, err := storage.FetchQueryToM3Query(&query.FetchQuery, options)
+	if err != nil {
+		return storage.AggregateSeriesResult{}, err
+	}
+
+	opts := seriesagg.Options{
+		Start:            query.Start,
+		End:              query.End,
+		Step:             query.Interval,
+		Range:            query.Range,
+		TemporalFunction: query.TemporalFunction,
+		Aggregation:      query.Aggregation,
+		GroupBy:          query.GroupBy,
+	}
+
+	ns := namespace.NamespaceID()
+	partials, exhaustive, err := namespace.Session().
+		AggregateSeries(ctx, ns, m3query, opts)
+	if err != nil {
+		return storage.AggregateSeriesResult{}, err
+	}
+
+	meta := block.NewResultMetadata()
+	meta.Exhaustive = exhaustive
+	meta.Namespaces = []block.NamespaceRange{{
+		Namespace:  ns.String(),
+		Resolution: namespace.Options().Attributes().Resolution,
+		Start:      namespace.start,
+		End:        namespace.end,
+	}}
+
+	var (
+		groups  = seriesagg.Merge(opts.Aggregation, opts.Steps(), partials...)
+		tagOpts = s.opts.TagOptions()
+		series  = make([]storage.AggregatedSeries, 0, len(groups))
+	)
+	for _, group := range groups {
+		tags := models.NewTags(len(group.Tags), tagOpts)
+		for _, tag := range group.Tags {
+			tags = tags.AddTag(models.Tag{
+				Name:  []byte(tag.Name),
+				Value: []byte(tag.Value),
+			})
+		}
+
+		series = append(series, storage.AggregatedSeries{
+			Tags:   tags,
+			Values: group.Values,
+		})
+	}
+
+	return storage.AggregateSeriesResult{
+		Series:   series,
+		Metadata: meta,
+	}, nil
+}
+
+// aggregateSeriesNamespace returns the namespace an aggregate series query
+// is served from, aggregations can only be evaluated by storage when a single
+// namespace serves the entire query range.
+func (s *m3storage) aggregateSeriesNamespace(
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (resolvedNamespace, error) {
+	fanout, namespaces, err := resolveClusterNamespacesForQueryWithStep(
+		s.nowFn(),
+		query.Start,
+		query.End,
+		query.Interval,
+		s.clusters,
+		options.FanoutOptions,
+		options.RestrictQueryOptions,
+	)
+	if err != nil {
+		return resolvedNamespace{}, err
+	}
+
+	if fanout != namespaceCoversAllQueryRange || len(namespaces) != 1 {
+		return resolvedNamespace{}, errAggregateSeriesNotSupported
+	}
+
+	return namespaces[0], nil
+}
@@ -358,3 +358,190 @@ func newCoversRangeFilter(opts coversRangeFilterOptions) func(namespace ClusterN
 		return !clusterStart.After(opts.queryStart)
 	}
 }
+
+// resolvedNamespace is a cluster namespace selected to serve the portion of a
+// query range between start (inclusive) and end (exclusive).
+type resolvedNamespace struct {
+	ClusterNamespace
+	start time.Time
+	end   time.Time
+}
+
+// resolvedNamespaces is a slice of resolved namespaces.
+type resolvedNamespaces []resolvedNamespace
+
+// resolveClusterNamespacesForQueryWithStep returns the namespaces that need
+// to be fanned out to for the query, along with the portion of the query range
+// each namespace should serve.
+//
+// When the query step is known, the namespaces guaranteed to contain every
+// metric are considered and, working back from the end of the query, the
+// coarsest namespace whose resolution satisfies the step is selected until its
+// retention boundary is reached; the remainder of the range is then served by
+// the next best namespace, stitching the results together. Without a step, or
+// when the query is restricted or no namespace is known to contain every
+// metric, this defers to resolveClusterNamespacesForQuery and each namespace
+// serves the entire query range.
+func resolveClusterNamespacesForQueryWithStep(
+	now, start, end time.Time,
+	step time.Duration,
+	clusters Clusters,
+	opts *storage.FanoutOptions,
+	restrict *storage.RestrictQueryOptions,
+) (queryFanoutType, resolvedNamespaces, error) {
+	if step <= 0 || restrict.GetRestrictByType() != nil {
+		return resolveClusterNamespacesForQueryFullRange(now, start, end,
+			clusters, opts, restrict)
+	}
+
+	candidates := completeNamespaces(clusters, opts)
+	if len(candidates) == 0 {
+		return resolveClusterNamespacesForQueryFullRange(now, start, end,
+			clusters, opts, restrict)
+	}
+
+	var (
+		result resolvedNamespaces
+		cursor = end
+	)
+	for cursor.After(start) {
+		covering := make(ClusterNamespaces, 0, len(candidates))
+		for _, n := range candidates {
+			nsStart := now.Add(-1 * n.Options().Attributes().Retention)
+			if nsStart.Before(cursor) {
+				covering = append(covering, n)
+			}
+		}
+
+		if len(covering) == 0 {
+			// No namespace retains data this far back.
+			break
+		}
+
+		selected := selectNamespaceForStep(covering, step)
+		segmentStart := now.Add(-1 * selected.Options().Attributes().Retention)
+		if segmentStart.Before(start) {
+			segmentStart = start
+		}
+
+		result = append(result, resolvedNamespace{
+			ClusterNamespace: selected,
+			start:            segmentStart,
+			end:              cursor,
+		})
+		cursor = segmentStart
+	}
+
+	if len(result) == 0 {
+		// Query range is entirely outside of the retention of every complete
+		// namespace, fallback to fanning out to the best effort namespaces.
+		return resolveClusterNamespacesForQueryFullRange(now, start, end,
+			clusters, opts, restrict)
+	}
+
+	// Return in ascending time order.
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+
+	if len(result) > 1 {
+		return namespacesStitchedAcrossQueryRange, result, nil
+	}
+
+	if result[0].start.Equal(start) {
+		return namespaceCoversAllQueryRange, result, nil
+	}
+
+	return namespaceCoversPartialQueryRange, result, nil
+}
+
+// resolveClusterNamespacesForQueryFullRange resolves the namespaces for the
+// query, with each namespace serving the entire query range.
+func resolveClusterNamespacesForQueryFullRange(
+	now, start, end time.Time,
+	clusters Clusters,
+	opts *storage.FanoutOptions,
+	restrict *storage.RestrictQueryOptions,
+) (queryFanoutType, resolvedNamespaces, error) {
+	fanout, namespaces, err := resolveClusterNamespacesForQuery(now, start,
+		end, clusters, opts, restrict)
+	if err != nil {
+		return fanout, nil, err
+	}
+
+	result := make(resolvedNamespaces, 0, len(namespaces))
+	for _, n := range namespaces {
+		result = append(result, resolvedNamespace{
+			ClusterNamespace: n,
+			start:            start,
+			end:              end,
+		})
+	}
+
+	return fanout, result, nil
+}
+
+// completeNamespaces returns the namespaces that are guaranteed to contain
+// every metric, respecting the fanout options.
+func completeNamespaces(
+	clusters Clusters,
+	opts *storage.FanoutOptions,
+) ClusterNamespaces {
+	var result ClusterNamespaces
+	if opts.FanoutUnaggregated != storage.FanoutForceDisable {
+		result = append(result, clusters.UnaggregatedClusterNamespace())
+	}
+
+	if opts.FanoutAggregated == storage.FanoutForceDisable {
+		return result
+	}
+
+	var r reusedAggregatedNamespaceSlices
+	r = aggregatedNamespaces(clusters.ClusterNamespaces(), r, nil, opts)
+	return append(result, r.completeAggregated...)
+}
+
+// selectNamespaceForStep selects the coarsest namespace with a resolution that
+// satisfies the query step. If no namespace has a fine enough resolution, the
+// most granular namespace is selected instead. Ties are broken by preferring
+// the namespace with the longest retention.
+func selectNamespaceForStep(
+	namespaces ClusterNamespaces,
+	step time.Duration,
+) ClusterNamespace {
+	var (
+		best      ClusterNamespace
+		bestAttrs storage.Attributes
+	)
+	for _, n := range namespaces {
+		attrs := n.Options().Attributes()
+		if best == nil {
+			best, bestAttrs = n, attrs
+			continue
+		}
+
+		satisfies := attrs.Resolution <= step
+		bestSatisfies := bestAttrs.Resolution <= step
+		var better bool
+		switch {
+		case satisfies && !bestSatisfies:
+			better = true
+		case !satisfies && bestSatisfies:
+			better = false
+		case attrs.Resolution == bestAttrs.Resolution:
+			better = attrs.Retention > bestAttrs.Retention
+		case satisfies:
+			// Both satisfy the step, prefer the coarser resolution.
+			better = attrs.Resolution > bestAttrs.Resolution
+		default:
+			// Neither satisfies the step, prefer the finer resolution.
+			better = attrs.Resolution < bestAttrs.Resolution
+		}
+
+		if better {
+			best, bestAttrs = n, attrs
+		}
+	}
+
+	return best
+}
@@ -527,3 +527,149 @@ func TestExampleCase(t *testing.T) {
 		assert.Equal(t, namespaceCoversPartialQueryRange, fanoutType)
 	}
 }
+
+func TestResolveClusterNamespacesForQueryWithStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockSession(ctrl)
+	clusters, err := NewClusters(UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("UNAGG"),
+		Retention:   6 * time.Hour,
+		Session:     session,
+	}, AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("AGG_1M_2D"),
+		Retention:   48 * time.Hour,
+		Resolution:  time.Minute,
+		Downsample:  &ClusterNamespaceDownsampleOptions{All: true},
+		Session:     session,
+	}, AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("AGG_1H_30D"),
+		Retention:   720 * time.Hour,
+		Resolution:  time.Hour,
+		Downsample:  &ClusterNamespaceDownsampleOptions{All: true},
+		Session:     session,
+	}, AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("AGG_PARTIAL_5M_30D"),
+		Retention:   720 * time.Hour,
+		Resolution:  5 * time.Minute,
+		Downsample:  &ClusterNamespaceDownsampleOptions{All: false},
+		Session:     session,
+	})
+	require.NoError(t, err)
+
+	type expectedNamespace struct {
+		name  string
+		start time.Duration
+		end   time.Duration
+	}
+
+	now := time.Now()
+	day := 24 * time.Hour
+	tests := []struct {
+		name         string
+		queryLength  time.Duration
+		step         time.Duration
+		opts         *storage.FanoutOptions
+		restrict     *storage.RestrictQueryOptions
+		expectedType queryFanoutType
+		expected     []expectedNamespace
+	}{
+		{
+			name:         "coarsest namespace satisfying step",
+			queryLength:  time.Hour,
+			step:         time.Minute,
+			opts:         &storage.FanoutOptions{},
+			expectedType: namespaceCoversAllQueryRange,
+			expected:     []expectedNamespace{{"AGG_1M_2D", time.Hour, 0}},
+		},
+		{
+			name:         "coarse step uses coarse namespace",
+			queryLength:  10 * day,
+			step:         2 * time.Hour,
+			opts:         &storage.FanoutOptions{},
+			expectedType: namespaceCoversAllQueryRange,
+			expected:     []expectedNamespace{{"AGG_1H_30D", 10 * day, 0}},
+		},
+		{
+			name:         "stitched at retention boundary",
+			queryLength:  10 * day,
+			step:         time.Minute,
+			opts:         &storage.FanoutOptions{},
+			expectedType: namespacesStitchedAcrossQueryRange,
+			expected: []expectedNamespace{
+				{"AGG_1H_30D", 10 * day, 2 * day},
+				{"AGG_1M_2D", 2 * day, 0},
+			},
+		},
+		{
+			name:         "fine step stitched across all namespaces",
+			queryLength:  10 * day,
+			step:         10 * time.Second,
+			opts:         &storage.FanoutOptions{},
+			expectedType: namespacesStitchedAcrossQueryRange,
+			expected: []expectedNamespace{
+				{"AGG_1H_30D", 10 * day, 2 * day},
+				{"AGG_1M_2D", 2 * day, 6 * time.Hour},
+				{"UNAGG", 6 * time.Hour, 0},
+			},
+		},
+		{
+			name:         "query beyond longest retention",
+			queryLength:  40 * day,
+			step:         time.Minute,
+			opts:         &storage.FanoutOptions{},
+			expectedType: namespacesStitchedAcrossQueryRange,
+			expected: []expectedNamespace{
+				{"AGG_1H_30D", 30 * day, 2 * day},
+				{"AGG_1M_2D", 2 * day, 0},
+			},
+		},
+		{
+			name:         "aggregated disabled",
+			queryLength:  time.Hour,
+			step:         time.Hour,
+			opts:         &storage.FanoutOptions{FanoutAggregated: storage.FanoutForceDisable},
+			expectedType: namespaceCoversAllQueryRange,
+			expected:     []expectedNamespace{{"UNAGG", time.Hour, 0}},
+		},
+		{
+			name:         "no step uses full range",
+			queryLength:  time.Hour,
+			opts:         &storage.FanoutOptions{},
+			expectedType: namespaceCoversAllQueryRange,
+			expected:     []expectedNamespace{{"UNAGG", time.Hour, 0}},
+		},
+		{
+			name:        "restricted uses full range",
+			queryLength: 10 * day,
+			step:        time.Hour,
+			opts:        &storage.FanoutOptions{},
+			restrict: &storage.RestrictQueryOptions{
+				RestrictByType: &storage.RestrictByType{
+					MetricsType: storage.UnaggregatedMetricsType,
+				},
+			},
+			expectedType: namespaceCoversPartialQueryRange,
+			expected:     []expectedNamespace{{"UNAGG", 10 * day, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := now.Add(-1 * tt.queryLength)
+			fanoutType, namespaces, err := resolveClusterNamespacesForQueryWithStep(
+				now, start, now, tt.step, clusters, tt.opts, tt.restrict)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedType, fanoutType)
+
+			require.Equal(t, len(tt.expected), len(namespaces))
+			for i, expected := range tt.expected {
+				actual := namespaces[i]
+				assert.Equal(t, expected.name, actual.NamespaceID().String())
+				assert.True(t, now.Add(-1*expected.start).Equal(actual.start))
+				assert.True(t, now.Add(-1*expected.end).Equal(actual.end))
+			}
+		})
+	}
+}
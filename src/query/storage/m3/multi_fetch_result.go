@@ -230,6 +230,20 @@ func (r *multiResult) addOrUpdateDedupeMap(
 				existing.attrs.Retention == attrs.Retention &&
 					existing.attrs.Resolution <= attrs.Resolution
 			existsBetter = existsLongerRetention || existsSameRetentionEqualOrBetterResolution
+		case namespacesStitchedAcrossQueryRange:
+			// Each namespace serves a distinct portion of the query range, so
+			// stitch the results together rather than choosing between them,
+			// attributing the series to the coarsest resolution it contains.
+			stitchedAttrs := existing.attrs
+			if attrs.Resolution > stitchedAttrs.Resolution {
+				stitchedAttrs = attrs
+			}
+
+			r.dedupeMap[id] = multiResultSeries{
+				attrs: stitchedAttrs,
+				iter:  newStitchedSeriesIter(existing.iter, iter),
+			}
+			continue
 		default:
 			r.err = r.err.Add(fmt.Errorf("unknown query fanout type: %d", r.fanout))
 			return
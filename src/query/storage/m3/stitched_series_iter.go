@@ -0,0 +1,138 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// stitchedSeriesIter iterates in turn over series iterators for the same
+// series fetched from namespaces serving adjacent, non-overlapping portions
+// of the query range.
+//
+// NB: the stitched iterators are owned by the fetch results they were
+// retrieved from, as such closing a stitched iterator does not close them.
+type stitchedSeriesIter struct {
+	iters []encoding.SeriesIterator
+	idx   int
+	err   error
+}
+
+var _ encoding.SeriesIterator = (*stitchedSeriesIter)(nil)
+
+// newStitchedSeriesIter stitches two series iterators, flattening any
+// iterators that are themselves stitched, ordered by start time.
+func newStitchedSeriesIter(a, b encoding.SeriesIterator) *stitchedSeriesIter {
+	var iters []encoding.SeriesIterator
+	for _, iter := range []encoding.SeriesIterator{a, b} {
+		if stitched, ok := iter.(*stitchedSeriesIter); ok {
+			iters = append(iters, stitched.iters...)
+			continue
+		}
+
+		iters = append(iters, iter)
+	}
+
+	sort.SliceStable(iters, func(i, j int) bool {
+		return iters[i].Start().Before(iters[j].Start())
+	})
+
+	return &stitchedSeriesIter{iters: iters}
+}
+
+func (it *stitchedSeriesIter) Next() bool {
+	for it.err == nil && it.idx < len(it.iters) {
+		iter := it.iters[it.idx]
+		if iter.Next() {
+			return true
+		}
+
+		if err := iter.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		it.idx++
+	}
+
+	return false
+}
+
+func (it *stitchedSeriesIter) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	return it.iters[it.idx].Current()
+}
+
+func (it *stitchedSeriesIter) Err() error {
+	return it.err
+}
+
+func (it *stitchedSeriesIter) Close() {
+	it.iters = nil
+	it.idx = 0
+	it.err = nil
+}
+
+func (it *stitchedSeriesIter) ID() ident.ID {
+	return it.iters[0].ID()
+}
+
+func (it *stitchedSeriesIter) Namespace() ident.ID {
+	return it.iters[0].Namespace()
+}
+
+func (it *stitchedSeriesIter) Tags() ident.TagIterator {
+	return it.iters[0].Tags()
+}
+
+func (it *stitchedSeriesIter) Start() time.Time {
+	return it.iters[0].Start()
+}
+
+func (it *stitchedSeriesIter) End() time.Time {
+	return it.iters[len(it.iters)-1].End()
+}
+
+// Reset is a no-op, stitched iterators do not own the underlying iterators
+// and as such cannot reset them.
+func (it *stitchedSeriesIter) Reset(_ encoding.SeriesIteratorOptions) {}
+
+func (it *stitchedSeriesIter) SetIterateEqualTimestampStrategy(
+	strategy encoding.IterateEqualTimestampStrategy,
+) {
+	for _, iter := range it.iters {
+		iter.SetIterateEqualTimestampStrategy(strategy)
+	}
+}
+
+func (it *stitchedSeriesIter) Replicas() []encoding.MultiReaderIterator {
+	var replicas []encoding.MultiReaderIterator
+	for _, iter := range it.iters {
+		replicas = append(replicas, iter.Replicas()...)
+	}
+
+	return replicas
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStitchTestIter(
+	ctrl *gomock.Controller,
+	start, end time.Time,
+	values ...float64,
+) encoding.SeriesIterator {
+	iter := encoding.NewMockSeriesIterator(ctrl)
+	iter.EXPECT().ID().Return(ident.StringID("foo")).AnyTimes()
+	iter.EXPECT().Start().Return(start).AnyTimes()
+	iter.EXPECT().End().Return(end).AnyTimes()
+
+	for i, v := range values {
+		dp := ts.Datapoint{
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+			Value:     v,
+		}
+		iter.EXPECT().Next().Return(true)
+		iter.EXPECT().Current().Return(dp, xtime.Second, nil)
+	}
+
+	iter.EXPECT().Next().Return(false)
+	iter.EXPECT().Err().Return(nil)
+	return iter
+}
+
+func TestStitchedSeriesIter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().Truncate(time.Hour)
+	first := newStitchTestIter(ctrl, now.Add(-2*time.Hour), now.Add(-time.Hour), 1, 2)
+	second := newStitchTestIter(ctrl, now.Add(-time.Hour), now, 3)
+	third := newStitchTestIter(ctrl, now, now.Add(time.Hour), 4, 5)
+
+	// Stitch out of order to ensure iterators are ordered by start.
+	iter := newStitchedSeriesIter(third, newStitchedSeriesIter(second, first))
+	assert.Equal(t, "foo", iter.ID().String())
+	assert.Equal(t, now.Add(-2*time.Hour), iter.Start())
+	assert.Equal(t, now.Add(time.Hour), iter.End())
+
+	var values []float64
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		values = append(values, dp.Value)
+	}
+
+	require.NoError(t, iter.Err())
+	assert.Equal(t, []float64{1, 2, 3, 4, 5}, values)
+
+	// NB: stitched iterators do not own the underlying iterators.
+	iter.Close()
+}
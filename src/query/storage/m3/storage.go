@@ -28,9 +28,11 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/explain"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
@@ -56,6 +58,7 @@ const (
 	namespaceInvalid queryFanoutType = iota
 	namespaceCoversAllQueryRange
 	namespaceCoversPartialQueryRange
+	namespacesStitchedAcrossQueryRange
 )
 
 func (t queryFanoutType) String() string {
@@ -64,6 +67,8 @@ func (t queryFanoutType) String() string {
 		return "coversAllQueryRange"
 	case namespaceCoversPartialQueryRange:
 		return "coversPartialQueryRange"
+	case namespacesStitchedAcrossQueryRange:
+		return "stitchedAcrossQueryRange"
 	default:
 		return "unknown"
 	}
@@ -170,6 +175,8 @@ func (s *m3storage) Fetch(
 		return nil, errMismatchedFetchedLength
 	}
 
+	fetchResult.Metadata.FetchedSeriesCount = len(fetchResult.SeriesList)
+
 	if options.IncludeResolution {
 		resolutions := make([]int64, 0, len(fetchResult.SeriesList))
 		for _, attr := range attrs {
@@ -277,6 +284,10 @@ func (s *m3storage) FetchCompressed(
 		return result, noop, err
 	}
 
+	if result.SeriesIterators != nil {
+		result.Metadata.FetchedSeriesCount = result.SeriesIterators.Len()
+	}
+
 	if options.IncludeResolution {
 		resolutions := make([]int64, 0, len(attrs))
 		for _, attr := range attrs {
@@ -311,10 +322,13 @@ func (s *m3storage) fetchCompressed(
 	// cluster that can completely fulfill this range and then prefer the
 	// highest resolution (most fine grained) results.
 	// This needs to be optimized, however this is a start.
-	fanout, namespaces, err := resolveClusterNamespacesForQuery(
+	// If the query step is known, the coarsest namespaces that satisfy the
+	// step are selected and results are stitched at retention boundaries.
+	fanout, namespaces, err := resolveClusterNamespacesForQueryWithStep(
 		s.nowFn(),
 		query.Start,
 		query.End,
+		query.Interval,
 		s.clusters,
 		options.FanoutOptions,
 		options.RestrictQueryOptions,
@@ -331,6 +345,8 @@ func (s *m3storage) fetchCompressed(
 				zap.String("m3query", m3query.String()),
 				zap.Time("start", query.Start),
 				zap.Time("end", query.End),
+				zap.Time("namespaceStart", n.start),
+				zap.Time("namespaceEnd", n.end),
 				zap.String("fanoutType", fanout.String()),
 				zap.String("namespace", n.NamespaceID().String()),
 				zap.String("type", n.Options().Attributes().MetricsType.String()),
@@ -341,10 +357,7 @@ func (s *m3storage) fetchCompressed(
 		}
 	}
 
-	var (
-		opts = storage.FetchOptionsToM3Options(options, query)
-		wg   sync.WaitGroup
-	)
+	var wg sync.WaitGroup
 	if len(namespaces) == 0 {
 		return nil, errNoNamespacesConfigured
 	}
@@ -354,6 +367,7 @@ func (s *m3storage) fetchCompressed(
 		return nil, fmt.Errorf("unable to retrieve iterator pools: %v", err)
 	}
 
+	explanation, explaining := explain.FromContext(ctx)
 	result := newMultiFetchResult(fanout, pools)
 	for _, namespace := range namespaces {
 		namespace := namespace // Capture var
@@ -361,9 +375,25 @@ func (s *m3storage) fetchCompressed(
 		go func() {
 			session := namespace.Session()
 			ns := namespace.NamespaceID()
-			iters, exhaustive, err := session.FetchTagged(ns, m3query, opts)
+			opts := storage.FetchOptionsToM3Options(options, query)
+			opts.StartInclusive = namespace.start
+			opts.EndExclusive = namespace.end
+			start := time.Now()
+			iters, exhaustive, err := client.FetchTaggedWithContext(ctx, session,
+				ns, m3query, opts)
+			if explaining {
+				explanation.RecordFetch(explainFetch(namespace.ClusterNamespace, fanout,
+					iters, exhaustive, time.Since(start), err))
+			}
+
 			meta := block.NewResultMetadata()
 			meta.Exhaustive = exhaustive
+			meta.Namespaces = []block.NamespaceRange{{
+				Namespace:  ns.String(),
+				Resolution: namespace.Options().Attributes().Resolution,
+				Start:      namespace.start,
+				End:        namespace.end,
+			}}
 			fetchResult := SeriesFetchResult{
 				SeriesIterators: iters,
 				Metadata:        meta,
@@ -388,6 +418,36 @@ func (s *m3storage) fetchCompressed(
 	return result, err
 }
 
+func explainFetch(
+	namespace ClusterNamespace,
+	fanout queryFanoutType,
+	iters encoding.SeriesIterators,
+	exhaustive bool,
+	duration time.Duration,
+	err error,
+) explain.Fetch {
+	attrs := namespace.Options().Attributes()
+	fetch := explain.Fetch{
+		Namespace:   namespace.NamespaceID().String(),
+		MetricsType: attrs.MetricsType.String(),
+		Retention:   explain.Duration(attrs.Retention),
+		Resolution:  explain.Duration(attrs.Resolution),
+		FanoutType:  fanout.String(),
+		Exhaustive:  exhaustive,
+		WallTime:    explain.Duration(duration),
+	}
+
+	if iters != nil {
+		fetch.Series = iters.Len()
+	}
+
+	if err != nil {
+		fetch.Error = err.Error()
+	}
+
+	return fetch
+}
+
 func (s *m3storage) SearchSeries(
 	ctx context.Context,
 	query *storage.FetchQuery,
@@ -601,7 +661,8 @@ func (s *m3storage) SearchCompressed(
 		go func() {
 			session := namespace.Session()
 			namespaceID := namespace.NamespaceID()
-			iter, exhaustive, err := session.FetchTaggedIDs(namespaceID, m3query, m3opts)
+			iter, exhaustive, err := client.FetchTaggedIDsWithContext(ctx, session,
+				namespaceID, m3query, m3opts)
 			meta := block.NewResultMetadata()
 			meta.Exhaustive = exhaustive
 			result.Add(iter, meta, err)
@@ -30,6 +30,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/explain"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/test/seriesiter"
@@ -334,6 +335,46 @@ func TestLocalReadExceedsUnaggregatedRetentionWithinAggregatedRetention(t *testi
 	assertFetchResult(t, results, testTag)
 }
 
+func TestLocalReadExplain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store, sessions := setup(t, ctrl)
+	testTag := seriesiter.GenerateTag()
+
+	session := sessions.aggregated3MonthRetention5MinuteResolution
+	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(seriesiter.NewMockSeriesIters(ctrl, testTag, 1, 2), true, nil)
+	session.EXPECT().IteratorPools().Return(newTestIteratorPools(ctrl), nil).AnyTimes()
+
+	session = sessions.aggregatedPartial6MonthRetention1MinuteResolution
+	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(encoding.EmptySeriesIterators, true, nil)
+	session.EXPECT().IteratorPools().Return(newTestIteratorPools(ctrl), nil).AnyTimes()
+
+	explanation := explain.New()
+	ctx := explain.NewContext(context.TODO(), explanation)
+	searchReq := newFetchReq()
+	searchReq.Start = time.Now().Add(-2 * test1MonthRetention)
+	searchReq.End = time.Now()
+	results, err := store.Fetch(ctx, searchReq, buildFetchOpts())
+	require.NoError(t, err)
+	assertFetchResult(t, results, testTag)
+
+	fetches := explanation.Report().Fetches
+	require.Equal(t, 2, len(fetches))
+
+	assert.Equal(t, "metrics_aggregated_5m:90d", fetches[0].Namespace)
+	assert.Equal(t, storage.AggregatedMetricsType.String(), fetches[0].MetricsType)
+	assert.Equal(t, explain.Duration(5*time.Minute), fetches[0].Resolution)
+	assert.Equal(t, explain.Duration(test3MonthRetention), fetches[0].Retention)
+	assert.Equal(t, 1, fetches[0].Series)
+	assert.True(t, fetches[0].Exhaustive)
+
+	assert.Equal(t, "metrics_aggregated_partial_1m:180d", fetches[1].Namespace)
+	assert.Equal(t, 0, fetches[1].Series)
+	assert.Empty(t, fetches[1].Error)
+}
+
 func TestLocalReadExceedsAggregatedButNotUnaggregatedAndPartialAggregated(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
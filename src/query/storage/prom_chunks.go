@@ -0,0 +1,152 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	xcost "github.com/m3db/m3/src/x/cost"
+
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+)
+
+const (
+	// maxSamplesPerPromChunk is the maximum number of samples encoded in a
+	// single XOR chunk, matching the chunk size used by Prometheus.
+	maxSamplesPerPromChunk = 120
+)
+
+// SeriesIteratorToPromChunkedSeries decodes the M3TSZ encoded datapoints of a
+// series iterator and re-encodes them as Prometheus XOR chunks.
+func SeriesIteratorToPromChunkedSeries(
+	iter encoding.SeriesIterator,
+	enforcer cost.ChainedEnforcer,
+) (*prompb.ChunkedSeries, error) {
+	labels, err := tagIteratorToLabels(iter.Tags())
+	if err != nil {
+		return nil, err
+	}
+
+	var builder promChunksBuilder
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		err := builder.append(TimeToPromTimestamp(dp.Timestamp), dp.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	r := enforcer.Add(xcost.Cost(builder.numSamples))
+	if r.Error != nil {
+		return nil, r.Error
+	}
+
+	return &prompb.ChunkedSeries{
+		Labels: sortLabels(labels),
+		Chunks: builder.build(),
+	}, nil
+}
+
+// PromTimeSeriesToChunkedSeries encodes the samples of a Prometheus time
+// series as Prometheus XOR chunks.
+func PromTimeSeriesToChunkedSeries(
+	series *prompb.TimeSeries,
+) (*prompb.ChunkedSeries, error) {
+	var builder promChunksBuilder
+	for _, sample := range series.GetSamples() {
+		if err := builder.append(sample.Timestamp, sample.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return &prompb.ChunkedSeries{
+		Labels: sortLabels(series.GetLabels()),
+		Chunks: builder.build(),
+	}, nil
+}
+
+func sortLabels(labels []prompb.Label) []prompb.Label {
+	sort.Slice(labels, func(i, j int) bool {
+		return bytes.Compare(labels[i].Name, labels[j].Name) < 0
+	})
+
+	return labels
+}
+
+// promChunksBuilder splits samples into XOR chunks of bounded size.
+type promChunksBuilder struct {
+	chunks     []prompb.Chunk
+	chunk      *chunkenc.XORChunk
+	appender   chunkenc.Appender
+	minTime    int64
+	maxTime    int64
+	numSamples int
+}
+
+func (b *promChunksBuilder) append(t int64, v float64) error {
+	if b.chunk == nil {
+		chunk := chunkenc.NewXORChunk()
+		appender, err := chunk.Appender()
+		if err != nil {
+			return err
+		}
+
+		b.chunk = chunk
+		b.appender = appender
+		b.minTime = t
+	}
+
+	b.appender.Append(t, v)
+	b.maxTime = t
+	b.numSamples++
+	if b.chunk.NumSamples() == maxSamplesPerPromChunk {
+		b.cutChunk()
+	}
+
+	return nil
+}
+
+func (b *promChunksBuilder) cutChunk() {
+	b.chunks = append(b.chunks, prompb.Chunk{
+		MinTimeMs: b.minTime,
+		MaxTimeMs: b.maxTime,
+		Type:      prompb.Chunk_XOR,
+		Data:      b.chunk.Bytes(),
+	})
+	b.chunk = nil
+	b.appender = nil
+}
+
+func (b *promChunksBuilder) build() []prompb.Chunk {
+	if b.chunk != nil {
+		b.cutChunk()
+	}
+
+	return b.chunks
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeXORChunk decodes an XOR chunk using the Prometheus XOR chunk
+// decoding, to verify encoded chunks.
+func decodeXORChunk(t *testing.T, data []byte) []prompb.Sample {
+	chunk, err := chunkenc.FromData(chunkenc.EncXOR, data)
+	require.NoError(t, err)
+
+	samples := make([]prompb.Sample, 0, chunk.NumSamples())
+	iter := chunk.Iterator(nil)
+	for iter.Next() {
+		timestamp, value := iter.At()
+		samples = append(samples, prompb.Sample{
+			Timestamp: timestamp,
+			Value:     value,
+		})
+	}
+
+	require.NoError(t, iter.Err())
+	return samples
+}
+
+func decodeChunkedSeries(
+	t *testing.T,
+	series *prompb.ChunkedSeries,
+) []prompb.Sample {
+	var samples []prompb.Sample
+	for _, chunk := range series.Chunks {
+		require.Equal(t, prompb.Chunk_XOR, chunk.Type)
+		decoded := decodeXORChunk(t, chunk.Data)
+		require.True(t, len(decoded) > 0)
+		assert.Equal(t, chunk.MinTimeMs, decoded[0].Timestamp)
+		assert.Equal(t, chunk.MaxTimeMs, decoded[len(decoded)-1].Timestamp)
+		samples = append(samples, decoded...)
+	}
+
+	return samples
+}
+
+func TestPromTimeSeriesToChunkedSeries(t *testing.T) {
+	var (
+		samples = make([]prompb.Sample, 0, 300)
+		start   = int64(1570000000000)
+		curr    = start
+	)
+	for i := 0; i < 300; i++ {
+		// Mix regular and irregular intervals, and constant and varying values
+		// to exercise each of the timestamp and value encodings.
+		switch {
+		case i%50 == 0:
+			curr += 10000000
+		case i%7 == 0:
+			curr += 15000 + int64(i)
+		default:
+			curr += 15000
+		}
+
+		v := float64(i / 3)
+		if i%11 == 0 {
+			v = math.Pi * float64(i)
+		}
+
+		samples = append(samples, prompb.Sample{Timestamp: curr, Value: v})
+	}
+
+	series := &prompb.TimeSeries{
+		Labels: []prompb.Label{
+			{Name: []byte("foo"), Value: []byte("bar")},
+			{Name: []byte("__name__"), Value: []byte("baz")},
+		},
+		Samples: samples,
+	}
+
+	chunked, err := PromTimeSeriesToChunkedSeries(series)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(chunked.Chunks))
+	assert.Equal(t, []prompb.Label{
+		{Name: []byte("__name__"), Value: []byte("baz")},
+		{Name: []byte("foo"), Value: []byte("bar")},
+	}, chunked.Labels)
+
+	assert.Equal(t, samples, decodeChunkedSeries(t, chunked))
+}
+
+func TestSeriesIteratorToPromChunkedSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now().Truncate(time.Second)
+	iter := encoding.NewMockSeriesIterator(ctrl)
+	iter.EXPECT().Tags().Return(ident.NewTagsIterator(ident.NewTags(
+		ident.StringTag("foo", "bar"))))
+
+	expected := make([]prompb.Sample, 0, 3)
+	for i := 0; i < 3; i++ {
+		dp := ts.Datapoint{
+			Timestamp: now.Add(time.Duration(i) * time.Second),
+			Value:     float64(i),
+		}
+		expected = append(expected, prompb.Sample{
+			Timestamp: TimeToPromTimestamp(dp.Timestamp),
+			Value:     dp.Value,
+		})
+		iter.EXPECT().Next().Return(true)
+		iter.EXPECT().Current().Return(dp, xtime.Second, nil)
+	}
+
+	iter.EXPECT().Next().Return(false)
+	iter.EXPECT().Err().Return(nil)
+
+	chunked, err := SeriesIteratorToPromChunkedSeries(iter,
+		cost.NoopChainedEnforcer())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(chunked.Labels))
+	assert.True(t, bytes.Equal([]byte("foo"), chunked.Labels[0].Name))
+	require.Equal(t, 1, len(chunked.Chunks))
+	assert.Equal(t, expected, decodeChunkedSeries(t, chunked))
+}
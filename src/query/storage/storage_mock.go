@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/m3db/m3/src/query/storage (interfaces: Storage)
+// Source: github.com/m3db/m3/src/query/storage (interfaces: AggregateSeriesStorage,Storage)
 
 // Copyright (c) 2019 Uber Technologies, Inc.
 //
@@ -33,6 +33,58 @@ import (
 	"github.com/golang/mock/gomock"
 )
 
+// MockAggregateSeriesStorage is a mock of AggregateSeriesStorage interface
+type MockAggregateSeriesStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockAggregateSeriesStorageMockRecorder
+}
+
+// MockAggregateSeriesStorageMockRecorder is the mock recorder for MockAggregateSeriesStorage
+type MockAggregateSeriesStorageMockRecorder struct {
+	mock *MockAggregateSeriesStorage
+}
+
+// NewMockAggregateSeriesStorage creates a new mock instance
+func NewMockAggregateSeriesStorage(ctrl *gomock.Controller) *MockAggregateSeriesStorage {
+	mock := &MockAggregateSeriesStorage{ctrl: ctrl}
+	mock.recorder = &MockAggregateSeriesStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAggregateSeriesStorage) EXPECT() *MockAggregateSeriesStorageMockRecorder {
+	return m.recorder
+}
+
+// AggregateSeries mocks base method
+func (m *MockAggregateSeriesStorage) AggregateSeries(arg0 context.Context, arg1 *AggregateSeriesQuery, arg2 *FetchOptions) (AggregateSeriesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateSeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].(AggregateSeriesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateSeries indicates an expected call of AggregateSeries
+func (mr *MockAggregateSeriesStorageMockRecorder) AggregateSeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateSeries", reflect.TypeOf((*MockAggregateSeriesStorage)(nil).AggregateSeries), arg0, arg1, arg2)
+}
+
+// AggregateSeriesCapabilities mocks base method
+func (m *MockAggregateSeriesStorage) AggregateSeriesCapabilities(arg0 *FetchQuery, arg1 *FetchOptions) AggregateSeriesCapabilities {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateSeriesCapabilities", arg0, arg1)
+	ret0, _ := ret[0].(AggregateSeriesCapabilities)
+	return ret0
+}
+
+// AggregateSeriesCapabilities indicates an expected call of AggregateSeriesCapabilities
+func (mr *MockAggregateSeriesStorageMockRecorder) AggregateSeriesCapabilities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateSeriesCapabilities", reflect.TypeOf((*MockAggregateSeriesStorage)(nil).AggregateSeriesCapabilities), arg0, arg1)
+}
+
 // MockStorage is a mock of Storage interface
 type MockStorage struct {
 	ctrl     *gomock.Controller
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/cost"
@@ -127,6 +128,8 @@ type FetchOptions struct {
 	// IncludeResolution if set, appends resolution information to fetch results.
 	// Currently only used for graphite queries.
 	IncludeResolution bool
+	// Source is the user or tenant that issued the fetch, if known.
+	Source []byte
 }
 
 // FanoutOptions describes which namespaces should be fanned out to for
@@ -373,6 +376,76 @@ type CompleteTagsResultBuilder interface {
 	Build() CompleteTagsResult
 }
 
+// AggregateSeriesQuery represents a query that evaluates a temporal function
+// followed by a grouping aggregation within the storage rather than fetching
+// the matching series.
+type AggregateSeriesQuery struct {
+	FetchQuery
+	// Range is the duration of the window the temporal function is
+	// evaluated over.
+	Range time.Duration
+	// TemporalFunction is the function applied to each series.
+	TemporalFunction seriesagg.TemporalFunction
+	// Aggregation is the aggregation applied across series of a group.
+	Aggregation seriesagg.Aggregation
+	// GroupBy is the set of tag names series are grouped by.
+	GroupBy [][]byte
+}
+
+// AggregateSeriesCapabilities describes whether and how a storage is able
+// to evaluate an aggregate series query.
+type AggregateSeriesCapabilities struct {
+	// Enabled is set if the storage can evaluate the query.
+	Enabled bool
+	// ShardPartitioned is set if every series is read from exactly one
+	// replica, making aggregations that are not idempotent such as sum and
+	// count safe to evaluate.
+	ShardPartitioned bool
+}
+
+// AggregateSeriesResult is the result of an aggregate series query.
+type AggregateSeriesResult struct {
+	// Series are the aggregated series, one for each group.
+	Series []AggregatedSeries
+	// Metadata describes any metadata for the operation.
+	Metadata block.ResultMetadata
+}
+
+// AggregatedSeries is a series resulting from an aggregate series query.
+type AggregatedSeries struct {
+	// Tags are the group by tags of the series.
+	Tags models.Tags
+	// Values are the aggregated values, one for each step.
+	Values []float64
+}
+
+// AggregateSeriesStorage is implemented by storages that are able to evaluate
+// aggregations in place of the query engine.
+type AggregateSeriesStorage interface {
+	// AggregateSeriesCapabilities returns whether the storage can evaluate an
+	// aggregate series query for the given fetch.
+	AggregateSeriesCapabilities(
+		query *FetchQuery,
+		options *FetchOptions,
+	) AggregateSeriesCapabilities
+
+	// AggregateSeries evaluates an aggregate series query.
+	AggregateSeries(
+		ctx context.Context,
+		query *AggregateSeriesQuery,
+		options *FetchOptions,
+	) (AggregateSeriesResult, error)
+}
+
+// SingleStoreStorage is implemented by storages that delegate to other
+// storages, such as the fanout storage, allowing callers to use the
+// capabilities of the underlying store serving a query.
+type SingleStoreStorage interface {
+	// SingleStore returns the store a fetch query is served by, which is
+	// only possible when the query is served by exactly one store.
+	SingleStore(query *FetchQuery) (Storage, bool)
+}
+
 // Appender provides batched appends against a storage.
 type Appender interface {
 	// Write writes a batched set of datapoints to storage based on the provided
@@ -21,6 +21,7 @@
 package m3db
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -29,6 +30,8 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/storage/seriesagg"
+	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/x/ident"
 	xtime "github.com/m3db/m3/src/x/time"
 )
@@ -167,6 +170,34 @@ func (s *AsyncSession) FetchTaggedIDs(namespace ident.ID, q index.Query,
 	return s.session.FetchTaggedIDs(namespace, q, opts)
 }
 
+// FetchTaggedWithContext resolves the provided query to known IDs, and
+// fetches the data for them, cancelling the fetch once the context is done.
+func (s *AsyncSession) FetchTaggedWithContext(ctx context.Context,
+	namespace ident.ID, q index.Query, opts index.QueryOptions,
+) (encoding.SeriesIterators, bool, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, false, s.err
+	}
+
+	return client.FetchTaggedWithContext(ctx, s.session, namespace, q, opts)
+}
+
+// FetchTaggedIDsWithContext resolves the provided query to known IDs,
+// cancelling the fetch once the context is done.
+func (s *AsyncSession) FetchTaggedIDsWithContext(ctx context.Context,
+	namespace ident.ID, q index.Query, opts index.QueryOptions,
+) (client.TaggedIDsIterator, bool, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, false, s.err
+	}
+
+	return client.FetchTaggedIDsWithContext(ctx, s.session, namespace, q, opts)
+}
+
 // Aggregate aggregates values from the database for the given set of constraints.
 func (s *AsyncSession) Aggregate(namespace ident.ID, q index.Query, opts index.AggregationOptions) (client.AggregatedTagsIterator, bool, error) {
 	s.RLock()
@@ -178,6 +209,31 @@ func (s *AsyncSession) Aggregate(namespace ident.ID, q index.Query, opts index.A
 	return s.session.Aggregate(namespace, q, opts)
 }
 
+// AggregateSeries evaluates a temporal function followed by a grouping
+// aggregation over the series matching the query on the nodes that own them.
+func (s *AsyncSession) AggregateSeries(ctx context.Context, namespace ident.ID,
+	q index.Query, opts seriesagg.Options) ([][]seriesagg.Group, bool, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, false, s.err
+	}
+
+	return s.session.AggregateSeries(ctx, namespace, q, opts)
+}
+
+// ReadConsistencyLevel returns the current read consistency level of the
+// session, or none while the session is uninitialized.
+func (s *AsyncSession) ReadConsistencyLevel() topology.ReadConsistencyLevel {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return topology.ReadConsistencyLevelNone
+	}
+
+	return s.session.ReadConsistencyLevel()
+}
+
 // ShardID returns the given shard for an ID for callers
 // to easily discern what shard is failing when operations
 // for given IDs begin failing.